@@ -21,6 +21,13 @@ type AdminServiceClient interface {
 	GetScheduledSweep(ctx context.Context, in *GetScheduledSweepRequest, opts ...grpc.CallOption) (*GetScheduledSweepResponse, error)
 	GetRoundDetails(ctx context.Context, in *GetRoundDetailsRequest, opts ...grpc.CallOption) (*GetRoundDetailsResponse, error)
 	GetRounds(ctx context.Context, in *GetRoundsRequest, opts ...grpc.CallOption) (*GetRoundsResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
+	LiftBan(ctx context.Context, in *LiftBanRequest, opts ...grpc.CallOption) (*LiftBanResponse, error)
+	ListPendingTxs(ctx context.Context, in *ListPendingTxsRequest, opts ...grpc.CallOption) (*ListPendingTxsResponse, error)
+	BumpTxFee(ctx context.Context, in *BumpTxFeeRequest, opts ...grpc.CallOption) (*BumpTxFeeResponse, error)
+	GetLiquidityForecast(ctx context.Context, in *GetLiquidityForecastRequest, opts ...grpc.CallOption) (*GetLiquidityForecastResponse, error)
+	GetConnectorsRelease(ctx context.Context, in *GetConnectorsReleaseRequest, opts ...grpc.CallOption) (*GetConnectorsReleaseResponse, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
 }
 
 type adminServiceClient struct {
@@ -58,6 +65,69 @@ func (c *adminServiceClient) GetRounds(ctx context.Context, in *GetRoundsRequest
 	return out, nil
 }
 
+func (c *adminServiceClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/ListBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) LiftBan(ctx context.Context, in *LiftBanRequest, opts ...grpc.CallOption) (*LiftBanResponse, error) {
+	out := new(LiftBanResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/LiftBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPendingTxs(ctx context.Context, in *ListPendingTxsRequest, opts ...grpc.CallOption) (*ListPendingTxsResponse, error) {
+	out := new(ListPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/ListPendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BumpTxFee(ctx context.Context, in *BumpTxFeeRequest, opts ...grpc.CallOption) (*BumpTxFeeResponse, error) {
+	out := new(BumpTxFeeResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/BumpTxFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetLiquidityForecast(ctx context.Context, in *GetLiquidityForecastRequest, opts ...grpc.CallOption) (*GetLiquidityForecastResponse, error) {
+	out := new(GetLiquidityForecastResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/GetLiquidityForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetConnectorsRelease(ctx context.Context, in *GetConnectorsReleaseRequest, opts ...grpc.CallOption) (*GetConnectorsReleaseResponse, error) {
+	out := new(GetConnectorsReleaseResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/GetConnectorsRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.AdminService/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations should embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -65,6 +135,13 @@ type AdminServiceServer interface {
 	GetScheduledSweep(context.Context, *GetScheduledSweepRequest) (*GetScheduledSweepResponse, error)
 	GetRoundDetails(context.Context, *GetRoundDetailsRequest) (*GetRoundDetailsResponse, error)
 	GetRounds(context.Context, *GetRoundsRequest) (*GetRoundsResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+	LiftBan(context.Context, *LiftBanRequest) (*LiftBanResponse, error)
+	ListPendingTxs(context.Context, *ListPendingTxsRequest) (*ListPendingTxsResponse, error)
+	BumpTxFee(context.Context, *BumpTxFeeRequest) (*BumpTxFeeResponse, error)
+	GetLiquidityForecast(context.Context, *GetLiquidityForecastRequest) (*GetLiquidityForecastResponse, error)
+	GetConnectorsRelease(context.Context, *GetConnectorsReleaseRequest) (*GetConnectorsReleaseResponse, error)
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
 }
 
 // UnimplementedAdminServiceServer should be embedded to have forward compatible implementations.
@@ -80,6 +157,27 @@ func (UnimplementedAdminServiceServer) GetRoundDetails(context.Context, *GetRoun
 func (UnimplementedAdminServiceServer) GetRounds(context.Context, *GetRoundsRequest) (*GetRoundsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRounds not implemented")
 }
+func (UnimplementedAdminServiceServer) ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBans not implemented")
+}
+func (UnimplementedAdminServiceServer) LiftBan(context.Context, *LiftBanRequest) (*LiftBanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftBan not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPendingTxs(context.Context, *ListPendingTxsRequest) (*ListPendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTxs not implemented")
+}
+func (UnimplementedAdminServiceServer) BumpTxFee(context.Context, *BumpTxFeeRequest) (*BumpTxFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpTxFee not implemented")
+}
+func (UnimplementedAdminServiceServer) GetLiquidityForecast(context.Context, *GetLiquidityForecastRequest) (*GetLiquidityForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityForecast not implemented")
+}
+func (UnimplementedAdminServiceServer) GetConnectorsRelease(context.Context, *GetConnectorsReleaseRequest) (*GetConnectorsReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectorsRelease not implemented")
+}
+func (UnimplementedAdminServiceServer) Backup(context.Context, *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AdminServiceServer will
@@ -146,6 +244,132 @@ func _AdminService_GetRounds_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/ListBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_LiftBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).LiftBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/LiftBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).LiftBan(ctx, req.(*LiftBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/ListPendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPendingTxs(ctx, req.(*ListPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BumpTxFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpTxFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BumpTxFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/BumpTxFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BumpTxFee(ctx, req.(*BumpTxFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetLiquidityForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidityForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetLiquidityForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/GetLiquidityForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetLiquidityForecast(ctx, req.(*GetLiquidityForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetConnectorsRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectorsReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetConnectorsRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/GetConnectorsRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetConnectorsRelease(ctx, req.(*GetConnectorsReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.AdminService/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Backup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -165,6 +389,34 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRounds",
 			Handler:    _AdminService_GetRounds_Handler,
 		},
+		{
+			MethodName: "ListBans",
+			Handler:    _AdminService_ListBans_Handler,
+		},
+		{
+			MethodName: "LiftBan",
+			Handler:    _AdminService_LiftBan_Handler,
+		},
+		{
+			MethodName: "ListPendingTxs",
+			Handler:    _AdminService_ListPendingTxs_Handler,
+		},
+		{
+			MethodName: "BumpTxFee",
+			Handler:    _AdminService_BumpTxFee_Handler,
+		},
+		{
+			MethodName: "GetLiquidityForecast",
+			Handler:    _AdminService_GetLiquidityForecast_Handler,
+		},
+		{
+			MethodName: "GetConnectorsRelease",
+			Handler:    _AdminService_GetConnectorsRelease_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _AdminService_Backup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ark/v1/admin.proto",
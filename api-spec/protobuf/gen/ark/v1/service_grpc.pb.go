@@ -19,9 +19,11 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ArkServiceClient interface {
 	RegisterPayment(ctx context.Context, in *RegisterPaymentRequest, opts ...grpc.CallOption) (*RegisterPaymentResponse, error)
+	GetPaymentNonce(ctx context.Context, in *GetPaymentNonceRequest, opts ...grpc.CallOption) (*GetPaymentNonceResponse, error)
 	ClaimPayment(ctx context.Context, in *ClaimPaymentRequest, opts ...grpc.CallOption) (*ClaimPaymentResponse, error)
 	FinalizePayment(ctx context.Context, in *FinalizePaymentRequest, opts ...grpc.CallOption) (*FinalizePaymentResponse, error)
-	// TODO BTC: signTree rpc
+	SendTreeNonces(ctx context.Context, in *SendTreeNoncesRequest, opts ...grpc.CallOption) (*SendTreeNoncesResponse, error)
+	SendTreeSignatures(ctx context.Context, in *SendTreeSignaturesRequest, opts ...grpc.CallOption) (*SendTreeSignaturesResponse, error)
 	GetRound(ctx context.Context, in *GetRoundRequest, opts ...grpc.CallOption) (*GetRoundResponse, error)
 	GetRoundById(ctx context.Context, in *GetRoundByIdRequest, opts ...grpc.CallOption) (*GetRoundByIdResponse, error)
 	GetEventStream(ctx context.Context, in *GetEventStreamRequest, opts ...grpc.CallOption) (ArkService_GetEventStreamClient, error)
@@ -50,6 +52,15 @@ func (c *arkServiceClient) RegisterPayment(ctx context.Context, in *RegisterPaym
 	return out, nil
 }
 
+func (c *arkServiceClient) GetPaymentNonce(ctx context.Context, in *GetPaymentNonceRequest, opts ...grpc.CallOption) (*GetPaymentNonceResponse, error) {
+	out := new(GetPaymentNonceResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.ArkService/GetPaymentNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arkServiceClient) ClaimPayment(ctx context.Context, in *ClaimPaymentRequest, opts ...grpc.CallOption) (*ClaimPaymentResponse, error) {
 	out := new(ClaimPaymentResponse)
 	err := c.cc.Invoke(ctx, "/ark.v1.ArkService/ClaimPayment", in, out, opts...)
@@ -68,6 +79,24 @@ func (c *arkServiceClient) FinalizePayment(ctx context.Context, in *FinalizePaym
 	return out, nil
 }
 
+func (c *arkServiceClient) SendTreeNonces(ctx context.Context, in *SendTreeNoncesRequest, opts ...grpc.CallOption) (*SendTreeNoncesResponse, error) {
+	out := new(SendTreeNoncesResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.ArkService/SendTreeNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arkServiceClient) SendTreeSignatures(ctx context.Context, in *SendTreeSignaturesRequest, opts ...grpc.CallOption) (*SendTreeSignaturesResponse, error) {
+	out := new(SendTreeSignaturesResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.ArkService/SendTreeSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arkServiceClient) GetRound(ctx context.Context, in *GetRoundRequest, opts ...grpc.CallOption) (*GetRoundResponse, error) {
 	out := new(GetRoundResponse)
 	err := c.cc.Invoke(ctx, "/ark.v1.ArkService/GetRound", in, out, opts...)
@@ -177,9 +206,11 @@ func (c *arkServiceClient) CompletePayment(ctx context.Context, in *CompletePaym
 // for forward compatibility
 type ArkServiceServer interface {
 	RegisterPayment(context.Context, *RegisterPaymentRequest) (*RegisterPaymentResponse, error)
+	GetPaymentNonce(context.Context, *GetPaymentNonceRequest) (*GetPaymentNonceResponse, error)
 	ClaimPayment(context.Context, *ClaimPaymentRequest) (*ClaimPaymentResponse, error)
 	FinalizePayment(context.Context, *FinalizePaymentRequest) (*FinalizePaymentResponse, error)
-	// TODO BTC: signTree rpc
+	SendTreeNonces(context.Context, *SendTreeNoncesRequest) (*SendTreeNoncesResponse, error)
+	SendTreeSignatures(context.Context, *SendTreeSignaturesRequest) (*SendTreeSignaturesResponse, error)
 	GetRound(context.Context, *GetRoundRequest) (*GetRoundResponse, error)
 	GetRoundById(context.Context, *GetRoundByIdRequest) (*GetRoundByIdResponse, error)
 	GetEventStream(*GetEventStreamRequest, ArkService_GetEventStreamServer) error
@@ -198,12 +229,21 @@ type UnimplementedArkServiceServer struct {
 func (UnimplementedArkServiceServer) RegisterPayment(context.Context, *RegisterPaymentRequest) (*RegisterPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPayment not implemented")
 }
+func (UnimplementedArkServiceServer) GetPaymentNonce(context.Context, *GetPaymentNonceRequest) (*GetPaymentNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentNonce not implemented")
+}
 func (UnimplementedArkServiceServer) ClaimPayment(context.Context, *ClaimPaymentRequest) (*ClaimPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimPayment not implemented")
 }
 func (UnimplementedArkServiceServer) FinalizePayment(context.Context, *FinalizePaymentRequest) (*FinalizePaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePayment not implemented")
 }
+func (UnimplementedArkServiceServer) SendTreeNonces(context.Context, *SendTreeNoncesRequest) (*SendTreeNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTreeNonces not implemented")
+}
+func (UnimplementedArkServiceServer) SendTreeSignatures(context.Context, *SendTreeSignaturesRequest) (*SendTreeSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTreeSignatures not implemented")
+}
 func (UnimplementedArkServiceServer) GetRound(context.Context, *GetRoundRequest) (*GetRoundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRound not implemented")
 }
@@ -261,6 +301,24 @@ func _ArkService_RegisterPayment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArkService_GetPaymentNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArkServiceServer).GetPaymentNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.ArkService/GetPaymentNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArkServiceServer).GetPaymentNonce(ctx, req.(*GetPaymentNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArkService_ClaimPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClaimPaymentRequest)
 	if err := dec(in); err != nil {
@@ -297,6 +355,42 @@ func _ArkService_FinalizePayment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArkService_SendTreeNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTreeNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArkServiceServer).SendTreeNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.ArkService/SendTreeNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArkServiceServer).SendTreeNonces(ctx, req.(*SendTreeNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArkService_SendTreeSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTreeSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArkServiceServer).SendTreeSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.ArkService/SendTreeSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArkServiceServer).SendTreeSignatures(ctx, req.(*SendTreeSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArkService_GetRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRoundRequest)
 	if err := dec(in); err != nil {
@@ -473,6 +567,10 @@ var ArkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPayment",
 			Handler:    _ArkService_RegisterPayment_Handler,
 		},
+		{
+			MethodName: "GetPaymentNonce",
+			Handler:    _ArkService_GetPaymentNonce_Handler,
+		},
 		{
 			MethodName: "ClaimPayment",
 			Handler:    _ArkService_ClaimPayment_Handler,
@@ -481,6 +579,14 @@ var ArkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePayment",
 			Handler:    _ArkService_FinalizePayment_Handler,
 		},
+		{
+			MethodName: "SendTreeNonces",
+			Handler:    _ArkService_SendTreeNonces_Handler,
+		},
+		{
+			MethodName: "SendTreeSignatures",
+			Handler:    _ArkService_SendTreeSignatures_Handler,
+		},
 		{
 			MethodName: "GetRound",
 			Handler:    _ArkService_GetRound_Handler,
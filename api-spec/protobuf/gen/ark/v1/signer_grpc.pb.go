@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package arkv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SignerServiceClient is the client API for SignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SignerServiceClient interface {
+	// GetAccounts returns the xpubs of the wallet accounts, to be imported by
+	// the watch-only wallet.
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	// SignPsbt signs the inputs of the given PSBT that have a BIP32 derivation
+	// path of the wallet keys.
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error)
+}
+
+type signerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSignerServiceClient(cc grpc.ClientConnInterface) SignerServiceClient {
+	return &signerServiceClient{cc}
+}
+
+func (c *signerServiceClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.SignerService/GetAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerServiceClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error) {
+	out := new(SignPsbtResponse)
+	err := c.cc.Invoke(ctx, "/ark.v1.SignerService/SignPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SignerServiceServer is the server API for SignerService service.
+// All implementations should embed UnimplementedSignerServiceServer
+// for forward compatibility
+type SignerServiceServer interface {
+	// GetAccounts returns the xpubs of the wallet accounts, to be imported by
+	// the watch-only wallet.
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	// SignPsbt signs the inputs of the given PSBT that have a BIP32 derivation
+	// path of the wallet keys.
+	SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error)
+}
+
+// UnimplementedSignerServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedSignerServiceServer struct {
+}
+
+func (UnimplementedSignerServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedSignerServiceServer) SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPsbt not implemented")
+}
+
+// UnsafeSignerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignerServiceServer will
+// result in compilation errors.
+type UnsafeSignerServiceServer interface {
+	mustEmbedUnimplementedSignerServiceServer()
+}
+
+func RegisterSignerServiceServer(s grpc.ServiceRegistrar, srv SignerServiceServer) {
+	s.RegisterService(&SignerService_ServiceDesc, srv)
+}
+
+func _SignerService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServiceServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.SignerService/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServiceServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignerService_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServiceServer).SignPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ark.v1.SignerService/SignPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServiceServer).SignPsbt(ctx, req.(*SignPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SignerService_ServiceDesc is the grpc.ServiceDesc for SignerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SignerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ark.v1.SignerService",
+	HandlerType: (*SignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccounts",
+			Handler:    _SignerService_GetAccounts_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _SignerService_SignPsbt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ark/v1/signer.proto",
+}
@@ -21,8 +21,6 @@ import (
 	"github.com/vulpemventures/go-elements/transaction"
 )
 
-const dust = 450
-
 type covenantLiquidCLI struct{}
 
 func (c *covenantLiquidCLI) SendAsync(ctx *cli.Context) error {
@@ -106,6 +104,18 @@ func sendOnchain(ctx *cli.Context, receivers []receiver) (string, error) {
 
 	liquidNet := toElementsNetwork(net)
 
+	client, close, err := getClientFromState(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer close()
+
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+	dust := info.GetDust()
+
 	targetAmount := uint64(0)
 	for _, receiver := range receivers {
 		targetAmount += receiver.Amount
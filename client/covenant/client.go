@@ -3,6 +3,7 @@ package covenant
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/ark-network/ark/common/tree"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/urfave/cli/v2"
 	"github.com/vulpemventures/go-elements/psetv2"
@@ -30,19 +32,38 @@ type vtxo struct {
 	expireAt *time.Time
 }
 
+// listSpendableVtxos returns the spendable vtxos of the given address,
+// following the cursor until all the pages are fetched.
+func listSpendableVtxos(
+	ctx *cli.Context, client arkv1.ArkServiceClient, addr string,
+) ([]*arkv1.Vtxo, error) {
+	spent := false
+	req := &arkv1.ListVtxosRequest{Address: addr, Spent: &spent}
+	vtxos := make([]*arkv1.Vtxo, 0)
+	for {
+		response, err := client.ListVtxos(ctx.Context, req)
+		if err != nil {
+			return nil, err
+		}
+		vtxos = append(vtxos, response.GetSpendableVtxos()...)
+		if len(response.GetNextCursor()) <= 0 {
+			return vtxos, nil
+		}
+		req.Cursor = response.GetNextCursor()
+	}
+}
+
 func getVtxos(
 	ctx *cli.Context, explorer utils.Explorer, client arkv1.ArkServiceClient,
 	addr string, computeExpiration bool,
 ) ([]vtxo, error) {
-	response, err := client.ListVtxos(ctx.Context, &arkv1.ListVtxosRequest{
-		Address: addr,
-	})
+	spendableVtxos, err := listSpendableVtxos(ctx, client, addr)
 	if err != nil {
 		return nil, err
 	}
 
-	vtxos := make([]vtxo, 0, len(response.GetSpendableVtxos()))
-	for _, v := range response.GetSpendableVtxos() {
+	vtxos := make([]vtxo, 0, len(spendableVtxos))
+	for _, v := range spendableVtxos {
 		var expireAt *time.Time
 		if v.ExpireAt > 0 {
 			t := time.Unix(v.ExpireAt, 0)
@@ -235,6 +256,10 @@ func handleRoundStream(
 
 	defer pingStop()
 
+	// rounds overlap, therefore skip the events of the rounds our payment is
+	// not part of
+	var roundId string
+
 	for {
 		event, err := stream.Recv()
 		if err == io.EOF {
@@ -244,12 +269,32 @@ func handleRoundStream(
 			return "", err
 		}
 
-		if e := event.GetRoundFailed(); e != nil {
+		if e := event.GetPaymentsRegistered(); e != nil {
+			for _, id := range e.GetPaymentIds() {
+				if id == paymentID {
+					roundId = e.GetId()
+					break
+				}
+			}
+			continue
+		}
+
+		if e := event.GetPaymentsDropped(); e != nil {
+			for _, id := range e.GetPaymentIds() {
+				if id == paymentID {
+					pingStop()
+					return "", fmt.Errorf("payment dropped from round %s", e.GetId())
+				}
+			}
+			continue
+		}
+
+		if e := event.GetRoundFailed(); e != nil && e.GetId() == roundId {
 			pingStop()
 			return "", fmt.Errorf("round failed: %s", e.GetReason())
 		}
 
-		if e := event.GetRoundFinalization(); e != nil {
+		if e := event.GetRoundFinalization(); e != nil && e.GetId() == roundId {
 			// stop pinging as soon as we receive some forfeit txs
 			pingStop()
 			fmt.Println("round finalization started")
@@ -448,8 +493,14 @@ func handleRoundStream(
 
 			fmt.Printf("%d signed\n", len(signedForfeits))
 			fmt.Print("finalizing payment... ")
+			signatures, err := signFinalizePayment(secKey, paymentID, signedForfeits)
+			if err != nil {
+				return "", err
+			}
 			_, err = client.FinalizePayment(ctx.Context, &arkv1.FinalizePaymentRequest{
 				SignedForfeitTxs: signedForfeits,
+				PaymentId:        paymentID,
+				Signatures:       signatures,
 			})
 			if err != nil {
 				return "", err
@@ -460,14 +511,64 @@ func handleRoundStream(
 			continue
 		}
 
-		if event.GetRoundFinalized() != nil {
-			return event.GetRoundFinalized().GetPoolTxid(), nil
+		if e := event.GetRoundFinalized(); e != nil && e.GetId() == roundId {
+			return e.GetPoolTxid(), nil
 		}
 	}
 
 	return "", fmt.Errorf("stream closed unexpectedly")
 }
 
+// signPaymentInputs proves the ownership of the given inputs to the ASP by
+// signing the hash of their outpoints and of a nonce given by the ASP. The
+// returned signature is indexed by the owner pubkey and is valid only along
+// with the returned nonce.
+func signPaymentInputs(
+	ctx context.Context, client arkv1.ArkServiceClient,
+	secKey *secp256k1.PrivateKey, inputs []*arkv1.Input,
+) (map[string]string, string, error) {
+	outpoints := make([]wire.OutPoint, 0, len(inputs))
+	for _, input := range inputs {
+		txid, err := chainhash.NewHashFromStr(input.GetTxid())
+		if err != nil {
+			return nil, "", err
+		}
+		outpoints = append(outpoints, wire.OutPoint{Hash: *txid, Index: input.GetVout()})
+	}
+
+	resp, err := client.GetPaymentNonce(ctx, &arkv1.GetPaymentNonceRequest{})
+	if err != nil {
+		return nil, "", err
+	}
+	nonce, err := hex.DecodeString(resp.GetNonce())
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid nonce: %s", err)
+	}
+
+	sig, err := schnorr.Sign(secKey, common.PaymentInputsHash(outpoints, nonce))
+	if err != nil {
+		return nil, "", err
+	}
+	return map[string]string{
+		hex.EncodeToString(secKey.PubKey().SerializeCompressed()): hex.EncodeToString(sig.Serialize()),
+	}, resp.GetNonce(), nil
+}
+
+// signFinalizePayment proves to the ASP that the given forfeit txs of the
+// payment are submitted by the owner of its inputs, the returned signature is
+// indexed by the owner pubkey.
+func signFinalizePayment(
+	secKey *secp256k1.PrivateKey, paymentID string, forfeitTxs []string,
+) (map[string]string, error) {
+	sig, err := schnorr.Sign(secKey, common.FinalizePaymentHash(paymentID, forfeitTxs))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		hex.EncodeToString(secKey.PubKey().SerializeCompressed()): hex.EncodeToString(sig.Serialize()),
+	}, nil
+}
+
 // send 1 ping message every 5 seconds to signal to the ark service that we are still alive
 // returns a function that can be used to stop the pinging
 func ping(
@@ -62,7 +62,15 @@ func collaborativeRedeem(
 		return err
 	}
 
-	selectedCoins, changeAmount, err := coinSelect(vtxos, amount, withExpiryCoinselect)
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	selectedCoins, changeAmount, err := coinSelect(
+		vtxos, amount, info.GetDust(), info.GetFees().GetOnchain(),
+		withExpiryCoinselect,
+	)
 	if err != nil {
 		return err
 	}
@@ -88,8 +96,15 @@ func collaborativeRedeem(
 		return err
 	}
 
+	signatures, nonce, err := signPaymentInputs(ctx.Context, client, secKey, inputs)
+	if err != nil {
+		return err
+	}
+
 	registerResponse, err := client.RegisterPayment(ctx.Context, &arkv1.RegisterPaymentRequest{
-		Inputs: inputs,
+		Inputs:     inputs,
+		Signatures: signatures,
+		Nonce:      nonce,
 	})
 	if err != nil {
 		return err
@@ -89,6 +89,18 @@ func sendOffchain(ctx *cli.Context, receivers []receiver) error {
 		return err
 	}
 
+	client, close, err := getClientFromState(ctx)
+	if err != nil {
+		return err
+	}
+	defer close()
+
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+	dust := info.GetDust()
+
 	receiversOutput := make([]*arkv1.Output, 0)
 	sumOfReceivers := uint64(0)
 
@@ -114,11 +126,6 @@ func sendOffchain(ctx *cli.Context, receivers []receiver) error {
 		})
 		sumOfReceivers += receiver.Amount
 	}
-	client, close, err := getClientFromState(ctx)
-	if err != nil {
-		return err
-	}
-	defer close()
 
 	explorer := utils.NewExplorer(ctx)
 
@@ -126,7 +133,10 @@ func sendOffchain(ctx *cli.Context, receivers []receiver) error {
 	if err != nil {
 		return err
 	}
-	selectedCoins, changeAmount, err := coinSelect(vtxos, sumOfReceivers, withExpiryCoinselect)
+	selectedCoins, changeAmount, err := coinSelect(
+		vtxos, sumOfReceivers, info.GetDust(), info.GetFees().GetOffchain(),
+		withExpiryCoinselect,
+	)
 	if err != nil {
 		return err
 	}
@@ -153,8 +163,17 @@ func sendOffchain(ctx *cli.Context, receivers []receiver) error {
 		return err
 	}
 
+	signatures, nonce, err := signPaymentInputs(ctx.Context, client, secKey, inputs)
+	if err != nil {
+		return err
+	}
+
 	registerResponse, err := client.RegisterPayment(
-		ctx.Context, &arkv1.RegisterPaymentRequest{Inputs: inputs},
+		ctx.Context, &arkv1.RegisterPaymentRequest{
+			Inputs:     inputs,
+			Signatures: signatures,
+			Nonce:      nonce,
+		},
 	)
 	if err != nil {
 		return err
@@ -181,7 +200,13 @@ func sendOffchain(ctx *cli.Context, receivers []receiver) error {
 	})
 }
 
-func coinSelect(vtxos []vtxo, amount uint64, sortByExpirationTime bool) ([]vtxo, uint64, error) {
+// coinSelect selects the vtxos to cover the given amount plus the service fee
+// charged by the ASP on the selected ones, and returns them along with the
+// change.
+func coinSelect(
+	vtxos []vtxo, amount, dust uint64, fee *arkv1.ServiceFee,
+	sortByExpirationTime bool,
+) ([]vtxo, uint64, error) {
 	selected := make([]vtxo, 0)
 	notSelected := make([]vtxo, 0)
 	selectedAmount := uint64(0)
@@ -198,7 +223,7 @@ func coinSelect(vtxos []vtxo, amount uint64, sortByExpirationTime bool) ([]vtxo,
 	}
 
 	for _, vtxo := range vtxos {
-		if selectedAmount >= amount {
+		if selectedAmount >= amount+utils.ServiceFeeAmount(fee, selectedAmount) {
 			notSelected = append(notSelected, vtxo)
 			break
 		}
@@ -207,16 +232,22 @@ func coinSelect(vtxos []vtxo, amount uint64, sortByExpirationTime bool) ([]vtxo,
 		selectedAmount += vtxo.amount
 	}
 
-	if selectedAmount < amount {
-		return nil, 0, fmt.Errorf("not enough funds to cover amount%d", amount)
+	feeAmount := utils.ServiceFeeAmount(fee, selectedAmount)
+	if selectedAmount < amount+feeAmount {
+		return nil, 0, fmt.Errorf(
+			"not enough funds to cover amount %d and service fee %d",
+			amount, feeAmount,
+		)
 	}
 
-	change := selectedAmount - amount
+	change := selectedAmount - amount - feeAmount
 
 	if change < dust {
 		if len(notSelected) > 0 {
 			selected = append(selected, notSelected[0])
-			change += notSelected[0].amount
+			selectedAmount += notSelected[0].amount
+			change = selectedAmount - amount -
+				utils.ServiceFeeAmount(fee, selectedAmount)
 		}
 	}
 
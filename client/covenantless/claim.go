@@ -1,8 +1,12 @@
 package covenantless
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
 	"github.com/ark-network/ark/client/utils"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/urfave/cli/v2"
 )
 
@@ -35,9 +39,21 @@ func (c *clArkBitcoinCLI) ClaimAsync(ctx *cli.Context) error {
 		return nil
 	}
 
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+	fee := utils.ServiceFeeAmount(info.GetFees().GetOffchain(), pendingBalance)
+	if pendingBalance < fee+info.GetDust() {
+		return fmt.Errorf(
+			"pending balance %d too low to cover service fee %d",
+			pendingBalance, fee,
+		)
+	}
+
 	receiver := receiver{
 		To:     myselfOffchain,
-		Amount: pendingBalance,
+		Amount: pendingBalance - fee,
 	}
 	return selfTransferAllPendingPayments(
 		ctx, client, pendingVtxos, receiver,
@@ -69,8 +85,23 @@ func selfTransferAllPendingPayments(
 		return err
 	}
 
+	signatures, nonce, err := signPaymentInputs(ctx.Context, client, secKey, inputs)
+	if err != nil {
+		return err
+	}
+
+	ephemeralKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return err
+	}
+
 	registerResponse, err := client.RegisterPayment(
-		ctx.Context, &arkv1.RegisterPaymentRequest{Inputs: inputs},
+		ctx.Context, &arkv1.RegisterPaymentRequest{
+			Inputs:          inputs,
+			EphemeralPubkey: hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed()),
+			Signatures:      signatures,
+			Nonce:           nonce,
+		},
 	)
 	if err != nil {
 		return err
@@ -86,7 +117,7 @@ func selfTransferAllPendingPayments(
 
 	poolTxID, err := handleRoundStream(
 		ctx, client, registerResponse.GetId(),
-		pendingVtxos, secKey, receiversOutput,
+		pendingVtxos, secKey, receiversOutput, ephemeralKey,
 	)
 	if err != nil {
 		return err
@@ -5,6 +5,7 @@ import (
 	"math"
 	"time"
 
+	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
 	"github.com/ark-network/ark/client/interfaces"
 	"github.com/ark-network/ark/client/utils"
 	"github.com/ark-network/ark/common"
@@ -20,8 +21,6 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-const dust = 450
-
 type clArkBitcoinCLI struct{}
 
 func (c *clArkBitcoinCLI) Receive(ctx *cli.Context) error {
@@ -102,6 +101,18 @@ func sendOnchain(ctx *cli.Context, receivers []receiver) (string, error) {
 
 	netParams := toChainParams(net)
 
+	client, close, err := getClientFromState(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer close()
+
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+	dust := info.GetDust()
+
 	targetAmount := uint64(0)
 	for _, receiver := range receivers {
 		targetAmount += receiver.Amount
@@ -3,6 +3,7 @@ package covenantless
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strings"
@@ -10,10 +11,14 @@ import (
 
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
 	"github.com/ark-network/ark/client/utils"
+	"github.com/ark-network/ark/common"
 	"github.com/ark-network/ark/common/bitcointree"
 	"github.com/ark-network/ark/common/tree"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
@@ -30,19 +35,38 @@ type vtxo struct {
 	pending  bool
 }
 
+// listSpendableVtxos returns the spendable vtxos of the given address,
+// following the cursor until all the pages are fetched.
+func listSpendableVtxos(
+	ctx *cli.Context, client arkv1.ArkServiceClient, addr string,
+) ([]*arkv1.Vtxo, error) {
+	spent := false
+	req := &arkv1.ListVtxosRequest{Address: addr, Spent: &spent}
+	vtxos := make([]*arkv1.Vtxo, 0)
+	for {
+		response, err := client.ListVtxos(ctx.Context, req)
+		if err != nil {
+			return nil, err
+		}
+		vtxos = append(vtxos, response.GetSpendableVtxos()...)
+		if len(response.GetNextCursor()) <= 0 {
+			return vtxos, nil
+		}
+		req.Cursor = response.GetNextCursor()
+	}
+}
+
 func getVtxos(
 	ctx *cli.Context, explorer utils.Explorer, client arkv1.ArkServiceClient,
 	addr string, computeExpiration bool,
 ) ([]vtxo, error) {
-	response, err := client.ListVtxos(ctx.Context, &arkv1.ListVtxosRequest{
-		Address: addr,
-	})
+	spendableVtxos, err := listSpendableVtxos(ctx, client, addr)
 	if err != nil {
 		return nil, err
 	}
 
-	vtxos := make([]vtxo, 0, len(response.GetSpendableVtxos()))
-	for _, v := range response.GetSpendableVtxos() {
+	vtxos := make([]vtxo, 0, len(spendableVtxos))
+	for _, v := range spendableVtxos {
 		var expireAt *time.Time
 		if v.GetExpireAt() > 0 {
 			t := time.Unix(v.ExpireAt, 0)
@@ -220,6 +244,7 @@ func castCongestionTree(congestionTree tree.CongestionTree) *arkv1.Tree {
 func handleRoundStream(
 	ctx *cli.Context, client arkv1.ArkServiceClient, paymentID string,
 	vtxosToSign []vtxo, secKey *secp256k1.PrivateKey, receivers []*arkv1.Output,
+	ephemeralKey *secp256k1.PrivateKey,
 ) (poolTxID string, err error) {
 	stream, err := client.GetEventStream(ctx.Context, &arkv1.GetEventStreamRequest{})
 	if err != nil {
@@ -236,6 +261,14 @@ func handleRoundStream(
 
 	defer pingStop()
 
+	// rounds overlap, therefore skip the events of the rounds our payment is
+	// not part of
+	var roundId string
+
+	ephemeralPubkey := hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed())
+	var signerSession bitcointree.SignerSession
+	var cosigners []*secp256k1.PublicKey
+
 	for {
 		event, err := stream.Recv()
 		if err == io.EOF {
@@ -245,12 +278,68 @@ func handleRoundStream(
 			return "", err
 		}
 
-		if e := event.GetRoundFailed(); e != nil {
+		if e := event.GetPaymentsRegistered(); e != nil {
+			for _, id := range e.GetPaymentIds() {
+				if id == paymentID {
+					roundId = e.GetId()
+					break
+				}
+			}
+			continue
+		}
+
+		if e := event.GetPaymentsDropped(); e != nil {
+			for _, id := range e.GetPaymentIds() {
+				if id == paymentID {
+					pingStop()
+					return "", fmt.Errorf("payment dropped from round %s", e.GetId())
+				}
+			}
+			continue
+		}
+
+		if e := event.GetRoundFailed(); e != nil && e.GetId() == roundId {
 			pingStop()
 			return "", fmt.Errorf("round failed: %s", e.GetReason())
 		}
 
-		if e := event.GetRoundFinalization(); e != nil {
+		if e := event.GetRoundSigning(); e != nil {
+			// skip signing sessions of rounds we are not part of
+			isCosigner := false
+			for _, pubkey := range e.GetCosignersPubkeys() {
+				if pubkey == ephemeralPubkey {
+					isCosigner = true
+					break
+				}
+			}
+			if !isCosigner {
+				continue
+			}
+
+			signerSession, cosigners, err = handleRoundSigningStarted(
+				ctx, client, e, ephemeralKey,
+			)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		if e := event.GetRoundSigningNoncesGenerated(); e != nil {
+			if signerSession == nil {
+				continue
+			}
+
+			if err := handleRoundSigningNoncesGenerated(
+				ctx, client, e, ephemeralKey, cosigners, signerSession,
+			); err != nil {
+				return "", err
+			}
+			signerSession = nil
+			continue
+		}
+
+		if e := event.GetRoundFinalization(); e != nil && e.GetId() == roundId {
 			// stop pinging as soon as we receive some forfeit txs
 			pingStop()
 
@@ -456,8 +545,14 @@ func handleRoundStream(
 
 			fmt.Printf("%d signed\n", len(signedForfeits))
 			fmt.Print("finalizing payment... ")
+			signatures, err := signFinalizePayment(secKey, paymentID, signedForfeits)
+			if err != nil {
+				return "", err
+			}
 			_, err = client.FinalizePayment(ctx.Context, &arkv1.FinalizePaymentRequest{
 				SignedForfeitTxs: signedForfeits,
+				PaymentId:        paymentID,
+				Signatures:       signatures,
 			})
 			if err != nil {
 				return "", err
@@ -468,14 +563,64 @@ func handleRoundStream(
 			continue
 		}
 
-		if event.GetRoundFinalized() != nil {
-			return event.GetRoundFinalized().GetPoolTxid(), nil
+		if e := event.GetRoundFinalized(); e != nil && e.GetId() == roundId {
+			return e.GetPoolTxid(), nil
 		}
 	}
 
 	return "", fmt.Errorf("stream closed unexpectedly")
 }
 
+// signPaymentInputs proves the ownership of the given inputs to the ASP by
+// signing the hash of their outpoints and of a nonce given by the ASP. The
+// returned signature is indexed by the owner pubkey and is valid only along
+// with the returned nonce.
+func signPaymentInputs(
+	ctx context.Context, client arkv1.ArkServiceClient,
+	secKey *secp256k1.PrivateKey, inputs []*arkv1.Input,
+) (map[string]string, string, error) {
+	outpoints := make([]wire.OutPoint, 0, len(inputs))
+	for _, input := range inputs {
+		txid, err := chainhash.NewHashFromStr(input.GetTxid())
+		if err != nil {
+			return nil, "", err
+		}
+		outpoints = append(outpoints, wire.OutPoint{Hash: *txid, Index: input.GetVout()})
+	}
+
+	resp, err := client.GetPaymentNonce(ctx, &arkv1.GetPaymentNonceRequest{})
+	if err != nil {
+		return nil, "", err
+	}
+	nonce, err := hex.DecodeString(resp.GetNonce())
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid nonce: %s", err)
+	}
+
+	sig, err := schnorr.Sign(secKey, common.PaymentInputsHash(outpoints, nonce))
+	if err != nil {
+		return nil, "", err
+	}
+	return map[string]string{
+		hex.EncodeToString(secKey.PubKey().SerializeCompressed()): hex.EncodeToString(sig.Serialize()),
+	}, resp.GetNonce(), nil
+}
+
+// signFinalizePayment proves to the ASP that the given forfeit txs of the
+// payment are submitted by the owner of its inputs, the returned signature is
+// indexed by the owner pubkey.
+func signFinalizePayment(
+	secKey *secp256k1.PrivateKey, paymentID string, forfeitTxs []string,
+) (map[string]string, error) {
+	sig, err := schnorr.Sign(secKey, common.FinalizePaymentHash(paymentID, forfeitTxs))
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		hex.EncodeToString(secKey.PubKey().SerializeCompressed()): hex.EncodeToString(sig.Serialize()),
+	}, nil
+}
+
 // send 1 ping message every 5 seconds to signal to the ark service that we are still alive
 // returns a function that can be used to stop the pinging
 func ping(
@@ -512,3 +657,142 @@ func isOnchainOnly(receivers []*arkv1.Output) bool {
 
 	return true
 }
+
+// handleRoundSigningStarted verifies the unsigned congestion tree and sends
+// the nonces of a new signer session to the ASP.
+func handleRoundSigningStarted(
+	ctx *cli.Context, client arkv1.ArkServiceClient,
+	event *arkv1.RoundSigningEvent, ephemeralKey *secp256k1.PrivateKey,
+) (bitcointree.SignerSession, []*secp256k1.PublicKey, error) {
+	cosigners := make([]*secp256k1.PublicKey, 0, len(event.GetCosignersPubkeys()))
+	for _, pubkey := range event.GetCosignersPubkeys() {
+		buf, err := hex.DecodeString(pubkey)
+		if err != nil {
+			return nil, nil, err
+		}
+		cosigner, err := secp256k1.ParsePubKey(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		cosigners = append(cosigners, cosigner)
+	}
+
+	congestionTree, err := toCongestionTree(event.GetUnsignedTree())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aspPubkey, err := utils.GetAspPublicKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roundLifetime, err := utils.GetRoundLifetime(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	minRelayFee, err := utils.GetMinRelayFee(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := bitcointree.ValidateCongestionTree(
+		congestionTree, event.GetUnsignedRoundTx(), aspPubkey,
+		int64(roundLifetime), int64(minRelayFee),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	sweepClosure := bitcointree.CSVSigClosure{
+		Pubkey:  aspPubkey,
+		Seconds: uint(roundLifetime),
+	}
+
+	sweepTapLeaf, err := sweepClosure.Leaf()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sweepTapTree := txscript.AssembleTaprootScriptTree(*sweepTapLeaf)
+	root := sweepTapTree.RootNode.TapHash()
+
+	signerSession := bitcointree.NewTreeSignerSession(
+		ephemeralKey, congestionTree, int64(minRelayFee), root.CloneBytes(),
+	)
+
+	nonces, err := signerSession.GetNonces()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := nonces.Encode(&buf); err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := schnorr.Sign(
+		ephemeralKey, common.CosignerNoncesHash(event.GetId(), buf.Bytes()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := client.SendTreeNonces(ctx.Context, &arkv1.SendTreeNoncesRequest{
+		RoundId:    event.GetId(),
+		PublicKey:  hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed()),
+		TreeNonces: hex.EncodeToString(buf.Bytes()),
+		Signature:  hex.EncodeToString(sig.Serialize()),
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return signerSession, cosigners, nil
+}
+
+// handleRoundSigningNoncesGenerated signs the congestion tree with the
+// aggregated nonces and sends the partial signatures to the ASP.
+func handleRoundSigningNoncesGenerated(
+	ctx *cli.Context, client arkv1.ArkServiceClient,
+	event *arkv1.RoundSigningNoncesGeneratedEvent, ephemeralKey *secp256k1.PrivateKey,
+	cosigners []*secp256k1.PublicKey, signerSession bitcointree.SignerSession,
+) error {
+	buf, err := hex.DecodeString(event.GetTreeNonces())
+	if err != nil {
+		return err
+	}
+
+	nonces, err := bitcointree.DecodeNonces(bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+
+	if err := signerSession.SetKeys(cosigners, nonces); err != nil {
+		return err
+	}
+
+	sigs, err := signerSession.Sign()
+	if err != nil {
+		return err
+	}
+
+	var sigsBuf bytes.Buffer
+	if err := sigs.Encode(&sigsBuf); err != nil {
+		return err
+	}
+
+	sig, err := schnorr.Sign(
+		ephemeralKey, common.CosignerSignaturesHash(event.GetId(), sigsBuf.Bytes()),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SendTreeSignatures(ctx.Context, &arkv1.SendTreeSignaturesRequest{
+		RoundId:        event.GetId(),
+		PublicKey:      hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed()),
+		TreeSignatures: hex.EncodeToString(sigsBuf.Bytes()),
+		Signature:      hex.EncodeToString(sig.Serialize()),
+	})
+	return err
+}
@@ -2,6 +2,7 @@ package covenantless
 
 import (
 	"bufio"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -11,6 +12,7 @@ import (
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
 	"github.com/ark-network/ark/client/utils"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/urfave/cli/v2"
 )
 
@@ -54,7 +56,15 @@ func collaborativeRedeem(
 		return err
 	}
 
-	selectedCoins, changeAmount, err := coinSelect(vtxos, amount, withExpiryCoinselect)
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	selectedCoins, changeAmount, err := coinSelect(
+		vtxos, amount, info.GetDust(), info.GetFees().GetOnchain(),
+		withExpiryCoinselect,
+	)
 	if err != nil {
 		return err
 	}
@@ -80,8 +90,21 @@ func collaborativeRedeem(
 		return err
 	}
 
+	signatures, nonce, err := signPaymentInputs(ctx.Context, client, secKey, inputs)
+	if err != nil {
+		return err
+	}
+
+	ephemeralKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return err
+	}
+
 	registerResponse, err := client.RegisterPayment(ctx.Context, &arkv1.RegisterPaymentRequest{
-		Inputs: inputs,
+		Inputs:          inputs,
+		EphemeralPubkey: hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed()),
+		Signatures:      signatures,
+		Nonce:           nonce,
 	})
 	if err != nil {
 		return err
@@ -102,6 +125,7 @@ func collaborativeRedeem(
 		selectedCoins,
 		secKey,
 		receivers,
+		ephemeralKey,
 	)
 	if err != nil {
 		return err
@@ -18,7 +18,18 @@ func (c *clArkBitcoinCLI) SendAsync(ctx *cli.Context) error {
 	amount := ctx.Uint64("amount")
 	withExpiryCoinselect := ctx.Bool("enable-expiry-coinselect")
 
-	if amount < dust {
+	client, close, err := getClientFromState(ctx)
+	if err != nil {
+		return err
+	}
+	defer close()
+
+	info, err := client.GetInfo(ctx.Context, &arkv1.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+
+	if dust := info.GetDust(); amount < dust {
 		return fmt.Errorf("invalid amount (%d), must be greater than dust %d", amount, dust)
 	}
 
@@ -59,19 +70,16 @@ func (c *clArkBitcoinCLI) SendAsync(ctx *cli.Context) error {
 	})
 	sumOfReceivers += amount
 
-	client, close, err := getClientFromState(ctx)
-	if err != nil {
-		return err
-	}
-	defer close()
-
 	explorer := utils.NewExplorer(ctx)
 
 	vtxos, err := getVtxos(ctx, explorer, client, offchainAddr, withExpiryCoinselect)
 	if err != nil {
 		return err
 	}
-	selectedCoins, changeAmount, err := coinSelect(vtxos, sumOfReceivers, withExpiryCoinselect)
+	selectedCoins, changeAmount, err := coinSelect(
+		vtxos, sumOfReceivers, info.GetDust(), info.GetFees().GetAsync(),
+		withExpiryCoinselect,
+	)
 	if err != nil {
 		return err
 	}
@@ -155,7 +163,13 @@ func (c *clArkBitcoinCLI) SendAsync(ctx *cli.Context) error {
 	return nil
 }
 
-func coinSelect(vtxos []vtxo, amount uint64, sortByExpirationTime bool) ([]vtxo, uint64, error) {
+// coinSelect selects the vtxos to cover the given amount plus the service fee
+// charged by the ASP on the selected ones, and returns them along with the
+// change.
+func coinSelect(
+	vtxos []vtxo, amount, dust uint64, fee *arkv1.ServiceFee,
+	sortByExpirationTime bool,
+) ([]vtxo, uint64, error) {
 	selected := make([]vtxo, 0)
 	notSelected := make([]vtxo, 0)
 	selectedAmount := uint64(0)
@@ -172,7 +186,7 @@ func coinSelect(vtxos []vtxo, amount uint64, sortByExpirationTime bool) ([]vtxo,
 	}
 
 	for _, vtxo := range vtxos {
-		if selectedAmount >= amount {
+		if selectedAmount >= amount+utils.ServiceFeeAmount(fee, selectedAmount) {
 			notSelected = append(notSelected, vtxo)
 			break
 		}
@@ -181,16 +195,22 @@ func coinSelect(vtxos []vtxo, amount uint64, sortByExpirationTime bool) ([]vtxo,
 		selectedAmount += vtxo.amount
 	}
 
-	if selectedAmount < amount {
-		return nil, 0, fmt.Errorf("not enough funds to cover amount%d", amount)
+	feeAmount := utils.ServiceFeeAmount(fee, selectedAmount)
+	if selectedAmount < amount+feeAmount {
+		return nil, 0, fmt.Errorf(
+			"not enough funds to cover amount %d and service fee %d",
+			amount, feeAmount,
+		)
 	}
 
-	change := selectedAmount - amount
+	change := selectedAmount - amount - feeAmount
 
 	if change > 0 && change < dust {
 		if len(notSelected) > 0 {
 			selected = append(selected, notSelected[0])
-			change += notSelected[0].amount
+			selectedAmount += notSelected[0].amount
+			change = selectedAmount - amount -
+				utils.ServiceFeeAmount(fee, selectedAmount)
 		}
 	}
 
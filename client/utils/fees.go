@@ -0,0 +1,14 @@
+package utils
+
+import (
+	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+)
+
+// ServiceFeeAmount returns the fee charged by the ASP for spending inputs of
+// the given amount, ie. the base fee plus the proportional one, in parts per
+// million of the amount.
+func ServiceFeeAmount(fee *arkv1.ServiceFee, inputAmount uint64) uint64 {
+	proportional := inputAmount/1_000_000*fee.GetRate() +
+		inputAmount%1_000_000*fee.GetRate()/1_000_000
+	return fee.GetBase() + proportional
+}
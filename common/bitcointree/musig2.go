@@ -1,6 +1,7 @@
 package bitcointree
 
 import (
+	"encoding/binary"
 	"errors"
 	"io"
 	"strings"
@@ -15,8 +16,10 @@ import (
 )
 
 var (
-	ErrCongestionTreeNotSet = errors.New("congestion tree not set")
-	ErrAggregateKeyNotSet   = errors.New("aggregate key not set")
+	ErrCongestionTreeNotSet    = errors.New("congestion tree not set")
+	ErrAggregateKeyNotSet      = errors.New("aggregate key not set")
+	ErrInvalidPartialSignature = errors.New("invalid partial signature")
+	ErrAggregatedNoncesNotSet  = errors.New("nonces not aggregated yet")
 )
 
 type TreeNonces [][][66]byte // public nonces
@@ -100,42 +103,77 @@ func ValidateTreeSigs(
 	return nil
 }
 
-func (n TreeNonces) Decode(r io.Reader, matrixFormat []int) error {
-	for i := 0; i < len(matrixFormat); i++ {
-		for j := 0; j < matrixFormat[i]; j++ {
-			// read 66 bytes
-			nonce := make([]byte, 66)
-			_, err := r.Read(nonce)
-			if err != nil {
+// Encode writes the nonces matrix, each row is prefixed by its length.
+func (n TreeNonces) Encode(w io.Writer) error {
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(n))); err != nil {
+		return err
+	}
+
+	for _, level := range n {
+		if err := binary.Write(w, binary.LittleEndian, uint32(len(level))); err != nil {
+			return err
+		}
+
+		for _, nonce := range level {
+			if _, err := w.Write(nonce[:]); err != nil {
 				return err
 			}
-
-			n[i][j] = [66]byte(nonce)
 		}
 	}
 
 	return nil
 }
 
-func (n TreeNonces) Encode(w io.Writer) error {
-	for i := 0; i < len(n); i++ {
-		for j := 0; j < len(n[i]); j++ {
-			nonce := n[i][j][:]
-			_, err := w.Write(nonce)
-			if err != nil {
-				return err
+// DecodeNonces parses a nonces matrix serialized with TreeNonces.Encode.
+func DecodeNonces(r io.Reader) (TreeNonces, error) {
+	var nbLevels uint32
+	if err := binary.Read(r, binary.LittleEndian, &nbLevels); err != nil {
+		return nil, err
+	}
+
+	nonces := make(TreeNonces, 0)
+	for i := uint32(0); i < nbLevels; i++ {
+		var nbNonces uint32
+		if err := binary.Read(r, binary.LittleEndian, &nbNonces); err != nil {
+			return nil, err
+		}
+
+		levelNonces := make([][66]byte, 0)
+		for j := uint32(0); j < nbNonces; j++ {
+			var nonce [66]byte
+			if _, err := io.ReadFull(r, nonce[:]); err != nil {
+				return nil, err
 			}
+			levelNonces = append(levelNonces, nonce)
 		}
+
+		nonces = append(nonces, levelNonces)
 	}
 
-	return nil
+	return nonces, nil
 }
 
-func (n TreePartialSigs) Decode(r io.Reader, matrixFormat []int) error {
-	for i := 0; i < len(matrixFormat); i++ {
-		for j := 0; j < matrixFormat[i]; j++ {
-			sig := &musig2.PartialSignature{}
-			if err := sig.Decode(r); err != nil {
+// Encode writes the partial signatures matrix, each row is prefixed by its
+// length. Every signature is serialized as s (32 bytes) followed by the
+// compressed nonce point R (33 bytes).
+func (n TreePartialSigs) Encode(w io.Writer) error {
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(n))); err != nil {
+		return err
+	}
+
+	for _, level := range n {
+		if err := binary.Write(w, binary.LittleEndian, uint32(len(level))); err != nil {
+			return err
+		}
+
+		for _, sig := range level {
+			if sig == nil || sig.R == nil {
+				return errors.New("invalid partial signature")
+			}
+			if err := sig.Encode(w); err != nil {
+				return err
+			}
+			if _, err := w.Write(sig.R.SerializeCompressed()); err != nil {
 				return err
 			}
 		}
@@ -144,16 +182,45 @@ func (n TreePartialSigs) Decode(r io.Reader, matrixFormat []int) error {
 	return nil
 }
 
-func (n TreePartialSigs) Encode(w io.Writer) error {
-	for i := 0; i < len(n); i++ {
-		for j := 0; j < len(n[i]); j++ {
-			if err := n[i][j].Encode(w); err != nil {
-				return err
+// DecodeSignatures parses a partial signatures matrix serialized with
+// TreePartialSigs.Encode.
+func DecodeSignatures(r io.Reader) (TreePartialSigs, error) {
+	var nbLevels uint32
+	if err := binary.Read(r, binary.LittleEndian, &nbLevels); err != nil {
+		return nil, err
+	}
+
+	sigs := make(TreePartialSigs, 0)
+	for i := uint32(0); i < nbLevels; i++ {
+		var nbSigs uint32
+		if err := binary.Read(r, binary.LittleEndian, &nbSigs); err != nil {
+			return nil, err
+		}
+
+		levelSigs := make([]*musig2.PartialSignature, 0)
+		for j := uint32(0); j < nbSigs; j++ {
+			sig := &musig2.PartialSignature{}
+			if err := sig.Decode(r); err != nil {
+				return nil, err
+			}
+
+			var nonce [33]byte
+			if _, err := io.ReadFull(r, nonce[:]); err != nil {
+				return nil, err
+			}
+			noncePoint, err := btcec.ParsePubKey(nonce[:])
+			if err != nil {
+				return nil, err
 			}
+			sig.R = noncePoint
+
+			levelSigs = append(levelSigs, sig)
 		}
+
+		sigs = append(sigs, levelSigs)
 	}
 
-	return nil
+	return sigs, nil
 }
 
 func NewTreeSignerSession(
@@ -319,12 +386,13 @@ func (t *treeSignerSession) signPartial(partialTx *psbt.Packet, posx int, posy i
 }
 
 type treeCoordinatorSession struct {
-	scriptRoot     []byte
-	tree           tree.CongestionTree
-	keys           []*btcec.PublicKey
-	nonces         []TreeNonces
-	sigs           []TreePartialSigs
-	prevoutFetcher func(*psbt.Packet) txscript.PrevOutputFetcher
+	scriptRoot       []byte
+	tree             tree.CongestionTree
+	keys             []*btcec.PublicKey
+	nonces           []TreeNonces
+	aggregatedNonces TreeNonces
+	sigs             []TreePartialSigs
+	prevoutFetcher   func(*psbt.Packet) txscript.PrevOutputFetcher
 }
 
 func NewTreeCoordinatorSession(congestionTree tree.CongestionTree, minRelayFee int64, scriptRoot []byte, keys []*btcec.PublicKey) (CoordinatorSession, error) {
@@ -366,6 +434,10 @@ func (t *treeCoordinatorSession) AddNonce(pubkey *btcec.PublicKey, nonce TreeNon
 		return errors.New("public key not found")
 	}
 
+	if !matchTreeShape(t.tree, len(nonce), func(i int) int { return len(nonce[i]) }) {
+		return errors.New("nonces do not match the tree shape")
+	}
+
 	t.nonces[index] = nonce
 	return nil
 }
@@ -376,10 +448,58 @@ func (t *treeCoordinatorSession) AddSig(pubkey *btcec.PublicKey, sig TreePartial
 		return errors.New("public key not found")
 	}
 
+	if t.aggregatedNonces == nil {
+		return ErrAggregatedNoncesNotSet
+	}
+	if !matchTreeShape(t.tree, len(sig), func(i int) int { return len(sig[i]) }) {
+		return errors.New("signatures do not match the tree shape")
+	}
+
+	// every partial signature is verified against the nonce of the signer, so
+	// that an invalid one is attributed to it rather than making the
+	// combined signature invalid.
+	for i, level := range t.tree {
+		for j, node := range level {
+			s := sig[i][j]
+			if s == nil || s.R == nil {
+				return ErrInvalidPartialSignature
+			}
+
+			partialTx, err := psbt.NewFromRawBytes(strings.NewReader(node.Tx), true)
+			if err != nil {
+				return err
+			}
+			message, err := t.sigHash(partialTx)
+			if err != nil {
+				return err
+			}
+
+			if !s.Verify(
+				t.nonces[index][i][j], t.aggregatedNonces[i][j], t.keys, pubkey,
+				[32]byte(message),
+				musig2.WithSortedKeys(), musig2.WithTaprootSignTweak(t.scriptRoot),
+			) {
+				return ErrInvalidPartialSignature
+			}
+		}
+	}
+
 	t.sigs[index] = sig
 	return nil
 }
 
+func (t *treeCoordinatorSession) sigHash(partialTx *psbt.Packet) ([]byte, error) {
+	inputFetcher := t.prevoutFetcher(partialTx)
+
+	return txscript.CalcTaprootSignatureHash(
+		txscript.NewTxSigHashes(partialTx.UnsignedTx, inputFetcher),
+		txscript.SigHashDefault,
+		partialTx.UnsignedTx,
+		0,
+		inputFetcher,
+	)
+}
+
 func (t *treeCoordinatorSession) AggregateNonces() (TreeNonces, error) {
 	for _, nonce := range t.nonces {
 		if nonce == nil {
@@ -409,6 +529,7 @@ func (t *treeCoordinatorSession) AggregateNonces() (TreeNonces, error) {
 		aggregatedNonces = append(aggregatedNonces, levelNonces)
 	}
 
+	t.aggregatedNonces = aggregatedNonces
 	return aggregatedNonces, nil
 }
 
@@ -437,23 +558,15 @@ func (t *treeCoordinatorSession) SignTree() (tree.CongestionTree, error) {
 				sigs = append(sigs, sig[i][j])
 			}
 
-			inputFetcher := t.prevoutFetcher(partialTx)
-
-			message, err := txscript.CalcTaprootSignatureHash(
-				txscript.NewTxSigHashes(partialTx.UnsignedTx, inputFetcher),
-				txscript.SigHashDefault,
-				partialTx.UnsignedTx,
-				0,
-				inputFetcher,
-			)
+			message, err := t.sigHash(partialTx)
+			if err != nil {
+				return nil, err
+			}
 
 			combinedSig := musig2.CombineSigs(
 				sigs[0].R, sigs,
 				musig2.WithTaprootTweakedCombine([32]byte(message), t.keys, t.scriptRoot, true),
 			)
-			if err != nil {
-				return nil, err
-			}
 
 			if !combinedSig.Verify(message, aggregatedKey.FinalKey) {
 				return nil, errors.New("invalid signature")
@@ -474,6 +587,22 @@ func (t *treeCoordinatorSession) SignTree() (tree.CongestionTree, error) {
 	return t.tree, nil
 }
 
+// matchTreeShape returns true if the given matrix has one row per tree level
+// and one column per node of the level.
+func matchTreeShape(
+	congestionTree tree.CongestionTree, nbRows int, rowLen func(int) int,
+) bool {
+	if nbRows != len(congestionTree) {
+		return false
+	}
+	for i, level := range congestionTree {
+		if rowLen(i) != len(level) {
+			return false
+		}
+	}
+	return true
+}
+
 // given a final aggregated key and a min-relay-fee, returns the expected prevout
 func prevOutFetcherFactory(
 	feeAmount int64, finalAggregatedKey *btcec.PublicKey,
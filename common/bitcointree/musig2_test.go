@@ -1,6 +1,7 @@
 package bitcointree_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
 	"testing"
@@ -85,6 +86,14 @@ func TestRoundTripSignTree(t *testing.T) {
 		aspNonces, err := aspSession.GetNonces()
 		require.NoError(t, err)
 
+		// nonces and signatures are sent over the wire by the participants
+		var buf bytes.Buffer
+		err = aliceNonces.Encode(&buf)
+		require.NoError(t, err)
+
+		aliceNonces, err = bitcointree.DecodeNonces(&buf)
+		require.NoError(t, err)
+
 		err = aspCoordinator.AddNonce(alice.PubKey(), aliceNonces)
 		require.NoError(t, err)
 
@@ -94,6 +103,9 @@ func TestRoundTripSignTree(t *testing.T) {
 		err = aspCoordinator.AddNonce(asp.PubKey(), aspNonces)
 		require.NoError(t, err)
 
+		err = aspCoordinator.AddSig(asp.PubKey(), bitcointree.TreePartialSigs{})
+		require.ErrorIs(t, err, bitcointree.ErrAggregatedNoncesNotSet)
+
 		aggregatedNonce, err := aspCoordinator.AggregateNonces()
 		require.NoError(t, err)
 
@@ -126,6 +138,17 @@ func TestRoundTripSignTree(t *testing.T) {
 		aspSig, err := aspSession.Sign()
 		require.NoError(t, err)
 
+		buf.Reset()
+		err = aliceSig.Encode(&buf)
+		require.NoError(t, err)
+
+		aliceSig, err = bitcointree.DecodeSignatures(&buf)
+		require.NoError(t, err)
+
+		// signatures not made by the given cosigner are rejected
+		err = aspCoordinator.AddSig(bob.PubKey(), aliceSig)
+		require.ErrorIs(t, err, bitcointree.ErrInvalidPartialSignature)
+
 		// coordinator receives the signatures and combines them
 		err = aspCoordinator.AddSig(alice.PubKey(), aliceSig)
 		require.NoError(t, err)
@@ -2,8 +2,12 @@ package bitcointree
 
 import (
 	"bytes"
+	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
@@ -46,6 +50,73 @@ func GetCosignerKeys(in psbt.PInput) ([]*secp256k1.PublicKey, error) {
 	return keys, nil
 }
 
+// AddSharedOutputTapTree sets the internal key and the sweep leaf of the shared
+// output at the given index, which let a signer verify the output is sweepable
+// by the ASP.
+func AddSharedOutputTapTree(
+	outIndex int, ptx *psbt.Packet, cosigners []*secp256k1.PublicKey,
+	aspPubkey *secp256k1.PublicKey, roundLifetime int64,
+) error {
+	aggregatedKey, sweepLeaf, err := createAggregatedKeyWithSweep(
+		cosigners, aspPubkey, roundLifetime,
+	)
+	if err != nil {
+		return err
+	}
+
+	tapTree, err := EncodeTapTree(
+		txscript.NewTapLeaf(sweepLeaf.LeafVersion, sweepLeaf.Script),
+	)
+	if err != nil {
+		return err
+	}
+
+	ptx.Outputs[outIndex].TaprootInternalKey = schnorr.SerializePubKey(
+		aggregatedKey.PreTweakedKey,
+	)
+	ptx.Outputs[outIndex].TaprootTapTree = tapTree
+	return nil
+}
+
+// EncodeTapTree serializes the tree made of the given single leaf in the format
+// of the psbt output taproot tree field (BIP-371).
+func EncodeTapTree(leaf txscript.TapLeaf) ([]byte, error) {
+	var buf bytes.Buffer
+	// depth of the leaf
+	buf.WriteByte(0)
+	buf.WriteByte(byte(leaf.LeafVersion))
+	if err := wire.WriteVarBytes(&buf, 0, leaf.Script); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeTapTree parses the leaves of the given psbt output taproot tree field.
+func DecodeTapTree(tapTree []byte) ([]txscript.TapLeaf, error) {
+	leaves := make([]txscript.TapLeaf, 0)
+	r := bytes.NewReader(tapTree)
+	for r.Len() > 0 {
+		if _, err := r.ReadByte(); err != nil {
+			return nil, err
+		}
+		leafVersion, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		script, err := wire.ReadVarBytes(r, 0, txscript.MaxScriptSize, "script")
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, txscript.NewTapLeaf(
+			txscript.TapscriptLeafVersion(leafVersion), script,
+		))
+	}
+	if len(leaves) <= 0 {
+		return nil, fmt.Errorf("empty taproot tree")
+	}
+	return leaves, nil
+}
+
 func cosignerPrefixedKey(index int) []byte {
 	return append(COSIGNER_PSBT_KEY_PREFIX, byte(index))
 }
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	paymentInputsTag     = []byte("ark/payment-inputs")
+	finalizePaymentTag   = []byte("ark/finalize-payment")
+	cosignerNoncesTag    = []byte("ark/cosigner-nonces")
+	cosignerSignatureTag = []byte("ark/cosigner-signatures")
+)
+
+// PaymentNonceValidity is the time span the nonce, that the signatures proving
+// the ownership of the inputs of a payment commit to, is valid for.
+const PaymentNonceValidity = time.Minute
+
+// PaymentInputsHash returns the message that the owner of every input of a
+// payment must sign to prove the ownership of the vtxos registered for a round.
+// The hash commits to all the given outpoints, in order, so that a signature
+// can't be reused to register a different set of inputs, and to the single-use
+// nonce issued by the ASP, so that it can't be replayed.
+func PaymentInputsHash(inputs []wire.OutPoint, nonce []byte) []byte {
+	var buf bytes.Buffer
+	_ = wire.WriteVarBytes(&buf, 0, nonce)
+	for _, input := range inputs {
+		buf.Write(input.Hash[:])
+		_ = binary.Write(&buf, binary.LittleEndian, input.Index)
+	}
+	return chainhash.TaggedHash(paymentInputsTag, buf.Bytes())[:]
+}
+
+// FinalizePaymentHash returns the message that the owners of the inputs of a
+// payment must sign to submit its signed forfeit txs. The hash commits to the
+// payment id and to the given txs, in order, so that nobody else can submit
+// them on behalf of the owners.
+func FinalizePaymentHash(paymentId string, forfeitTxs []string) []byte {
+	var buf bytes.Buffer
+	_ = wire.WriteVarString(&buf, 0, paymentId)
+	for _, tx := range forfeitTxs {
+		_ = wire.WriteVarString(&buf, 0, tx)
+	}
+	return chainhash.TaggedHash(finalizePaymentTag, buf.Bytes())[:]
+}
+
+// CosignerNoncesHash returns the message that a cosigner of the congestion
+// tree of a round must sign with its key to submit its serialized nonces, so
+// that nobody else can submit them on its behalf.
+func CosignerNoncesHash(roundId string, nonces []byte) []byte {
+	return cosignerHash(cosignerNoncesTag, roundId, nonces)
+}
+
+// CosignerSignaturesHash returns the message that a cosigner of the
+// congestion tree of a round must sign with its key to submit its serialized
+// partial signatures.
+func CosignerSignaturesHash(roundId string, sigs []byte) []byte {
+	return cosignerHash(cosignerSignatureTag, roundId, sigs)
+}
+
+func cosignerHash(tag []byte, roundId string, data []byte) []byte {
+	var buf bytes.Buffer
+	_ = wire.WriteVarString(&buf, 0, roundId)
+	_ = wire.WriteVarBytes(&buf, 0, data)
+	return chainhash.TaggedHash(tag, buf.Bytes())[:]
+}
@@ -0,0 +1,83 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/ark-network/ark/common"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentInputsHash(t *testing.T) {
+	txid, err := chainhash.NewHashFromStr(
+		"d9fd4a0e1fbce81d09af1a8e8b3b8fa1b05e39b0b4e3e5a9a3c8b3dfb1c7a5e2",
+	)
+	require.NoError(t, err)
+
+	inputs := []wire.OutPoint{
+		{Hash: *txid, Index: 0},
+		{Hash: *txid, Index: 1},
+	}
+
+	nonce := []byte("nonce")
+
+	hash := common.PaymentInputsHash(inputs, nonce)
+	require.Len(t, hash, 32)
+
+	reversed := common.PaymentInputsHash(
+		[]wire.OutPoint{inputs[1], inputs[0]}, nonce,
+	)
+	require.NotEqual(t, hash, reversed)
+
+	otherNonce := common.PaymentInputsHash(inputs, []byte("other"))
+	require.NotEqual(t, hash, otherNonce)
+
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	sig, err := schnorr.Sign(key, hash)
+	require.NoError(t, err)
+	require.True(t, sig.Verify(hash, key.PubKey()))
+	require.False(t, sig.Verify(reversed, key.PubKey()))
+	require.False(t, sig.Verify(otherNonce, key.PubKey()))
+}
+
+func TestFinalizePaymentHash(t *testing.T) {
+	forfeitTxs := []string{"forfeit0", "forfeit1"}
+
+	hash := common.FinalizePaymentHash("payment", forfeitTxs)
+	require.Len(t, hash, 32)
+
+	reversed := common.FinalizePaymentHash(
+		"payment", []string{forfeitTxs[1], forfeitTxs[0]},
+	)
+	require.NotEqual(t, hash, reversed)
+
+	otherPayment := common.FinalizePaymentHash("other", forfeitTxs)
+	require.NotEqual(t, hash, otherPayment)
+
+	// the payment id and the txs can't be shifted into each other
+	shifted := common.FinalizePaymentHash("paymentforfeit0", forfeitTxs[1:])
+	require.NotEqual(t, hash, shifted)
+}
+
+func TestCosignerHashes(t *testing.T) {
+	data := []byte("data")
+
+	noncesHash := common.CosignerNoncesHash("round", data)
+	require.Len(t, noncesHash, 32)
+	sigsHash := common.CosignerSignaturesHash("round", data)
+	require.Len(t, sigsHash, 32)
+
+	// nonces and signatures can't be submitted in place of each other
+	require.NotEqual(t, noncesHash, sigsHash)
+
+	otherRound := common.CosignerNoncesHash("other", data)
+	require.NotEqual(t, noncesHash, otherRound)
+
+	otherData := common.CosignerNoncesHash("round", []byte("other"))
+	require.NotEqual(t, noncesHash, otherData)
+}
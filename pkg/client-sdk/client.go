@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ark-network/ark/common"
 	"github.com/ark-network/ark/pkg/client-sdk/client"
 	"github.com/ark-network/ark/pkg/client-sdk/explorer"
 	"github.com/ark-network/ark/pkg/client-sdk/internal/utils"
@@ -16,10 +17,14 @@ import (
 	walletstore "github.com/ark-network/ark/pkg/client-sdk/wallet/singlekey/store"
 	filestore "github.com/ark-network/ark/pkg/client-sdk/wallet/singlekey/store/file"
 	inmemorystore "github.com/ark-network/ark/pkg/client-sdk/wallet/singlekey/store/inmemory"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
 const (
+	// DUST is the min amount of the onchain outputs of the txs built by the
+	// wallet, offchain receivers are checked against the dust of the ASP.
 	DUST = 450
 	// transport
 	GrpcClient = client.GrpcClient
@@ -229,6 +234,104 @@ func (a *arkClient) ping(
 	return ticker.Stop
 }
 
+// getServiceInfo returns the fee schedule and the dust amount currently
+// published by the ASP.
+func (a *arkClient) getServiceInfo(ctx context.Context) (*client.Info, error) {
+	info, err := a.client.GetInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service info: %s", err)
+	}
+	return info, nil
+}
+
+// listVtxos returns the spendable and spent vtxos of the given address,
+// following the cursor until all the pages are fetched.
+func (a *arkClient) listVtxos(
+	ctx context.Context, addr string,
+) ([]client.Vtxo, []client.Vtxo, error) {
+	spendableVtxos, spentVtxos := make([]client.Vtxo, 0), make([]client.Vtxo, 0)
+	opts := client.ListVtxosOpts{}
+	for {
+		spendable, spent, nextCursor, err := a.client.ListVtxos(ctx, addr, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		spendableVtxos = append(spendableVtxos, spendable...)
+		spentVtxos = append(spentVtxos, spent...)
+		if len(nextCursor) <= 0 {
+			return spendableVtxos, spentVtxos, nil
+		}
+		opts.Cursor = nextCursor
+	}
+}
+
+// signPaymentInputs proves the ownership of the given inputs to the ASP by
+// signing the hash of their outpoints and of a nonce given by the ASP with
+// the wallet key. The returned signature is indexed by the pubkey of the
+// wallet and is valid only along with the returned nonce.
+func (a *arkClient) signPaymentInputs(
+	ctx context.Context, inputs []client.VtxoKey,
+) (map[string]string, string, error) {
+	outpoints := make([]wire.OutPoint, 0, len(inputs))
+	for _, input := range inputs {
+		txid, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return nil, "", err
+		}
+		outpoints = append(outpoints, wire.OutPoint{Hash: *txid, Index: input.VOut})
+	}
+
+	nonce, err := a.client.GetPaymentNonce(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	buf, err := hex.DecodeString(nonce)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid nonce: %s", err)
+	}
+
+	signatures, err := a.signWithWallet(ctx, common.PaymentInputsHash(outpoints, buf))
+	if err != nil {
+		return nil, "", err
+	}
+	return signatures, nonce, nil
+}
+
+// signFinalizePayment proves to the ASP that the given forfeit txs of the
+// payment are submitted by the owner of its inputs, the returned signature is
+// indexed by the pubkey of the wallet.
+func (a *arkClient) signFinalizePayment(
+	ctx context.Context, paymentID string, forfeitTxs []string,
+) (map[string]string, error) {
+	return a.signWithWallet(ctx, common.FinalizePaymentHash(paymentID, forfeitTxs))
+}
+
+// signWithWallet signs the given message with the wallet key and returns the
+// signature indexed by the hex encoded pubkey of the wallet.
+func (a *arkClient) signWithWallet(
+	ctx context.Context, msg []byte,
+) (map[string]string, error) {
+	offchainAddrs, _, _, err := a.wallet.GetAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(offchainAddrs) <= 0 {
+		return nil, fmt.Errorf("no offchain address")
+	}
+	_, pubkey, _, err := common.DecodeAddress(offchainAddrs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := a.wallet.SignMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		hex.EncodeToString(pubkey.SerializeCompressed()): sig,
+	}, nil
+}
+
 func getClient(
 	supportedClients utils.SupportedType[utils.ClientFactory], clientType, aspUrl string,
 ) (client.ASPClient, error) {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/ark-network/ark/common/bitcointree"
 	"github.com/ark-network/ark/common/tree"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
 const (
@@ -18,14 +20,18 @@ type RoundEvent interface {
 
 type ASPClient interface {
 	GetInfo(ctx context.Context) (*Info, error)
-	ListVtxos(ctx context.Context, addr string) ([]Vtxo, []Vtxo, error)
+	ListVtxos(
+		ctx context.Context, addr string, opts ListVtxosOpts,
+	) (spendableVtxos, spentVtxos []Vtxo, nextCursor string, err error)
 	GetRound(ctx context.Context, txID string) (*Round, error)
 	GetRoundByID(ctx context.Context, roundID string) (*Round, error)
 	Onboard(
 		ctx context.Context, tx, userPubkey string, congestionTree tree.CongestionTree,
 	) error
+	GetPaymentNonce(ctx context.Context) (string, error)
 	RegisterPayment(
-		ctx context.Context, inputs []VtxoKey,
+		ctx context.Context, inputs []VtxoKey, signatures map[string]string,
+		nonce, ephemeralPublicKey string,
 	) (string, error)
 	ClaimPayment(
 		ctx context.Context, paymentID string, outputs []Output,
@@ -35,7 +41,16 @@ type ASPClient interface {
 	) (<-chan RoundEventChannel, error)
 	Ping(ctx context.Context, paymentID string) (*RoundFinalizationEvent, error)
 	FinalizePayment(
-		ctx context.Context, signedForfeitTxs []string,
+		ctx context.Context, paymentID string, signedForfeitTxs []string,
+		signatures map[string]string,
+	) error
+	SendTreeNonces(
+		ctx context.Context, roundID, cosignerPubkey string,
+		nonces bitcointree.TreeNonces, signature string,
+	) error
+	SendTreeSignatures(
+		ctx context.Context, roundID, cosignerPubkey string,
+		signatures bitcointree.TreePartialSigs, signature string,
 	) error
 	CreatePayment(
 		ctx context.Context, inputs []VtxoKey, outputs []Output,
@@ -53,6 +68,30 @@ type Info struct {
 	RoundInterval       int64
 	Network             string
 	MinRelayFee         int64
+	Fees                FeeSchedule
+	Dust                uint64
+}
+
+// ServiceFee is a base fee plus a proportional fee, in parts per million,
+// charged by the ASP on the total amount of the inputs of a payment.
+type ServiceFee struct {
+	Base uint64
+	Rate uint64
+}
+
+// Amount returns the fee charged for spending inputs of the given amount.
+func (f ServiceFee) Amount(inputAmount uint64) uint64 {
+	proportional := inputAmount/1_000_000*f.Rate +
+		inputAmount%1_000_000*f.Rate/1_000_000
+	return f.Base + proportional
+}
+
+// FeeSchedule holds the service fees for payments with offchain receivers
+// only, for those with at least one onchain receiver and for async payments.
+type FeeSchedule struct {
+	Offchain ServiceFee
+	Onchain  ServiceFee
+	Async    ServiceFee
 }
 
 type RoundEventChannel struct {
@@ -75,6 +114,24 @@ type Vtxo struct {
 	Pending                 bool
 }
 
+// ListVtxosOpts filters and paginates the vtxos listed by the ASP, the zero
+// values are ignored. Redeemed vtxos are excluded unless Redeemed is set.
+type ListVtxosOpts struct {
+	Spent       *bool
+	Swept       *bool
+	Redeemed    *bool
+	MinAmount   uint64
+	MaxAmount   uint64
+	MinExpireAt int64
+	MaxExpireAt int64
+	RoundTxid   string
+	// Cursor is the one returned with the previous page, empty for the first.
+	Cursor string
+	// PageSize is the max number of vtxos returned, 0 means the default page
+	// size of the ASP.
+	PageSize uint32
+}
+
 type Output struct {
 	Address string
 	Amount  uint64
@@ -122,6 +179,7 @@ type RoundFinalizationEvent struct {
 	ForfeitTxs []string
 	Tree       tree.CongestionTree
 	Connectors []string
+	Attempt    int64
 }
 
 func (e RoundFinalizationEvent) isRoundEvent() {}
@@ -134,8 +192,46 @@ type RoundFinalizedEvent struct {
 func (e RoundFinalizedEvent) isRoundEvent() {}
 
 type RoundFailedEvent struct {
-	ID     string
-	Reason string
+	ID      string
+	Reason  string
+	Attempt int64
 }
 
 func (e RoundFailedEvent) isRoundEvent() {}
+
+type RoundSigningStartedEvent struct {
+	ID                  string
+	UnsignedTree        tree.CongestionTree
+	CosignersPublicKeys []*secp256k1.PublicKey
+	UnsignedRoundTx     string
+}
+
+func (e RoundSigningStartedEvent) isRoundEvent() {}
+
+type RoundSigningNoncesGeneratedEvent struct {
+	ID     string
+	Nonces bitcointree.TreeNonces
+}
+
+func (e RoundSigningNoncesGeneratedEvent) isRoundEvent() {}
+
+type PaymentsDeferredEvent struct {
+	ID         string
+	PaymentIDs []string
+}
+
+func (e PaymentsDeferredEvent) isRoundEvent() {}
+
+type PaymentsDroppedEvent struct {
+	ID         string
+	PaymentIDs []string
+}
+
+func (e PaymentsDroppedEvent) isRoundEvent() {}
+
+type PaymentsRegisteredEvent struct {
+	ID         string
+	PaymentIDs []string
+}
+
+func (e PaymentsRegisteredEvent) isRoundEvent() {}
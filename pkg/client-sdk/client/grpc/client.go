@@ -1,15 +1,19 @@
 package grpcclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
 
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+	"github.com/ark-network/ark/common/bitcointree"
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/pkg/client-sdk/client"
 	"github.com/ark-network/ark/pkg/client-sdk/internal/utils"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -18,7 +22,6 @@ import (
 type grpcClient struct {
 	conn      *grpc.ClientConn
 	svc       arkv1.ArkServiceClient
-	eventsCh  chan client.RoundEventChannel
 	treeCache *utils.Cache[tree.CongestionTree]
 }
 
@@ -43,10 +46,9 @@ func NewClient(aspUrl string) (client.ASPClient, error) {
 	}
 
 	svc := arkv1.NewArkServiceClient(conn)
-	eventsCh := make(chan client.RoundEventChannel)
 	treeCache := utils.NewCache[tree.CongestionTree]()
 
-	return &grpcClient{conn, svc, eventsCh, treeCache}, nil
+	return &grpcClient{conn, svc, treeCache}, nil
 }
 
 func (c *grpcClient) Close() {
@@ -63,21 +65,39 @@ func (a *grpcClient) GetEventStream(
 		return nil, err
 	}
 
+	eventsCh := make(chan client.RoundEventChannel)
+	send := func(ev client.RoundEventChannel) bool {
+		select {
+		case eventsCh <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
 	go func() {
-		defer close(a.eventsCh)
+		defer close(eventsCh)
 
 		for {
 			resp, err := stream.Recv()
 			if err != nil {
-				a.eventsCh <- client.RoundEventChannel{Err: err}
+				send(client.RoundEventChannel{Err: err})
+				return
+			}
+
+			ev, err := event{resp}.toRoundEvent()
+			if err != nil {
+				send(client.RoundEventChannel{Err: err})
 				return
 			}
 
-			a.eventsCh <- client.RoundEventChannel{Event: event{resp}.toRoundEvent()}
+			if !send(client.RoundEventChannel{Event: ev}) {
+				return
+			}
 		}
 	}()
 
-	return a.eventsCh, nil
+	return eventsCh, nil
 }
 
 func (a *grpcClient) GetInfo(ctx context.Context) (*client.Info, error) {
@@ -93,17 +113,36 @@ func (a *grpcClient) GetInfo(ctx context.Context) (*client.Info, error) {
 		RoundInterval:       resp.GetRoundInterval(),
 		Network:             resp.GetNetwork(),
 		MinRelayFee:         resp.GetMinRelayFee(),
+		Fees: client.FeeSchedule{
+			Offchain: serviceFee{resp.GetFees().GetOffchain()}.parse(),
+			Onchain:  serviceFee{resp.GetFees().GetOnchain()}.parse(),
+			Async:    serviceFee{resp.GetFees().GetAsync()}.parse(),
+		},
+		Dust: resp.GetDust(),
 	}, nil
 }
 
 func (a *grpcClient) ListVtxos(
-	ctx context.Context, addr string,
-) ([]client.Vtxo, []client.Vtxo, error) {
-	resp, err := a.svc.ListVtxos(ctx, &arkv1.ListVtxosRequest{Address: addr})
+	ctx context.Context, addr string, opts client.ListVtxosOpts,
+) ([]client.Vtxo, []client.Vtxo, string, error) {
+	resp, err := a.svc.ListVtxos(ctx, &arkv1.ListVtxosRequest{
+		Address:     addr,
+		Spent:       opts.Spent,
+		Swept:       opts.Swept,
+		Redeemed:    opts.Redeemed,
+		MinAmount:   opts.MinAmount,
+		MaxAmount:   opts.MaxAmount,
+		MinExpireAt: opts.MinExpireAt,
+		MaxExpireAt: opts.MaxExpireAt,
+		RoundTxid:   opts.RoundTxid,
+		Cursor:      opts.Cursor,
+		PageSize:    opts.PageSize,
+	})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
-	return vtxos(resp.GetSpendableVtxos()).toVtxos(), vtxos(resp.GetSpentVtxos()).toVtxos(), nil
+	return vtxos(resp.GetSpendableVtxos()).toVtxos(),
+		vtxos(resp.GetSpentVtxos()).toVtxos(), resp.GetNextCursor(), nil
 }
 
 func (a *grpcClient) GetRound(
@@ -145,11 +184,23 @@ func (a *grpcClient) Onboard(
 	return err
 }
 
+func (a *grpcClient) GetPaymentNonce(ctx context.Context) (string, error) {
+	resp, err := a.svc.GetPaymentNonce(ctx, &arkv1.GetPaymentNonceRequest{})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetNonce(), nil
+}
+
 func (a *grpcClient) RegisterPayment(
-	ctx context.Context, inputs []client.VtxoKey,
+	ctx context.Context, inputs []client.VtxoKey, signatures map[string]string,
+	nonce, ephemeralPublicKey string,
 ) (string, error) {
 	req := &arkv1.RegisterPaymentRequest{
-		Inputs: ins(inputs).toProto(),
+		Inputs:          ins(inputs).toProto(),
+		EphemeralPubkey: ephemeralPublicKey,
+		Signatures:      signatures,
+		Nonce:           nonce,
 	}
 	resp, err := a.svc.RegisterPayment(ctx, req)
 	if err != nil {
@@ -190,15 +241,56 @@ func (a *grpcClient) Ping(
 }
 
 func (a *grpcClient) FinalizePayment(
-	ctx context.Context, signedForfeitTxs []string,
+	ctx context.Context, paymentID string, signedForfeitTxs []string,
+	signatures map[string]string,
 ) error {
 	req := &arkv1.FinalizePaymentRequest{
 		SignedForfeitTxs: signedForfeitTxs,
+		PaymentId:        paymentID,
+		Signatures:       signatures,
 	}
 	_, err := a.svc.FinalizePayment(ctx, req)
 	return err
 }
 
+func (a *grpcClient) SendTreeNonces(
+	ctx context.Context, roundID, cosignerPubkey string,
+	nonces bitcointree.TreeNonces, signature string,
+) error {
+	var nonceBuffer bytes.Buffer
+	if err := nonces.Encode(&nonceBuffer); err != nil {
+		return err
+	}
+
+	req := &arkv1.SendTreeNoncesRequest{
+		RoundId:    roundID,
+		PublicKey:  cosignerPubkey,
+		TreeNonces: hex.EncodeToString(nonceBuffer.Bytes()),
+		Signature:  signature,
+	}
+	_, err := a.svc.SendTreeNonces(ctx, req)
+	return err
+}
+
+func (a *grpcClient) SendTreeSignatures(
+	ctx context.Context, roundID, cosignerPubkey string,
+	signatures bitcointree.TreePartialSigs, signature string,
+) error {
+	var sigsBuffer bytes.Buffer
+	if err := signatures.Encode(&sigsBuffer); err != nil {
+		return err
+	}
+
+	req := &arkv1.SendTreeSignaturesRequest{
+		RoundId:        roundID,
+		PublicKey:      cosignerPubkey,
+		TreeSignatures: hex.EncodeToString(sigsBuffer.Bytes()),
+		Signature:      signature,
+	}
+	_, err := a.svc.SendTreeSignatures(ctx, req)
+	return err
+}
+
 func (a *grpcClient) CreatePayment(
 	ctx context.Context, inputs []client.VtxoKey, outputs []client.Output,
 ) (string, []string, error) {
@@ -275,12 +367,13 @@ type event struct {
 	*arkv1.GetEventStreamResponse
 }
 
-func (e event) toRoundEvent() client.RoundEvent {
+func (e event) toRoundEvent() (client.RoundEvent, error) {
 	if ee := e.GetRoundFailed(); ee != nil {
 		return client.RoundFailedEvent{
-			ID:     ee.GetId(),
-			Reason: ee.GetReason(),
-		}
+			ID:      ee.GetId(),
+			Reason:  ee.GetReason(),
+			Attempt: ee.GetAttempt(),
+		}, nil
 	}
 	if ee := e.GetRoundFinalization(); ee != nil {
 		tree := treeFromProto{ee.GetCongestionTree()}.parse()
@@ -290,13 +383,66 @@ func (e event) toRoundEvent() client.RoundEvent {
 			ForfeitTxs: ee.GetForfeitTxs(),
 			Tree:       tree,
 			Connectors: ee.GetConnectors(),
+			Attempt:    ee.GetAttempt(),
+		}, nil
+	}
+	if ee := e.GetRoundSigning(); ee != nil {
+		pubkeys := make([]*secp256k1.PublicKey, 0, len(ee.GetCosignersPubkeys()))
+		for _, pubkey := range ee.GetCosignersPubkeys() {
+			buf, err := hex.DecodeString(pubkey)
+			if err != nil {
+				return nil, err
+			}
+			pk, err := secp256k1.ParsePubKey(buf)
+			if err != nil {
+				return nil, err
+			}
+			pubkeys = append(pubkeys, pk)
 		}
+		return client.RoundSigningStartedEvent{
+			ID:                  ee.GetId(),
+			UnsignedTree:        treeFromProto{ee.GetUnsignedTree()}.parse(),
+			CosignersPublicKeys: pubkeys,
+			UnsignedRoundTx:     ee.GetUnsignedRoundTx(),
+		}, nil
+	}
+	if ee := e.GetRoundSigningNoncesGenerated(); ee != nil {
+		buf, err := hex.DecodeString(ee.GetTreeNonces())
+		if err != nil {
+			return nil, err
+		}
+		nonces, err := bitcointree.DecodeNonces(bytes.NewReader(buf))
+		if err != nil {
+			return nil, err
+		}
+		return client.RoundSigningNoncesGeneratedEvent{
+			ID:     ee.GetId(),
+			Nonces: nonces,
+		}, nil
+	}
+	if ee := e.GetPaymentsDeferred(); ee != nil {
+		return client.PaymentsDeferredEvent{
+			ID:         ee.GetId(),
+			PaymentIDs: ee.GetPaymentIds(),
+		}, nil
+	}
+	if ee := e.GetPaymentsDropped(); ee != nil {
+		return client.PaymentsDroppedEvent{
+			ID:         ee.GetId(),
+			PaymentIDs: ee.GetPaymentIds(),
+		}, nil
+	}
+	if ee := e.GetPaymentsRegistered(); ee != nil {
+		return client.PaymentsRegisteredEvent{
+			ID:         ee.GetId(),
+			PaymentIDs: ee.GetPaymentIds(),
+		}, nil
 	}
 	ee := e.GetRoundFinalized()
 	return client.RoundFinalizedEvent{
 		ID:   ee.GetId(),
 		Txid: ee.GetPoolTxid(),
-	}
+	}, nil
 }
 
 type vtxo struct {
@@ -358,6 +504,17 @@ func (i ins) toProto() []*arkv1.Input {
 	return list
 }
 
+type serviceFee struct {
+	*arkv1.ServiceFee
+}
+
+func (f serviceFee) parse() client.ServiceFee {
+	return client.ServiceFee{
+		Base: f.GetBase(),
+		Rate: f.GetRate(),
+	}
+}
+
 type treeFromProto struct {
 	*arkv1.Tree
 }
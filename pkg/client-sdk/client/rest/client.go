@@ -1,14 +1,19 @@
 package restclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+	"github.com/ark-network/ark/common/bitcointree"
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/pkg/client-sdk/client"
 	"github.com/ark-network/ark/pkg/client-sdk/client/rest/service/arkservice"
@@ -16,14 +21,15 @@ import (
 	"github.com/ark-network/ark/pkg/client-sdk/client/rest/service/models"
 	"github.com/ark-network/ark/pkg/client-sdk/internal/utils"
 	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	"github.com/vulpemventures/go-elements/psetv2"
 )
 
 type restClient struct {
+	serverURL      string
 	svc            ark_service.ClientService
-	eventsCh       chan client.RoundEventChannel
 	requestTimeout time.Duration
 	treeCache      *utils.Cache[tree.CongestionTree]
 }
@@ -36,83 +42,88 @@ func NewClient(aspUrl string) (client.ASPClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	eventsCh := make(chan client.RoundEventChannel)
 	reqTimeout := 15 * time.Second
 	treeCache := utils.NewCache[tree.CongestionTree]()
 
-	return &restClient{svc, eventsCh, reqTimeout, treeCache}, nil
+	return &restClient{
+		strings.TrimSuffix(aspUrl, "/"), svc, reqTimeout, treeCache,
+	}, nil
 }
 
 func (c *restClient) Close() {}
 
+// GetEventStream consumes the server-side stream exposed by the REST gateway,
+// where every event is a JSON object separated by a newline.
 func (a *restClient) GetEventStream(
 	ctx context.Context, paymentID string,
 ) (<-chan client.RoundEventChannel, error) {
-	go func(payID string) {
-		defer close(a.eventsCh)
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, fmt.Sprintf("%s/v1/events", a.serverURL), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		// nolint
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to open event stream: %s", resp.Status)
+	}
+
+	eventsCh := make(chan client.RoundEventChannel)
+	send := func(ev client.RoundEventChannel) bool {
+		select {
+		case eventsCh <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
 
-		timeout := time.After(a.requestTimeout)
+	go func() {
+		defer close(eventsCh)
+		// nolint
+		defer resp.Body.Close()
 
+		decoder := json.NewDecoder(resp.Body)
 		for {
-			select {
-			case <-timeout:
-				a.eventsCh <- client.RoundEventChannel{
-					Err: fmt.Errorf("timeout reached"),
-				}
+			chunk := struct {
+				Result *models.V1GetEventStreamResponse `json:"result"`
+				Error  *models.RPCStatus                `json:"error"`
+			}{}
+			if err := decoder.Decode(&chunk); err != nil {
+				send(client.RoundEventChannel{Err: err})
 				return
-			default:
-				event, err := a.Ping(ctx, payID)
-				if err != nil {
-					a.eventsCh <- client.RoundEventChannel{
-						Err: err,
-					}
-					return
-				}
+			}
 
-				if event != nil {
-					a.eventsCh <- client.RoundEventChannel{
-						Event: *event,
-					}
-
-					for {
-						roundID := event.ID
-						round, err := a.GetRoundByID(ctx, roundID)
-						if err != nil {
-							a.eventsCh <- client.RoundEventChannel{
-								Err: err,
-							}
-							return
-						}
-
-						if round.Stage == client.RoundStageFinalized {
-							a.eventsCh <- client.RoundEventChannel{
-								Event: client.RoundFinalizedEvent{
-									ID:   roundID,
-									Txid: getTxid(round.Tx),
-								},
-							}
-							return
-						}
-
-						if round.Stage == client.RoundStageFailed {
-							a.eventsCh <- client.RoundEventChannel{
-								Event: client.RoundFailedEvent{
-									ID: roundID,
-								},
-							}
-							return
-						}
-
-						time.Sleep(1 * time.Second)
-					}
-				}
+			if chunk.Error != nil {
+				send(client.RoundEventChannel{
+					Err: fmt.Errorf("%s", chunk.Error.Message),
+				})
+				return
+			}
+
+			if chunk.Result == nil {
+				continue
+			}
+
+			event, err := eventFromProto{chunk.Result}.parse()
+			if err != nil {
+				send(client.RoundEventChannel{Err: err})
+				return
+			}
 
-				time.Sleep(1 * time.Second)
+			if !send(client.RoundEventChannel{Event: event}) {
+				return
 			}
 		}
-	}(paymentID)
+	}()
 
-	return a.eventsCh, nil
+	return eventsCh, nil
 }
 
 func (a *restClient) GetInfo(
@@ -143,6 +154,30 @@ func (a *restClient) GetInfo(
 		return nil, err
 	}
 
+	var dust uint64
+	if len(resp.Payload.Dust) > 0 {
+		dust, err = strconv.ParseUint(resp.Payload.Dust, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fees client.FeeSchedule
+	if resp.Payload.Fees != nil {
+		fees.Offchain, err = serviceFee{resp.Payload.Fees.Offchain}.parse()
+		if err != nil {
+			return nil, err
+		}
+		fees.Onchain, err = serviceFee{resp.Payload.Fees.Onchain}.parse()
+		if err != nil {
+			return nil, err
+		}
+		fees.Async, err = serviceFee{resp.Payload.Fees.Async}.parse()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &client.Info{
 		Pubkey:              resp.Payload.Pubkey,
 		RoundLifetime:       int64(roundLifetime),
@@ -150,17 +185,49 @@ func (a *restClient) GetInfo(
 		RoundInterval:       int64(roundInterval),
 		Network:             resp.Payload.Network,
 		MinRelayFee:         int64(minRelayFee),
+		Fees:                fees,
+		Dust:                dust,
 	}, nil
 }
 
 func (a *restClient) ListVtxos(
-	ctx context.Context, addr string,
-) ([]client.Vtxo, []client.Vtxo, error) {
-	resp, err := a.svc.ArkServiceListVtxos(
-		ark_service.NewArkServiceListVtxosParams().WithAddress(addr),
-	)
+	ctx context.Context, addr string, opts client.ListVtxosOpts,
+) ([]client.Vtxo, []client.Vtxo, string, error) {
+	params := ark_service.NewArkServiceListVtxosParams().
+		WithAddress(addr).
+		WithSpent(opts.Spent).
+		WithSwept(opts.Swept).
+		WithRedeemed(opts.Redeemed)
+	if opts.MinAmount > 0 {
+		minAmount := strconv.FormatUint(opts.MinAmount, 10)
+		params.SetMinAmount(&minAmount)
+	}
+	if opts.MaxAmount > 0 {
+		maxAmount := strconv.FormatUint(opts.MaxAmount, 10)
+		params.SetMaxAmount(&maxAmount)
+	}
+	if opts.MinExpireAt > 0 {
+		minExpireAt := strconv.FormatInt(opts.MinExpireAt, 10)
+		params.SetMinExpireAt(&minExpireAt)
+	}
+	if opts.MaxExpireAt > 0 {
+		maxExpireAt := strconv.FormatInt(opts.MaxExpireAt, 10)
+		params.SetMaxExpireAt(&maxExpireAt)
+	}
+	if len(opts.RoundTxid) > 0 {
+		params.SetRoundTxid(&opts.RoundTxid)
+	}
+	if len(opts.Cursor) > 0 {
+		params.SetCursor(&opts.Cursor)
+	}
+	if opts.PageSize > 0 {
+		pageSize := int64(opts.PageSize)
+		params.SetPageSize(&pageSize)
+	}
+
+	resp, err := a.svc.ArkServiceListVtxos(params)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, "", err
 	}
 
 	spendableVtxos := make([]client.Vtxo, 0, len(resp.Payload.SpendableVtxos))
@@ -169,7 +236,7 @@ func (a *restClient) ListVtxos(
 		if v.ExpireAt != "" && v.ExpireAt != "0" {
 			expAt, err := strconv.Atoi(v.ExpireAt)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, "", err
 			}
 			t := time.Unix(int64(expAt), 0)
 			expiresAt = &t
@@ -177,7 +244,7 @@ func (a *restClient) ListVtxos(
 
 		amount, err := strconv.Atoi(v.Receiver.Amount)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, "", err
 		}
 
 		var redeemTx string
@@ -207,7 +274,7 @@ func (a *restClient) ListVtxos(
 		if v.ExpireAt != "" && v.ExpireAt != "0" {
 			expAt, err := strconv.Atoi(v.ExpireAt)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, "", err
 			}
 			t := time.Unix(int64(expAt), 0)
 			expiresAt = &t
@@ -215,7 +282,7 @@ func (a *restClient) ListVtxos(
 
 		amount, err := strconv.Atoi(v.Receiver.Amount)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, "", err
 		}
 
 		spentVtxos = append(spentVtxos, client.Vtxo{
@@ -229,7 +296,7 @@ func (a *restClient) ListVtxos(
 		})
 	}
 
-	return spendableVtxos, spentVtxos, nil
+	return spendableVtxos, spentVtxos, resp.Payload.NextCursor, nil
 }
 
 func (a *restClient) GetRound(
@@ -285,8 +352,19 @@ func (a *restClient) Onboard(
 	return err
 }
 
+func (a *restClient) GetPaymentNonce(ctx context.Context) (string, error) {
+	resp, err := a.svc.ArkServiceGetPaymentNonce(
+		ark_service.NewArkServiceGetPaymentNonceParams(),
+	)
+	if err != nil {
+		return "", err
+	}
+	return resp.Payload.Nonce, nil
+}
+
 func (a *restClient) RegisterPayment(
-	ctx context.Context, inputs []client.VtxoKey,
+	ctx context.Context, inputs []client.VtxoKey, signatures map[string]string,
+	nonce, ephemeralPublicKey string,
 ) (string, error) {
 	ins := make([]*models.V1Input, 0, len(inputs))
 	for _, i := range inputs {
@@ -296,7 +374,10 @@ func (a *restClient) RegisterPayment(
 		})
 	}
 	body := models.V1RegisterPaymentRequest{
-		Inputs: ins,
+		Inputs:          ins,
+		EphemeralPubkey: ephemeralPublicKey,
+		Nonce:           nonce,
+		Signatures:      signatures,
 	}
 	resp, err := a.svc.ArkServiceRegisterPayment(
 		ark_service.NewArkServiceRegisterPaymentParams().WithBody(&body),
@@ -354,13 +435,18 @@ func (a *restClient) Ping(
 }
 
 func (a *restClient) FinalizePayment(
-	ctx context.Context, signedForfeitTxs []string,
+	ctx context.Context, paymentID string, signedForfeitTxs []string,
+	signatures map[string]string,
 ) error {
 	req := &arkv1.FinalizePaymentRequest{
 		SignedForfeitTxs: signedForfeitTxs,
+		PaymentId:        paymentID,
+		Signatures:       signatures,
 	}
 	body := models.V1FinalizePaymentRequest{
 		SignedForfeitTxs: req.GetSignedForfeitTxs(),
+		PaymentID:        req.GetPaymentId(),
+		Signatures:       req.GetSignatures(),
 	}
 	_, err := a.svc.ArkServiceFinalizePayment(
 		ark_service.NewArkServiceFinalizePaymentParams().WithBody(&body),
@@ -368,6 +454,48 @@ func (a *restClient) FinalizePayment(
 	return err
 }
 
+func (a *restClient) SendTreeNonces(
+	ctx context.Context, roundID, cosignerPubkey string,
+	nonces bitcointree.TreeNonces, signature string,
+) error {
+	var nonceBuffer bytes.Buffer
+	if err := nonces.Encode(&nonceBuffer); err != nil {
+		return err
+	}
+
+	body := models.V1SendTreeNoncesRequest{
+		RoundID:    roundID,
+		PublicKey:  cosignerPubkey,
+		TreeNonces: hex.EncodeToString(nonceBuffer.Bytes()),
+		Signature:  signature,
+	}
+	_, err := a.svc.ArkServiceSendTreeNonces(
+		ark_service.NewArkServiceSendTreeNoncesParams().WithBody(&body),
+	)
+	return err
+}
+
+func (a *restClient) SendTreeSignatures(
+	ctx context.Context, roundID, cosignerPubkey string,
+	signatures bitcointree.TreePartialSigs, signature string,
+) error {
+	var sigsBuffer bytes.Buffer
+	if err := signatures.Encode(&sigsBuffer); err != nil {
+		return err
+	}
+
+	body := models.V1SendTreeSignaturesRequest{
+		RoundID:        roundID,
+		PublicKey:      cosignerPubkey,
+		TreeSignatures: hex.EncodeToString(sigsBuffer.Bytes()),
+		Signature:      signature,
+	}
+	_, err := a.svc.ArkServiceSendTreeSignatures(
+		ark_service.NewArkServiceSendTreeSignaturesParams().WithBody(&body),
+	)
+	return err
+}
+
 func (a *restClient) CreatePayment(
 	ctx context.Context, inputs []client.VtxoKey, outputs []client.Output,
 ) (string, []string, error) {
@@ -496,6 +624,133 @@ func toRoundStage(stage models.V1RoundStage) client.RoundStage {
 	}
 }
 
+type eventFromProto struct {
+	*models.V1GetEventStreamResponse
+}
+
+func (e eventFromProto) parse() (client.RoundEvent, error) {
+	if ee := e.RoundFailed; ee != nil {
+		attempt, err := parseAttempt(ee.Attempt)
+		if err != nil {
+			return nil, err
+		}
+		return client.RoundFailedEvent{
+			ID:      ee.ID,
+			Reason:  ee.Reason,
+			Attempt: attempt,
+		}, nil
+	}
+	if ee := e.RoundFinalization; ee != nil {
+		attempt, err := parseAttempt(ee.Attempt)
+		if err != nil {
+			return nil, err
+		}
+		return client.RoundFinalizationEvent{
+			ID:         ee.ID,
+			Tx:         ee.PoolTx,
+			ForfeitTxs: ee.ForfeitTxs,
+			Tree:       treeFromProto{ee.CongestionTree}.parse(),
+			Connectors: ee.Connectors,
+			Attempt:    attempt,
+		}, nil
+	}
+	if ee := e.RoundFinalized; ee != nil {
+		return client.RoundFinalizedEvent{
+			ID:   ee.ID,
+			Txid: ee.PoolTxid,
+		}, nil
+	}
+	if ee := e.RoundSigning; ee != nil {
+		pubkeys := make([]*secp256k1.PublicKey, 0, len(ee.CosignersPubkeys))
+		for _, pubkey := range ee.CosignersPubkeys {
+			buf, err := hex.DecodeString(pubkey)
+			if err != nil {
+				return nil, err
+			}
+			pk, err := secp256k1.ParsePubKey(buf)
+			if err != nil {
+				return nil, err
+			}
+			pubkeys = append(pubkeys, pk)
+		}
+		return client.RoundSigningStartedEvent{
+			ID:                  ee.ID,
+			UnsignedTree:        treeFromProto{ee.UnsignedTree}.parse(),
+			CosignersPublicKeys: pubkeys,
+			UnsignedRoundTx:     ee.UnsignedRoundTx,
+		}, nil
+	}
+	if ee := e.RoundSigningNoncesGenerated; ee != nil {
+		buf, err := hex.DecodeString(ee.TreeNonces)
+		if err != nil {
+			return nil, err
+		}
+		nonces, err := bitcointree.DecodeNonces(bytes.NewReader(buf))
+		if err != nil {
+			return nil, err
+		}
+		return client.RoundSigningNoncesGeneratedEvent{
+			ID:     ee.ID,
+			Nonces: nonces,
+		}, nil
+	}
+	if ee := e.PaymentsDeferred; ee != nil {
+		return client.PaymentsDeferredEvent{
+			ID:         ee.ID,
+			PaymentIDs: ee.PaymentIds,
+		}, nil
+	}
+	if ee := e.PaymentsDropped; ee != nil {
+		return client.PaymentsDroppedEvent{
+			ID:         ee.ID,
+			PaymentIDs: ee.PaymentIds,
+		}, nil
+	}
+	if ee := e.PaymentsRegistered; ee != nil {
+		return client.PaymentsRegisteredEvent{
+			ID:         ee.ID,
+			PaymentIDs: ee.PaymentIds,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown event")
+}
+
+// parseAttempt parses the attempt of a round event, missing if the ASP
+// doesn't retry the finalization of rounds.
+func parseAttempt(attempt string) (int64, error) {
+	if len(attempt) <= 0 {
+		return 0, nil
+	}
+	return strconv.ParseInt(attempt, 10, 64)
+}
+
+type serviceFee struct {
+	*models.V1ServiceFee
+}
+
+func (f serviceFee) parse() (client.ServiceFee, error) {
+	if f.V1ServiceFee == nil {
+		return client.ServiceFee{}, nil
+	}
+
+	parse := func(value string) (uint64, error) {
+		if len(value) <= 0 {
+			return 0, nil
+		}
+		return strconv.ParseUint(value, 10, 64)
+	}
+
+	base, err := parse(f.Base)
+	if err != nil {
+		return client.ServiceFee{}, fmt.Errorf("invalid base fee: %s", err)
+	}
+	rate, err := parse(f.Rate)
+	if err != nil {
+		return client.ServiceFee{}, fmt.Errorf("invalid fee rate: %s", err)
+	}
+	return client.ServiceFee{Base: base, Rate: rate}, nil
+}
+
 type treeFromProto struct {
 	*models.V1Tree
 }
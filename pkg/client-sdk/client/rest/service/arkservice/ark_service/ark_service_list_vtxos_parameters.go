@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewArkServiceListVtxosParams creates a new ArkServiceListVtxosParams object,
@@ -64,6 +65,63 @@ type ArkServiceListVtxosParams struct {
 	// Address.
 	Address string
 
+	/* Cursor.
+
+	   Cursor returned with the previous page, empty for the first one.
+	*/
+	Cursor *string
+
+	// MaxAmount.
+	//
+	// Format: uint64
+	MaxAmount *string
+
+	// MaxExpireAt.
+	//
+	// Format: int64
+	MaxExpireAt *string
+
+	/* MinAmount.
+
+	   Amount range in satoshis, bounds included.
+
+	   Format: uint64
+	*/
+	MinAmount *string
+
+	/* MinExpireAt.
+
+	   Expiry range as unix timestamp, bounds included.
+
+	   Format: int64
+	*/
+	MinExpireAt *string
+
+	/* PageSize.
+
+	   Max number of vtxos of the page, 0 means the default of 100, at most
+	1000.
+
+	   Format: int64
+	*/
+	PageSize *int64
+
+	// Redeemed.
+	Redeemed *bool
+
+	// RoundTxid.
+	RoundTxid *string
+
+	/* Spent.
+
+	   Filters, the unset ones are ignored. Redeemed vtxos are excluded unless
+	redeemed is set.
+	*/
+	Spent *bool
+
+	// Swept.
+	Swept *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -128,6 +186,116 @@ func (o *ArkServiceListVtxosParams) SetAddress(address string) {
 	o.Address = address
 }
 
+// WithCursor adds the cursor to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithCursor(cursor *string) *ArkServiceListVtxosParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithMaxAmount adds the maxAmount to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithMaxAmount(maxAmount *string) *ArkServiceListVtxosParams {
+	o.SetMaxAmount(maxAmount)
+	return o
+}
+
+// SetMaxAmount adds the maxAmount to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetMaxAmount(maxAmount *string) {
+	o.MaxAmount = maxAmount
+}
+
+// WithMaxExpireAt adds the maxExpireAt to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithMaxExpireAt(maxExpireAt *string) *ArkServiceListVtxosParams {
+	o.SetMaxExpireAt(maxExpireAt)
+	return o
+}
+
+// SetMaxExpireAt adds the maxExpireAt to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetMaxExpireAt(maxExpireAt *string) {
+	o.MaxExpireAt = maxExpireAt
+}
+
+// WithMinAmount adds the minAmount to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithMinAmount(minAmount *string) *ArkServiceListVtxosParams {
+	o.SetMinAmount(minAmount)
+	return o
+}
+
+// SetMinAmount adds the minAmount to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetMinAmount(minAmount *string) {
+	o.MinAmount = minAmount
+}
+
+// WithMinExpireAt adds the minExpireAt to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithMinExpireAt(minExpireAt *string) *ArkServiceListVtxosParams {
+	o.SetMinExpireAt(minExpireAt)
+	return o
+}
+
+// SetMinExpireAt adds the minExpireAt to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetMinExpireAt(minExpireAt *string) {
+	o.MinExpireAt = minExpireAt
+}
+
+// WithPageSize adds the pageSize to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithPageSize(pageSize *int64) *ArkServiceListVtxosParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithRedeemed adds the redeemed to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithRedeemed(redeemed *bool) *ArkServiceListVtxosParams {
+	o.SetRedeemed(redeemed)
+	return o
+}
+
+// SetRedeemed adds the redeemed to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetRedeemed(redeemed *bool) {
+	o.Redeemed = redeemed
+}
+
+// WithRoundTxid adds the roundTxid to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithRoundTxid(roundTxid *string) *ArkServiceListVtxosParams {
+	o.SetRoundTxid(roundTxid)
+	return o
+}
+
+// SetRoundTxid adds the roundTxid to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetRoundTxid(roundTxid *string) {
+	o.RoundTxid = roundTxid
+}
+
+// WithSpent adds the spent to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithSpent(spent *bool) *ArkServiceListVtxosParams {
+	o.SetSpent(spent)
+	return o
+}
+
+// SetSpent adds the spent to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetSpent(spent *bool) {
+	o.Spent = spent
+}
+
+// WithSwept adds the swept to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) WithSwept(swept *bool) *ArkServiceListVtxosParams {
+	o.SetSwept(swept)
+	return o
+}
+
+// SetSwept adds the swept to the ark service list vtxos params
+func (o *ArkServiceListVtxosParams) SetSwept(swept *bool) {
+	o.Swept = swept
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ArkServiceListVtxosParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -141,6 +309,176 @@ func (o *ArkServiceListVtxosParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxAmount != nil {
+
+		// query param maxAmount
+		var qrMaxAmount string
+
+		if o.MaxAmount != nil {
+			qrMaxAmount = *o.MaxAmount
+		}
+		qMaxAmount := qrMaxAmount
+		if qMaxAmount != "" {
+
+			if err := r.SetQueryParam("maxAmount", qMaxAmount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxExpireAt != nil {
+
+		// query param maxExpireAt
+		var qrMaxExpireAt string
+
+		if o.MaxExpireAt != nil {
+			qrMaxExpireAt = *o.MaxExpireAt
+		}
+		qMaxExpireAt := qrMaxExpireAt
+		if qMaxExpireAt != "" {
+
+			if err := r.SetQueryParam("maxExpireAt", qMaxExpireAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinAmount != nil {
+
+		// query param minAmount
+		var qrMinAmount string
+
+		if o.MinAmount != nil {
+			qrMinAmount = *o.MinAmount
+		}
+		qMinAmount := qrMinAmount
+		if qMinAmount != "" {
+
+			if err := r.SetQueryParam("minAmount", qMinAmount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinExpireAt != nil {
+
+		// query param minExpireAt
+		var qrMinExpireAt string
+
+		if o.MinExpireAt != nil {
+			qrMinExpireAt = *o.MinExpireAt
+		}
+		qMinExpireAt := qrMinExpireAt
+		if qMinExpireAt != "" {
+
+			if err := r.SetQueryParam("minExpireAt", qMinExpireAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageSize != nil {
+
+		// query param pageSize
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("pageSize", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Redeemed != nil {
+
+		// query param redeemed
+		var qrRedeemed bool
+
+		if o.Redeemed != nil {
+			qrRedeemed = *o.Redeemed
+		}
+		qRedeemed := swag.FormatBool(qrRedeemed)
+		if qRedeemed != "" {
+
+			if err := r.SetQueryParam("redeemed", qRedeemed); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.RoundTxid != nil {
+
+		// query param roundTxid
+		var qrRoundTxid string
+
+		if o.RoundTxid != nil {
+			qrRoundTxid = *o.RoundTxid
+		}
+		qRoundTxid := qrRoundTxid
+		if qRoundTxid != "" {
+
+			if err := r.SetQueryParam("roundTxid", qRoundTxid); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Spent != nil {
+
+		// query param spent
+		var qrSpent bool
+
+		if o.Spent != nil {
+			qrSpent = *o.Spent
+		}
+		qSpent := swag.FormatBool(qrSpent)
+		if qSpent != "" {
+
+			if err := r.SetQueryParam("spent", qSpent); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Swept != nil {
+
+		// query param swept
+		var qrSwept bool
+
+		if o.Swept != nil {
+			qrSwept = *o.Swept
+		}
+		qSwept := swag.FormatBool(qrSwept)
+		if qSwept != "" {
+
+			if err := r.SetQueryParam("swept", qSwept); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -17,8 +17,15 @@ import (
 // swagger:model v1FinalizePaymentRequest
 type V1FinalizePaymentRequest struct {
 
+	// Id of the payment whose inputs are forfeited.
+	PaymentID string `json:"paymentId,omitempty"`
+
 	// Forfeit txs signed by the user.
 	SignedForfeitTxs []string `json:"signedForfeitTxs"`
+
+	// Schnorr signatures of the owners of the payment inputs, by hex encoded
+	// pubkey. Each is made over the hash of payment_id and signed_forfeit_txs.
+	Signatures map[string]string `json:"signatures,omitempty"`
 }
 
 // Validate validates this v1 finalize payment request
@@ -18,20 +18,43 @@ import (
 // swagger:model v1GetEventStreamResponse
 type V1GetEventStreamResponse struct {
 
+	// payments deferred
+	PaymentsDeferred *V1PaymentsDeferredEvent `json:"paymentsDeferred,omitempty"`
+
+	// payments dropped
+	PaymentsDropped *V1PaymentsDroppedEvent `json:"paymentsDropped,omitempty"`
+
+	// payments registered
+	PaymentsRegistered *V1PaymentsRegisteredEvent `json:"paymentsRegistered,omitempty"`
+
 	// round failed
 	RoundFailed *V1RoundFailed `json:"roundFailed,omitempty"`
 
-	// TODO: BTC add "signTree" event
+	// round finalization
 	RoundFinalization *V1RoundFinalizationEvent `json:"roundFinalization,omitempty"`
 
 	// round finalized
 	RoundFinalized *V1RoundFinalizedEvent `json:"roundFinalized,omitempty"`
+
+	// round signing
+	RoundSigning *V1RoundSigningEvent `json:"roundSigning,omitempty"`
+
+	// round signing nonces generated
+	RoundSigningNoncesGenerated *V1RoundSigningNoncesGeneratedEvent `json:"roundSigningNoncesGenerated,omitempty"`
 }
 
 // Validate validates this v1 get event stream response
 func (m *V1GetEventStreamResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validatePaymentsDeferred(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePaymentsRegistered(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRoundFailed(formats); err != nil {
 		res = append(res, err)
 	}
@@ -44,12 +67,62 @@ func (m *V1GetEventStreamResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoundSigning(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoundSigningNoncesGenerated(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePaymentsDropped(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *V1GetEventStreamResponse) validatePaymentsDeferred(formats strfmt.Registry) error {
+	if swag.IsZero(m.PaymentsDeferred) { // not required
+		return nil
+	}
+
+	if m.PaymentsDeferred != nil {
+		if err := m.PaymentsDeferred.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("paymentsDeferred")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("paymentsDeferred")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetEventStreamResponse) validatePaymentsRegistered(formats strfmt.Registry) error {
+	if swag.IsZero(m.PaymentsRegistered) { // not required
+		return nil
+	}
+
+	if m.PaymentsRegistered != nil {
+		if err := m.PaymentsRegistered.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("paymentsRegistered")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("paymentsRegistered")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V1GetEventStreamResponse) validateRoundFailed(formats strfmt.Registry) error {
 	if swag.IsZero(m.RoundFailed) { // not required
 		return nil
@@ -107,10 +180,75 @@ func (m *V1GetEventStreamResponse) validateRoundFinalized(formats strfmt.Registr
 	return nil
 }
 
+func (m *V1GetEventStreamResponse) validateRoundSigning(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoundSigning) { // not required
+		return nil
+	}
+
+	if m.RoundSigning != nil {
+		if err := m.RoundSigning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("roundSigning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("roundSigning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetEventStreamResponse) validateRoundSigningNoncesGenerated(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoundSigningNoncesGenerated) { // not required
+		return nil
+	}
+
+	if m.RoundSigningNoncesGenerated != nil {
+		if err := m.RoundSigningNoncesGenerated.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("roundSigningNoncesGenerated")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("roundSigningNoncesGenerated")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetEventStreamResponse) validatePaymentsDropped(formats strfmt.Registry) error {
+	if swag.IsZero(m.PaymentsDropped) { // not required
+		return nil
+	}
+
+	if m.PaymentsDropped != nil {
+		if err := m.PaymentsDropped.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("paymentsDropped")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("paymentsDropped")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this v1 get event stream response based on the context it is used
 func (m *V1GetEventStreamResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidatePaymentsDeferred(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePaymentsRegistered(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateRoundFailed(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -123,12 +261,66 @@ func (m *V1GetEventStreamResponse) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRoundSigning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoundSigningNoncesGenerated(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePaymentsDropped(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *V1GetEventStreamResponse) contextValidatePaymentsDeferred(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.PaymentsDeferred != nil {
+
+		if swag.IsZero(m.PaymentsDeferred) { // not required
+			return nil
+		}
+
+		if err := m.PaymentsDeferred.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("paymentsDeferred")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("paymentsDeferred")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetEventStreamResponse) contextValidatePaymentsRegistered(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.PaymentsRegistered != nil {
+
+		if swag.IsZero(m.PaymentsRegistered) { // not required
+			return nil
+		}
+
+		if err := m.PaymentsRegistered.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("paymentsRegistered")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("paymentsRegistered")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V1GetEventStreamResponse) contextValidateRoundFailed(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.RoundFailed != nil {
@@ -192,6 +384,69 @@ func (m *V1GetEventStreamResponse) contextValidateRoundFinalized(ctx context.Con
 	return nil
 }
 
+func (m *V1GetEventStreamResponse) contextValidateRoundSigning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RoundSigning != nil {
+
+		if swag.IsZero(m.RoundSigning) { // not required
+			return nil
+		}
+
+		if err := m.RoundSigning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("roundSigning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("roundSigning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetEventStreamResponse) contextValidateRoundSigningNoncesGenerated(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RoundSigningNoncesGenerated != nil {
+
+		if swag.IsZero(m.RoundSigningNoncesGenerated) { // not required
+			return nil
+		}
+
+		if err := m.RoundSigningNoncesGenerated.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("roundSigningNoncesGenerated")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("roundSigningNoncesGenerated")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetEventStreamResponse) contextValidatePaymentsDropped(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.PaymentsDropped != nil {
+
+		if swag.IsZero(m.PaymentsDropped) { // not required
+			return nil
+		}
+
+		if err := m.PaymentsDropped.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("paymentsDropped")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("paymentsDropped")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *V1GetEventStreamResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
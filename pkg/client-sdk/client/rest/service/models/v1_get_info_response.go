@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,6 +18,12 @@ import (
 // swagger:model v1GetInfoResponse
 type V1GetInfoResponse struct {
 
+	// Min amount in satoshis of the receivers of the current round.
+	Dust string `json:"dust,omitempty"`
+
+	// fees
+	Fees *V1FeeSchedule `json:"fees,omitempty"`
+
 	// min relay fee
 	MinRelayFee string `json:"minRelayFee,omitempty"`
 
@@ -32,17 +39,126 @@ type V1GetInfoResponse struct {
 	// round lifetime
 	RoundLifetime string `json:"roundLifetime,omitempty"`
 
+	// round trigger
+	RoundTrigger *V1RoundTrigger `json:"roundTrigger,omitempty"`
+
 	// unilateral exit delay
 	UnilateralExitDelay string `json:"unilateralExitDelay,omitempty"`
 }
 
 // Validate validates this v1 get info response
 func (m *V1GetInfoResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFees(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoundTrigger(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1GetInfoResponse) validateFees(formats strfmt.Registry) error {
+	if swag.IsZero(m.Fees) { // not required
+		return nil
+	}
+
+	if m.Fees != nil {
+		if err := m.Fees.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fees")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fees")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetInfoResponse) validateRoundTrigger(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoundTrigger) { // not required
+		return nil
+	}
+
+	if m.RoundTrigger != nil {
+		if err := m.RoundTrigger.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("roundTrigger")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("roundTrigger")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this v1 get info response based on context it is used
+// ContextValidate validate this v1 get info response based on the context it is used
 func (m *V1GetInfoResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFees(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoundTrigger(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1GetInfoResponse) contextValidateFees(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Fees != nil {
+
+		if swag.IsZero(m.Fees) { // not required
+			return nil
+		}
+
+		if err := m.Fees.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fees")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fees")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1GetInfoResponse) contextValidateRoundTrigger(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RoundTrigger != nil {
+
+		if swag.IsZero(m.RoundTrigger) { // not required
+			return nil
+		}
+
+		if err := m.RoundTrigger.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("roundTrigger")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("roundTrigger")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -19,6 +19,9 @@ import (
 // swagger:model v1ListVtxosResponse
 type V1ListVtxosResponse struct {
 
+	// Cursor of the next page, empty if this is the last one.
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// spendable vtxos
 	SpendableVtxos []*V1Vtxo `json:"spendableVtxos"`
 
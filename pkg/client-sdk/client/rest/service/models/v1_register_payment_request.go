@@ -19,8 +19,19 @@ import (
 // swagger:model v1RegisterPaymentRequest
 type V1RegisterPaymentRequest struct {
 
+	// Ephemeral public key used by the participant to cosign the congestion tree.
+	EphemeralPubkey string `json:"ephemeralPubkey,omitempty"`
+
 	// inputs
 	Inputs []*V1Input `json:"inputs"`
+
+	// Nonce returned by GetPaymentNonce, valid for a single registration.
+	Nonce string `json:"nonce,omitempty"`
+
+	// Schnorr signatures proving the ownership of the inputs, by hex encoded
+	// pubkey of their owners. Each is made over the hash of all inputs and of
+	// the nonce.
+	Signatures map[string]string `json:"signatures,omitempty"`
 }
 
 // Validate validates this v1 register payment request
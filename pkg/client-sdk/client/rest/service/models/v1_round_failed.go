@@ -17,6 +17,10 @@ import (
 // swagger:model v1RoundFailed
 type V1RoundFailed struct {
 
+	// Number of the finalization attempt that failed, 0 if the round failed
+	// before being finalized.
+	Attempt string `json:"attempt,omitempty"`
+
 	// id
 	ID string `json:"id,omitempty"`
 
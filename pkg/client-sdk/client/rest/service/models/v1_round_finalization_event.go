@@ -18,6 +18,10 @@ import (
 // swagger:model v1RoundFinalizationEvent
 type V1RoundFinalizationEvent struct {
 
+	// Number of the finalization attempt, starting from 1. The round is
+	// finalized again if some payments are dropped.
+	Attempt string `json:"attempt,omitempty"`
+
 	// congestion tree
 	CongestionTree *V1Tree `json:"congestionTree,omitempty"`
 
@@ -398,7 +398,7 @@ func (a *covenantArkClient) UnilateralRedeem(ctx context.Context) error {
 
 	vtxos := make([]client.Vtxo, 0)
 	for _, offchainAddr := range offchainAddrs {
-		fetchedVtxos, _, err := a.client.ListVtxos(ctx, offchainAddr)
+		fetchedVtxos, _, err := a.listVtxos(ctx, offchainAddr)
 		if err != nil {
 			return err
 		}
@@ -501,8 +501,13 @@ func (a *covenantArkClient) CollaborativeRedeem(
 		vtxos = append(vtxos, spendableVtxos...)
 	}
 
+	info, err := a.getServiceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	selectedCoins, changeAmount, err := utils.CoinSelect(
-		vtxos, amount, DUST, withExpiryCoinselect,
+		vtxos, amount, info.Dust, info.Fees.Onchain, withExpiryCoinselect,
 	)
 	if err != nil {
 		return "", err
@@ -528,7 +533,14 @@ func (a *covenantArkClient) CollaborativeRedeem(
 		})
 	}
 
-	paymentID, err := a.client.RegisterPayment(ctx, inputs)
+	signatures, nonce, err := a.signPaymentInputs(ctx, inputs)
+	if err != nil {
+		return "", err
+	}
+
+	paymentID, err := a.client.RegisterPayment(
+		ctx, inputs, signatures, nonce, "",
+	)
 	if err != nil {
 		return "", err
 	}
@@ -733,6 +745,11 @@ func (a *covenantArkClient) sendOffchain(
 		return "", err
 	}
 
+	info, err := a.getServiceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	receiversOutput := make([]client.Output, 0)
 	sumOfReceivers := uint64(0)
 
@@ -748,8 +765,8 @@ func (a *covenantArkClient) sendOffchain(
 			return "", fmt.Errorf("invalid receiver address '%s': must be associated with the connected service provider", receiver.To())
 		}
 
-		if receiver.Amount() < DUST {
-			return "", fmt.Errorf("invalid amount (%d), must be greater than dust %d", receiver.Amount(), DUST)
+		if receiver.Amount() < info.Dust {
+			return "", fmt.Errorf("invalid amount (%d), must be greater than dust %d", receiver.Amount(), info.Dust)
 		}
 
 		receiversOutput = append(receiversOutput, client.Output{
@@ -769,7 +786,7 @@ func (a *covenantArkClient) sendOffchain(
 	}
 
 	selectedCoins, changeAmount, err := utils.CoinSelect(
-		vtxos, sumOfReceivers, DUST, withExpiryCoinselect,
+		vtxos, sumOfReceivers, info.Dust, info.Fees.Offchain, withExpiryCoinselect,
 	)
 	if err != nil {
 		return "", err
@@ -795,8 +812,13 @@ func (a *covenantArkClient) sendOffchain(
 		})
 	}
 
+	signatures, nonce, err := a.signPaymentInputs(ctx, inputs)
+	if err != nil {
+		return "", err
+	}
+
 	paymentID, err := a.client.RegisterPayment(
-		ctx, inputs,
+		ctx, inputs, signatures, nonce, "",
 	)
 	if err != nil {
 		return "", err
@@ -942,7 +964,10 @@ func (a *covenantArkClient) handleRoundStream(
 	ctx context.Context,
 	paymentID string, vtxosToSign []client.Vtxo, receivers []client.Output,
 ) (string, error) {
-	eventsCh, err := a.client.GetEventStream(ctx, paymentID)
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	defer cancelStream()
+
+	eventsCh, err := a.client.GetEventStream(streamCtx, paymentID)
 	if err != nil {
 		return "", err
 	}
@@ -954,23 +979,64 @@ func (a *covenantArkClient) handleRoundStream(
 
 	defer pingStop()
 
+	// Rounds overlap, therefore the events of the rounds the payment is not
+	// part of are skipped.
+	var roundID string
 	for {
 		select {
 		case <-ctx.Done():
 			return "", ctx.Err()
 		case notify := <-eventsCh:
 			if notify.Err != nil {
-				return "", err
+				return "", notify.Err
 			}
 
 			switch event := notify.Event; event.(type) {
+			case client.PaymentsRegisteredEvent:
+				e := event.(client.PaymentsRegisteredEvent)
+				for _, id := range e.PaymentIDs {
+					if id == paymentID {
+						log.Infof("payment registered in round %s", e.ID)
+						roundID = e.ID
+						break
+					}
+				}
+			case client.PaymentsDeferredEvent:
+				e := event.(client.PaymentsDeferredEvent)
+				for _, id := range e.PaymentIDs {
+					if id == paymentID {
+						log.Info("payment deferred to next round")
+						break
+					}
+				}
+			case client.PaymentsDroppedEvent:
+				e := event.(client.PaymentsDroppedEvent)
+				for _, id := range e.PaymentIDs {
+					if id == paymentID {
+						return "", fmt.Errorf("payment dropped from round %s", e.ID)
+					}
+				}
 			case client.RoundFinalizedEvent:
-				return event.(client.RoundFinalizedEvent).Txid, nil
+				e := event.(client.RoundFinalizedEvent)
+				if e.ID != roundID {
+					continue
+				}
+				return e.Txid, nil
 			case client.RoundFailedEvent:
-				return "", fmt.Errorf("round failed: %s", event.(client.RoundFailedEvent).Reason)
+				e := event.(client.RoundFailedEvent)
+				if e.ID != roundID {
+					continue
+				}
+				return "", fmt.Errorf("round failed: %s", e.Reason)
 			case client.RoundFinalizationEvent:
+				if event.(client.RoundFinalizationEvent).ID != roundID {
+					continue
+				}
 				pingStop()
-				log.Info("a round finalization started")
+				log.Infof(
+					"a round finalization started (attempt %d)",
+					event.(client.RoundFinalizationEvent).Attempt,
+				)
 
 				signedForfeitTxs, err := a.handleRoundFinalization(
 					ctx, event.(client.RoundFinalizationEvent), vtxosToSign, receivers,
@@ -984,8 +1050,15 @@ func (a *covenantArkClient) handleRoundStream(
 					continue
 				}
 
+				signatures, err := a.signFinalizePayment(ctx, paymentID, signedForfeitTxs)
+				if err != nil {
+					return "", err
+				}
+
 				log.Info("finalizing payment... ")
-				if err := a.client.FinalizePayment(ctx, signedForfeitTxs); err != nil {
+				if err := a.client.FinalizePayment(
+					ctx, paymentID, signedForfeitTxs, signatures,
+				); err != nil {
 					return "", err
 				}
 
@@ -1343,7 +1416,7 @@ func (a *covenantArkClient) getOffchainBalance(
 func (a *covenantArkClient) getVtxos(
 	ctx context.Context, addr string, computeVtxoExpiration bool,
 ) ([]client.Vtxo, error) {
-	vtxos, _, err := a.client.ListVtxos(ctx, addr)
+	vtxos, _, err := a.listVtxos(ctx, addr)
 	if err != nil {
 		return nil, err
 	}
@@ -581,8 +581,13 @@ func (a *covenantlessArkClient) CollaborativeRedeem(
 		vtxos = append(vtxos, spendableVtxos...)
 	}
 
+	info, err := a.getServiceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	selectedCoins, changeAmount, err := utils.CoinSelect(
-		vtxos, amount, DUST, withExpiryCoinselect,
+		vtxos, amount, info.Dust, info.Fees.Onchain, withExpiryCoinselect,
 	)
 	if err != nil {
 		return "", err
@@ -608,7 +613,20 @@ func (a *covenantlessArkClient) CollaborativeRedeem(
 		})
 	}
 
-	paymentID, err := a.client.RegisterPayment(ctx, inputs)
+	roundEphemeralKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+
+	signatures, nonce, err := a.signPaymentInputs(ctx, inputs)
+	if err != nil {
+		return "", err
+	}
+
+	paymentID, err := a.client.RegisterPayment(
+		ctx, inputs, signatures, nonce,
+		hex.EncodeToString(roundEphemeralKey.PubKey().SerializeCompressed()),
+	)
 	if err != nil {
 		return "", err
 	}
@@ -618,7 +636,7 @@ func (a *covenantlessArkClient) CollaborativeRedeem(
 	}
 
 	poolTxID, err := a.handleRoundStream(
-		ctx, paymentID, selectedCoins, receivers,
+		ctx, paymentID, selectedCoins, receivers, roundEphemeralKey,
 	)
 	if err != nil {
 		return "", err
@@ -655,6 +673,11 @@ func (a *covenantlessArkClient) SendAsync(
 		return "", err
 	}
 
+	info, err := a.getServiceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	receiversOutput := make([]client.Output, 0)
 	sumOfReceivers := uint64(0)
 
@@ -670,8 +693,8 @@ func (a *covenantlessArkClient) SendAsync(
 			return "", fmt.Errorf("invalid receiver address '%s': must be associated with the connected service provider", receiver)
 		}
 
-		if receiver.Amount() < DUST {
-			return "", fmt.Errorf("invalid amount (%d), must be greater than dust %d", receiver.Amount(), DUST)
+		if receiver.Amount() < info.Dust {
+			return "", fmt.Errorf("invalid amount (%d), must be greater than dust %d", receiver.Amount(), info.Dust)
 		}
 
 		receiversOutput = append(receiversOutput, client.Output{
@@ -685,8 +708,9 @@ func (a *covenantlessArkClient) SendAsync(
 	if err != nil {
 		return "", err
 	}
+
 	selectedCoins, changeAmount, err := utils.CoinSelect(
-		vtxos, sumOfReceivers, DUST, withExpiryCoinselect,
+		vtxos, sumOfReceivers, info.Dust, info.Fees.Async, withExpiryCoinselect,
 	)
 	if err != nil {
 		return "", err
@@ -759,9 +783,20 @@ func (a *covenantlessArkClient) ClaimAsync(
 		return "", nil
 	}
 
+	info, err := a.getServiceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	fee := info.Fees.Offchain.Amount(pendingBalance)
+	if pendingBalance < fee+info.Dust {
+		return "", fmt.Errorf(
+			"pending balance %d too low to cover service fee %d", pendingBalance, fee,
+		)
+	}
+
 	receiver := client.Output{
 		Address: myselfOffchain,
-		Amount:  pendingBalance,
+		Amount:  pendingBalance - fee,
 	}
 	return a.selfTransferAllPendingPayments(ctx, pendingVtxos, receiver)
 }
@@ -928,6 +963,11 @@ func (a *covenantlessArkClient) sendOffchain(
 		return "", err
 	}
 
+	info, err := a.getServiceInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	receiversOutput := make([]client.Output, 0)
 	sumOfReceivers := uint64(0)
 
@@ -943,8 +983,8 @@ func (a *covenantlessArkClient) sendOffchain(
 			return "", fmt.Errorf("invalid receiver address '%s': must be associated with the connected service provider", receiver.To())
 		}
 
-		if receiver.Amount() < DUST {
-			return "", fmt.Errorf("invalid amount (%d), must be greater than dust %d", receiver.Amount(), DUST)
+		if receiver.Amount() < info.Dust {
+			return "", fmt.Errorf("invalid amount (%d), must be greater than dust %d", receiver.Amount(), info.Dust)
 		}
 
 		receiversOutput = append(receiversOutput, client.Output{
@@ -964,7 +1004,7 @@ func (a *covenantlessArkClient) sendOffchain(
 	}
 
 	selectedCoins, changeAmount, err := utils.CoinSelect(
-		vtxos, sumOfReceivers, DUST, withExpiryCoinselect,
+		vtxos, sumOfReceivers, info.Dust, info.Fees.Offchain, withExpiryCoinselect,
 	)
 	if err != nil {
 		return "", err
@@ -990,8 +1030,19 @@ func (a *covenantlessArkClient) sendOffchain(
 		})
 	}
 
+	roundEphemeralKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+
+	signatures, nonce, err := a.signPaymentInputs(ctx, inputs)
+	if err != nil {
+		return "", err
+	}
+
 	paymentID, err := a.client.RegisterPayment(
-		ctx, inputs,
+		ctx, inputs, signatures, nonce,
+		hex.EncodeToString(roundEphemeralKey.PubKey().SerializeCompressed()),
 	)
 	if err != nil {
 		return "", err
@@ -1006,7 +1057,7 @@ func (a *covenantlessArkClient) sendOffchain(
 	log.Infof("payment registered with id: %s", paymentID)
 
 	poolTxID, err := a.handleRoundStream(
-		ctx, paymentID, selectedCoins, receiversOutput,
+		ctx, paymentID, selectedCoins, receiversOutput, roundEphemeralKey,
 	)
 	if err != nil {
 		return "", err
@@ -1145,8 +1196,12 @@ func (a *covenantlessArkClient) addVtxoInput(
 func (a *covenantlessArkClient) handleRoundStream(
 	ctx context.Context,
 	paymentID string, vtxosToSign []client.Vtxo, receivers []client.Output,
+	roundEphemeralKey *secp256k1.PrivateKey,
 ) (string, error) {
-	eventsCh, err := a.client.GetEventStream(ctx, paymentID)
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	defer cancelStream()
+
+	eventsCh, err := a.client.GetEventStream(streamCtx, paymentID)
 	if err != nil {
 		return "", err
 	}
@@ -1158,23 +1213,94 @@ func (a *covenantlessArkClient) handleRoundStream(
 
 	defer pingStop()
 
+	// Rounds overlap, therefore the events of the rounds the payment is not
+	// part of are skipped.
+	var roundID string
+	var signerSession bitcointree.SignerSession
+	var cosigners []*secp256k1.PublicKey
+
 	for {
 		select {
 		case <-ctx.Done():
 			return "", ctx.Err()
 		case notify := <-eventsCh:
 			if notify.Err != nil {
-				return "", err
+				return "", notify.Err
 			}
 
 			switch event := notify.Event; event.(type) {
+			case client.PaymentsRegisteredEvent:
+				e := event.(client.PaymentsRegisteredEvent)
+				for _, id := range e.PaymentIDs {
+					if id == paymentID {
+						log.Infof("payment registered in round %s", e.ID)
+						roundID = e.ID
+						break
+					}
+				}
+			case client.PaymentsDeferredEvent:
+				e := event.(client.PaymentsDeferredEvent)
+				for _, id := range e.PaymentIDs {
+					if id == paymentID {
+						log.Info("payment deferred to next round")
+						break
+					}
+				}
+			case client.PaymentsDroppedEvent:
+				e := event.(client.PaymentsDroppedEvent)
+				for _, id := range e.PaymentIDs {
+					if id == paymentID {
+						return "", fmt.Errorf("payment dropped from round %s", e.ID)
+					}
+				}
 			case client.RoundFinalizedEvent:
-				return event.(client.RoundFinalizedEvent).Txid, nil
+				e := event.(client.RoundFinalizedEvent)
+				if e.ID != roundID {
+					continue
+				}
+				return e.Txid, nil
 			case client.RoundFailedEvent:
-				return "", fmt.Errorf("round failed: %s", event.(client.RoundFailedEvent).Reason)
+				e := event.(client.RoundFailedEvent)
+				if e.ID != roundID {
+					continue
+				}
+				return "", fmt.Errorf("round failed: %s", e.Reason)
+			case client.RoundSigningStartedEvent:
+				e := event.(client.RoundSigningStartedEvent)
+				if e.ID != roundID {
+					continue
+				}
+				log.Info("a round signing started")
+
+				signerSession, err = a.handleRoundSigningStarted(
+					ctx, roundEphemeralKey, e, receivers,
+				)
+				if err != nil {
+					return "", err
+				}
+				cosigners = e.CosignersPublicKeys
+			case client.RoundSigningNoncesGeneratedEvent:
+				if signerSession == nil {
+					continue
+				}
+				log.Info("round combined nonces generated")
+
+				if err := a.handleRoundSigningNoncesGenerated(
+					ctx, roundEphemeralKey, event.(client.RoundSigningNoncesGeneratedEvent),
+					cosigners, signerSession,
+				); err != nil {
+					return "", err
+				}
+				signerSession = nil
 			case client.RoundFinalizationEvent:
+				if event.(client.RoundFinalizationEvent).ID != roundID {
+					continue
+				}
 				pingStop()
-				log.Info("a round finalization started")
+				log.Infof(
+					"a round finalization started (attempt %d)",
+					event.(client.RoundFinalizationEvent).Attempt,
+				)
 
 				signedForfeitTxs, err := a.handleRoundFinalization(
 					ctx, event.(client.RoundFinalizationEvent), vtxosToSign, receivers,
@@ -1188,8 +1314,15 @@ func (a *covenantlessArkClient) handleRoundStream(
 					continue
 				}
 
+				signatures, err := a.signFinalizePayment(ctx, paymentID, signedForfeitTxs)
+				if err != nil {
+					return "", err
+				}
+
 				log.Info("finalizing payment... ")
-				if err := a.client.FinalizePayment(ctx, signedForfeitTxs); err != nil {
+				if err := a.client.FinalizePayment(
+					ctx, paymentID, signedForfeitTxs, signatures,
+				); err != nil {
 					return "", err
 				}
 
@@ -1200,11 +1333,113 @@ func (a *covenantlessArkClient) handleRoundStream(
 	}
 }
 
+// handleRoundSigningStarted verifies the unsigned congestion tree and sends
+// the nonces of a new signer session to the ASP.
+func (a *covenantlessArkClient) handleRoundSigningStarted(
+	ctx context.Context, ephemeralKey *secp256k1.PrivateKey,
+	event client.RoundSigningStartedEvent, receivers []client.Output,
+) (bitcointree.SignerSession, error) {
+	if err := a.validateCongestionTree(
+		event.UnsignedRoundTx, event.UnsignedTree, receivers,
+	); err != nil {
+		return nil, fmt.Errorf("failed to verify congestion tree: %s", err)
+	}
+
+	sweepClosure := bitcointree.CSVSigClosure{
+		Pubkey:  a.AspPubkey,
+		Seconds: uint(a.RoundLifetime),
+	}
+
+	sweepTapLeaf, err := sweepClosure.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	sweepTapTree := txscript.AssembleTaprootScriptTree(*sweepTapLeaf)
+	root := sweepTapTree.RootNode.TapHash()
+
+	signerSession := bitcointree.NewTreeSignerSession(
+		ephemeralKey, event.UnsignedTree, int64(a.MinRelayFee), root.CloneBytes(),
+	)
+
+	nonces, err := signerSession.GetNonces()
+	if err != nil {
+		return nil, err
+	}
+
+	var serializedNonces bytes.Buffer
+	if err := nonces.Encode(&serializedNonces); err != nil {
+		return nil, err
+	}
+	signature, err := signWithEphemeralKey(
+		ephemeralKey, common.CosignerNoncesHash(event.ID, serializedNonces.Bytes()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.client.SendTreeNonces(
+		ctx, event.ID,
+		hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed()), nonces,
+		signature,
+	); err != nil {
+		return nil, err
+	}
+
+	return signerSession, nil
+}
+
+// handleRoundSigningNoncesGenerated signs the congestion tree with the
+// aggregated nonces and sends the partial signatures to the ASP.
+func (a *covenantlessArkClient) handleRoundSigningNoncesGenerated(
+	ctx context.Context, ephemeralKey *secp256k1.PrivateKey,
+	event client.RoundSigningNoncesGeneratedEvent,
+	cosigners []*secp256k1.PublicKey, signerSession bitcointree.SignerSession,
+) error {
+	if err := signerSession.SetKeys(cosigners, event.Nonces); err != nil {
+		return err
+	}
+
+	sigs, err := signerSession.Sign()
+	if err != nil {
+		return err
+	}
+
+	var serializedSigs bytes.Buffer
+	if err := sigs.Encode(&serializedSigs); err != nil {
+		return err
+	}
+	signature, err := signWithEphemeralKey(
+		ephemeralKey, common.CosignerSignaturesHash(event.ID, serializedSigs.Bytes()),
+	)
+	if err != nil {
+		return err
+	}
+
+	return a.client.SendTreeSignatures(
+		ctx, event.ID,
+		hex.EncodeToString(ephemeralKey.PubKey().SerializeCompressed()), sigs,
+		signature,
+	)
+}
+
+// signWithEphemeralKey proves to the ASP that the nonces or the signatures of
+// the congestion tree are submitted by the owner of the ephemeral key.
+func signWithEphemeralKey(
+	ephemeralKey *secp256k1.PrivateKey, msg []byte,
+) (string, error) {
+	sig, err := schnorr.Sign(ephemeralKey, msg)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sig.Serialize()), nil
+}
+
 func (a *covenantlessArkClient) handleRoundFinalization(
 	ctx context.Context, event client.RoundFinalizationEvent,
 	vtxos []client.Vtxo, receivers []client.Output,
 ) ([]string, error) {
-	if err := a.validateCongestionTree(event, receivers); err != nil {
+	if err := a.validateCongestionTree(event.Tx, event.Tree, receivers); err != nil {
 		return nil, fmt.Errorf("failed to verify congestion tree: %s", err)
 	}
 
@@ -1214,9 +1449,8 @@ func (a *covenantlessArkClient) handleRoundFinalization(
 }
 
 func (a *covenantlessArkClient) validateCongestionTree(
-	event client.RoundFinalizationEvent, receivers []client.Output,
+	poolTx string, congestionTree tree.CongestionTree, receivers []client.Output,
 ) error {
-	poolTx := event.Tx
 	ptx, err := psbt.NewFromRawBytes(strings.NewReader(poolTx), true)
 	if err != nil {
 		return err
@@ -1224,7 +1458,7 @@ func (a *covenantlessArkClient) validateCongestionTree(
 
 	if !utils.IsOnchainOnly(receivers) {
 		if err := bitcointree.ValidateCongestionTree(
-			event.Tree, poolTx, a.StoreData.AspPubkey, a.RoundLifetime, int64(a.MinRelayFee),
+			congestionTree, poolTx, a.StoreData.AspPubkey, a.RoundLifetime, int64(a.MinRelayFee),
 		); err != nil {
 			return err
 		}
@@ -1235,7 +1469,7 @@ func (a *covenantlessArkClient) validateCongestionTree(
 	// }
 
 	if err := a.validateReceivers(
-		ptx, receivers, event.Tree, a.StoreData.AspPubkey,
+		ptx, receivers, congestionTree, a.StoreData.AspPubkey,
 	); err != nil {
 		return err
 	}
@@ -1567,7 +1801,7 @@ func (a *covenantlessArkClient) getOffchainBalance(
 func (a *covenantlessArkClient) getVtxos(
 	ctx context.Context, addr string, computeVtxoExpiration bool,
 ) ([]client.Vtxo, []client.Vtxo, error) {
-	vtxos, _, err := a.client.ListVtxos(ctx, addr)
+	vtxos, _, err := a.listVtxos(ctx, addr)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1619,7 +1853,20 @@ func (a *covenantlessArkClient) selfTransferAllPendingPayments(
 
 	outputs := []client.Output{myself}
 
-	paymentID, err := a.client.RegisterPayment(ctx, inputs)
+	roundEphemeralKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+
+	signatures, nonce, err := a.signPaymentInputs(ctx, inputs)
+	if err != nil {
+		return "", err
+	}
+
+	paymentID, err := a.client.RegisterPayment(
+		ctx, inputs, signatures, nonce,
+		hex.EncodeToString(roundEphemeralKey.PubKey().SerializeCompressed()),
+	)
 	if err != nil {
 		return "", err
 	}
@@ -1629,7 +1876,7 @@ func (a *covenantlessArkClient) selfTransferAllPendingPayments(
 	}
 
 	roundTxid, err := a.handleRoundStream(
-		ctx, paymentID, pendingVtxos, outputs,
+		ctx, paymentID, pendingVtxos, outputs, roundEphemeralKey,
 	)
 	if err != nil {
 		return "", err
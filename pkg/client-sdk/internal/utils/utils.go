@@ -19,8 +19,12 @@ import (
 	"golang.org/x/crypto/scrypt"
 )
 
+// CoinSelect selects the vtxos to cover the given amount plus the service
+// fee charged by the ASP on the selected ones, and returns them along with
+// the change.
 func CoinSelect(
-	vtxos []client.Vtxo, amount, dust uint64, sortByExpirationTime bool,
+	vtxos []client.Vtxo, amount, dust uint64, fee client.ServiceFee,
+	sortByExpirationTime bool,
 ) ([]client.Vtxo, uint64, error) {
 	selected := make([]client.Vtxo, 0)
 	notSelected := make([]client.Vtxo, 0)
@@ -38,7 +42,7 @@ func CoinSelect(
 	}
 
 	for _, vtxo := range vtxos {
-		if selectedAmount >= amount {
+		if selectedAmount >= amount+fee.Amount(selectedAmount) {
 			notSelected = append(notSelected, vtxo)
 			break
 		}
@@ -47,16 +51,21 @@ func CoinSelect(
 		selectedAmount += vtxo.Amount
 	}
 
-	if selectedAmount < amount {
-		return nil, 0, fmt.Errorf("not enough funds to cover amount%d", amount)
+	feeAmount := fee.Amount(selectedAmount)
+	if selectedAmount < amount+feeAmount {
+		return nil, 0, fmt.Errorf(
+			"not enough funds to cover amount %d and service fee %d",
+			amount, feeAmount,
+		)
 	}
 
-	change := selectedAmount - amount
+	change := selectedAmount - amount - feeAmount
 
 	if change < dust {
 		if len(notSelected) > 0 {
 			selected = append(selected, notSelected[0])
-			change += notSelected[0].Amount
+			selectedAmount += notSelected[0].Amount
+			change = selectedAmount - amount - fee.Amount(selectedAmount)
 		}
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ark-network/ark/pkg/client-sdk/store"
 	"github.com/ark-network/ark/pkg/client-sdk/wallet"
 	walletstore "github.com/ark-network/ark/pkg/client-sdk/wallet/singlekey/store"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
@@ -116,3 +117,17 @@ func (w *singlekeyWallet) Unlock(
 func (w *singlekeyWallet) IsLocked() bool {
 	return w.privateKey == nil
 }
+
+func (w *singlekeyWallet) SignMessage(
+	_ context.Context, message []byte,
+) (string, error) {
+	if w.IsLocked() {
+		return "", fmt.Errorf("wallet is locked")
+	}
+
+	sig, err := schnorr.Sign(w.privateKey, message)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sig.Serialize()), nil
+}
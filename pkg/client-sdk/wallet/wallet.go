@@ -30,4 +30,7 @@ type WalletService interface {
 	SignTransaction(
 		ctx context.Context, explorerSvc explorer.Explorer, tx string,
 	) (singedTx string, err error)
+	SignMessage(
+		ctx context.Context, message []byte,
+	) (signature string, err error)
 }
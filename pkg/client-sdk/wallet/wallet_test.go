@@ -2,6 +2,7 @@ package wallet_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"strings"
 	"testing"
 
@@ -143,6 +144,17 @@ func TestWallet(t *testing.T) {
 
 			locked = walletSvc.IsLocked()
 			require.False(t, locked)
+
+			msg := sha256.Sum256([]byte("message"))
+			sig, err := walletSvc.SignMessage(ctx, msg[:])
+			require.NoError(t, err)
+			require.NotEmpty(t, sig)
+
+			err = walletSvc.Lock(ctx, password)
+			require.NoError(t, err)
+
+			_, err = walletSvc.SignMessage(ctx, msg[:])
+			require.Error(t, err)
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/hex"
@@ -9,9 +10,21 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ark-network/ark/common"
+	appconfig "github.com/ark-network/ark/server/internal/app-config"
+	"github.com/ark-network/ark/server/internal/config"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/ark-network/ark/server/internal/infrastructure/backup"
+	"github.com/ark-network/ark/server/internal/infrastructure/db"
+	btcwallet "github.com/ark-network/ark/server/internal/infrastructure/wallet/btc-embedded"
+	liquidwallet "github.com/ark-network/ark/server/internal/infrastructure/wallet/liquid-standalone"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	"gopkg.in/macaroon.v2"
 )
@@ -27,11 +40,75 @@ var (
 		Name:  "mnemonic",
 		Usage: "mnemonic from which restore the wallet",
 	}
+	watchOnlyFlag = &cli.BoolFlag{
+		Name:  "watch-only",
+		Usage: "create a watch-only wallet, whose keys are held by the remote signer",
+	}
 	gapLimitFlag = &cli.Uint64Flag{
 		Name:  "addr-gap-limit",
 		Usage: "address gap limit for wallet restoration",
 		Value: 100,
 	}
+	addressFlag = &cli.StringFlag{
+		Name:     "address",
+		Usage:    "the address to send funds to",
+		Required: true,
+	}
+	amountFlag = &cli.Uint64Flag{
+		Name:     "amount",
+		Usage:    "the amount to send in sats",
+		Required: true,
+	}
+	feeRateFlag = &cli.Uint64Flag{
+		Name:  "fee-rate",
+		Usage: "the fee rate in sats/kvbyte, if not set the current one is estimated",
+	}
+	maxInputsFlag = &cli.UintFlag{
+		Name:  "max-inputs",
+		Usage: "the max number of utxos to merge, the smallest first",
+		Value: 100,
+	}
+	alertThresholdFlag = &cli.Uint64Flag{
+		Name:  "alert-threshold",
+		Usage: "the available amount in sats below which to raise an alert",
+	}
+	lookbackFlag = &cli.DurationFlag{
+		Name:  "lookback",
+		Usage: "the period of the recent rounds used to estimate the amount spent by the next ones",
+		Value: 24 * time.Hour,
+	}
+	fromDbFlag = &cli.StringFlag{
+		Name:     "from",
+		Usage:    "the type of the db to migrate from",
+		Required: true,
+	}
+	toDbFlag = &cli.StringFlag{
+		Name:     "to",
+		Usage:    "the type of the db to migrate to",
+		Required: true,
+	}
+	fromEventDbFlag = &cli.StringFlag{
+		Name:  "from-events",
+		Usage: "the type of the event db to migrate from, defaults to the configured one",
+	}
+	toEventDbFlag = &cli.StringFlag{
+		Name:  "to-events",
+		Usage: "the type of the event db to migrate to, defaults to the type of the target db",
+	}
+	backupPasswordFlag = &cli.StringFlag{
+		Name:     "password",
+		Usage:    "the password to encrypt or decrypt the backup",
+		Required: true,
+	}
+	archiveFlag = &cli.StringFlag{
+		Name:     "archive",
+		Usage:    "the path of the backup archive to restore",
+		Required: true,
+	}
+	walletPasswordFlag = &cli.StringFlag{
+		Name:  "wallet-password",
+		Usage: "the password of the wallet of the backup, required unless its keys are held by a remote signer or by ocean",
+	}
 )
 
 // commands
@@ -46,6 +123,8 @@ var (
 			walletUnlockCmd,
 			walletAddressCmd,
 			walletBalanceCmd,
+			walletSendCmd,
+			walletConsolidateCmd,
 		),
 	}
 	walletStatusCmd = &cli.Command{
@@ -57,7 +136,7 @@ var (
 		Name:   "create",
 		Usage:  "Create or restore the wallet",
 		Action: walletCreateOrRestoreAction,
-		Flags:  []cli.Flag{passwordFlag, mnemonicFlag, gapLimitFlag},
+		Flags:  []cli.Flag{passwordFlag, mnemonicFlag, watchOnlyFlag, gapLimitFlag},
 	}
 	walletUnlockCmd = &cli.Command{
 		Name:   "unlock",
@@ -75,6 +154,59 @@ var (
 		Usage:  "Get the wallet balance",
 		Action: walletBalanceAction,
 	}
+	walletSendCmd = &cli.Command{
+		Name:   "send",
+		Usage:  "Send funds of the wallet to an address",
+		Action: walletSendAction,
+		Flags:  []cli.Flag{addressFlag, amountFlag, feeRateFlag},
+	}
+	walletConsolidateCmd = &cli.Command{
+		Name:   "consolidate",
+		Usage:  "Merge the small utxos of the wallet into a single one",
+		Action: walletConsolidateAction,
+		Flags:  []cli.Flag{maxInputsFlag, feeRateFlag},
+	}
+	liquidityCmd = &cli.Command{
+		Name:   "liquidity",
+		Usage:  "Forecast the liquidity of the wallet until the scheduled sweeps",
+		Action: liquidityAction,
+		Flags:  []cli.Flag{alertThresholdFlag, lookbackFlag},
+	}
+	connectorsCmd = &cli.Command{
+		Name:   "connectors",
+		Usage:  "Show the connector utxos to be released to the main account",
+		Action: connectorsAction,
+	}
+	dbCmd = &cli.Command{
+		Name:        "db",
+		Usage:       "Manage the Ark Server db",
+		Subcommands: append(cli.Commands{}, dbMigrateCmd),
+	}
+	dbMigrateCmd = &cli.Command{
+		Name:  "migrate",
+		Usage: "Copy rounds, vtxos and round events to another type of db, arkd must be stopped",
+		Description: "The migration can be resumed by running the command again " +
+			"if interrupted, the data already copied is skipped",
+		Action: dbMigrateAction,
+		Flags:  []cli.Flag{fromDbFlag, toDbFlag, fromEventDbFlag, toEventDbFlag},
+	}
+	backupCmd = &cli.Command{
+		Name:  "backup",
+		Usage: "Write an encrypted backup of the dbs, the wallet and the macaroons",
+		Description: "The rounds are paused while the dbs are copied, the archive " +
+			"is written in the backups folder of the datadir",
+		Action: backupAction,
+		Flags:  []cli.Flag{backupPasswordFlag},
+	}
+	restoreCmd = &cli.Command{
+		Name:  "restore",
+		Usage: "Restore a backup into the datadir, arkd must be stopped",
+		Description: "The backup must belong to the configured network and to " +
+			"the pubkey of its wallet, or of the configured remote signer or " +
+			"ocean wallet, none of its files must exist in the datadir",
+		Action: restoreAction,
+		Flags:  []cli.Flag{archiveFlag, backupPasswordFlag, walletPasswordFlag},
+	}
 )
 
 func walletStatusAction(ctx *cli.Context) error {
@@ -99,11 +231,27 @@ func walletCreateOrRestoreAction(ctx *cli.Context) error {
 	password := ctx.String("password")
 	mnemonic := ctx.String("mnemonic")
 	gapLimit := ctx.Uint64("addr-gap-limit")
+	watchOnly := ctx.Bool("watch-only")
 	tlsCertPath := ctx.String("tls-cert-path")
 	if strings.Contains(baseURL, "http://") {
 		tlsCertPath = ""
 	}
 
+	if watchOnly {
+		if len(mnemonic) > 0 {
+			return fmt.Errorf("mnemonic must not be set for a watch-only wallet")
+		}
+
+		url := fmt.Sprintf("%s/v1/admin/wallet/create", baseURL)
+		body := fmt.Sprintf(`{"password": "%s"}`, password)
+		if _, err := post[struct{}](url, body, "", "", tlsCertPath); err != nil {
+			return err
+		}
+
+		fmt.Println("watch-only wallet created")
+		return nil
+	}
+
 	if len(mnemonic) > 0 {
 		url := fmt.Sprintf("%s/v1/admin/wallet/restore", baseURL)
 		body := fmt.Sprintf(
@@ -206,6 +354,354 @@ func walletBalanceAction(ctx *cli.Context) error {
 	return nil
 }
 
+func walletSendAction(ctx *cli.Context) error {
+	baseURL := ctx.String("url")
+	var macaroon string
+	if !ctx.Bool("no-macaroon") {
+		macaroonPath := ctx.String("macaroon-path")
+		mac, err := getMacaroon(macaroonPath)
+		if err != nil {
+			return err
+		}
+		macaroon = mac
+	}
+	tlsCertPath := ctx.String("tls-cert-path")
+	if strings.Contains(baseURL, "http://") {
+		tlsCertPath = ""
+	}
+
+	url := fmt.Sprintf("%s/v1/admin/wallet/withdraw", baseURL)
+	body := fmt.Sprintf(
+		`{"address": "%s", "amount": %d, "fee_rate": %d}`,
+		ctx.String("address"), ctx.Uint64("amount"), ctx.Uint64("fee-rate"),
+	)
+	txid, err := post[string](url, body, "txid", macaroon, tlsCertPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(txid)
+	return nil
+}
+
+func walletConsolidateAction(ctx *cli.Context) error {
+	baseURL := ctx.String("url")
+	var macaroon string
+	if !ctx.Bool("no-macaroon") {
+		macaroonPath := ctx.String("macaroon-path")
+		mac, err := getMacaroon(macaroonPath)
+		if err != nil {
+			return err
+		}
+		macaroon = mac
+	}
+	tlsCertPath := ctx.String("tls-cert-path")
+	if strings.Contains(baseURL, "http://") {
+		tlsCertPath = ""
+	}
+
+	url := fmt.Sprintf("%s/v1/admin/wallet/consolidate", baseURL)
+	body := fmt.Sprintf(
+		`{"max_inputs": %d, "fee_rate": %d}`,
+		ctx.Uint("max-inputs"), ctx.Uint64("fee-rate"),
+	)
+	txid, err := post[string](url, body, "txid", macaroon, tlsCertPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(txid)
+	return nil
+}
+
+func liquidityAction(ctx *cli.Context) error {
+	baseURL := ctx.String("url")
+	var macaroon string
+	if !ctx.Bool("no-macaroon") {
+		macaroonPath := ctx.String("macaroon-path")
+		mac, err := getMacaroon(macaroonPath)
+		if err != nil {
+			return err
+		}
+		macaroon = mac
+	}
+	tlsCertPath := ctx.String("tls-cert-path")
+	if strings.Contains(baseURL, "http://") {
+		tlsCertPath = ""
+	}
+
+	url := fmt.Sprintf(
+		"%s/v1/admin/liquidity?alert_threshold=%d&lookback=%d", baseURL,
+		ctx.Uint64("alert-threshold"), int64(ctx.Duration("lookback").Seconds()),
+	)
+	forecast, err := getLiquidityForecast(url, macaroon, tlsCertPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(forecast)
+	return nil
+}
+
+func connectorsAction(ctx *cli.Context) error {
+	baseURL := ctx.String("url")
+	var macaroon string
+	if !ctx.Bool("no-macaroon") {
+		macaroonPath := ctx.String("macaroon-path")
+		mac, err := getMacaroon(macaroonPath)
+		if err != nil {
+			return err
+		}
+		macaroon = mac
+	}
+	tlsCertPath := ctx.String("tls-cert-path")
+	if strings.Contains(baseURL, "http://") {
+		tlsCertPath = ""
+	}
+
+	url := fmt.Sprintf("%s/v1/admin/connectors/release", baseURL)
+	release, err := getConnectorsRelease(url, macaroon, tlsCertPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(release)
+	return nil
+}
+
+func dbMigrateAction(ctx *cli.Context) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("invalid config: %s", err)
+	}
+	log.SetLevel(log.Level(cfg.LogLevel))
+
+	fromDbType, toDbType := ctx.String("from"), ctx.String("to")
+	fromEventDbType, toEventDbType := ctx.String("from-events"), ctx.String("to-events")
+	if len(fromEventDbType) <= 0 {
+		fromEventDbType = cfg.EventDbType
+	}
+	if len(toEventDbType) <= 0 {
+		toEventDbType = toDbType
+	}
+	if fromDbType == toDbType {
+		return fmt.Errorf("source and target db types must be different")
+	}
+	if fromEventDbType == toEventDbType {
+		return fmt.Errorf("source and target event db types must be different")
+	}
+
+	from, err := getRepoManager(cfg, fromDbType, fromEventDbType)
+	if err != nil {
+		return fmt.Errorf("failed to open source db: %s", err)
+	}
+	defer from.Close()
+
+	to, err := getRepoManager(cfg, toDbType, toEventDbType)
+	if err != nil {
+		return fmt.Errorf("failed to open target db: %s", err)
+	}
+	defer to.Close()
+
+	summary, err := db.Migrate(ctx.Context, from, to)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("vtxos: %s\n", summary.Vtxos)
+	fmt.Printf("round events: %s\n", summary.Events)
+	fmt.Printf("rounds: %s\n", summary.Rounds)
+	fmt.Printf("sweeps: %s\n", summary.Sweeps)
+	fmt.Printf("offenders: %s\n", summary.Offenders)
+	fmt.Printf("payment requests: %s\n", summary.PaymentRequests)
+	fmt.Printf("async payment requests: %s\n", summary.AsyncPaymentRequests)
+	fmt.Printf("monitored txs: %s\n", summary.MonitoredTxs)
+	return nil
+}
+
+func backupAction(ctx *cli.Context) error {
+	baseURL := ctx.String("url")
+	var macaroon string
+	if !ctx.Bool("no-macaroon") {
+		macaroonPath := ctx.String("macaroon-path")
+		mac, err := getMacaroon(macaroonPath)
+		if err != nil {
+			return err
+		}
+		macaroon = mac
+	}
+	tlsCertPath := ctx.String("tls-cert-path")
+	if strings.Contains(baseURL, "http://") {
+		tlsCertPath = ""
+	}
+
+	url := fmt.Sprintf("%s/v1/admin/backup", baseURL)
+	body := fmt.Sprintf(`{"password": "%s"}`, ctx.String("password"))
+	result, err := createBackup(url, body, macaroon, tlsCertPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(result)
+	return nil
+}
+
+func restoreAction(ctx *cli.Context) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("invalid config: %s", err)
+	}
+
+	archivePath, password := ctx.String("archive"), ctx.String("password")
+
+	manifest, err := readBackupManifest(archivePath, password)
+	if err != nil {
+		return err
+	}
+	if manifest.Network != cfg.Network.Name {
+		return fmt.Errorf(
+			"backup network %s doesn't match configured one %s",
+			manifest.Network, cfg.Network.Name,
+		)
+	}
+	for _, entry := range manifest.Entries {
+		path := filepath.Join(cfg.Datadir, filepath.FromSlash(entry.Path))
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf(
+				"%s already exists, move it out of the datadir before restoring", path,
+			)
+		}
+	}
+
+	if err := os.MkdirAll(cfg.Datadir, 0755); err != nil {
+		return err
+	}
+	stagingDir, err := os.MkdirTemp(cfg.Datadir, ".restore-")
+	if err != nil {
+		return err
+	}
+	// nolint
+	defer os.RemoveAll(stagingDir)
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	// nolint
+	defer f.Close()
+
+	// Files are moved to the datadir only once all of them are verified.
+	if _, err := backup.Extract(f, password, stagingDir); err != nil {
+		return fmt.Errorf("failed to extract backup: %s", err)
+	}
+
+	// The pubkey of the manifest is checked against the one of the wallet
+	// actually restored, the manifest alone doesn't prove who owns the backup.
+	dbDir, err := filepath.Rel(cfg.Datadir, cfg.DbDir)
+	if err != nil {
+		return err
+	}
+	pubkey, err := getBackupWalletPubkey(
+		ctx.Context, cfg, filepath.Join(stagingDir, dbDir),
+		ctx.String("wallet-password"),
+	)
+	if err != nil {
+		return err
+	}
+	if manifest.Pubkey != pubkey {
+		return fmt.Errorf(
+			"backup pubkey %s doesn't match the wallet one %s",
+			manifest.Pubkey, pubkey,
+		)
+	}
+	for _, entry := range manifest.Entries {
+		relPath := filepath.FromSlash(entry.Path)
+		path := filepath.Join(cfg.Datadir, relPath)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(filepath.Join(stagingDir, relPath), path); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf(
+		"restored %d files of backup created at %s\n",
+		len(manifest.Entries), time.Unix(manifest.CreatedAt, 0).Format(time.RFC3339),
+	)
+	return nil
+}
+
+// getBackupWalletPubkey returns the pubkey of the wallet of the backup
+// extracted into the given wallet dir, or that of the remote signer or ocean
+// wallet holding its keys.
+func getBackupWalletPubkey(
+	ctx context.Context, cfg *config.Config, walletDir, walletPassword string,
+) (string, error) {
+	var pubkey *secp256k1.PublicKey
+	var err error
+	switch {
+	case common.IsLiquid(cfg.Network):
+		var wallet ports.WalletService
+		wallet, err = liquidwallet.NewService(cfg.WalletAddr)
+		if err != nil {
+			return "", fmt.Errorf("failed to connect to ocean wallet: %s", err)
+		}
+		defer wallet.Close()
+		pubkey, err = wallet.GetPubkey(ctx)
+	case cfg.SignerAddr != "":
+		pubkey, err = btcwallet.GetSignerPubkey(ctx, btcwallet.RemoteSignerConfig{
+			Addr:      cfg.SignerAddr,
+			AuthToken: cfg.SignerAuthToken,
+			TLSCert:   cfg.SignerTLSCert,
+		})
+	default:
+		if len(walletPassword) <= 0 {
+			return "", fmt.Errorf("missing wallet password")
+		}
+		pubkey, err = btcwallet.ReadPubkey(walletDir, walletPassword, cfg.Network)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get wallet pubkey: %s", err)
+	}
+	return hex.EncodeToString(pubkey.SerializeCompressed()), nil
+}
+
+func readBackupManifest(path, password string) (*backup.Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	// nolint
+	defer f.Close()
+
+	manifest, err := backup.ReadManifest(f, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup: %s", err)
+	}
+	return manifest, nil
+}
+
+func getRepoManager(
+	cfg *config.Config, dbType, eventDbType string,
+) (ports.RepoManager, error) {
+	dbMigrationPath := cfg.DbMigrationPath
+	if dbType != cfg.DbType {
+		dbMigrationPath = config.DefaultDbMigrationPath(dbType)
+	}
+
+	appConfig := &appconfig.Config{
+		DbType:               dbType,
+		EventDbType:          eventDbType,
+		DbDir:                cfg.DbDir,
+		DbMigrationPath:      dbMigrationPath,
+		EventDbDir:           cfg.DbDir,
+		EventDbMigrationPath: cfg.EventDbMigrationPath,
+		DbUrl:                cfg.DbUrl,
+	}
+	return appConfig.RepoManager()
+}
+
 func post[T any](url, body, key, macaroon, tlsCert string) (result T, err error) {
 	tlsConfig, err := getTLSConfig(tlsCert)
 	if err != nil {
@@ -358,6 +854,241 @@ func getBalance(url, macaroon, tlsCert string) (*balance, error) {
 	return result, nil
 }
 
+type liquidityPoint struct {
+	Timestamp string `json:"timestamp"`
+	Locked    string `json:"locked"`
+	Available string `json:"available"`
+	Swept     string `json:"swept"`
+	Alert     bool   `json:"alert"`
+}
+
+func (p liquidityPoint) String() string {
+	timestamp, _ := strconv.ParseInt(p.Timestamp, 10, 64)
+	str := fmt.Sprintf(
+		"%s   available: %s   locked: %s   swept: %s",
+		time.Unix(timestamp, 0).Format(time.DateTime), p.Available, p.Locked,
+		p.Swept,
+	)
+	if p.Alert {
+		str += "   ALERT"
+	}
+	return str
+}
+
+type liquidityForecast struct {
+	MainAccount        accountBalance   `json:"mainAccount"`
+	ConnectorsAccount  accountBalance   `json:"connectorsAccount"`
+	VtxosLiability     string           `json:"vtxosLiability"`
+	RecentRoundsAmount string           `json:"recentRoundsAmount"`
+	Lookback           string           `json:"lookback"`
+	AlertThreshold     string           `json:"alertThreshold"`
+	Timeline           []liquidityPoint `json:"timeline"`
+}
+
+func (f liquidityForecast) String() string {
+	lookback, _ := strconv.ParseInt(f.Lookback, 10, 64)
+	timeline := make([]string, 0, len(f.Timeline))
+	for _, point := range f.Timeline {
+		timeline = append(timeline, point.String())
+	}
+	return fmt.Sprintf(
+		"main account\n%s\nconnectors account\n%s\n"+
+			"vtxos liability: %s\nrecent rounds amount: %s (last %s)\n"+
+			"alert threshold: %s\ntimeline\n%s",
+		f.MainAccount, f.ConnectorsAccount, f.VtxosLiability,
+		f.RecentRoundsAmount, time.Duration(lookback)*time.Second,
+		f.AlertThreshold, strings.Join(timeline, "\n"),
+	)
+}
+
+func getLiquidityForecast(
+	url, macaroon, tlsCert string,
+) (*liquidityForecast, error) {
+	tlsConfig, err := getTLSConfig(tlsCert)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if len(macaroon) > 0 {
+		req.Header.Add("X-Macaroon", macaroon)
+	}
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf(string(buf))
+		return nil, err
+	}
+
+	result := &liquidityForecast{}
+	if err := json.Unmarshal(buf, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type releasableConnector struct {
+	Txid      string `json:"txid"`
+	Vout      uint32 `json:"vout"`
+	Amount    string `json:"amount"`
+	RoundTxid string `json:"roundTxid"`
+}
+
+type connectorsRelease struct {
+	Connectors []releasableConnector `json:"connectors"`
+	Amount     string                `json:"amount"`
+	FeeRate    string                `json:"feeRate"`
+	SkipReason string                `json:"skipReason"`
+}
+
+func (r connectorsRelease) String() string {
+	connectors := make([]string, 0, len(r.Connectors))
+	for _, c := range r.Connectors {
+		connectors = append(connectors, fmt.Sprintf(
+			"%s:%d   amount: %s   round: %s", c.Txid, c.Vout, c.Amount, c.RoundTxid,
+		))
+	}
+	str := fmt.Sprintf(
+		"connectors\n%s\namount: %s\nfee rate: %s sats/kvbyte",
+		strings.Join(connectors, "\n"), r.Amount, r.FeeRate,
+	)
+	if len(r.SkipReason) > 0 {
+		str += fmt.Sprintf("\nskipped: %s", r.SkipReason)
+	}
+	return str
+}
+
+func getConnectorsRelease(
+	url, macaroon, tlsCert string,
+) (*connectorsRelease, error) {
+	tlsConfig, err := getTLSConfig(tlsCert)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if len(macaroon) > 0 {
+		req.Header.Add("X-Macaroon", macaroon)
+	}
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf(string(buf))
+		return nil, err
+	}
+
+	result := &connectorsRelease{}
+	if err := json.Unmarshal(buf, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type backupEntry struct {
+	Path   string `json:"path"`
+	Size   string `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+type backupResult struct {
+	Path      string        `json:"path"`
+	CreatedAt string        `json:"createdAt"`
+	Network   string        `json:"network"`
+	Pubkey    string        `json:"pubkey"`
+	Entries   []backupEntry `json:"entries"`
+}
+
+func (r backupResult) String() string {
+	entries := make([]string, 0, len(r.Entries))
+	for _, e := range r.Entries {
+		entries = append(entries, fmt.Sprintf(
+			"%s   size: %s   sha256: %s", e.Path, e.Size, e.Sha256,
+		))
+	}
+	return fmt.Sprintf(
+		"path: %s\ncreated at: %s\nnetwork: %s\npubkey: %s\nfiles\n%s",
+		r.Path, r.CreatedAt, r.Network, r.Pubkey, strings.Join(entries, "\n"),
+	)
+}
+
+func createBackup(
+	url, body, macaroon, tlsCert string,
+) (*backupResult, error) {
+	tlsConfig, err := getTLSConfig(tlsCert)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if len(macaroon) > 0 {
+		req.Header.Add("X-Macaroon", macaroon)
+	}
+	// Copying the dbs may take a while.
+	client := &http.Client{
+		Timeout: 10 * time.Minute,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf(string(buf))
+		return nil, err
+	}
+
+	result := &backupResult{}
+	if err := json.Unmarshal(buf, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 type status struct {
 	Initialized bool `json:"initialized"`
 	Unlocked    bool `json:"unlocked"`
@@ -60,25 +60,54 @@ func mainAction(_ *cli.Context) error {
 	}
 
 	appConfig := &appconfig.Config{
-		EventDbType:           cfg.EventDbType,
-		DbType:                cfg.DbType,
-		DbDir:                 cfg.DbDir,
-		DbMigrationPath:       cfg.DbMigrationPath,
-		EventDbDir:            cfg.DbDir,
-		RoundInterval:         cfg.RoundInterval,
-		Network:               cfg.Network,
-		SchedulerType:         cfg.SchedulerType,
-		TxBuilderType:         cfg.TxBuilderType,
-		BlockchainScannerType: cfg.BlockchainScannerType,
-		WalletAddr:            cfg.WalletAddr,
-		MinRelayFee:           cfg.MinRelayFee,
-		RoundLifetime:         cfg.RoundLifetime,
-		UnilateralExitDelay:   cfg.UnilateralExitDelay,
-		EsploraURL:            cfg.EsploraURL,
-		NeutrinoPeer:          cfg.NeutrinoPeer,
-		BitcoindRpcUser:       cfg.BitcoindRpcUser,
-		BitcoindRpcPass:       cfg.BitcoindRpcPass,
-		BitcoindRpcHost:       cfg.BitcoindRpcHost,
+		EventDbType:                 cfg.EventDbType,
+		DbType:                      cfg.DbType,
+		DbDir:                       cfg.DbDir,
+		DbMigrationPath:             cfg.DbMigrationPath,
+		EventDbMigrationPath:        cfg.EventDbMigrationPath,
+		DbUrl:                       cfg.DbUrl,
+		EventDbDir:                  cfg.DbDir,
+		RoundInterval:               cfg.RoundInterval,
+		Network:                     cfg.Network,
+		SchedulerType:               cfg.SchedulerType,
+		TxBuilderType:               cfg.TxBuilderType,
+		BlockchainScannerType:       cfg.BlockchainScannerType,
+		WalletAddr:                  cfg.WalletAddr,
+		MinRelayFee:                 cfg.MinRelayFee,
+		RoundLifetime:               cfg.RoundLifetime,
+		UnilateralExitDelay:         cfg.UnilateralExitDelay,
+		BanThreshold:                cfg.BanThreshold,
+		BanDuration:                 cfg.BanDuration,
+		RoundTrigger:                cfg.RoundTrigger,
+		RoundTriggerMinPayments:     cfg.RoundTriggerMinPayments,
+		RoundTriggerMinAmount:       cfg.RoundTriggerMinAmount,
+		RoundTriggerMaxWait:         cfg.RoundTriggerMaxWait,
+		PaymentSelection:            cfg.PaymentSelection,
+		MaxTreeDepth:                cfg.MaxTreeDepth,
+		MaxPoolTxWeight:             cfg.MaxPoolTxWeight,
+		OffchainBaseFee:             cfg.OffchainBaseFee,
+		OffchainFeeRate:             cfg.OffchainFeeRate,
+		OnchainBaseFee:              cfg.OnchainBaseFee,
+		OnchainFeeRate:              cfg.OnchainFeeRate,
+		AsyncBaseFee:                cfg.AsyncBaseFee,
+		AsyncFeeRate:                cfg.AsyncFeeRate,
+		SweepBatchWindow:            cfg.SweepBatchWindow,
+		SweepMaxTxWeight:            cfg.SweepMaxTxWeight,
+		TxBumpDeadline:              cfg.TxBumpDeadline,
+		TxBumpMaxFeeRate:            cfg.TxBumpMaxFeeRate,
+		ConnectorsReleaseInterval:   cfg.ConnectorsReleaseInterval,
+		ConnectorsReleaseFeeRate:    cfg.ConnectorsReleaseFeeRate,
+		ConnectorsReleaseMaxFeeRate: cfg.ConnectorsReleaseMaxFeeRate,
+		ConnectorsReleaseMinAmount:  cfg.ConnectorsReleaseMinAmount,
+		EsploraURL:                  cfg.EsploraURL,
+		NeutrinoPeer:                cfg.NeutrinoPeer,
+		BitcoindRpcUser:             cfg.BitcoindRpcUser,
+		BitcoindRpcPass:             cfg.BitcoindRpcPass,
+		BitcoindRpcHost:             cfg.BitcoindRpcHost,
+		ElectrumURL:                 cfg.ElectrumURL,
+		SignerAddr:                  cfg.SignerAddr,
+		SignerAuthToken:             cfg.SignerAuthToken,
+		SignerTLSCert:               cfg.SignerTLSCert,
 	}
 	svc, err := grpcservice.NewService(svcConfig, appConfig)
 	if err != nil {
@@ -107,7 +136,10 @@ func main() {
 	app.Version = Version
 	app.Name = "Arkd CLI"
 	app.Usage = "arkd command line interface"
-	app.Commands = append(app.Commands, walletCmd)
+	app.Commands = append(
+		app.Commands, walletCmd, liquidityCmd, connectorsCmd, dbCmd, backupCmd,
+		restoreCmd,
+	)
 	app.Action = mainAction
 	app.Flags = append(app.Flags, urlFlag, noMacaroonFlag, macaroonFlag, tlsCertFlag)
 
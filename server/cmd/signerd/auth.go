@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authInterceptor rejects the requests that don't carry the auth token of the
+// signer as bearer of the authorization metadata.
+func authInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{},
+		_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkAuthToken(ctx, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func checkAuthToken(ctx context.Context, token string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing auth token")
+	}
+
+	values := md.Get("authorization")
+	if len(values) != 1 || !strings.HasPrefix(values[0], "Bearer ") {
+		return status.Error(codes.Unauthenticated, "missing auth token")
+	}
+
+	got := strings.TrimPrefix(values[0], "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid auth token")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+	btcwallet "github.com/ark-network/ark/server/internal/infrastructure/wallet/btc-embedded"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type handler struct {
+	signer *btcwallet.Signer
+}
+
+func (h *handler) GetAccounts(
+	_ context.Context, _ *arkv1.GetAccountsRequest,
+) (*arkv1.GetAccountsResponse, error) {
+	accounts, err := h.signer.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*arkv1.SignerAccount, 0, len(accounts))
+	for _, account := range accounts {
+		list = append(list, &arkv1.SignerAccount{
+			Name:                 account.Name,
+			Purpose:              account.Purpose,
+			CoinType:             account.CoinType,
+			Number:               account.Number,
+			Xpub:                 account.Xpub,
+			MasterKeyFingerprint: account.MasterKeyFingerprint,
+		})
+	}
+	return &arkv1.GetAccountsResponse{Accounts: list}, nil
+}
+
+func (h *handler) SignPsbt(
+	_ context.Context, req *arkv1.SignPsbtRequest,
+) (*arkv1.SignPsbtResponse, error) {
+	if len(req.GetPsbt()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing psbt")
+	}
+
+	psbt, signedInputs, err := h.signer.SignPsbt(req.GetPsbt())
+	if err != nil {
+		if errors.Is(err, btcwallet.ErrOutputNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return &arkv1.SignPsbtResponse{Psbt: psbt, SignedInputs: signedInputs}, nil
+}
@@ -0,0 +1,190 @@
+// signerd is the reference remote signer of arkd. It holds the keys of the
+// wallet, whose watch-only counterpart is run by arkd with ARK_SIGNER_ADDR set
+// to the address of this daemon and ARK_SIGNER_AUTH_TOKEN to its auth token.
+//
+// The daemon listens on localhost unless TLS is enabled, and signs only the
+// PSBTs paying to the wallet, or to the shared output of a round, plus at most
+// SIGNER_MAX_EXTERNAL_AMOUNT sats to external outputs, like collaborative exits
+// and withdrawals.
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+	"github.com/ark-network/ark/common"
+	btcwallet "github.com/ark-network/ark/server/internal/infrastructure/wallet/btc-embedded"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	Datadir           = "DATADIR"
+	Network           = "NETWORK"
+	Host              = "HOST"
+	Port              = "PORT"
+	Password          = "PASSWORD"
+	Mnemonic          = "MNEMONIC"
+	LogLevel          = "LOG_LEVEL"
+	AuthToken         = "AUTH_TOKEN"
+	TLSCert           = "TLS_CERT"
+	TLSKey            = "TLS_KEY"
+	MaxExternalAmount = "MAX_EXTERNAL_AMOUNT"
+
+	defaultHost     = "127.0.0.1"
+	defaultPort     = 7071
+	defaultNetwork  = "bitcoin"
+	defaultLogLevel = 4
+)
+
+var defaultDatadir = common.AppDataDir("signerd", false)
+
+type config struct {
+	datadir           string
+	network           common.Network
+	host              string
+	port              uint32
+	password          string
+	mnemonic          string
+	logLevel          int
+	authToken         string
+	tlsCert           string
+	tlsKey            string
+	maxExternalAmount uint64
+}
+
+func loadConfig() (*config, error) {
+	viper.SetEnvPrefix("SIGNER")
+	viper.AutomaticEnv()
+
+	viper.SetDefault(Datadir, defaultDatadir)
+	viper.SetDefault(Network, defaultNetwork)
+	viper.SetDefault(Host, defaultHost)
+	viper.SetDefault(Port, defaultPort)
+	viper.SetDefault(LogLevel, defaultLogLevel)
+
+	network, err := getNetwork()
+	if err != nil {
+		return nil, err
+	}
+	if len(viper.GetString(Password)) <= 0 {
+		return nil, fmt.Errorf("missing wallet password, set SIGNER_PASSWORD env var")
+	}
+	if len(viper.GetString(AuthToken)) <= 0 {
+		return nil, fmt.Errorf("missing auth token, set SIGNER_AUTH_TOKEN env var")
+	}
+
+	tlsCert, tlsKey := viper.GetString(TLSCert), viper.GetString(TLSKey)
+	if (len(tlsCert) > 0) != (len(tlsKey) > 0) {
+		return nil, fmt.Errorf("SIGNER_TLS_CERT and SIGNER_TLS_KEY must be set together")
+	}
+	host := viper.GetString(Host)
+	if len(tlsCert) <= 0 && !isLoopback(host) {
+		return nil, fmt.Errorf(
+			"listening on %s requires TLS, set SIGNER_TLS_CERT and SIGNER_TLS_KEY", host,
+		)
+	}
+
+	datadir := viper.GetString(Datadir)
+	if err := os.MkdirAll(datadir, os.ModeDir|0755); err != nil {
+		return nil, fmt.Errorf("error while creating datadir: %s", err)
+	}
+
+	return &config{
+		datadir:           datadir,
+		network:           network,
+		host:              host,
+		port:              viper.GetUint32(Port),
+		password:          viper.GetString(Password),
+		mnemonic:          viper.GetString(Mnemonic),
+		logLevel:          viper.GetInt(LogLevel),
+		authToken:         viper.GetString(AuthToken),
+		tlsCert:           tlsCert,
+		tlsKey:            tlsKey,
+		maxExternalAmount: viper.GetUint64(MaxExternalAmount),
+	}, nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func getNetwork() (common.Network, error) {
+	switch strings.ToLower(viper.GetString(Network)) {
+	case common.Bitcoin.Name:
+		return common.Bitcoin, nil
+	case common.BitcoinTestNet.Name:
+		return common.BitcoinTestNet, nil
+	case common.BitcoinRegTest.Name:
+		return common.BitcoinRegTest, nil
+	case common.BitcoinSigNet.Name:
+		return common.BitcoinSigNet, nil
+	default:
+		return common.Network{}, fmt.Errorf("unknown network %s", viper.GetString(Network))
+	}
+}
+
+func main() {
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatalf("invalid config: %s", err)
+	}
+
+	log.SetLevel(log.Level(cfg.logLevel))
+
+	// the mnemonic is required only the first time, to create the wallet
+	signer, err := btcwallet.NewSigner(btcwallet.WalletConfig{
+		Datadir: cfg.datadir,
+		Network: cfg.network,
+	}, cfg.mnemonic, cfg.password, btcwallet.SignerPolicy{
+		MaxExternalAmount: cfg.maxExternalAmount,
+	})
+	if err != nil {
+		log.Fatalf("failed to open signer wallet: %s", err)
+	}
+	defer signer.Close()
+
+	addr := net.JoinHostPort(cfg.host, strconv.Itoa(int(cfg.port)))
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen: %s", err)
+	}
+
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(authInterceptor(cfg.authToken))}
+	if len(cfg.tlsCert) > 0 {
+		creds, err := credentials.NewServerTLSFromFile(cfg.tlsCert, cfg.tlsKey)
+		if err != nil {
+			log.Fatalf("failed to load tls cert: %s", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	server := grpc.NewServer(opts...)
+	arkv1.RegisterSignerServiceServer(server, &handler{signer})
+
+	go func() {
+		log.Infof("signer listening on %s", addr)
+		if err := server.Serve(lis); err != nil {
+			log.WithError(err).Fatal("signer stopped")
+		}
+	}()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT, os.Interrupt)
+	<-sigChan
+
+	log.Info("shutting down signer...")
+	server.GracefulStop()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ark-network/ark/server/internal/core/application"
 	"github.com/ark-network/ark/server/internal/core/ports"
 	"github.com/ark-network/ark/server/internal/infrastructure/db"
+	blockscheduler "github.com/ark-network/ark/server/internal/infrastructure/scheduler/block"
 	scheduler "github.com/ark-network/ark/server/internal/infrastructure/scheduler/gocron"
 	txbuilder "github.com/ark-network/ark/server/internal/infrastructure/tx-builder/covenant"
 	cltxbuilder "github.com/ark-network/ark/server/internal/infrastructure/tx-builder/covenantless"
@@ -20,14 +21,18 @@ const minAllowedSequence = 512
 
 var (
 	supportedEventDbs = supportedType{
-		"badger": {},
+		"badger":   {},
+		"sqlite":   {},
+		"postgres": {},
 	}
 	supportedDbs = supportedType{
-		"badger": {},
-		"sqlite": {},
+		"badger":   {},
+		"sqlite":   {},
+		"postgres": {},
 	}
 	supportedSchedulers = supportedType{
 		"gocron": {},
+		"block":  {},
 	}
 	supportedTxBuilders = supportedType{
 		"covenant":     {},
@@ -49,42 +54,70 @@ var (
 )
 
 type Config struct {
-	DbType                string
-	EventDbType           string
-	DbDir                 string
-	DbMigrationPath       string
-	EventDbDir            string
-	RoundInterval         int64
-	Network               common.Network
-	SchedulerType         string
-	TxBuilderType         string
-	BlockchainScannerType string
-	WalletAddr            string
-	MinRelayFee           uint64
-	RoundLifetime         int64
-	UnilateralExitDelay   int64
+	DbType                      string
+	EventDbType                 string
+	DbDir                       string
+	DbMigrationPath             string
+	EventDbDir                  string
+	EventDbMigrationPath        string
+	DbUrl                       string
+	RoundInterval               int64
+	Network                     common.Network
+	SchedulerType               string
+	TxBuilderType               string
+	BlockchainScannerType       string
+	WalletAddr                  string
+	MinRelayFee                 uint64
+	RoundLifetime               int64
+	UnilateralExitDelay         int64
+	BanThreshold                int
+	BanDuration                 int64
+	RoundTrigger                string
+	RoundTriggerMinPayments     int64
+	RoundTriggerMinAmount       uint64
+	RoundTriggerMaxWait         int64
+	PaymentSelection            string
+	MaxTreeDepth                int
+	MaxPoolTxWeight             int64
+	OffchainBaseFee             uint64
+	OffchainFeeRate             uint64
+	OnchainBaseFee              uint64
+	OnchainFeeRate              uint64
+	AsyncBaseFee                uint64
+	AsyncFeeRate                uint64
+	SweepBatchWindow            int64
+	SweepMaxTxWeight            int64
+	TxBumpDeadline              int64
+	TxBumpMaxFeeRate            uint64
+	ConnectorsReleaseInterval   int64
+	ConnectorsReleaseFeeRate    uint64
+	ConnectorsReleaseMaxFeeRate uint64
+	ConnectorsReleaseMinAmount  uint64
 
 	EsploraURL      string
 	NeutrinoPeer    string
 	BitcoindRpcUser string
 	BitcoindRpcPass string
 	BitcoindRpcHost string
-
-	repo      ports.RepoManager
-	svc       application.Service
-	adminSvc  application.AdminService
-	wallet    ports.WalletService
-	txBuilder ports.TxBuilder
-	scanner   ports.BlockchainScanner
-	scheduler ports.SchedulerService
+	ElectrumURL     string
+	SignerAddr      string
+	SignerAuthToken string
+	SignerTLSCert   string
+
+	repo       ports.RepoManager
+	svc        application.Service
+	adminSvc   application.AdminService
+	wallet     ports.WalletService
+	txBuilder  ports.TxBuilder
+	scanner    ports.BlockchainScanner
+	scheduler  ports.SchedulerService
+	txMonitor  application.TxMonitor
+	rebalancer application.ConnectorsRebalancer
 }
 
 func (c *Config) Validate() error {
-	if !supportedEventDbs.supports(c.EventDbType) {
-		return fmt.Errorf("event db type not supported, please select one of: %s", supportedEventDbs)
-	}
-	if !supportedDbs.supports(c.DbType) {
-		return fmt.Errorf("db type not supported, please select one of: %s", supportedDbs)
+	if err := c.validateDb(); err != nil {
+		return err
 	}
 	if !supportedSchedulers.supports(c.SchedulerType) {
 		return fmt.Errorf("scheduler type not supported, please select one of: %s", supportedSchedulers)
@@ -142,6 +175,13 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.BanThreshold < 0 {
+		return fmt.Errorf("invalid ban threshold, must be at least 0")
+	}
+	if c.BanThreshold > 0 && c.BanDuration <= 0 {
+		return fmt.Errorf("invalid ban duration, must be greater than 0")
+	}
+
 	if err := c.repoManager(); err != nil {
 		return err
 	}
@@ -157,12 +197,32 @@ func (c *Config) Validate() error {
 	if err := c.schedulerService(); err != nil {
 		return err
 	}
+	if err := c.txMonitorService(); err != nil {
+		return err
+	}
+	if err := c.connectorsRebalancerService(); err != nil {
+		return err
+	}
 	if err := c.adminService(); err != nil {
 		return err
 	}
 	return nil
 }
 
+// RepoManager returns the repository manager without setting up any other
+// service, it's meant for offline operations on the db.
+func (c *Config) RepoManager() (ports.RepoManager, error) {
+	if c.repo == nil {
+		if err := c.validateDb(); err != nil {
+			return nil, err
+		}
+		if err := c.repoManager(); err != nil {
+			return nil, err
+		}
+	}
+	return c.repo, nil
+}
+
 func (c *Config) AppService() (application.Service, error) {
 	if c.svc == nil {
 		if err := c.appService(); err != nil {
@@ -180,6 +240,22 @@ func (c *Config) WalletService() ports.WalletService {
 	return c.wallet
 }
 
+func (c *Config) validateDb() error {
+	if !supportedEventDbs.supports(c.EventDbType) {
+		return fmt.Errorf("event db type not supported, please select one of: %s", supportedEventDbs)
+	}
+	if !supportedDbs.supports(c.DbType) {
+		return fmt.Errorf("db type not supported, please select one of: %s", supportedDbs)
+	}
+	if c.EventDbType == "sqlite" && c.DbType != "sqlite" {
+		return fmt.Errorf("sqlite event db type requires sqlite db type")
+	}
+	if (c.EventDbType == "postgres" || c.DbType == "postgres") && len(c.DbUrl) <= 0 {
+		return fmt.Errorf("missing db url, required for postgres db type")
+	}
+	return nil
+}
+
 func (c *Config) repoManager() error {
 	var svc ports.RepoManager
 	var err error
@@ -190,6 +266,10 @@ func (c *Config) repoManager() error {
 	switch c.EventDbType {
 	case "badger":
 		eventStoreConfig = []interface{}{c.EventDbDir, logger}
+	case "sqlite":
+		// The sqlite event store shares the db of the data stores.
+	case "postgres":
+		eventStoreConfig = []interface{}{c.DbUrl, c.EventDbMigrationPath}
 	default:
 		return fmt.Errorf("unknown event db type")
 	}
@@ -199,6 +279,8 @@ func (c *Config) repoManager() error {
 		dataStoreConfig = []interface{}{c.DbDir, logger}
 	case "sqlite":
 		dataStoreConfig = []interface{}{c.DbDir, c.DbMigrationPath}
+	case "postgres":
+		dataStoreConfig = []interface{}{c.DbUrl, c.DbMigrationPath}
 	default:
 		return fmt.Errorf("unknown db type")
 	}
@@ -220,6 +302,10 @@ func (c *Config) repoManager() error {
 
 func (c *Config) walletService() error {
 	if common.IsLiquid(c.Network) {
+		if c.SignerAddr != "" {
+			return fmt.Errorf("remote signer is not supported for liquid networks")
+		}
+
 		svc, err := liquidwallet.NewService(c.WalletAddr)
 		if err != nil {
 			return err
@@ -237,28 +323,48 @@ func (c *Config) walletService() error {
 	if c.NeutrinoPeer != "" && (c.BitcoindRpcUser != "" || c.BitcoindRpcPass != "") {
 		return fmt.Errorf("cannot use both Neutrino peer and Bitcoind RPC credentials")
 	}
+	if c.ElectrumURL != "" && (c.NeutrinoPeer != "" || c.BitcoindRpcUser != "" || c.BitcoindRpcPass != "") {
+		return fmt.Errorf("cannot use Electrum server along with Neutrino peer or Bitcoind RPC credentials")
+	}
 
 	var svc ports.WalletService
 	var err error
 
+	// the wallet is watch-only if its keys are held by a remote signer
+	opts := make([]btcwallet.WalletOption, 0)
+	if c.SignerAddr != "" {
+		opts = append(opts, btcwallet.WithRemoteSigner(btcwallet.RemoteSignerConfig{
+			Addr:      c.SignerAddr,
+			AuthToken: c.SignerAuthToken,
+			TLSCert:   c.SignerTLSCert,
+		}))
+	}
+
 	switch {
 	case c.NeutrinoPeer != "":
 		svc, err = btcwallet.NewService(btcwallet.WalletConfig{
 			Datadir:    c.DbDir,
 			Network:    c.Network,
 			EsploraURL: c.EsploraURL,
-		}, btcwallet.WithNeutrino(c.NeutrinoPeer))
+		}, append(opts, btcwallet.WithNeutrino(c.NeutrinoPeer))...)
 
 	case c.BitcoindRpcUser != "" && c.BitcoindRpcPass != "":
 		svc, err = btcwallet.NewService(btcwallet.WalletConfig{
 			Datadir:    c.DbDir,
 			Network:    c.Network,
 			EsploraURL: c.EsploraURL,
-		}, btcwallet.WithPollingBitcoind(c.BitcoindRpcHost, c.BitcoindRpcUser, c.BitcoindRpcPass))
+		}, append(opts, btcwallet.WithPollingBitcoind(c.BitcoindRpcHost, c.BitcoindRpcUser, c.BitcoindRpcPass))...)
+
+	case c.ElectrumURL != "":
+		svc, err = btcwallet.NewService(btcwallet.WalletConfig{
+			Datadir:    c.DbDir,
+			Network:    c.Network,
+			EsploraURL: c.EsploraURL,
+		}, append(opts, btcwallet.WithElectrum(c.ElectrumURL))...)
 
 	// Placeholder for future initializers like WithBitcoindZMQ
 	default:
-		return fmt.Errorf("either Neutrino peer or Bitcoind RPC credentials must be provided")
+		return fmt.Errorf("either Neutrino peer, Bitcoind RPC credentials or Electrum server must be provided")
 	}
 
 	if err != nil {
@@ -309,6 +415,12 @@ func (c *Config) schedulerService() error {
 	switch c.SchedulerType {
 	case "gocron":
 		svc = scheduler.NewScheduler()
+	case "block":
+		if common.IsLiquid(c.Network) {
+			err = fmt.Errorf("block scheduler not supported for liquid network")
+			break
+		}
+		svc = blockscheduler.NewScheduler(c.scanner)
 	default:
 		err = fmt.Errorf("unknown scheduler type")
 	}
@@ -320,11 +432,74 @@ func (c *Config) schedulerService() error {
 	return nil
 }
 
+func (c *Config) txMonitorService() error {
+	txMonitor, err := application.NewTxMonitor(
+		c.wallet, c.txBuilder, c.scanner, c.repo, application.TxBumping{
+			Deadline:   c.TxBumpDeadline,
+			MaxFeeRate: c.TxBumpMaxFeeRate,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	c.txMonitor = txMonitor
+	return nil
+}
+
+func (c *Config) connectorsRebalancerService() error {
+	rebalancer, err := application.NewConnectorsRebalancer(
+		c.wallet, c.repo, application.ConnectorsRebalancing{
+			Interval:   c.ConnectorsReleaseInterval,
+			FeeRate:    c.ConnectorsReleaseFeeRate,
+			MaxFeeRate: c.ConnectorsReleaseMaxFeeRate,
+			MinAmount:  c.ConnectorsReleaseMinAmount,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	c.rebalancer = rebalancer
+	return nil
+}
+
 func (c *Config) appService() error {
+	roundTrigger := application.RoundTrigger{
+		Type:        c.RoundTrigger,
+		MinPayments: c.RoundTriggerMinPayments,
+		MinAmount:   c.RoundTriggerMinAmount,
+		MaxWait:     c.RoundTriggerMaxWait,
+	}
+	paymentSelection := application.PaymentSelection{
+		Type:            c.PaymentSelection,
+		MaxTreeDepth:    c.MaxTreeDepth,
+		MaxPoolTxWeight: c.MaxPoolTxWeight,
+	}
+	fees := application.FeeSchedule{
+		Offchain: application.ServiceFee{
+			Base: c.OffchainBaseFee,
+			Rate: c.OffchainFeeRate,
+		},
+		Onchain: application.ServiceFee{
+			Base: c.OnchainBaseFee,
+			Rate: c.OnchainFeeRate,
+		},
+		Async: application.ServiceFee{
+			Base: c.AsyncBaseFee,
+			Rate: c.AsyncFeeRate,
+		},
+	}
+	sweepBatching := application.SweepBatching{
+		Window:      c.SweepBatchWindow,
+		MaxTxWeight: c.SweepMaxTxWeight,
+	}
 	if common.IsLiquid(c.Network) {
 		svc, err := application.NewCovenantService(
 			c.Network, c.RoundInterval, c.RoundLifetime, c.UnilateralExitDelay,
-			c.MinRelayFee, c.wallet, c.repo, c.txBuilder, c.scanner, c.scheduler,
+			c.MinRelayFee, c.BanThreshold, c.BanDuration, roundTrigger,
+			paymentSelection, fees, sweepBatching, c.wallet, c.repo, c.txBuilder,
+			c.scanner, c.scheduler, c.txMonitor, c.rebalancer,
 		)
 		if err != nil {
 			return err
@@ -336,7 +511,9 @@ func (c *Config) appService() error {
 
 	svc, err := application.NewCovenantlessService(
 		c.Network, c.RoundInterval, c.RoundLifetime, c.UnilateralExitDelay,
-		c.MinRelayFee, c.wallet, c.repo, c.txBuilder, c.scanner, c.scheduler,
+		c.MinRelayFee, c.BanThreshold, c.BanDuration, roundTrigger,
+		paymentSelection, fees, sweepBatching, c.wallet, c.repo, c.txBuilder,
+		c.scanner, c.scheduler, c.txMonitor, c.rebalancer,
 	)
 	if err != nil {
 		return err
@@ -347,7 +524,9 @@ func (c *Config) appService() error {
 }
 
 func (c *Config) adminService() error {
-	c.adminSvc = application.NewAdminService(c.wallet, c.repo, c.txBuilder)
+	c.adminSvc = application.NewAdminService(
+		c.wallet, c.repo, c.txBuilder, c.txMonitor, c.rebalancer,
+	)
 	return nil
 }
 
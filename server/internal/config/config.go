@@ -11,76 +11,146 @@ import (
 )
 
 type Config struct {
-	Datadir               string
-	WalletAddr            string
-	RoundInterval         int64
-	Port                  uint32
-	EventDbType           string
-	DbType                string
-	DbDir                 string
-	DbMigrationPath       string
-	SchedulerType         string
-	TxBuilderType         string
-	BlockchainScannerType string
-	NoTLS                 bool
-	NoMacaroons           bool
-	Network               common.Network
-	LogLevel              int
-	MinRelayFee           uint64
-	RoundLifetime         int64
-	UnilateralExitDelay   int64
-	EsploraURL            string
-	NeutrinoPeer          string
-	BitcoindRpcUser       string
-	BitcoindRpcPass       string
-	BitcoindRpcHost       string
-	TLSExtraIPs           []string
-	TLSExtraDomains       []string
+	Datadir                     string
+	WalletAddr                  string
+	RoundInterval               int64
+	Port                        uint32
+	EventDbType                 string
+	DbType                      string
+	DbDir                       string
+	DbMigrationPath             string
+	EventDbMigrationPath        string
+	DbUrl                       string
+	SchedulerType               string
+	TxBuilderType               string
+	BlockchainScannerType       string
+	NoTLS                       bool
+	NoMacaroons                 bool
+	Network                     common.Network
+	LogLevel                    int
+	MinRelayFee                 uint64
+	RoundLifetime               int64
+	UnilateralExitDelay         int64
+	BanThreshold                int
+	BanDuration                 int64
+	RoundTrigger                string
+	RoundTriggerMinPayments     int64
+	RoundTriggerMinAmount       uint64
+	RoundTriggerMaxWait         int64
+	PaymentSelection            string
+	MaxTreeDepth                int
+	MaxPoolTxWeight             int64
+	OffchainBaseFee             uint64
+	OffchainFeeRate             uint64
+	OnchainBaseFee              uint64
+	OnchainFeeRate              uint64
+	AsyncBaseFee                uint64
+	AsyncFeeRate                uint64
+	SweepBatchWindow            int64
+	SweepMaxTxWeight            int64
+	TxBumpDeadline              int64
+	TxBumpMaxFeeRate            uint64
+	ConnectorsReleaseInterval   int64
+	ConnectorsReleaseFeeRate    uint64
+	ConnectorsReleaseMaxFeeRate uint64
+	ConnectorsReleaseMinAmount  uint64
+	EsploraURL                  string
+	NeutrinoPeer                string
+	BitcoindRpcUser             string
+	BitcoindRpcPass             string
+	BitcoindRpcHost             string
+	ElectrumURL                 string
+	SignerAddr                  string
+	SignerAuthToken             string
+	SignerTLSCert               string
+	TLSExtraIPs                 []string
+	TLSExtraDomains             []string
 }
 
 var (
-	Datadir               = "DATADIR"
-	WalletAddr            = "WALLET_ADDR"
-	RoundInterval         = "ROUND_INTERVAL"
-	Port                  = "PORT"
-	EventDbType           = "EVENT_DB_TYPE"
-	DbType                = "DB_TYPE"
-	DbMigrationPath       = "DB_MIGRATION_PATH"
-	SchedulerType         = "SCHEDULER_TYPE"
-	TxBuilderType         = "TX_BUILDER_TYPE"
-	BlockchainScannerType = "BC_SCANNER_TYPE"
-	LogLevel              = "LOG_LEVEL"
-	Network               = "NETWORK"
-	MinRelayFee           = "MIN_RELAY_FEE"
-	RoundLifetime         = "ROUND_LIFETIME"
-	UnilateralExitDelay   = "UNILATERAL_EXIT_DELAY"
-	EsploraURL            = "ESPLORA_URL"
-	NeutrinoPeer          = "NEUTRINO_PEER"
-	BitcoindRpcUser       = "BITCOIND_RPC_USER"
-	BitcoindRpcPass       = "BITCOIND_RPC_PASS"
-	BitcoindRpcHost       = "BITCOIND_RPC_HOST"
-	NoMacaroons           = "NO_MACAROONS"
-	NoTLS                 = "NO_TLS"
-	TLSExtraIP            = "TLS_EXTRA_IP"
-	TLSExtraDomain        = "TLS_EXTRA_DOMAIN"
-
-	defaultDatadir               = common.AppDataDir("arkd", false)
-	defaultRoundInterval         = 5
-	DefaultPort                  = 7070
-	defaultWalletAddr            = "localhost:18000"
-	defaultDbType                = "sqlite"
-	defaultDbMigrationPath       = "file://internal/infrastructure/db/sqlite/migration"
-	defaultEventDbType           = "badger"
-	defaultSchedulerType         = "gocron"
-	defaultTxBuilderType         = "covenant"
-	defaultBlockchainScannerType = "ocean"
-	defaultNetwork               = "liquid"
-	defaultLogLevel              = 4
-	defaultMinRelayFee           = 30 // 0.1 sat/vbyte on Liquid
-	defaultRoundLifetime         = 604672
-	defaultUnilateralExitDelay   = 1024
-	defaultNoMacaroons           = false
-	defaultNoTLS                 = false
+	Datadir                     = "DATADIR"
+	WalletAddr                  = "WALLET_ADDR"
+	RoundInterval               = "ROUND_INTERVAL"
+	Port                        = "PORT"
+	EventDbType                 = "EVENT_DB_TYPE"
+	DbType                      = "DB_TYPE"
+	DbMigrationPath             = "DB_MIGRATION_PATH"
+	EventDbMigrationPath        = "EVENT_DB_MIGRATION_PATH"
+	DbUrl                       = "DB_URL"
+	SchedulerType               = "SCHEDULER_TYPE"
+	TxBuilderType               = "TX_BUILDER_TYPE"
+	BlockchainScannerType       = "BC_SCANNER_TYPE"
+	LogLevel                    = "LOG_LEVEL"
+	Network                     = "NETWORK"
+	MinRelayFee                 = "MIN_RELAY_FEE"
+	RoundLifetime               = "ROUND_LIFETIME"
+	UnilateralExitDelay         = "UNILATERAL_EXIT_DELAY"
+	BanThreshold                = "BAN_THRESHOLD"
+	BanDuration                 = "BAN_DURATION"
+	RoundTrigger                = "ROUND_TRIGGER"
+	RoundTriggerMinPayments     = "ROUND_TRIGGER_MIN_PAYMENTS"
+	RoundTriggerMinAmount       = "ROUND_TRIGGER_MIN_AMOUNT"
+	RoundTriggerMaxWait         = "ROUND_TRIGGER_MAX_WAIT"
+	PaymentSelection            = "PAYMENT_SELECTION"
+	MaxTreeDepth                = "MAX_TREE_DEPTH"
+	MaxPoolTxWeight             = "MAX_POOL_TX_WEIGHT"
+	OffchainBaseFee             = "OFFCHAIN_BASE_FEE"
+	OffchainFeeRate             = "OFFCHAIN_FEE_RATE"
+	OnchainBaseFee              = "ONCHAIN_BASE_FEE"
+	OnchainFeeRate              = "ONCHAIN_FEE_RATE"
+	AsyncBaseFee                = "ASYNC_BASE_FEE"
+	AsyncFeeRate                = "ASYNC_FEE_RATE"
+	SweepBatchWindow            = "SWEEP_BATCH_WINDOW"
+	SweepMaxTxWeight            = "SWEEP_MAX_TX_WEIGHT"
+	TxBumpDeadline              = "TX_BUMP_DEADLINE"
+	TxBumpMaxFeeRate            = "TX_BUMP_MAX_FEE_RATE"
+	ConnectorsReleaseInterval   = "CONNECTORS_RELEASE_INTERVAL"
+	ConnectorsReleaseFeeRate    = "CONNECTORS_RELEASE_FEE_RATE"
+	ConnectorsReleaseMaxFeeRate = "CONNECTORS_RELEASE_MAX_FEE_RATE"
+	ConnectorsReleaseMinAmount  = "CONNECTORS_RELEASE_MIN_AMOUNT"
+	EsploraURL                  = "ESPLORA_URL"
+	NeutrinoPeer                = "NEUTRINO_PEER"
+	BitcoindRpcUser             = "BITCOIND_RPC_USER"
+	BitcoindRpcPass             = "BITCOIND_RPC_PASS"
+	BitcoindRpcHost             = "BITCOIND_RPC_HOST"
+	ElectrumURL                 = "ELECTRUM_URL"
+	SignerAddr                  = "SIGNER_ADDR"
+	SignerAuthToken             = "SIGNER_AUTH_TOKEN"
+	SignerTLSCert               = "SIGNER_TLS_CERT"
+	NoMacaroons                 = "NO_MACAROONS"
+	NoTLS                       = "NO_TLS"
+	TLSExtraIP                  = "TLS_EXTRA_IP"
+	TLSExtraDomain              = "TLS_EXTRA_DOMAIN"
+
+	defaultDatadir                     = common.AppDataDir("arkd", false)
+	defaultRoundInterval               = 5
+	DefaultPort                        = 7070
+	defaultWalletAddr                  = "localhost:18000"
+	defaultDbType                      = "sqlite"
+	defaultDbMigrationPath             = "file://internal/infrastructure/db/sqlite/migration"
+	defaultPgDbMigrationPath           = "file://internal/infrastructure/db/postgres/migration"
+	defaultEventDbType                 = "badger"
+	defaultSchedulerType               = "gocron"
+	defaultTxBuilderType               = "covenant"
+	defaultBlockchainScannerType       = "ocean"
+	defaultNetwork                     = "liquid"
+	defaultLogLevel                    = 4
+	defaultMinRelayFee                 = 30 // 0.1 sat/vbyte on Liquid
+	defaultRoundLifetime               = 604672
+	defaultUnilateralExitDelay         = 1024
+	defaultBanThreshold                = 3
+	defaultBanDuration                 = 86400 // 1 day
+	defaultRoundTrigger                = "interval"
+	defaultRoundTriggerMaxWait         = 60
+	defaultPaymentSelection            = "fifo"
+	defaultMaxTreeDepth                = 8
+	defaultMaxPoolTxWeight             = 400000
+	defaultSweepMaxTxWeight            = 400000
+	defaultTxBumpMaxFeeRate            = 100000 // 100 sats/vbyte
+	defaultConnectorsReleaseInterval   = 3600   // 1 hour
+	defaultConnectorsReleaseMaxFeeRate = 10000  // 10 sats/vbyte
+	defaultNoMacaroons                 = false
+	defaultNoTLS                       = false
 )
 
 func LoadConfig() (*Config, error) {
@@ -90,7 +160,6 @@ func LoadConfig() (*Config, error) {
 	viper.SetDefault(Datadir, defaultDatadir)
 	viper.SetDefault(Port, DefaultPort)
 	viper.SetDefault(DbType, defaultDbType)
-	viper.SetDefault(DbMigrationPath, defaultDbMigrationPath)
 	viper.SetDefault(NoTLS, defaultNoTLS)
 	viper.SetDefault(LogLevel, defaultLogLevel)
 	viper.SetDefault(Network, defaultNetwork)
@@ -102,6 +171,17 @@ func LoadConfig() (*Config, error) {
 	viper.SetDefault(EventDbType, defaultEventDbType)
 	viper.SetDefault(TxBuilderType, defaultTxBuilderType)
 	viper.SetDefault(UnilateralExitDelay, defaultUnilateralExitDelay)
+	viper.SetDefault(BanThreshold, defaultBanThreshold)
+	viper.SetDefault(BanDuration, defaultBanDuration)
+	viper.SetDefault(RoundTrigger, defaultRoundTrigger)
+	viper.SetDefault(RoundTriggerMaxWait, defaultRoundTriggerMaxWait)
+	viper.SetDefault(PaymentSelection, defaultPaymentSelection)
+	viper.SetDefault(MaxTreeDepth, defaultMaxTreeDepth)
+	viper.SetDefault(MaxPoolTxWeight, defaultMaxPoolTxWeight)
+	viper.SetDefault(SweepMaxTxWeight, defaultSweepMaxTxWeight)
+	viper.SetDefault(TxBumpMaxFeeRate, defaultTxBumpMaxFeeRate)
+	viper.SetDefault(ConnectorsReleaseInterval, defaultConnectorsReleaseInterval)
+	viper.SetDefault(ConnectorsReleaseMaxFeeRate, defaultConnectorsReleaseMaxFeeRate)
 	viper.SetDefault(BlockchainScannerType, defaultBlockchainScannerType)
 	viper.SetDefault(NoMacaroons, defaultNoMacaroons)
 
@@ -114,32 +194,63 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("error while creating datadir: %s", err)
 	}
 
+	dbMigrationPath, eventDbMigrationPath := getDbMigrationPaths()
+
 	return &Config{
-		Datadir:               viper.GetString(Datadir),
-		WalletAddr:            viper.GetString(WalletAddr),
-		RoundInterval:         viper.GetInt64(RoundInterval),
-		Port:                  viper.GetUint32(Port),
-		EventDbType:           viper.GetString(EventDbType),
-		DbType:                viper.GetString(DbType),
-		DbMigrationPath:       viper.GetString(DbMigrationPath),
-		SchedulerType:         viper.GetString(SchedulerType),
-		TxBuilderType:         viper.GetString(TxBuilderType),
-		BlockchainScannerType: viper.GetString(BlockchainScannerType),
-		NoTLS:                 viper.GetBool(NoTLS),
-		DbDir:                 filepath.Join(viper.GetString(Datadir), "db"),
-		LogLevel:              viper.GetInt(LogLevel),
-		Network:               net,
-		MinRelayFee:           viper.GetUint64(MinRelayFee),
-		RoundLifetime:         viper.GetInt64(RoundLifetime),
-		UnilateralExitDelay:   viper.GetInt64(UnilateralExitDelay),
-		EsploraURL:            viper.GetString(EsploraURL),
-		NeutrinoPeer:          viper.GetString(NeutrinoPeer),
-		BitcoindRpcUser:       viper.GetString(BitcoindRpcUser),
-		BitcoindRpcPass:       viper.GetString(BitcoindRpcPass),
-		BitcoindRpcHost:       viper.GetString(BitcoindRpcHost),
-		NoMacaroons:           viper.GetBool(NoMacaroons),
-		TLSExtraIPs:           viper.GetStringSlice(TLSExtraIP),
-		TLSExtraDomains:       viper.GetStringSlice(TLSExtraDomain),
+		Datadir:                     viper.GetString(Datadir),
+		WalletAddr:                  viper.GetString(WalletAddr),
+		RoundInterval:               viper.GetInt64(RoundInterval),
+		Port:                        viper.GetUint32(Port),
+		EventDbType:                 viper.GetString(EventDbType),
+		DbType:                      viper.GetString(DbType),
+		DbMigrationPath:             dbMigrationPath,
+		EventDbMigrationPath:        eventDbMigrationPath,
+		DbUrl:                       viper.GetString(DbUrl),
+		SchedulerType:               viper.GetString(SchedulerType),
+		TxBuilderType:               viper.GetString(TxBuilderType),
+		BlockchainScannerType:       viper.GetString(BlockchainScannerType),
+		NoTLS:                       viper.GetBool(NoTLS),
+		DbDir:                       filepath.Join(viper.GetString(Datadir), "db"),
+		LogLevel:                    viper.GetInt(LogLevel),
+		Network:                     net,
+		MinRelayFee:                 viper.GetUint64(MinRelayFee),
+		RoundLifetime:               viper.GetInt64(RoundLifetime),
+		UnilateralExitDelay:         viper.GetInt64(UnilateralExitDelay),
+		BanThreshold:                viper.GetInt(BanThreshold),
+		BanDuration:                 viper.GetInt64(BanDuration),
+		RoundTrigger:                viper.GetString(RoundTrigger),
+		RoundTriggerMinPayments:     viper.GetInt64(RoundTriggerMinPayments),
+		RoundTriggerMinAmount:       viper.GetUint64(RoundTriggerMinAmount),
+		RoundTriggerMaxWait:         viper.GetInt64(RoundTriggerMaxWait),
+		PaymentSelection:            viper.GetString(PaymentSelection),
+		MaxTreeDepth:                viper.GetInt(MaxTreeDepth),
+		MaxPoolTxWeight:             viper.GetInt64(MaxPoolTxWeight),
+		OffchainBaseFee:             viper.GetUint64(OffchainBaseFee),
+		OffchainFeeRate:             viper.GetUint64(OffchainFeeRate),
+		OnchainBaseFee:              viper.GetUint64(OnchainBaseFee),
+		OnchainFeeRate:              viper.GetUint64(OnchainFeeRate),
+		AsyncBaseFee:                viper.GetUint64(AsyncBaseFee),
+		AsyncFeeRate:                viper.GetUint64(AsyncFeeRate),
+		SweepBatchWindow:            viper.GetInt64(SweepBatchWindow),
+		SweepMaxTxWeight:            viper.GetInt64(SweepMaxTxWeight),
+		TxBumpDeadline:              viper.GetInt64(TxBumpDeadline),
+		TxBumpMaxFeeRate:            viper.GetUint64(TxBumpMaxFeeRate),
+		ConnectorsReleaseInterval:   viper.GetInt64(ConnectorsReleaseInterval),
+		ConnectorsReleaseFeeRate:    viper.GetUint64(ConnectorsReleaseFeeRate),
+		ConnectorsReleaseMaxFeeRate: viper.GetUint64(ConnectorsReleaseMaxFeeRate),
+		ConnectorsReleaseMinAmount:  viper.GetUint64(ConnectorsReleaseMinAmount),
+		EsploraURL:                  viper.GetString(EsploraURL),
+		NeutrinoPeer:                viper.GetString(NeutrinoPeer),
+		BitcoindRpcUser:             viper.GetString(BitcoindRpcUser),
+		BitcoindRpcPass:             viper.GetString(BitcoindRpcPass),
+		BitcoindRpcHost:             viper.GetString(BitcoindRpcHost),
+		ElectrumURL:                 viper.GetString(ElectrumURL),
+		SignerAddr:                  viper.GetString(SignerAddr),
+		SignerAuthToken:             viper.GetString(SignerAuthToken),
+		SignerTLSCert:               viper.GetString(SignerTLSCert),
+		NoMacaroons:                 viper.GetBool(NoMacaroons),
+		TLSExtraIPs:                 viper.GetStringSlice(TLSExtraIP),
+		TLSExtraDomains:             viper.GetStringSlice(TLSExtraDomain),
 	}, nil
 }
 
@@ -175,3 +286,36 @@ func getNetwork() (common.Network, error) {
 		return common.Network{}, fmt.Errorf("unknown network %s", viper.GetString(Network))
 	}
 }
+
+// DefaultDbMigrationPath returns the default migration path for the given db
+// type, if it requires any.
+func DefaultDbMigrationPath(dbType string) string {
+	switch dbType {
+	case "sqlite":
+		return defaultDbMigrationPath
+	case "postgres":
+		return defaultPgDbMigrationPath
+	default:
+		return ""
+	}
+}
+
+// getDbMigrationPaths returns the migration paths for the data and event
+// stores. If not set, the default for the selected db type is used. The event
+// store only requires migrations when backed by postgres, in which case it
+// defaults to the data store path if that's postgres as well.
+func getDbMigrationPaths() (string, string) {
+	dbMigrationPath := viper.GetString(DbMigrationPath)
+	if dbMigrationPath == "" {
+		dbMigrationPath = DefaultDbMigrationPath(viper.GetString(DbType))
+	}
+
+	eventDbMigrationPath := viper.GetString(EventDbMigrationPath)
+	if eventDbMigrationPath == "" {
+		eventDbMigrationPath = defaultPgDbMigrationPath
+		if viper.GetString(DbType) == "postgres" {
+			eventDbMigrationPath = dbMigrationPath
+		}
+	}
+	return dbMigrationPath, eventDbMigrationPath
+}
@@ -2,7 +2,10 @@ package application
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
 )
 
@@ -21,11 +24,21 @@ type SweepableOutput struct {
 	Vout        uint32
 	Amount      uint64
 	ScheduledAt int64
+	// SweepTxid is the txid of the tx that swept the output, if any.
+	SweepTxid string
 }
 
+// ScheduledSweep is a sweep either scheduled or already attempted. The outputs
+// of the scheduled ones are those sweepable at the moment, while the others
+// have the outputs they tried to sweep.
 type ScheduledSweep struct {
 	RoundId          string
 	SweepableOutputs []SweepableOutput
+	Status           string
+	ScheduledAt      int64
+	AttemptedAt      int64
+	Amount           uint64
+	Error            string
 }
 
 type RoundDetails struct {
@@ -40,26 +53,59 @@ type RoundDetails struct {
 	ExitAddresses    []string
 }
 
+type Strike struct {
+	Reason    string
+	RoundId   string
+	Timestamp int64
+}
+
+type Ban struct {
+	Key         string
+	Strikes     []Strike
+	BannedUntil int64
+}
+
 type AdminService interface {
 	Wallet() ports.WalletService
 	GetScheduledSweeps(ctx context.Context) ([]ScheduledSweep, error)
 	GetRoundDetails(ctx context.Context, roundId string) (*RoundDetails, error)
-	GetRounds(ctx context.Context, after int64, before int64) ([]string, error)
+	GetRounds(
+		ctx context.Context, filter domain.RoundFilter, page domain.Page,
+	) (rounds []string, nextCursor string, err error)
 	GetWalletAddress(ctx context.Context) (string, error)
 	GetWalletStatus(ctx context.Context) (*WalletStatus, error)
+	ListBans(ctx context.Context) ([]Ban, error)
+	LiftBan(ctx context.Context, key string) error
+	ListPendingTxs(ctx context.Context) ([]PendingTx, error)
+	BumpTxFee(ctx context.Context, txid string, feeRate uint64) (string, error)
+	GetLiquidityForecast(
+		ctx context.Context, alertThreshold uint64, lookback int64,
+	) (*LiquidityForecast, error)
+	GetConnectorsRelease(ctx context.Context) (*ConnectorsRelease, error)
+	// Backup writes a consistent copy of the db and of the wallet into the
+	// given dir, rounds are expected to be paused meanwhile.
+	Backup(ctx context.Context, dir string) error
 }
 
 type adminService struct {
 	walletSvc   ports.WalletService
 	repoManager ports.RepoManager
 	txBuilder   ports.TxBuilder
+	txMonitor   TxMonitor
+	rebalancer  ConnectorsRebalancer
 }
 
-func NewAdminService(walletSvc ports.WalletService, repoManager ports.RepoManager, txBuilder ports.TxBuilder) AdminService {
+func NewAdminService(
+	walletSvc ports.WalletService, repoManager ports.RepoManager,
+	txBuilder ports.TxBuilder, txMonitor TxMonitor,
+	rebalancer ConnectorsRebalancer,
+) AdminService {
 	return &adminService{
 		walletSvc:   walletSvc,
 		repoManager: repoManager,
 		txBuilder:   txBuilder,
+		txMonitor:   txMonitor,
+		rebalancer:  rebalancer,
 	}
 }
 
@@ -116,34 +162,64 @@ func (a *adminService) GetRoundDetails(ctx context.Context, roundId string) (*Ro
 	return roundDetails, nil
 }
 
-func (a *adminService) GetRounds(ctx context.Context, after int64, before int64) ([]string, error) {
-	return a.repoManager.Rounds().GetRoundsIds(ctx, after, before)
+func (a *adminService) GetRounds(
+	ctx context.Context, filter domain.RoundFilter, page domain.Page,
+) ([]string, string, error) {
+	return a.repoManager.Rounds().ListRoundsIds(ctx, filter, page)
 }
 
 func (a *adminService) GetScheduledSweeps(ctx context.Context) ([]ScheduledSweep, error) {
-	sweepableRounds, err := a.repoManager.Rounds().GetSweepableRounds(ctx)
+	sweeps, err := a.repoManager.Sweeps().GetAllSweeps(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	scheduledSweeps := make([]ScheduledSweep, 0, len(sweepableRounds))
+	rounds := make(map[string]*domain.Round)
+	scheduledSweeps := make([]ScheduledSweep, 0, len(sweeps))
 
-	for _, round := range sweepableRounds {
-		sweepable, err := findSweepableOutputs(
-			ctx, a.walletSvc, a.txBuilder, round.CongestionTree,
-		)
-		if err != nil {
-			return nil, err
+	for _, sweep := range sweeps {
+		round, ok := rounds[sweep.RoundTxid]
+		if !ok {
+			round, err = a.repoManager.Rounds().GetRoundWithTxid(ctx, sweep.RoundTxid)
+			if err != nil {
+				return nil, err
+			}
+			rounds[sweep.RoundTxid] = round
 		}
 
-		sweepableOutputs := make([]SweepableOutput, 0)
-		for expirationTime, inputs := range sweepable {
-			for _, input := range inputs {
+		sweepableOutputs := make([]SweepableOutput, 0, len(sweep.Outputs))
+		amount := sweep.Amount()
+		if sweep.Status == domain.SweepScheduled {
+			congestionTree, err := computeSubTree(round.CongestionTree, sweep.Id)
+			if err != nil {
+				return nil, err
+			}
+			sweepable, err := findSweepableOutputs(
+				ctx, a.walletSvc, a.txBuilder, ports.UnixTime, congestionTree,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			for expirationTime, inputs := range sweepable {
+				for _, input := range inputs {
+					sweepableOutputs = append(sweepableOutputs, SweepableOutput{
+						TxId:        input.GetHash().String(),
+						Vout:        input.GetIndex(),
+						Amount:      input.GetAmount(),
+						ScheduledAt: expirationTime,
+					})
+					amount += input.GetAmount()
+				}
+			}
+		} else {
+			for _, output := range sweep.Outputs {
 				sweepableOutputs = append(sweepableOutputs, SweepableOutput{
-					TxId:        input.GetHash().String(),
-					Vout:        input.GetIndex(),
-					Amount:      input.GetAmount(),
-					ScheduledAt: expirationTime,
+					TxId:        output.Txid,
+					Vout:        output.VOut,
+					Amount:      output.Amount,
+					ScheduledAt: sweep.ScheduledAt,
+					SweepTxid:   output.SweepTxid,
 				})
 			}
 		}
@@ -151,6 +227,11 @@ func (a *adminService) GetScheduledSweeps(ctx context.Context) ([]ScheduledSweep
 		scheduledSweeps = append(scheduledSweeps, ScheduledSweep{
 			RoundId:          round.Id,
 			SweepableOutputs: sweepableOutputs,
+			Status:           string(sweep.Status),
+			ScheduledAt:      sweep.ScheduledAt,
+			AttemptedAt:      sweep.AttemptedAt,
+			Amount:           amount,
+			Error:            sweep.Error,
 		})
 	}
 
@@ -177,3 +258,156 @@ func (a *adminService) GetWalletStatus(ctx context.Context) (*WalletStatus, erro
 		IsSynced:      status.IsSynced(),
 	}, nil
 }
+
+func (a *adminService) ListBans(ctx context.Context) ([]Ban, error) {
+	offenders, err := a.repoManager.Offenders().GetBannedOffenders(
+		ctx, time.Now().Unix(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bans := make([]Ban, 0, len(offenders))
+	for _, offender := range offenders {
+		strikes := make([]Strike, 0, len(offender.Strikes))
+		for _, strike := range offender.Strikes {
+			strikes = append(strikes, Strike{
+				Reason:    string(strike.Reason),
+				RoundId:   strike.RoundId,
+				Timestamp: strike.Timestamp,
+			})
+		}
+		bans = append(bans, Ban{
+			Key:         offender.Key,
+			Strikes:     strikes,
+			BannedUntil: offender.BannedUntil,
+		})
+	}
+	return bans, nil
+}
+
+func (a *adminService) LiftBan(ctx context.Context, key string) error {
+	offender, err := a.repoManager.Offenders().GetOffender(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if err := offender.Lift(); err != nil {
+		return err
+	}
+
+	return a.repoManager.Offenders().AddOrUpdateOffender(ctx, *offender)
+}
+
+func (a *adminService) ListPendingTxs(ctx context.Context) ([]PendingTx, error) {
+	return a.txMonitor.ListPendingTxs(), nil
+}
+
+func (a *adminService) BumpTxFee(
+	ctx context.Context, txid string, feeRate uint64,
+) (string, error) {
+	return a.txMonitor.BumpFee(ctx, txid, feeRate)
+}
+
+func (a *adminService) Backup(ctx context.Context, dir string) error {
+	if err := a.repoManager.Backup(ctx, dir); err != nil {
+		return fmt.Errorf("failed to backup db: %s", err)
+	}
+	if err := a.walletSvc.Backup(ctx, dir); err != nil {
+		return fmt.Errorf("failed to backup wallet: %s", err)
+	}
+	return nil
+}
+
+// GetConnectorsRelease returns the connector utxos that would be released
+// back to the main account by the next release, without releasing them.
+func (a *adminService) GetConnectorsRelease(
+	ctx context.Context,
+) (*ConnectorsRelease, error) {
+	return a.rebalancer.Release(ctx, true)
+}
+
+// GetLiquidityForecast combines the balance of the wallet, the scheduled
+// sweeps and the amount spent by the rounds of the lookback period (in
+// seconds) to forecast the liquidity available until the last sweep.
+func (a *adminService) GetLiquidityForecast(
+	ctx context.Context, alertThreshold uint64, lookback int64,
+) (*LiquidityForecast, error) {
+	if lookback <= 0 {
+		lookback = defaultLiquidityLookback
+	}
+	now := time.Now().Unix()
+
+	mainAvailable, mainLocked, err := a.walletSvc.MainAccountBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	connectorsAvailable, connectorsLocked, err := a.walletSvc.ConnectorsAccountBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sweeps, err := a.GetScheduledSweeps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	locked := uint64(0)
+	sweepsByTime := make(map[int64]uint64)
+	for _, sweep := range sweeps {
+		if sweep.Status == string(domain.SweepCompleted) {
+			continue
+		}
+		for _, output := range sweep.SweepableOutputs {
+			if len(output.SweepTxid) > 0 {
+				continue
+			}
+			sweepsByTime[output.ScheduledAt] += output.Amount
+			locked += output.Amount
+		}
+	}
+
+	roundIds, err := a.repoManager.Rounds().GetRoundsIds(ctx, now-lookback, now)
+	if err != nil {
+		return nil, err
+	}
+	roundsAmount := uint64(0)
+	for _, id := range roundIds {
+		round, err := a.repoManager.Rounds().GetRoundWithId(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if round.IsEnded() {
+			roundsAmount += round.TotalOutputAmount()
+		}
+	}
+
+	vtxos, _, err := a.repoManager.Vtxos().GetAllVtxos(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	liability := uint64(0)
+	for _, vtxo := range vtxos {
+		if !vtxo.Swept {
+			liability += vtxo.Amount
+		}
+	}
+
+	spendingRate := float64(roundsAmount) / float64(lookback)
+	return &LiquidityForecast{
+		MainAccountBalance: Balance{
+			Locked:    mainLocked,
+			Available: mainAvailable,
+		},
+		ConnectorsAccountBalance: Balance{
+			Locked:    connectorsLocked,
+			Available: connectorsAvailable,
+		},
+		VtxosLiability:     liability,
+		RecentRoundsAmount: roundsAmount,
+		Lookback:           lookback,
+		AlertThreshold:     alertThreshold,
+		Timeline: forecastLiquidity(
+			now, mainAvailable, locked, spendingRate, sweepsByTime, alertThreshold,
+		),
+	}, nil
+}
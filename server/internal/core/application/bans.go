@@ -0,0 +1,136 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+// banManager records strikes for the owners and the outpoints of the vtxos
+// involved in round misbehaviours, and bans them once they collect too many.
+// A non-positive threshold disables the strikes.
+type banManager struct {
+	repoManager ports.RepoManager
+	threshold   int
+	duration    int64
+	lock        *sync.Mutex
+}
+
+func newBanManager(
+	repoManager ports.RepoManager, threshold int, duration int64,
+) *banManager {
+	return &banManager{repoManager, threshold, duration, &sync.Mutex{}}
+}
+
+// strikePayments adds a strike for every input, and its owner, of the given
+// payments.
+func (b *banManager) strikePayments(
+	ctx context.Context, reason domain.StrikeReason, roundId string,
+	payments []domain.Payment,
+) {
+	vtxos := make([]domain.Vtxo, 0)
+	for _, payment := range payments {
+		vtxos = append(vtxos, payment.Inputs...)
+	}
+	b.strikeVtxos(ctx, reason, roundId, vtxos)
+}
+
+func (b *banManager) strikeVtxos(
+	ctx context.Context, reason domain.StrikeReason, roundId string,
+	vtxos []domain.Vtxo,
+) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for _, key := range getOffenderKeys(vtxos) {
+		offender, err := b.getOffender(ctx, key)
+		if err != nil {
+			log.WithError(err).Warnf("failed to get offender %s", key)
+			continue
+		}
+		if offender.IsBanned() {
+			continue
+		}
+
+		banned, err := offender.AddStrike(reason, roundId, b.threshold, b.duration)
+		if err != nil {
+			log.WithError(err).Warnf("failed to add strike to offender %s", key)
+			continue
+		}
+
+		if err := b.repoManager.Offenders().AddOrUpdateOffender(
+			ctx, *offender,
+		); err != nil {
+			log.WithError(err).Warnf("failed to store offender %s", key)
+			continue
+		}
+
+		log.Debugf("added strike %s to offender %s", reason, key)
+		if banned {
+			log.Infof(
+				"banned offender %s until %s", key,
+				time.Unix(offender.BannedUntil, 0).Format(time.RFC3339),
+			)
+		}
+	}
+}
+
+// checkVtxos returns an error if the owner or the outpoint of any of the
+// given vtxos is banned.
+func (b *banManager) checkVtxos(ctx context.Context, vtxos []domain.Vtxo) error {
+	for _, key := range getOffenderKeys(vtxos) {
+		offender, err := b.getOffender(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get offender %s: %s", key, err)
+		}
+		if offender.IsBanned() {
+			return fmt.Errorf(
+				"%s is banned until %s", key,
+				time.Unix(offender.BannedUntil, 0).Format(time.RFC3339),
+			)
+		}
+	}
+	return nil
+}
+
+func (b *banManager) getOffender(
+	ctx context.Context, key string,
+) (*domain.Offender, error) {
+	offender, err := b.repoManager.Offenders().GetOffender(ctx, key)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return domain.NewOffender(key), nil
+		}
+		return nil, err
+	}
+	return offender, nil
+}
+
+// getOffenderKeys returns the owner pubkeys and the outpoints of the given
+// vtxos, without duplicates.
+func getOffenderKeys(vtxos []domain.Vtxo) []string {
+	keys := make([]string, 0, len(vtxos)*2)
+	found := make(map[string]struct{})
+	for _, vtxo := range vtxos {
+		for _, key := range []string{
+			vtxo.Pubkey, fmt.Sprintf("%s:%d", vtxo.Txid, vtxo.VOut),
+		} {
+			if _, ok := found[key]; ok {
+				continue
+			}
+			found[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
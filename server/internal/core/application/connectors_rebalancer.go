@@ -0,0 +1,279 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+// ConnectorsRebalancing configures the release of the connector utxos not
+// needed anymore back to the main account.
+type ConnectorsRebalancing struct {
+	// Interval is how often in seconds the connector utxos are released. Zero
+	// disables the automatic releases, leaving only the dry-run view.
+	Interval int64
+	// FeeRate is the fee rate in sats per kvbyte of the release txs. Zero
+	// means the estimated one is used.
+	FeeRate uint64
+	// MaxFeeRate is the max estimated fee rate, in sats per kvbyte, the release
+	// is done at. If higher, the release is postponed to the next interval.
+	MaxFeeRate uint64
+	// MinAmount is the min amount of the releasable connector utxos worth a
+	// release tx.
+	MinAmount uint64
+}
+
+func (r ConnectorsRebalancing) validate() error {
+	if r.Interval < 0 {
+		return fmt.Errorf("invalid interval, must be at least 0")
+	}
+	if r.MaxFeeRate <= 0 {
+		return fmt.Errorf("invalid max fee rate, must be greater than 0")
+	}
+	if r.FeeRate > r.MaxFeeRate {
+		return fmt.Errorf("invalid fee rate, must not be greater than max fee rate")
+	}
+	return nil
+}
+
+// ReleasableConnector is a connector utxo of a settled round.
+type ReleasableConnector struct {
+	Txid      string
+	Vout      uint32
+	Amount    uint64
+	RoundTxid string
+}
+
+// ConnectorsRelease is the outcome of a release of the connector utxos, or
+// the expected one if dry-run.
+type ConnectorsRelease struct {
+	Connectors []ReleasableConnector
+	Amount     uint64
+	FeeRate    uint64
+	// SkipReason explains why the connectors are not released, empty if they
+	// are.
+	SkipReason string
+	// Txid is the txid of the release tx, empty if dry-run or skipped.
+	Txid string
+}
+
+// ConnectorsRebalancer periodically sends back to the main account the
+// connector utxos of the settled rounds, since no forfeit tx can spend them
+// anymore. A round is settled once swept, or once all the vtxos it forfeited
+// are swept, so that none of them can be redeemed onchain.
+type ConnectorsRebalancer interface {
+	Start()
+	Stop()
+	// Release sends the releasable connector utxos back to the main account,
+	// unless dryRun is true, in which case it only returns what it would do.
+	Release(ctx context.Context, dryRun bool) (*ConnectorsRelease, error)
+}
+
+type connectorsRebalancer struct {
+	wallet      ports.WalletService
+	repoManager ports.RepoManager
+	config      ConnectorsRebalancing
+
+	lock *sync.Mutex
+	quit chan struct{}
+}
+
+func NewConnectorsRebalancer(
+	wallet ports.WalletService, repoManager ports.RepoManager,
+	config ConnectorsRebalancing,
+) (ConnectorsRebalancer, error) {
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid connectors rebalancing: %s", err)
+	}
+
+	return &connectorsRebalancer{
+		wallet:      wallet,
+		repoManager: repoManager,
+		config:      config,
+		lock:        &sync.Mutex{},
+		quit:        make(chan struct{}),
+	}, nil
+}
+
+func (r *connectorsRebalancer) Start() {
+	if r.config.Interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(r.config.Interval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.quit:
+				return
+			case <-ticker.C:
+				release, err := r.Release(context.Background(), false)
+				if err != nil {
+					log.WithError(err).Warn("failed to release connector utxos")
+					continue
+				}
+				if len(release.SkipReason) > 0 {
+					log.Debugf("connector utxos not released: %s", release.SkipReason)
+					continue
+				}
+				log.Infof(
+					"released %d connector utxos (%d sats) with tx %s",
+					len(release.Connectors), release.Amount, release.Txid,
+				)
+			}
+		}
+	}()
+}
+
+func (r *connectorsRebalancer) Stop() {
+	close(r.quit)
+}
+
+func (r *connectorsRebalancer) Release(
+	ctx context.Context, dryRun bool,
+) (*ConnectorsRelease, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	connectors, utxos, err := r.getReleasableConnectors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	release := &ConnectorsRelease{
+		Connectors: connectors,
+		FeeRate:    r.config.FeeRate,
+	}
+	for _, connector := range connectors {
+		release.Amount += connector.Amount
+	}
+
+	if len(connectors) <= 0 {
+		release.SkipReason = "no releasable connector utxos"
+		return release, nil
+	}
+	if release.Amount < r.config.MinAmount {
+		release.SkipReason = fmt.Sprintf(
+			"amount below min amount (%d)", r.config.MinAmount,
+		)
+		return release, nil
+	}
+	if release.FeeRate == 0 {
+		feeRate, err := r.wallet.EstimateFeeRate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate fee rate: %s", err)
+		}
+		release.FeeRate = feeRate
+		if feeRate > r.config.MaxFeeRate {
+			release.SkipReason = fmt.Sprintf(
+				"estimated fee rate above max fee rate (%d)", r.config.MaxFeeRate,
+			)
+			return release, nil
+		}
+	}
+
+	if dryRun {
+		return release, nil
+	}
+
+	// the round builder reuses the connector utxos of the swept rounds as
+	// well, they're leased to not be selected while being released.
+	outpoints := make([]ports.TxOutpoint, 0, len(utxos))
+	for _, utxo := range utxos {
+		outpoints = append(outpoints, utxo)
+	}
+	if err := r.wallet.LockConnectorUtxos(ctx, outpoints); err != nil {
+		return nil, fmt.Errorf("failed to lock connector utxos: %s", err)
+	}
+
+	txid, err := r.wallet.ReleaseConnectorUtxos(ctx, utxos, release.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	release.Txid = txid
+	return release, nil
+}
+
+func (r *connectorsRebalancer) getReleasableConnectors(
+	ctx context.Context,
+) ([]ReleasableConnector, []ports.TxInput, error) {
+	sweptRounds, err := r.repoManager.Rounds().GetSweptRounds(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sweepableRounds, err := r.repoManager.Rounds().GetSweepableRounds(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connectors := make([]ReleasableConnector, 0)
+	utxos := make([]ports.TxInput, 0)
+	for _, round := range append(sweptRounds, sweepableRounds...) {
+		if len(round.ConnectorAddress) <= 0 {
+			continue
+		}
+
+		settled, err := r.isSettled(ctx, round)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !settled {
+			continue
+		}
+
+		roundUtxos, err := r.wallet.ListConnectorUtxos(ctx, round.ConnectorAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, utxo := range roundUtxos {
+			connectors = append(connectors, ReleasableConnector{
+				Txid:      utxo.GetTxid(),
+				Vout:      utxo.GetIndex(),
+				Amount:    utxo.GetValue(),
+				RoundTxid: round.Txid,
+			})
+			utxos = append(utxos, utxo)
+		}
+	}
+	return connectors, utxos, nil
+}
+
+// isSettled returns whether none of the forfeit txs of the given round can be
+// broadcasted anymore, ie. the round is swept or all the vtxos it forfeited
+// are.
+func (r *connectorsRebalancer) isSettled(
+	ctx context.Context, round domain.Round,
+) (bool, error) {
+	if round.Swept {
+		return true, nil
+	}
+
+	inputs := make([]domain.VtxoKey, 0)
+	for _, payment := range round.Payments {
+		for _, vtxo := range payment.Inputs {
+			inputs = append(inputs, vtxo.VtxoKey)
+		}
+	}
+	if len(inputs) <= 0 {
+		return true, nil
+	}
+
+	// the vtxos stored in the round may be outdated
+	vtxos, err := r.repoManager.Vtxos().GetVtxos(ctx, inputs)
+	if err != nil {
+		return false, err
+	}
+	for _, vtxo := range vtxos {
+		if !vtxo.Swept {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,235 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sweptRoundTxid     = "0000000000000000000000000000000000000000000000000000000000000011"
+	settledRoundTxid   = "0000000000000000000000000000000000000000000000000000000000000012"
+	unsettledRoundTxid = "0000000000000000000000000000000000000000000000000000000000000013"
+	sweptVtxoTxid      = "0000000000000000000000000000000000000000000000000000000000000014"
+	unsweptVtxoTxid    = "0000000000000000000000000000000000000000000000000000000000000015"
+	releaseTxid        = "0000000000000000000000000000000000000000000000000000000000000016"
+)
+
+func TestConnectorsRebalancer(t *testing.T) {
+	t.Run("dry run", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRebalancerRepoManager(t)
+		defer repoManager.Close()
+
+		wallet := newMockedConnectorsWallet(2000)
+		rebalancer, err := NewConnectorsRebalancer(
+			wallet, repoManager, ConnectorsRebalancing{MaxFeeRate: 10000},
+		)
+		require.NoError(t, err)
+
+		release, err := rebalancer.Release(ctx, true)
+		require.NoError(t, err)
+		require.Empty(t, release.SkipReason)
+		require.Empty(t, release.Txid)
+		require.Equal(t, uint64(2000), release.FeeRate)
+		require.Equal(t, uint64(3000), release.Amount)
+		require.ElementsMatch(t, []ReleasableConnector{
+			{Txid: sweptRoundTxid, Amount: 1000, RoundTxid: sweptRoundTxid},
+			{Txid: settledRoundTxid, Amount: 2000, RoundTxid: settledRoundTxid},
+		}, release.Connectors)
+		require.Empty(t, wallet.released)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRebalancerRepoManager(t)
+		defer repoManager.Close()
+
+		wallet := newMockedConnectorsWallet(2000)
+		rebalancer, err := NewConnectorsRebalancer(
+			wallet, repoManager, ConnectorsRebalancing{
+				FeeRate: 1000, MaxFeeRate: 10000,
+			},
+		)
+		require.NoError(t, err)
+
+		release, err := rebalancer.Release(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, releaseTxid, release.Txid)
+		require.Equal(t, uint64(1000), release.FeeRate)
+		require.Len(t, wallet.locked, 2)
+		require.Len(t, wallet.released, 2)
+		require.Equal(t, uint64(1000), wallet.releaseFeeRate)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRebalancerRepoManager(t)
+		defer repoManager.Close()
+
+		fixtures := []struct {
+			name       string
+			config     ConnectorsRebalancing
+			skipReason string
+		}{
+			{
+				name:       "amount below min",
+				config:     ConnectorsRebalancing{MaxFeeRate: 10000, MinAmount: 5000},
+				skipReason: "amount below min amount (5000)",
+			},
+			{
+				name:       "estimated fee rate above max",
+				config:     ConnectorsRebalancing{MaxFeeRate: 1000},
+				skipReason: "estimated fee rate above max fee rate (1000)",
+			},
+		}
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				wallet := newMockedConnectorsWallet(2000)
+				rebalancer, err := NewConnectorsRebalancer(wallet, repoManager, f.config)
+				require.NoError(t, err)
+
+				release, err := rebalancer.Release(ctx, false)
+				require.NoError(t, err)
+				require.Equal(t, f.skipReason, release.SkipReason)
+				require.Empty(t, release.Txid)
+				require.Empty(t, wallet.locked)
+				require.Empty(t, wallet.released)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fixtures := []struct {
+			config ConnectorsRebalancing
+			err    string
+		}{
+			{
+				config: ConnectorsRebalancing{Interval: -1, MaxFeeRate: 1000},
+				err:    "invalid interval",
+			},
+			{
+				config: ConnectorsRebalancing{},
+				err:    "invalid max fee rate",
+			},
+			{
+				config: ConnectorsRebalancing{FeeRate: 2000, MaxFeeRate: 1000},
+				err:    "invalid fee rate",
+			},
+		}
+		for _, f := range fixtures {
+			rebalancer, err := NewConnectorsRebalancer(nil, nil, f.config)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), f.err)
+			require.Nil(t, rebalancer)
+		}
+	})
+}
+
+// newTestRebalancerRepoManager returns in-memory repositories with 3 ended
+// rounds: a swept one, a settled one, whose forfeited vtxo is swept, and an
+// unsettled one, whose forfeited vtxo is not swept.
+func newTestRebalancerRepoManager(t *testing.T) ports.RepoManager {
+	repoManager := newTestRepoManager(t)
+	ctx := context.Background()
+
+	sweptVtxo := domain.VtxoKey{Txid: sweptVtxoTxid, VOut: 0}
+	unsweptVtxo := domain.VtxoKey{Txid: unsweptVtxoTxid, VOut: 0}
+	require.NoError(t, repoManager.Vtxos().AddVtxos(ctx, []domain.Vtxo{
+		{VtxoKey: sweptVtxo, Receiver: domain.Receiver{Amount: 1000}},
+		{VtxoKey: unsweptVtxo, Receiver: domain.Receiver{Amount: 1000}},
+	}))
+	require.NoError(t, repoManager.Vtxos().SweepVtxos(
+		ctx, []domain.VtxoKey{sweptVtxo},
+	))
+
+	rounds := []domain.Round{
+		{
+			Id:               "swept",
+			Txid:             sweptRoundTxid,
+			ConnectorAddress: sweptRoundTxid,
+			Swept:            true,
+		},
+		{
+			Id:               "settled",
+			Txid:             settledRoundTxid,
+			ConnectorAddress: settledRoundTxid,
+			Payments: map[string]domain.Payment{
+				"payment": {Id: "payment", Inputs: []domain.Vtxo{{VtxoKey: sweptVtxo}}},
+			},
+		},
+		{
+			Id:               "unsettled",
+			Txid:             unsettledRoundTxid,
+			ConnectorAddress: unsettledRoundTxid,
+			Payments: map[string]domain.Payment{
+				"payment": {Id: "payment", Inputs: []domain.Vtxo{{VtxoKey: unsweptVtxo}}},
+			},
+		},
+	}
+	for _, round := range rounds {
+		round.Stage = domain.Stage{Code: domain.FinalizationStage, Ended: true}
+		require.NoError(t, repoManager.Rounds().AddOrUpdateRound(ctx, round))
+	}
+	return repoManager
+}
+
+// mockedConnectorsWallet has a single connector utxo for every round, whose
+// txid is the one of the round, used as connector address as well.
+type mockedConnectorsWallet struct {
+	ports.WalletService
+	feeRate        uint64
+	locked         []ports.TxOutpoint
+	released       []ports.TxInput
+	releaseFeeRate uint64
+}
+
+func newMockedConnectorsWallet(feeRate uint64) *mockedConnectorsWallet {
+	return &mockedConnectorsWallet{feeRate: feeRate}
+}
+
+func (m *mockedConnectorsWallet) ListConnectorUtxos(
+	_ context.Context, connectorAddress string,
+) ([]ports.TxInput, error) {
+	amounts := map[string]uint64{
+		sweptRoundTxid:     1000,
+		settledRoundTxid:   2000,
+		unsettledRoundTxid: 4000,
+	}
+	return []ports.TxInput{
+		mockedTxInput{txid: connectorAddress, value: amounts[connectorAddress]},
+	}, nil
+}
+
+func (m *mockedConnectorsWallet) EstimateFeeRate(_ context.Context) (uint64, error) {
+	return m.feeRate, nil
+}
+
+func (m *mockedConnectorsWallet) LockConnectorUtxos(
+	_ context.Context, utxos []ports.TxOutpoint,
+) error {
+	m.locked = append(m.locked, utxos...)
+	return nil
+}
+
+func (m *mockedConnectorsWallet) ReleaseConnectorUtxos(
+	_ context.Context, utxos []ports.TxInput, feeRate uint64,
+) (string, error) {
+	m.released = append(m.released, utxos...)
+	m.releaseFeeRate = feeRate
+	return releaseTxid, nil
+}
+
+type mockedTxInput struct {
+	txid  string
+	value uint64
+}
+
+func (i mockedTxInput) GetTxid() string   { return i.txid }
+func (i mockedTxInput) GetIndex() uint32  { return 0 }
+func (i mockedTxInput) GetScript() string { return "" }
+func (i mockedTxInput) GetAsset() string  { return "" }
+func (i mockedTxInput) GetValue() uint64  { return i.value }
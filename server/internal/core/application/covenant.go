@@ -13,11 +13,13 @@ import (
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	log "github.com/sirupsen/logrus"
 	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 type covenantService struct {
@@ -27,32 +29,73 @@ type covenantService struct {
 	roundInterval       int64
 	unilateralExitDelay int64
 	minRelayFee         uint64
+	roundTriggerConfig  RoundTrigger
+	paymentSelection    PaymentSelection
+	fees                FeeSchedule
 
 	wallet      ports.WalletService
 	repoManager ports.RepoManager
 	builder     ports.TxBuilder
 	scanner     ports.BlockchainScanner
 	sweeper     *sweeper
+	txMonitor   TxMonitor
+	rebalancer  ConnectorsRebalancer
+	reorgs      *reorgHandler
 
 	paymentRequests *paymentsMap
+	paymentNonces   *paymentNonces
 	forfeitTxs      *forfeitTxsMap
+	bans            *banManager
+	roundTrigger    roundTrigger
+	// interruptedRounds are the rounds failed by a restart, whose users are
+	// notified once listening again.
+	interruptedRounds *interruptedRounds
 
 	eventsCh     chan domain.RoundEvent
 	onboardingCh chan onboarding
 
-	currentRound *domain.Round
+	// currentRound is in registration stage, while finalizingRound is the
+	// previous one, if still being finalized. Registration for the next round
+	// opens as soon as the payments of the current one are selected.
+	currentRound    *domain.Round
+	finalizingRound *domain.Round
+	roundsLock      *sync.RWMutex
+	// finalizationLock makes rounds be finalized one at a time.
+	finalizationLock *sync.Mutex
+	// pauseLock is held for reading by the writers running outside of the
+	// rounds, and for writing while the rounds are paused, see notPaused.
+	pauseLock *sync.RWMutex
 }
 
 func NewCovenantService(
 	network common.Network,
 	roundInterval, roundLifetime, unilateralExitDelay int64, minRelayFee uint64,
-	walletSvc ports.WalletService, repoManager ports.RepoManager,
+	banThreshold int, banDuration int64, roundTriggerConfig RoundTrigger,
+	paymentSelection PaymentSelection, fees FeeSchedule,
+	sweepBatching SweepBatching, walletSvc ports.WalletService, repoManager ports.RepoManager,
 	builder ports.TxBuilder, scanner ports.BlockchainScanner,
-	scheduler ports.SchedulerService,
+	scheduler ports.SchedulerService, txMonitor TxMonitor,
+	rebalancer ConnectorsRebalancer,
 ) (Service, error) {
 	eventsCh := make(chan domain.RoundEvent)
 	onboardingCh := make(chan onboarding)
-	paymentRequests := newPaymentsMap(nil)
+	paymentRequests := newPaymentsMap(repoManager.PaymentRequests())
+
+	roundTrigger, err := newRoundTrigger(
+		roundTriggerConfig, roundInterval, paymentRequests, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid round trigger: %s", err)
+	}
+	if err := paymentSelection.validate(fees); err != nil {
+		return nil, fmt.Errorf("invalid payment selection: %s", err)
+	}
+	if err := fees.validate(); err != nil {
+		return nil, fmt.Errorf("invalid fee schedule: %s", err)
+	}
+	if err := sweepBatching.validate(); err != nil {
+		return nil, fmt.Errorf("invalid sweep batching: %s", err)
+	}
 
 	forfeitTxs := newForfeitTxsMap(builder)
 	pubkey, err := walletSvc.GetPubkey(context.Background())
@@ -60,22 +103,31 @@ func NewCovenantService(
 		return nil, fmt.Errorf("failed to fetch pubkey: %s", err)
 	}
 
-	sweeper := newSweeper(walletSvc, repoManager, builder, scheduler)
+	pauseLock := &sync.RWMutex{}
+	sweeper := newSweeper(
+		walletSvc, repoManager, builder, scheduler, txMonitor, sweepBatching,
+		pauseLock,
+	)
+	reorgs := newReorgHandler(
+		scanner, repoManager, sweeper, txMonitor, roundLifetime, pauseLock,
+	)
+	bans := newBanManager(repoManager, banThreshold, banDuration)
 
 	svc := &covenantService{
 		network, pubkey,
 		roundLifetime, roundInterval, unilateralExitDelay, minRelayFee,
-		walletSvc, repoManager, builder, scanner, sweeper,
-		paymentRequests, forfeitTxs, eventsCh, onboardingCh, nil,
+		roundTriggerConfig, paymentSelection, fees, walletSvc, repoManager, builder, scanner, sweeper, txMonitor,
+		rebalancer, reorgs, paymentRequests, newPaymentNonces(), forfeitTxs, bans, roundTrigger, newInterruptedRounds(), eventsCh, onboardingCh,
+		nil, nil, &sync.RWMutex{}, &sync.Mutex{}, pauseLock,
 	}
 	repoManager.RegisterEventsHandler(
 		func(round *domain.Round) {
 			go svc.propagateEvents(round)
-			go func() {
+			go notPaused(pauseLock, func() {
 				// utxo db must be updated before scheduling the sweep events
 				svc.updateVtxoSet(round)
 				svc.scheduleSweepVtxosForRound(round)
-			}()
+			})()
 		},
 	)
 
@@ -93,13 +145,33 @@ func (s *covenantService) Start() error {
 		return err
 	}
 
+	log.Debug("starting tx monitor")
+	s.txMonitor.Start()
+
+	log.Debug("starting connectors rebalancer")
+	s.rebalancer.Start()
+
+	log.Debug("starting reorg handler")
+	s.reorgs.start()
+
+	log.Debug("restoring round state")
+	if err := s.restoreRoundState(); err != nil {
+		return fmt.Errorf("failed to restore round state: %s", err)
+	}
+
 	log.Debug("starting app service")
-	go s.start()
+	// The first round is opened right away so that there's always a current
+	// round once the service is started.
+	round := s.newRound()
+	go s.waitForFinalization(round)
 	return nil
 }
 
 func (s *covenantService) Stop() {
 	s.sweeper.stop()
+	s.txMonitor.Stop()
+	s.rebalancer.Stop()
+	s.reorgs.stop()
 	// nolint
 	vtxos, _ := s.repoManager.Vtxos().GetAllSweepableVtxos(context.Background())
 	if len(vtxos) > 0 {
@@ -114,7 +186,14 @@ func (s *covenantService) Stop() {
 	close(s.onboardingCh)
 }
 
-func (s *covenantService) SpendVtxos(ctx context.Context, inputs []domain.VtxoKey) (string, error) {
+func (s *covenantService) GetPaymentNonce(ctx context.Context) (string, int64, error) {
+	return s.paymentNonces.new()
+}
+
+func (s *covenantService) SpendVtxos(
+	ctx context.Context, inputs []domain.VtxoKey,
+	signatures map[string]*schnorr.Signature, nonce string,
+) (string, error) {
 	vtxos, err := s.repoManager.Vtxos().GetVtxos(ctx, inputs)
 	if err != nil {
 		return "", err
@@ -125,6 +204,23 @@ func (s *covenantService) SpendVtxos(ctx context.Context, inputs []domain.VtxoKe
 		}
 	}
 
+	if err := s.bans.checkVtxos(ctx, vtxos); err != nil {
+		return "", err
+	}
+
+	buf, err := hex.DecodeString(nonce)
+	if err != nil {
+		return "", fmt.Errorf("invalid nonce: %s", err)
+	}
+	if err := verifyInputsOwnership(inputs, vtxos, signatures, buf); err != nil {
+		return "", err
+	}
+	// the nonce is consumed only by valid registrations, so that it can't be
+	// burnt by someone else.
+	if err := s.paymentNonces.use(nonce); err != nil {
+		return "", err
+	}
+
 	payment, err := domain.NewPayment(vtxos)
 	if err != nil {
 		return "", err
@@ -142,7 +238,18 @@ func (s *covenantService) ClaimVtxos(ctx context.Context, creds string, receiver
 		return fmt.Errorf("invalid credentials")
 	}
 
-	if err := payment.AddReceivers(receivers); err != nil {
+	// The fee is charged for the whole payment, including the receivers of
+	// previous claims, if any.
+	allReceivers := make([]domain.Receiver, 0, len(payment.Receivers)+len(receivers))
+	allReceivers = append(allReceivers, payment.Receivers...)
+	allReceivers = append(allReceivers, receivers...)
+	if err := s.paymentSelection.checkReceivers(allReceivers); err != nil {
+		return err
+	}
+	fee := s.fees.paymentFee(payment.Inputs, allReceivers)
+	if err := payment.AddReceivers(
+		receivers, fee, s.getCurrentRound().DustAmount,
+	); err != nil {
 		return err
 	}
 	return s.paymentRequests.update(*payment)
@@ -152,12 +259,18 @@ func (s *covenantService) UpdatePaymentStatus(_ context.Context, id string) ([]s
 	err := s.paymentRequests.updatePingTimestamp(id)
 	if err != nil {
 		if _, ok := err.(errPaymentNotFound); ok {
-			return s.forfeitTxs.view(), s.currentRound, nil
+			return s.forfeitTxs.view(), s.getFinalizingRound(), nil
 		}
 
 		return nil, nil, err
 	}
 
+	// the user of a payment queued again after its round was interrupted is
+	// listening by now
+	if event, ok := s.interruptedRounds.pop(id); ok {
+		s.eventsCh <- event
+	}
+
 	return nil, nil, nil
 }
 
@@ -169,13 +282,74 @@ func (s *covenantService) CreateAsyncPayment(ctx context.Context, inputs []domai
 	return "", nil, fmt.Errorf("unimplemented")
 }
 
-func (s *covenantService) SignVtxos(ctx context.Context, forfeitTxs []string) error {
-	return s.forfeitTxs.sign(forfeitTxs)
+func (s *covenantService) RegisterCosignerPubkey(ctx context.Context, paymentId string, _ *secp256k1.PublicKey) error {
+	// The congestion tree is not cosigned in covenant mode, drop the payment so
+	// that the user can register the inputs again without the ephemeral key.
+	if err := s.paymentRequests.delete(paymentId); err != nil {
+		log.WithError(err).Warn("failed to delete payment")
+	}
+
+	return fmt.Errorf("ephemeral pubkey not supported in covenant mode")
 }
 
-func (s *covenantService) ListVtxos(ctx context.Context, pubkey *secp256k1.PublicKey) ([]domain.Vtxo, []domain.Vtxo, error) {
-	pk := hex.EncodeToString(pubkey.SerializeCompressed())
-	return s.repoManager.Vtxos().GetAllVtxos(ctx, pk)
+func (s *covenantService) RegisterCosignerNonces(
+	context.Context, string, *secp256k1.PublicKey, string, *schnorr.Signature,
+) error {
+	return fmt.Errorf("unimplemented")
+}
+
+func (s *covenantService) RegisterCosignerSignatures(
+	context.Context, string, *secp256k1.PublicKey, string, *schnorr.Signature,
+) error {
+	return fmt.Errorf("unimplemented")
+}
+
+func (s *covenantService) SignVtxos(
+	ctx context.Context, paymentId string, forfeitTxs []string,
+	signatures map[string]*schnorr.Signature,
+) error {
+	round := s.getFinalizingRound()
+	if round == nil {
+		return fmt.Errorf("no round is being finalized")
+	}
+	payment, ok := round.Payments[paymentId]
+	if !ok {
+		return fmt.Errorf("payment %s not found in round %s", paymentId, round.Id)
+	}
+	if err := verifyPaymentOwners(payment, forfeitTxs, signatures); err != nil {
+		return err
+	}
+
+	invalidTxs, err := s.forfeitTxs.sign(forfeitTxs)
+	if err != nil {
+		return err
+	}
+
+	if len(invalidTxs) > 0 {
+		payments, err := s.getPaymentsOfForfeitTxs(round, invalidTxs)
+		if err != nil {
+			log.WithError(err).Warn("failed to get payments of invalid forfeit txs")
+			return nil
+		}
+		// only the owners of the payment are accountable for its forfeits
+		for _, p := range payments {
+			if p.Id == paymentId {
+				s.bans.strikePayments(
+					ctx, domain.StrikeInvalidSignature, round.Id, []domain.Payment{payment},
+				)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (s *covenantService) ListVtxos(
+	ctx context.Context, pubkey *secp256k1.PublicKey,
+	filter domain.VtxoFilter, page domain.Page,
+) ([]domain.Vtxo, []domain.Vtxo, string, error) {
+	filter.Pubkey = hex.EncodeToString(pubkey.SerializeCompressed())
+	return listVtxos(ctx, s.repoManager, filter, page)
 }
 
 func (s *covenantService) GetEventsChannel(ctx context.Context) <-chan domain.RoundEvent {
@@ -187,13 +361,17 @@ func (s *covenantService) GetRoundByTxid(ctx context.Context, poolTxid string) (
 }
 
 func (s *covenantService) GetCurrentRound(ctx context.Context) (*domain.Round, error) {
-	return domain.NewRoundFromEvents(s.currentRound.Events()), nil
+	return domain.NewRoundFromEvents(s.getCurrentRound().Events()), nil
 }
 
 func (s *covenantService) GetRoundById(ctx context.Context, id string) (*domain.Round, error) {
 	return s.repoManager.Rounds().GetRoundWithId(ctx, id)
 }
 
+func (s *covenantService) PauseRounds(ctx context.Context) (func(), error) {
+	return pauseRounds(ctx, s.finalizationLock, s.pauseLock)
+}
+
 func (s *covenantService) GetInfo(ctx context.Context) (*ServiceInfo, error) {
 	pubkey := hex.EncodeToString(s.pubkey.SerializeCompressed())
 
@@ -204,6 +382,9 @@ func (s *covenantService) GetInfo(ctx context.Context) (*ServiceInfo, error) {
 		RoundInterval:       s.roundInterval,
 		Network:             s.network.Name,
 		MinRelayFee:         int64(s.minRelayFee),
+		RoundTrigger:        s.roundTriggerConfig,
+		Fees:                s.fees,
+		DustAmount:          s.getCurrentRound().DustAmount,
 	}, nil
 }
 
@@ -248,82 +429,247 @@ func (s *covenantService) Onboard(
 	return nil
 }
 
-func (s *covenantService) start() {
-	s.startRound()
+func (s *covenantService) startRound() {
+	s.waitForFinalization(s.newRound())
 }
 
-func (s *covenantService) startRound() {
+// newRound starts the registration stage of a new round and makes it the
+// current one.
+func (s *covenantService) newRound() *domain.Round {
+	dustAmount := estimateDustAmount(context.Background(), s.wallet, s.network)
 	round := domain.NewRound(dustAmount)
 	//nolint:all
 	round.StartRegistration()
-	s.currentRound = round
 
-	defer func() {
-		time.Sleep(time.Duration(s.roundInterval/2) * time.Second)
-		s.startFinalization()
-	}()
+	s.roundsLock.Lock()
+	s.currentRound = round
+	s.roundsLock.Unlock()
 
 	log.Debugf("started registration stage for new round: %s", round.Id)
+	return round
 }
 
-func (s *covenantService) startFinalization() {
+// waitForFinalization starts the finalization of the given round once
+// triggered.
+func (s *covenantService) waitForFinalization(round *domain.Round) {
+	s.roundTrigger.wait()
+	s.startFinalization(round)
+}
+
+func (s *covenantService) startFinalization(round *domain.Round) {
+	// Wait for the previous round, if any, to be finalized.
+	s.finalizationLock.Lock()
+
 	ctx := context.Background()
-	round := s.currentRound
+	numOfEvents := 0
 
 	var roundAborted bool
 	defer func() {
 		if roundAborted {
-			s.startRound()
+			s.endFinalization(round)
 			return
 		}
 
-		if err := s.saveEvents(ctx, round.Id, round.Events()); err != nil {
+		if err := s.saveEvents(ctx, round.Id, round.Events()[numOfEvents:]); err != nil {
 			log.WithError(err).Warn("failed to store new round events")
 		}
 
 		if round.IsFailed() {
-			s.startRound()
+			s.endFinalization(round)
 			return
 		}
 		time.Sleep(time.Duration((s.roundInterval/2)-1) * time.Second)
-		s.finalizeRound()
+		s.finalizeRound(round)
 	}()
 
-	if round.IsFailed() {
-		return
+	if payments := s.paymentRequests.popUnresponsive(); len(payments) > 0 {
+		log.Debugf("dropped %d payments of unresponsive users", len(payments))
+		s.bans.strikePayments(ctx, domain.StrikeMissedPing, round.Id, payments)
 	}
 
-	// TODO: understand how many payments must be popped from the queue and actually registered for the round
-	num := s.paymentRequests.len()
-	if num == 0 {
+	// The congestion tree is not cosigned, payments don't need an ephemeral key.
+	withEphemeralKey := false
+	payments, _, deferredPayments := s.paymentRequests.pop(
+		round.Id, s.paymentSelection, withEphemeralKey,
+	)
+	s.openNextRound(round)
+
+	if len(deferredPayments) > 0 {
+		s.deferPayments(round.Id, deferredPayments)
+	}
+	if len(payments) <= 0 {
 		roundAborted = true
 		err := fmt.Errorf("no payments registered")
 		round.Fail(fmt.Errorf("round aborted: %s", err))
 		log.WithError(err).Debugf("round %s aborted", round.Id)
 		return
 	}
-	if num > paymentsThreshold {
-		num = paymentsThreshold
+
+	payments = s.dropDustPayments(round, payments)
+	if len(payments) <= 0 {
+		roundAborted = true
+		err := fmt.Errorf("no payments above dust registered")
+		round.Fail(fmt.Errorf("round aborted: %s", err))
+		log.WithError(err).Debugf("round %s aborted", round.Id)
+		return
 	}
-	payments := s.paymentRequests.pop(num)
+
 	if _, err := round.RegisterPayments(payments); err != nil {
 		round.Fail(fmt.Errorf("failed to register payments: %s", err))
 		log.WithError(err).Warn("failed to register payments")
 		return
 	}
 
+	// Store the round as soon as payments are registered so that, in case of
+	// restart, it can be marked as failed and its participants notified.
+	if err := s.saveEvents(ctx, round.Id, round.Events()); err != nil {
+		log.WithError(err).Warn("failed to store new round events")
+	} else {
+		numOfEvents = len(round.Events())
+	}
+	s.notifyRegisteredPayments(round.Id, payments)
+
+	if err := s.startFinalizationAttempt(ctx, round, payments); err != nil {
+		round.Fail(err)
+		log.WithError(err).Warn("failed to start finalization")
+		return
+	}
+}
+
+// dropDustPayments returns the given payments without those having any
+// receiver below the dust amount of the round, and lets their users know they
+// are dropped. These may have been claimed while the dust amount was lower.
+func (s *covenantService) dropDustPayments(
+	round *domain.Round, payments []domain.Payment,
+) []domain.Payment {
+	payments, dustPayments := filterDustPayments(payments, round.DustAmount)
+	if len(dustPayments) <= 0 {
+		return payments
+	}
+
+	ids := make([]string, 0, len(dustPayments))
+	for _, payment := range dustPayments {
+		ids = append(ids, payment.Id)
+	}
+
+	log.Warnf(
+		"dropped payments %v: receiver amount below dust %d",
+		ids, round.DustAmount,
+	)
+	s.eventsCh <- domain.PaymentsDropped{
+		Id:         round.Id,
+		PaymentIds: ids,
+	}
+	return payments
+}
+
+// deferPayments notifies the users of the ready payments left out of the
+// given round that they're kept in the queue for the next one.
+func (s *covenantService) deferPayments(roundId string, payments []domain.Payment) {
+	ids := make([]string, 0, len(payments))
+	for _, payment := range payments {
+		ids = append(ids, payment.Id)
+	}
+
+	log.Debugf("deferred %d payments to next round", len(ids))
+	s.eventsCh <- domain.PaymentsDeferred{
+		Id:         roundId,
+		PaymentIds: ids,
+	}
+}
+
+// notifyRegisteredPayments lets the users know the round their payments are
+// part of, since the events of overlapping rounds are sent on the same stream.
+func (s *covenantService) notifyRegisteredPayments(
+	roundId string, payments []domain.Payment,
+) {
+	s.eventsCh <- domain.PaymentsRegistered{
+		Id:       roundId,
+		Payments: payments,
+	}
+}
+
+// openNextRound marks the given round as the one being finalized and starts
+// the registration stage of the next one.
+func (s *covenantService) openNextRound(round *domain.Round) {
+	s.roundsLock.Lock()
+	s.finalizingRound = round
+	s.roundsLock.Unlock()
+
+	go s.startRound()
+}
+
+// endFinalization lets the next round be finalized once the given one ended.
+// The inputs of a failed round are released right away, while those of a
+// finalized one only once spent in the vtxo set.
+func (s *covenantService) endFinalization(round *domain.Round) {
+	if round.IsFailed() {
+		s.paymentRequests.unlockInputs(round.Id)
+	}
+
+	s.roundsLock.Lock()
+	s.finalizingRound = nil
+	s.roundsLock.Unlock()
+
+	s.finalizationLock.Unlock()
+}
+
+func (s *covenantService) getCurrentRound() *domain.Round {
+	s.roundsLock.RLock()
+	defer s.roundsLock.RUnlock()
+	return s.currentRound
+}
+
+func (s *covenantService) getFinalizingRound() *domain.Round {
+	s.roundsLock.RLock()
+	defer s.roundsLock.RUnlock()
+	return s.finalizingRound
+}
+
+// retryFinalization starts a new finalization attempt for the given round
+// with the payments left after dropping those that didn't sign their forfeits.
+func (s *covenantService) retryFinalization(round *domain.Round) {
+	ctx := context.Background()
+	numOfEvents := len(round.Events())
+
+	defer func() {
+		if err := s.saveEvents(ctx, round.Id, round.Events()[numOfEvents:]); err != nil {
+			log.WithError(err).Warn("failed to store new round events")
+		}
+
+		if round.IsFailed() {
+			s.endFinalization(round)
+			return
+		}
+		time.Sleep(time.Duration((s.roundInterval/2)-1) * time.Second)
+		s.finalizeRound(round)
+	}()
+
+	payments := make([]domain.Payment, 0, len(round.Payments))
+	for _, payment := range round.Payments {
+		payments = append(payments, payment)
+	}
+
+	if err := s.startFinalizationAttempt(ctx, round, payments); err != nil {
+		round.Fail(err)
+		log.WithError(err).Warn("failed to retry finalization")
+		return
+	}
+}
+
+// startFinalizationAttempt creates the pool tx and the forfeit txs for the
+// given payments, and moves the round to the finalization stage.
+func (s *covenantService) startFinalizationAttempt(
+	ctx context.Context, round *domain.Round, payments []domain.Payment,
+) error {
 	sweptRounds, err := s.repoManager.Rounds().GetSweptRounds(ctx)
 	if err != nil {
-		round.Fail(fmt.Errorf("failed to retrieve swept rounds: %s", err))
-		log.WithError(err).Warn("failed to retrieve swept rounds")
-		return
+		return fmt.Errorf("failed to retrieve swept rounds: %s", err)
 	}
 
 	unsignedPoolTx, tree, connectorAddress, err := s.builder.BuildPoolTx(s.pubkey, payments, s.minRelayFee, sweptRounds)
 	if err != nil {
-		round.Fail(fmt.Errorf("failed to create pool tx: %s", err))
-		log.WithError(err).Warn("failed to create pool tx")
-		return
+		return fmt.Errorf("failed to create pool tx: %s", err)
 	}
 	log.Debugf("pool tx created for round %s", round.Id)
 
@@ -331,9 +677,7 @@ func (s *covenantService) startFinalization() {
 
 	connectors, forfeitTxs, err := s.builder.BuildForfeitTxs(s.pubkey, unsignedPoolTx, payments, s.minRelayFee)
 	if err != nil {
-		round.Fail(fmt.Errorf("failed to create connectors and forfeit txs: %s", err))
-		log.WithError(err).Warn("failed to create connectors and forfeit txs")
-		return
+		return fmt.Errorf("failed to create connectors and forfeit txs: %s", err)
 	}
 
 	log.Debugf("forfeit transactions created for round %s", round.Id)
@@ -341,21 +685,29 @@ func (s *covenantService) startFinalization() {
 	if _, err := round.StartFinalization(
 		connectorAddress, connectors, tree, unsignedPoolTx,
 	); err != nil {
-		round.Fail(fmt.Errorf("failed to start finalization: %s", err))
-		log.WithError(err).Warn("failed to start finalization")
-		return
+		return fmt.Errorf("failed to start finalization: %s", err)
 	}
 
 	s.forfeitTxs.push(forfeitTxs)
 
-	log.Debugf("started finalization stage for round: %s", round.Id)
+	log.Debugf(
+		"started finalization stage for round: %s (attempt %d)",
+		round.Id, round.Attempt(),
+	)
+	return nil
 }
 
-func (s *covenantService) finalizeRound() {
-	defer s.startRound()
+func (s *covenantService) finalizeRound(round *domain.Round) {
+	var retry bool
+	defer func() {
+		if retry {
+			s.retryFinalization(round)
+			return
+		}
+		s.endFinalization(round)
+	}()
 
 	ctx := context.Background()
-	round := s.currentRound
 	if round.IsFailed() {
 		return
 	}
@@ -370,7 +722,24 @@ func (s *covenantService) finalizeRound() {
 
 	forfeitTxs, leftUnsigned := s.forfeitTxs.pop()
 	if len(leftUnsigned) > 0 {
-		err := fmt.Errorf("%d forfeit txs left to sign", len(leftUnsigned))
+		payments, err := s.getPaymentsOfForfeitTxs(round, leftUnsigned)
+		if err != nil {
+			log.WithError(err).Warn("failed to get payments of unsigned forfeit txs")
+		}
+		s.bans.strikePayments(ctx, domain.StrikeUnsignedForfeit, round.Id, payments)
+
+		err = fmt.Errorf("%d forfeit txs left to sign", len(leftUnsigned))
+
+		if round.Attempt() < maxFinalizationAttempts {
+			changes, retry = s.dropPayments(
+				round, payments, "not signing their forfeit txs",
+			)
+			if retry {
+				log.WithError(err).Warnf("retrying finalization of round %s", round.Id)
+				return
+			}
+		}
+
 		changes = round.Fail(fmt.Errorf("failed to finalize round: %s", err))
 		log.WithError(err).Warn("failed to finalize round")
 		return
@@ -384,21 +753,99 @@ func (s *covenantService) finalizeRound() {
 		return
 	}
 
+	tx, err := transaction.NewTxFromHex(signedPoolTx)
+	if err != nil {
+		changes = round.Fail(fmt.Errorf("failed to parse signed round tx: %s", err))
+		log.WithError(err).Warn("failed to parse signed round tx")
+		return
+	}
+
+	// Store the signed forfeit txs and the pool txid before broadcasting, so
+	// that the round can be ended at restart if the server goes down after
+	// the pool tx has been published.
+	signedEvents, err := round.SignPoolTx(forfeitTxs, tx.TxHash().String())
+	if err != nil {
+		changes = round.Fail(fmt.Errorf("failed to sign round: %s", err))
+		log.WithError(err).Warn("failed to sign round")
+		return
+	}
+	if err := s.saveEvents(ctx, round.Id, signedEvents); err != nil {
+		changes = round.Fail(fmt.Errorf("failed to store signed round: %s", err))
+		log.WithError(err).Warn("failed to store signed round")
+		return
+	}
+
 	txid, err := s.wallet.BroadcastTransaction(ctx, signedPoolTx)
 	if err != nil {
 		changes = round.Fail(fmt.Errorf("failed to broadcast pool tx: %s", err))
 		log.WithError(err).Warn("failed to broadcast pool tx")
 		return
 	}
+	s.txMonitor.Track(TxTypePool, txid, signedPoolTx)
 
 	changes, _ = round.EndFinalization(forfeitTxs, txid)
 
 	log.Debugf("finalized round %s with pool tx %s", round.Id, round.Txid)
 }
 
+// getPaymentsOfForfeitTxs returns the payments of the round whose inputs are
+// spent by the given forfeit txs.
+func (s *covenantService) getPaymentsOfForfeitTxs(
+	round *domain.Round, forfeitTxs []string,
+) ([]domain.Payment, error) {
+	spentVtxos := make([]domain.VtxoKey, 0, len(forfeitTxs))
+	for _, tx := range forfeitTxs {
+		ptx, err := psetv2.NewPsetFromBase64(tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse forfeit tx: %s", err)
+		}
+		for _, input := range ptx.Inputs {
+			spentVtxos = append(spentVtxos, domain.VtxoKey{
+				Txid: chainhash.Hash(input.PreviousTxid).String(),
+				VOut: input.PreviousTxIndex,
+			})
+		}
+	}
+	return getPaymentsSpendingVtxos(round.Payments, spentVtxos), nil
+}
+
+// dropPayments removes the given payments from the round, puts their inputs
+// on cooldown and lets their users know. It returns whether the round can be finalized again
+// with the remaining payments.
+func (s *covenantService) dropPayments(
+	round *domain.Round, payments []domain.Payment, reason string,
+) ([]domain.RoundEvent, bool) {
+	if len(payments) <= 0 || len(payments) >= len(round.Payments) {
+		return nil, false
+	}
+
+	paymentIds := make([]string, 0, len(payments))
+	droppedInputs := make([]domain.VtxoKey, 0)
+	for _, payment := range payments {
+		paymentIds = append(paymentIds, payment.Id)
+		for _, vtxo := range payment.Inputs {
+			droppedInputs = append(droppedInputs, vtxo.VtxoKey)
+		}
+	}
+
+	events, err := round.DropPayments(paymentIds)
+	if err != nil {
+		log.WithError(err).Warn("failed to drop payments")
+		return nil, false
+	}
+
+	s.paymentRequests.cooldown(
+		droppedInputs,
+		time.Duration(s.roundInterval*droppedInputsCooldownRounds)*time.Second,
+	)
+	log.Warnf("dropped payments %v for %s", paymentIds, reason)
+	s.eventsCh <- events[0]
+	return events, true
+}
+
 func (s *covenantService) listenToOnboarding() {
 	for onboarding := range s.onboardingCh {
-		go s.handleOnboarding(onboarding)
+		go notPaused(s.pauseLock, func() { s.handleOnboarding(onboarding) })()
 	}
 }
 
@@ -435,6 +882,7 @@ func (s *covenantService) handleOnboarding(onboarding onboarding) {
 
 	pubkey := hex.EncodeToString(onboarding.userPubkey.SerializeCompressed())
 	payments := getPaymentsFromOnboardingLiquid(onboarding.congestionTree, pubkey)
+	dustAmount := estimateDustAmount(ctx, s.wallet, s.network)
 	round := domain.NewFinalizedRound(
 		dustAmount, pubkey, txid, onboarding.tx, onboarding.congestionTree, payments,
 	)
@@ -450,7 +898,7 @@ func (s *covenantService) listenToScannerNotifications() {
 
 	mutx := &sync.Mutex{}
 	for vtxoKeys := range chVtxos {
-		go func(vtxoKeys map[string]ports.VtxoWithValue) {
+		go notPaused(s.pauseLock, func() {
 			vtxosRepo := s.repoManager.Vtxos()
 			roundRepo := s.repoManager.Rounds()
 
@@ -531,8 +979,9 @@ func (s *covenantService) listenToScannerNotifications() {
 				}
 
 				log.Debugf("broadcasted forfeit tx %s", forfeitTxid)
+				s.txMonitor.Track(TxTypeForfeit, forfeitTxid, forfeitTxHex)
 			}
-		}(vtxoKeys)
+		})()
 	}
 }
 
@@ -601,6 +1050,7 @@ func (s *covenantService) getNextConnector(
 							return "", 0, err
 						}
 						log.Debugf("broadcasted connector tx %s", connectorTxid)
+						s.txMonitor.Track(TxTypeConnector, connectorTxid, signedConnectorTx)
 
 						// wait for the connector tx to be in the mempool
 						if err := s.wallet.WaitForSync(ctx, connectorTxid); err != nil {
@@ -637,6 +1087,9 @@ func (s *covenantService) updateVtxoSet(round *domain.Round) {
 			break
 		}
 	}
+	// Now that the inputs of the round are spent, they can't be registered
+	// anymore.
+	s.paymentRequests.unlockInputs(round.Id)
 
 	newVtxos := s.getNewVtxos(round)
 	if len(newVtxos) > 0 {
@@ -676,8 +1129,14 @@ func (s *covenantService) propagateEvents(round *domain.Round) {
 			Connectors:         e.Connectors,
 			PoolTx:             e.PoolTx,
 			UnsignedForfeitTxs: forfeitTxs,
+			Attempt:            e.Attempt,
 		}
-	case domain.RoundFinalized, domain.RoundFailed:
+	case domain.RoundFailed:
+		if s.interruptedRounds.isInterrupted(e.Id) {
+			return
+		}
+		s.eventsCh <- e
+	case domain.RoundFinalized:
 		s.eventsCh <- e
 	}
 }
@@ -688,12 +1147,10 @@ func (s *covenantService) scheduleSweepVtxosForRound(round *domain.Round) {
 		return
 	}
 
-	expirationTimestamp := time.Now().Add(
-		time.Duration(s.roundLifetime+30) * time.Second,
-	)
+	expirationTimestamp := s.sweeper.nextExpiration(s.roundLifetime)
 
 	if err := s.sweeper.schedule(
-		expirationTimestamp.Unix(), round.Txid, round.CongestionTree,
+		expirationTimestamp, round.Txid, round.CongestionTree,
 	); err != nil {
 		log.WithError(err).Warn("failed to schedule sweep tx")
 	}
@@ -802,6 +1259,92 @@ func (s *covenantService) restoreWatchingVtxos() error {
 	return nil
 }
 
+// restoreRoundState restores the payments queued before a restart and fails
+// the rounds interrupted by it, queueing their payments again. Rounds whose
+// pool tx was already published are ended instead, and those whose pool tx
+// status can't be fetched are left pending and retried later.
+func (s *covenantService) restoreRoundState() error {
+	ctx := context.Background()
+
+	if err := s.paymentRequests.restore(ctx, s.repoManager.Vtxos()); err != nil {
+		return fmt.Errorf("failed to restore payments: %s", err)
+	}
+
+	roundIds, err := s.repoManager.Rounds().GetUnfinishedRoundsIds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get unfinished rounds: %s", err)
+	}
+	for _, id := range roundIds {
+		round, err := s.repoManager.Events().Load(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to load round %s: %s", id, err)
+		}
+		if err := s.restoreInterruptedRound(ctx, round); err != nil {
+			log.WithError(err).Warnf(
+				"failed to restore round %s interrupted by server restart, "+
+					"retrying later", id,
+			)
+			s.paymentRequests.lockInputs(id, getRoundPayments(round))
+			go s.retryInterruptedRound(round)
+		}
+	}
+	return nil
+}
+
+// restoreInterruptedRound ends the given round interrupted by a restart if
+// its pool tx was published, otherwise it fails it and queues its payments
+// again.
+func (s *covenantService) restoreInterruptedRound(
+	ctx context.Context, round *domain.Round,
+) error {
+	changes, ended, err := restoreInterruptedRound(ctx, s.scanner, round)
+	if err != nil {
+		return err
+	}
+	if !ended && len(changes) > 0 {
+		// recorded before storing the failure so that it's not propagated now
+		s.interruptedRounds.add(round, changes[len(changes)-1])
+	}
+	if err := s.saveEvents(ctx, round.Id, changes); err != nil {
+		return fmt.Errorf("failed to store events: %s", err)
+	}
+	s.paymentRequests.unlockInputs(round.Id)
+
+	if ended {
+		log.Infof(
+			"ended round %s interrupted by server restart with pool tx %s",
+			round.Id, round.Txid,
+		)
+		return nil
+	}
+
+	s.paymentRequests.requeue(
+		ctx, s.repoManager.Vtxos(), getRoundPayments(round),
+	)
+	log.Infof(
+		"failed round %s interrupted by server restart, its payments are "+
+			"queued again", round.Id,
+	)
+	return nil
+}
+
+// retryInterruptedRound restores the given round interrupted by a restart
+// every round interval until it succeeds. The inputs of its payments are
+// locked in the meantime.
+func (s *covenantService) retryInterruptedRound(round *domain.Round) {
+	for {
+		time.Sleep(time.Duration(s.roundInterval) * time.Second)
+		err := s.restoreInterruptedRound(context.Background(), round)
+		if err == nil {
+			return
+		}
+		log.WithError(err).Warnf(
+			"failed to restore round %s interrupted by server restart, "+
+				"retrying later", round.Id,
+		)
+	}
+}
+
 func (s *covenantService) extractVtxosScripts(vtxos []domain.Vtxo) ([]string, error) {
 	indexedScripts := make(map[string]struct{})
 	for _, vtxo := range vtxos {
@@ -833,11 +1376,8 @@ func (s *covenantService) saveEvents(
 	if len(events) <= 0 {
 		return nil
 	}
-	round, err := s.repoManager.Events().Save(ctx, id, events...)
-	if err != nil {
-		return err
-	}
-	return s.repoManager.Rounds().AddOrUpdateRound(ctx, *round)
+	_, err := s.repoManager.Events().Save(ctx, id, events...)
+	return err
 }
 
 func getPaymentsFromOnboardingLiquid(
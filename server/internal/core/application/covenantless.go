@@ -14,9 +14,11 @@ import (
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	log "github.com/sirupsen/logrus"
 )
@@ -28,37 +30,85 @@ type covenantlessService struct {
 	roundInterval       int64
 	unilateralExitDelay int64
 	minRelayFee         uint64
+	roundTriggerConfig  RoundTrigger
+	paymentSelection    PaymentSelection
+	fees                FeeSchedule
 
 	wallet      ports.WalletService
 	repoManager ports.RepoManager
 	builder     ports.TxBuilder
 	scanner     ports.BlockchainScanner
 	sweeper     *sweeper
+	txMonitor   TxMonitor
+	rebalancer  ConnectorsRebalancer
+	reorgs      *reorgHandler
 
 	paymentRequests *paymentsMap
+	paymentNonces   *paymentNonces
 	forfeitTxs      *forfeitTxsMap
+	bans            *banManager
+	roundTrigger    roundTrigger
+	// interruptedRounds are the rounds failed by a restart, whose users are
+	// notified once listening again.
+	interruptedRounds *interruptedRounds
 
 	eventsCh     chan domain.RoundEvent
 	onboardingCh chan onboarding
 
-	currentRound *domain.Round
+	// currentRound is in registration stage, while finalizingRound is the
+	// previous one, if still being finalized. Registration for the next round
+	// opens as soon as the payments of the current one are selected.
+	currentRound    *domain.Round
+	finalizingRound *domain.Round
+	roundsLock      *sync.RWMutex
+	// finalizationLock makes rounds be finalized one at a time.
+	finalizationLock *sync.Mutex
+	// pauseLock is held for reading by the writers running outside of the
+	// rounds, and for writing while the rounds are paused, see notPaused.
+	pauseLock *sync.RWMutex
+	// currentRoundCosigners holds the ephemeral keys of the payments of the
+	// round being finalized, required to cosign the tree at every finalization
+	// attempt.
+	currentRoundCosigners map[string]*secp256k1.PublicKey
 
 	asyncPaymentsCache map[domain.VtxoKey]struct {
 		receivers []domain.Receiver
 		expireAt  int64
 	}
+
+	treeSigningSessions     map[string]*treeSigningSession
+	treeSigningSessionsLock *sync.Mutex
 }
 
 func NewCovenantlessService(
 	network common.Network,
 	roundInterval, roundLifetime, unilateralExitDelay int64, minRelayFee uint64,
-	walletSvc ports.WalletService, repoManager ports.RepoManager,
+	banThreshold int, banDuration int64, roundTriggerConfig RoundTrigger,
+	paymentSelection PaymentSelection, fees FeeSchedule,
+	sweepBatching SweepBatching, walletSvc ports.WalletService, repoManager ports.RepoManager,
 	builder ports.TxBuilder, scanner ports.BlockchainScanner,
-	scheduler ports.SchedulerService,
+	scheduler ports.SchedulerService, txMonitor TxMonitor,
+	rebalancer ConnectorsRebalancer,
 ) (Service, error) {
 	eventsCh := make(chan domain.RoundEvent)
 	onboardingCh := make(chan onboarding)
-	paymentRequests := newPaymentsMap(nil)
+	paymentRequests := newPaymentsMap(repoManager.PaymentRequests())
+
+	roundTrigger, err := newRoundTrigger(
+		roundTriggerConfig, roundInterval, paymentRequests, true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid round trigger: %s", err)
+	}
+	if err := paymentSelection.validate(fees); err != nil {
+		return nil, fmt.Errorf("invalid payment selection: %s", err)
+	}
+	if err := fees.validate(); err != nil {
+		return nil, fmt.Errorf("invalid fee schedule: %s", err)
+	}
+	if err := sweepBatching.validate(); err != nil {
+		return nil, fmt.Errorf("invalid sweep batching: %s", err)
+	}
 
 	forfeitTxs := newForfeitTxsMap(builder)
 	pubkey, err := walletSvc.GetPubkey(context.Background())
@@ -66,39 +116,61 @@ func NewCovenantlessService(
 		return nil, fmt.Errorf("failed to fetch pubkey: %s", err)
 	}
 
-	sweeper := newSweeper(walletSvc, repoManager, builder, scheduler)
+	pauseLock := &sync.RWMutex{}
+	sweeper := newSweeper(
+		walletSvc, repoManager, builder, scheduler, txMonitor, sweepBatching,
+		pauseLock,
+	)
+	reorgs := newReorgHandler(
+		scanner, repoManager, sweeper, txMonitor, roundLifetime, pauseLock,
+	)
 	asyncPaymentsCache := make(map[domain.VtxoKey]struct {
 		receivers []domain.Receiver
 		expireAt  int64
 	})
 
 	svc := &covenantlessService{
-		network:             network,
-		pubkey:              pubkey,
-		roundLifetime:       roundLifetime,
-		roundInterval:       roundInterval,
-		unilateralExitDelay: unilateralExitDelay,
-		minRelayFee:         minRelayFee,
-		wallet:              walletSvc,
-		repoManager:         repoManager,
-		builder:             builder,
-		scanner:             scanner,
-		sweeper:             sweeper,
-		paymentRequests:     paymentRequests,
-		forfeitTxs:          forfeitTxs,
-		eventsCh:            eventsCh,
-		onboardingCh:        onboardingCh,
-		asyncPaymentsCache:  asyncPaymentsCache,
+		network:                 network,
+		pubkey:                  pubkey,
+		roundLifetime:           roundLifetime,
+		roundInterval:           roundInterval,
+		unilateralExitDelay:     unilateralExitDelay,
+		minRelayFee:             minRelayFee,
+		wallet:                  walletSvc,
+		repoManager:             repoManager,
+		builder:                 builder,
+		scanner:                 scanner,
+		sweeper:                 sweeper,
+		txMonitor:               txMonitor,
+		rebalancer:              rebalancer,
+		reorgs:                  reorgs,
+		paymentRequests:         paymentRequests,
+		paymentNonces:           newPaymentNonces(),
+		forfeitTxs:              forfeitTxs,
+		roundTriggerConfig:      roundTriggerConfig,
+		paymentSelection:        paymentSelection,
+		fees:                    fees,
+		bans:                    newBanManager(repoManager, banThreshold, banDuration),
+		roundTrigger:            roundTrigger,
+		interruptedRounds:       newInterruptedRounds(),
+		eventsCh:                eventsCh,
+		onboardingCh:            onboardingCh,
+		asyncPaymentsCache:      asyncPaymentsCache,
+		treeSigningSessions:     make(map[string]*treeSigningSession),
+		treeSigningSessionsLock: &sync.Mutex{},
+		roundsLock:              &sync.RWMutex{},
+		finalizationLock:        &sync.Mutex{},
+		pauseLock:               pauseLock,
 	}
 
 	repoManager.RegisterEventsHandler(
 		func(round *domain.Round) {
 			go svc.propagateEvents(round)
-			go func() {
+			go notPaused(pauseLock, func() {
 				// utxo db must be updated before scheduling the sweep events
 				svc.updateVtxoSet(round)
 				svc.scheduleSweepVtxosForRound(round)
-			}()
+			})()
 		},
 	)
 
@@ -116,13 +188,33 @@ func (s *covenantlessService) Start() error {
 		return err
 	}
 
+	log.Debug("starting tx monitor")
+	s.txMonitor.Start()
+
+	log.Debug("starting connectors rebalancer")
+	s.rebalancer.Start()
+
+	log.Debug("starting reorg handler")
+	s.reorgs.start()
+
+	log.Debug("restoring round state")
+	if err := s.restoreRoundState(); err != nil {
+		return fmt.Errorf("failed to restore round state: %s", err)
+	}
+
 	log.Debug("starting app service")
-	go s.start()
+	// The first round is opened right away so that there's always a current
+	// round once the service is started.
+	round := s.newRound()
+	go s.waitForFinalization(round)
 	return nil
 }
 
 func (s *covenantlessService) Stop() {
 	s.sweeper.stop()
+	s.txMonitor.Stop()
+	s.rebalancer.Stop()
+	s.reorgs.stop()
 	// nolint
 	vtxos, _ := s.repoManager.Vtxos().GetAllSweepableVtxos(context.Background())
 	if len(vtxos) > 0 {
@@ -140,6 +232,9 @@ func (s *covenantlessService) Stop() {
 func (s *covenantlessService) CompleteAsyncPayment(
 	ctx context.Context, redeemTx string, unconditionalForfeitTxs []string,
 ) error {
+	s.pauseLock.RLock()
+	defer s.pauseLock.RUnlock()
+
 	// TODO check that the user signed both transactions
 
 	redeemPtx, err := psbt.NewFromRawBytes(strings.NewReader(redeemTx), true)
@@ -188,6 +283,11 @@ func (s *covenantlessService) CompleteAsyncPayment(
 	log.Infof("spent %d vtxos", len(spentVtxos))
 
 	delete(s.asyncPaymentsCache, spentVtxos[0])
+	if err := s.repoManager.PaymentRequests().DeleteAsyncPaymentRequest(
+		ctx, spentVtxos[0],
+	); err != nil {
+		log.WithError(err).Warn("failed to delete stored async payment")
+	}
 
 	return nil
 }
@@ -195,6 +295,9 @@ func (s *covenantlessService) CompleteAsyncPayment(
 func (s *covenantlessService) CreateAsyncPayment(
 	ctx context.Context, inputs []domain.VtxoKey, receivers []domain.Receiver,
 ) (string, []string, error) {
+	s.pauseLock.RLock()
+	defer s.pauseLock.RUnlock()
+
 	vtxos, err := s.repoManager.Vtxos().GetVtxos(ctx, inputs)
 	if err != nil {
 		return "", nil, err
@@ -221,6 +324,20 @@ func (s *covenantlessService) CreateAsyncPayment(
 		}
 	}
 
+	// The service fee is the part of the input amount not assigned to any of
+	// the receivers.
+	fee := s.fees.asyncPaymentFee(vtxos)
+	outAmount := uint64(0)
+	for _, receiver := range receivers {
+		outAmount += receiver.Amount
+	}
+	if sumOfVtxos(vtxos) != outAmount+fee {
+		return "", nil, fmt.Errorf(
+			"input and output amounts mismatch, expected service fee of %d sats",
+			fee,
+		)
+	}
+
 	res, err := s.builder.BuildAsyncPaymentTransactions(
 		vtxos, s.pubkey, receivers, s.minRelayFee,
 	)
@@ -228,6 +345,16 @@ func (s *covenantlessService) CreateAsyncPayment(
 		return "", nil, fmt.Errorf("failed to build async payment txs: %s", err)
 	}
 
+	if err := s.repoManager.PaymentRequests().AddAsyncPaymentRequest(
+		ctx, domain.AsyncPaymentRequest{
+			VtxoKey:   inputs[0],
+			Receivers: receivers,
+			ExpireAt:  expiration,
+		},
+	); err != nil {
+		return "", nil, fmt.Errorf("failed to store async payment: %s", err)
+	}
+
 	s.asyncPaymentsCache[inputs[0]] = struct {
 		receivers []domain.Receiver
 		expireAt  int64
@@ -239,7 +366,14 @@ func (s *covenantlessService) CreateAsyncPayment(
 	return res.RedeemTx, res.UnconditionalForfeitTxs, nil
 }
 
-func (s *covenantlessService) SpendVtxos(ctx context.Context, inputs []domain.VtxoKey) (string, error) {
+func (s *covenantlessService) GetPaymentNonce(ctx context.Context) (string, int64, error) {
+	return s.paymentNonces.new()
+}
+
+func (s *covenantlessService) SpendVtxos(
+	ctx context.Context, inputs []domain.VtxoKey,
+	signatures map[string]*schnorr.Signature, nonce string,
+) (string, error) {
 	vtxos, err := s.repoManager.Vtxos().GetVtxos(ctx, inputs)
 	if err != nil {
 		return "", err
@@ -250,6 +384,23 @@ func (s *covenantlessService) SpendVtxos(ctx context.Context, inputs []domain.Vt
 		}
 	}
 
+	if err := s.bans.checkVtxos(ctx, vtxos); err != nil {
+		return "", err
+	}
+
+	buf, err := hex.DecodeString(nonce)
+	if err != nil {
+		return "", fmt.Errorf("invalid nonce: %s", err)
+	}
+	if err := verifyInputsOwnership(inputs, vtxos, signatures, buf); err != nil {
+		return "", err
+	}
+	// the nonce is consumed only by valid registrations, so that it can't be
+	// burnt by someone else.
+	if err := s.paymentNonces.use(nonce); err != nil {
+		return "", err
+	}
+
 	payment, err := domain.NewPayment(vtxos)
 	if err != nil {
 		return "", err
@@ -267,7 +418,18 @@ func (s *covenantlessService) ClaimVtxos(ctx context.Context, creds string, rece
 		return fmt.Errorf("invalid credentials")
 	}
 
-	if err := payment.AddReceivers(receivers); err != nil {
+	// The fee is charged for the whole payment, including the receivers of
+	// previous claims, if any.
+	allReceivers := make([]domain.Receiver, 0, len(payment.Receivers)+len(receivers))
+	allReceivers = append(allReceivers, payment.Receivers...)
+	allReceivers = append(allReceivers, receivers...)
+	if err := s.paymentSelection.checkReceivers(allReceivers); err != nil {
+		return err
+	}
+	fee := s.fees.paymentFee(payment.Inputs, allReceivers)
+	if err := payment.AddReceivers(
+		receivers, fee, s.getCurrentRound().DustAmount,
+	); err != nil {
 		return err
 	}
 	return s.paymentRequests.update(*payment)
@@ -277,22 +439,121 @@ func (s *covenantlessService) UpdatePaymentStatus(_ context.Context, id string)
 	err := s.paymentRequests.updatePingTimestamp(id)
 	if err != nil {
 		if _, ok := err.(errPaymentNotFound); ok {
-			return s.forfeitTxs.view(), s.currentRound, nil
+			return s.forfeitTxs.view(), s.getFinalizingRound(), nil
 		}
 
 		return nil, nil, err
 	}
 
+	// the user of a payment queued again after its round was interrupted is
+	// listening by now
+	if event, ok := s.interruptedRounds.pop(id); ok {
+		s.eventsCh <- event
+	}
+
 	return nil, nil, nil
 }
 
-func (s *covenantlessService) SignVtxos(ctx context.Context, forfeitTxs []string) error {
-	return s.forfeitTxs.sign(forfeitTxs)
+func (s *covenantlessService) SignVtxos(
+	ctx context.Context, paymentId string, forfeitTxs []string,
+	signatures map[string]*schnorr.Signature,
+) error {
+	round := s.getFinalizingRound()
+	if round == nil {
+		return fmt.Errorf("no round is being finalized")
+	}
+	payment, ok := round.Payments[paymentId]
+	if !ok {
+		return fmt.Errorf("payment %s not found in round %s", paymentId, round.Id)
+	}
+	if err := verifyPaymentOwners(payment, forfeitTxs, signatures); err != nil {
+		return err
+	}
+
+	invalidTxs, err := s.forfeitTxs.sign(forfeitTxs)
+	if err != nil {
+		return err
+	}
+
+	if len(invalidTxs) > 0 {
+		payments, err := s.getPaymentsOfForfeitTxs(round, invalidTxs)
+		if err != nil {
+			log.WithError(err).Warn("failed to get payments of invalid forfeit txs")
+			return nil
+		}
+		// only the owners of the payment are accountable for its forfeits
+		for _, p := range payments {
+			if p.Id == paymentId {
+				s.bans.strikePayments(
+					ctx, domain.StrikeInvalidSignature, round.Id, []domain.Payment{payment},
+				)
+				break
+			}
+		}
+	}
+	return nil
 }
 
-func (s *covenantlessService) ListVtxos(ctx context.Context, pubkey *secp256k1.PublicKey) ([]domain.Vtxo, []domain.Vtxo, error) {
-	pk := hex.EncodeToString(pubkey.SerializeCompressed())
-	return s.repoManager.Vtxos().GetAllVtxos(ctx, pk)
+func (s *covenantlessService) RegisterCosignerPubkey(
+	ctx context.Context, paymentId string, pubkey *secp256k1.PublicKey,
+) error {
+	return s.paymentRequests.pushEphemeralKey(paymentId, pubkey)
+}
+
+func (s *covenantlessService) RegisterCosignerNonces(
+	ctx context.Context, roundId string,
+	pubkey *secp256k1.PublicKey, encodedNonces string, signature *schnorr.Signature,
+) error {
+	session, ok := s.getTreeSigningSession(roundId)
+	if !ok {
+		return fmt.Errorf("signing session not found for round %s", roundId)
+	}
+
+	buf, err := hex.DecodeString(encodedNonces)
+	if err != nil {
+		return fmt.Errorf("failed to decode nonces: %s", err)
+	}
+	if signature == nil || !signature.Verify(common.CosignerNoncesHash(roundId, buf), pubkey) {
+		return fmt.Errorf("invalid signature of the cosigner nonces")
+	}
+	nonces, err := bitcointree.DecodeNonces(bytes.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("failed to decode nonces: %s", err)
+	}
+
+	return session.addNonces(pubkey, nonces)
+}
+
+func (s *covenantlessService) RegisterCosignerSignatures(
+	ctx context.Context, roundId string,
+	pubkey *secp256k1.PublicKey, encodedSignatures string, signature *schnorr.Signature,
+) error {
+	session, ok := s.getTreeSigningSession(roundId)
+	if !ok {
+		return fmt.Errorf("signing session not found for round %s", roundId)
+	}
+
+	buf, err := hex.DecodeString(encodedSignatures)
+	if err != nil {
+		return fmt.Errorf("failed to decode signatures: %s", err)
+	}
+	if signature == nil || !signature.Verify(common.CosignerSignaturesHash(roundId, buf), pubkey) {
+		return fmt.Errorf("invalid signature of the cosigner signatures")
+	}
+	signatures, err := bitcointree.DecodeSignatures(bytes.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("failed to decode signatures: %s", err)
+	}
+
+	return session.addSignatures(pubkey, signatures)
+}
+
+func (s *covenantlessService) ListVtxos(
+	ctx context.Context, pubkey *secp256k1.PublicKey,
+	filter domain.VtxoFilter, page domain.Page,
+) ([]domain.Vtxo, []domain.Vtxo, string, error) {
+	filter.Pubkey = hex.EncodeToString(pubkey.SerializeCompressed())
+	return listVtxos(ctx, s.repoManager, filter, page)
 }
 
 func (s *covenantlessService) GetEventsChannel(ctx context.Context) <-chan domain.RoundEvent {
@@ -308,7 +569,11 @@ func (s *covenantlessService) GetRoundById(ctx context.Context, id string) (*dom
 }
 
 func (s *covenantlessService) GetCurrentRound(ctx context.Context) (*domain.Round, error) {
-	return domain.NewRoundFromEvents(s.currentRound.Events()), nil
+	return domain.NewRoundFromEvents(s.getCurrentRound().Events()), nil
+}
+
+func (s *covenantlessService) PauseRounds(ctx context.Context) (func(), error) {
+	return pauseRounds(ctx, s.finalizationLock, s.pauseLock)
 }
 
 func (s *covenantlessService) GetInfo(ctx context.Context) (*ServiceInfo, error) {
@@ -321,6 +586,9 @@ func (s *covenantlessService) GetInfo(ctx context.Context) (*ServiceInfo, error)
 		RoundInterval:       s.roundInterval,
 		Network:             s.network.Name,
 		MinRelayFee:         int64(s.minRelayFee),
+		RoundTrigger:        s.roundTriggerConfig,
+		Fees:                s.fees,
+		DustAmount:          s.getCurrentRound().DustAmount,
 	}, nil
 }
 
@@ -367,190 +635,286 @@ func (s *covenantlessService) Onboard(
 	return nil
 }
 
-func (s *covenantlessService) start() {
-	s.startRound()
+func (s *covenantlessService) startRound() {
+	s.waitForFinalization(s.newRound())
 }
 
-func (s *covenantlessService) startRound() {
-	round := domain.NewRound(dustAmount) // TODO dynamic dust amount?
+// newRound starts the registration stage of a new round and makes it the
+// current one.
+func (s *covenantlessService) newRound() *domain.Round {
+	dustAmount := estimateDustAmount(context.Background(), s.wallet, s.network)
+	round := domain.NewRound(dustAmount)
 	//nolint:all
 	round.StartRegistration()
-	s.currentRound = round
 
-	defer func() {
-		time.Sleep(time.Duration(s.roundInterval/2) * time.Second)
-		s.startFinalization()
-	}()
+	s.roundsLock.Lock()
+	s.currentRound = round
+	s.roundsLock.Unlock()
 
 	log.Debugf("started registration stage for new round: %s", round.Id)
+	return round
+}
+
+// waitForFinalization starts the finalization of the given round once
+// triggered.
+func (s *covenantlessService) waitForFinalization(round *domain.Round) {
+	s.roundTrigger.wait()
+	s.startFinalization(round)
 }
 
-func (s *covenantlessService) startFinalization() {
+func (s *covenantlessService) startFinalization(round *domain.Round) {
+	// Wait for the previous round, if any, to be finalized.
+	s.finalizationLock.Lock()
+
 	ctx := context.Background()
-	round := s.currentRound
+	numOfEvents := 0
 
 	var roundAborted bool
 	defer func() {
 		if roundAborted {
-			s.startRound()
+			s.endFinalization(round)
 			return
 		}
 
-		if err := s.saveEvents(ctx, round.Id, round.Events()); err != nil {
+		if err := s.saveEvents(ctx, round.Id, round.Events()[numOfEvents:]); err != nil {
 			log.WithError(err).Warn("failed to store new round events")
 		}
 
 		if round.IsFailed() {
-			s.startRound()
+			s.endFinalization(round)
 			return
 		}
 		time.Sleep(time.Duration((s.roundInterval/2)-1) * time.Second)
-		s.finalizeRound()
+		s.finalizeRound(round)
 	}()
 
-	if round.IsFailed() {
-		return
+	if payments := s.paymentRequests.popUnresponsive(); len(payments) > 0 {
+		log.Debugf("dropped %d payments of unresponsive users", len(payments))
+		s.bans.strikePayments(ctx, domain.StrikeMissedPing, round.Id, payments)
 	}
 
-	// TODO: understand how many payments must be popped from the queue and actually registered for the round
-	num := s.paymentRequests.len()
-	if num == 0 {
+	// Every participant must cosign the congestion tree, therefore payments
+	// registered without an ephemeral key are left in the queue.
+	withEphemeralKey := true
+	payments, ephemeralKeys, deferredPayments := s.paymentRequests.pop(
+		round.Id, s.paymentSelection, withEphemeralKey,
+	)
+	s.openNextRound(round)
+
+	if len(deferredPayments) > 0 {
+		s.deferPayments(round.Id, deferredPayments)
+	}
+	if len(payments) <= 0 {
 		roundAborted = true
 		err := fmt.Errorf("no payments registered")
 		round.Fail(fmt.Errorf("round aborted: %s", err))
 		log.WithError(err).Debugf("round %s aborted", round.Id)
 		return
 	}
-	if num > paymentsThreshold {
-		num = paymentsThreshold
+
+	payments = s.dropDustPayments(round, payments)
+	if len(payments) <= 0 {
+		roundAborted = true
+		err := fmt.Errorf("no payments above dust registered")
+		round.Fail(fmt.Errorf("round aborted: %s", err))
+		log.WithError(err).Debugf("round %s aborted", round.Id)
+		return
 	}
-	payments := s.paymentRequests.pop(num)
+
+	cosigners := make(map[string]*secp256k1.PublicKey)
+	for _, payment := range payments {
+		cosigners[payment.Id] = ephemeralKeys[payment.Id]
+	}
+
 	if _, err := round.RegisterPayments(payments); err != nil {
 		round.Fail(fmt.Errorf("failed to register payments: %s", err))
 		log.WithError(err).Warn("failed to register payments")
 		return
 	}
 
-	sweptRounds, err := s.repoManager.Rounds().GetSweptRounds(ctx)
-	if err != nil {
-		round.Fail(fmt.Errorf("failed to retrieve swept rounds: %s", err))
-		log.WithError(err).Warn("failed to retrieve swept rounds")
+	// Store the round as soon as payments are registered so that, in case of
+	// restart, it can be marked as failed and its participants notified.
+	if err := s.saveEvents(ctx, round.Id, round.Events()); err != nil {
+		log.WithError(err).Warn("failed to store new round events")
+	} else {
+		numOfEvents = len(round.Events())
+	}
+	s.notifyRegisteredPayments(round.Id, payments)
+	s.currentRoundCosigners = cosigners
+
+	if err := s.startFinalizationAttempt(ctx, round, payments); err != nil {
+		round.Fail(err)
+		log.WithError(err).Warn("failed to start finalization")
 		return
 	}
+}
 
-	cosigners := make([]*secp256k1.PrivateKey, 0)
-	cosignersPubKeys := make([]*secp256k1.PublicKey, 0, len(cosigners))
-	for range payments {
-		// TODO sender should provide the ephemeral *public* key
-		ephemeralKey, err := secp256k1.GeneratePrivateKey()
-		if err != nil {
-			round.Fail(fmt.Errorf("failed to generate ephemeral key: %s", err))
-			log.WithError(err).Warn("failed to generate ephemeral key")
-			return
-		}
+// dropDustPayments returns the given payments without those having any
+// receiver below the dust amount of the round, and lets their users know they
+// are dropped. These may have been claimed while the dust amount was lower.
+func (s *covenantlessService) dropDustPayments(
+	round *domain.Round, payments []domain.Payment,
+) []domain.Payment {
+	payments, dustPayments := filterDustPayments(payments, round.DustAmount)
+	if len(dustPayments) <= 0 {
+		return payments
+	}
 
-		cosigners = append(cosigners, ephemeralKey)
-		cosignersPubKeys = append(cosignersPubKeys, ephemeralKey.PubKey())
+	ids := make([]string, 0, len(dustPayments))
+	for _, payment := range dustPayments {
+		ids = append(ids, payment.Id)
 	}
 
-	aspSigningKey, err := secp256k1.GeneratePrivateKey()
-	if err != nil {
-		round.Fail(fmt.Errorf("failed to generate asp signing key: %s", err))
-		log.WithError(err).Warn("failed to generate asp signing key")
-		return
+	log.Warnf(
+		"dropped payments %v: receiver amount below dust %d",
+		ids, round.DustAmount,
+	)
+	s.eventsCh <- domain.PaymentsDropped{
+		Id:         round.Id,
+		PaymentIds: ids,
 	}
+	return payments
+}
 
-	cosigners = append(cosigners, aspSigningKey)
-	cosignersPubKeys = append(cosignersPubKeys, aspSigningKey.PubKey())
+// deferPayments notifies the users of the ready payments left out of the
+// given round that they're kept in the queue for the next one.
+func (s *covenantlessService) deferPayments(roundId string, payments []domain.Payment) {
+	ids := make([]string, 0, len(payments))
+	for _, payment := range payments {
+		ids = append(ids, payment.Id)
+	}
 
-	unsignedPoolTx, tree, connectorAddress, err := s.builder.BuildPoolTx(s.pubkey, payments, s.minRelayFee, sweptRounds, cosignersPubKeys...)
-	if err != nil {
-		round.Fail(fmt.Errorf("failed to create pool tx: %s", err))
-		log.WithError(err).Warn("failed to create pool tx")
-		return
+	log.Debugf("deferred %d payments to next round", len(ids))
+	s.eventsCh <- domain.PaymentsDeferred{
+		Id:         roundId,
+		PaymentIds: ids,
 	}
-	log.Debugf("pool tx created for round %s", round.Id)
+}
 
-	if len(tree) > 0 {
-		sweepClosure := bitcointree.CSVSigClosure{
-			Pubkey:  s.pubkey,
-			Seconds: uint(s.roundLifetime),
-		}
+// notifyRegisteredPayments lets the users know the round their payments are
+// part of, since the events of overlapping rounds are sent on the same stream.
+func (s *covenantlessService) notifyRegisteredPayments(
+	roundId string, payments []domain.Payment,
+) {
+	s.eventsCh <- domain.PaymentsRegistered{
+		Id:       roundId,
+		Payments: payments,
+	}
+}
 
-		sweepTapLeaf, err := sweepClosure.Leaf()
-		if err != nil {
-			return
-		}
+// openNextRound marks the given round as the one being finalized and starts
+// the registration stage of the next one.
+func (s *covenantlessService) openNextRound(round *domain.Round) {
+	s.roundsLock.Lock()
+	s.finalizingRound = round
+	s.roundsLock.Unlock()
 
-		sweepTapTree := txscript.AssembleTaprootScriptTree(*sweepTapLeaf)
-		root := sweepTapTree.RootNode.TapHash()
+	go s.startRound()
+}
 
-		coordinator, err := s.createTreeCoordinatorSession(tree, cosignersPubKeys, root)
-		if err != nil {
-			round.Fail(fmt.Errorf("failed to create tree coordinator: %s", err))
-			log.WithError(err).Warn("failed to create tree coordinator")
-			return
-		}
+// endFinalization lets the next round be finalized once the given one ended.
+// The inputs of a failed round are released right away, while those of a
+// finalized one only once spent in the vtxo set.
+func (s *covenantlessService) endFinalization(round *domain.Round) {
+	if round.IsFailed() {
+		s.paymentRequests.unlockInputs(round.Id)
+	}
 
-		signers := make([]bitcointree.SignerSession, 0)
+	s.roundsLock.Lock()
+	s.finalizingRound = nil
+	s.roundsLock.Unlock()
 
-		for _, seckey := range cosigners {
-			signer := bitcointree.NewTreeSignerSession(
-				seckey, tree, int64(s.minRelayFee), root.CloneBytes(),
-			)
+	s.finalizationLock.Unlock()
+}
 
-			// TODO nonces should be sent by the sender
-			nonces, err := signer.GetNonces()
-			if err != nil {
-				round.Fail(fmt.Errorf("failed to get nonces: %s", err))
-				log.WithError(err).Warn("failed to get nonces")
-				return
-			}
+func (s *covenantlessService) getCurrentRound() *domain.Round {
+	s.roundsLock.RLock()
+	defer s.roundsLock.RUnlock()
+	return s.currentRound
+}
 
-			if err := coordinator.AddNonce(seckey.PubKey(), nonces); err != nil {
-				round.Fail(fmt.Errorf("failed to add nonce: %s", err))
-				log.WithError(err).Warn("failed to add nonce")
-				return
-			}
+func (s *covenantlessService) getFinalizingRound() *domain.Round {
+	s.roundsLock.RLock()
+	defer s.roundsLock.RUnlock()
+	return s.finalizingRound
+}
+
+// retryFinalization starts a new finalization attempt for the given round
+// with the payments left after dropping those that didn't sign their forfeits.
+func (s *covenantlessService) retryFinalization(round *domain.Round) {
+	ctx := context.Background()
+	numOfEvents := len(round.Events())
 
-			signers = append(signers, signer)
+	defer func() {
+		if err := s.saveEvents(ctx, round.Id, round.Events()[numOfEvents:]); err != nil {
+			log.WithError(err).Warn("failed to store new round events")
 		}
 
-		aggragatedNonces, err := coordinator.AggregateNonces()
-		if err != nil {
-			round.Fail(fmt.Errorf("failed to aggregate nonces: %s", err))
-			log.WithError(err).Warn("failed to aggregate nonces")
+		if round.IsFailed() {
+			s.endFinalization(round)
 			return
 		}
+		time.Sleep(time.Duration((s.roundInterval/2)-1) * time.Second)
+		s.finalizeRound(round)
+	}()
 
-		// TODO aggragated nonces and public keys should be sent back to signer
-		// TODO signing should be done client-side (except for the ASP)
-		for i, signer := range signers {
-			if err := signer.SetKeys(cosignersPubKeys, aggragatedNonces); err != nil {
-				round.Fail(fmt.Errorf("failed to set keys: %s", err))
-				log.WithError(err).Warn("failed to set keys")
-				return
-			}
+	payments := make([]domain.Payment, 0, len(round.Payments))
+	for _, payment := range round.Payments {
+		payments = append(payments, payment)
+	}
 
-			sig, err := signer.Sign()
-			if err != nil {
-				round.Fail(fmt.Errorf("failed to sign: %s", err))
-				log.WithError(err).Warn("failed to sign")
-				return
-			}
+	if err := s.startFinalizationAttempt(ctx, round, payments); err != nil {
+		round.Fail(err)
+		log.WithError(err).Warn("failed to retry finalization")
+		return
+	}
+}
 
-			if err := coordinator.AddSig(cosignersPubKeys[i], sig); err != nil {
-				round.Fail(fmt.Errorf("failed to add sig: %s", err))
-				log.WithError(err).Warn("failed to add sig")
-				return
-			}
-		}
+// startFinalizationAttempt creates the pool tx, the congestion tree signed by
+// all cosigners and the forfeit txs for the given payments, and moves the
+// round to the finalization stage.
+func (s *covenantlessService) startFinalizationAttempt(
+	ctx context.Context, round *domain.Round, payments []domain.Payment,
+) error {
+	sweptRounds, err := s.repoManager.Rounds().GetSweptRounds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve swept rounds: %s", err)
+	}
+
+	cosigners := make([]*secp256k1.PublicKey, 0, len(payments)+1)
+	for _, payment := range payments {
+		cosigners = append(cosigners, s.currentRoundCosigners[payment.Id])
+	}
+
+	aspSigningKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate asp signing key: %s", err)
+	}
 
-		signedTree, err := coordinator.SignTree()
+	cosigners = append(cosigners, aspSigningKey.PubKey())
+
+	unsignedPoolTx, tree, connectorAddress, err := s.builder.BuildPoolTx(s.pubkey, payments, s.minRelayFee, sweptRounds, cosigners...)
+	if err != nil {
+		return fmt.Errorf("failed to create pool tx: %s", err)
+	}
+	log.Debugf("pool tx created for round %s", round.Id)
+
+	if len(tree) > 0 {
+		signedTree, unsignedCosigners, err := s.signCongestionTree(
+			round.Id, tree, unsignedPoolTx, cosigners, aspSigningKey,
+		)
 		if err != nil {
-			round.Fail(fmt.Errorf("failed to sign tree: %s", err))
-			log.WithError(err).Warn("failed to sign tree")
-			return
+			return fmt.Errorf("failed to sign congestion tree: %s", err)
+		}
+
+		// The payments of the cosigners that didn't sign the tree are dropped
+		// and the tree is built again, and signed, with the remaining ones.
+		if len(unsignedCosigners) > 0 {
+			payments, err := s.dropUnsignedCosigners(ctx, round, unsignedCosigners)
+			if err != nil {
+				return fmt.Errorf("failed to sign congestion tree: %s", err)
+			}
+			return s.startFinalizationAttempt(ctx, round, payments)
 		}
 
 		tree = signedTree
@@ -558,9 +922,7 @@ func (s *covenantlessService) startFinalization() {
 
 	connectors, forfeitTxs, err := s.builder.BuildForfeitTxs(s.pubkey, unsignedPoolTx, payments, s.minRelayFee)
 	if err != nil {
-		round.Fail(fmt.Errorf("failed to create connectors and forfeit txs: %s", err))
-		log.WithError(err).Warn("failed to create connectors and forfeit txs")
-		return
+		return fmt.Errorf("failed to create connectors and forfeit txs: %s", err)
 	}
 
 	log.Debugf("forfeit transactions created for round %s", round.Id)
@@ -568,14 +930,178 @@ func (s *covenantlessService) startFinalization() {
 	if _, err := round.StartFinalization(
 		connectorAddress, connectors, tree, unsignedPoolTx,
 	); err != nil {
-		round.Fail(fmt.Errorf("failed to start finalization: %s", err))
-		log.WithError(err).Warn("failed to start finalization")
-		return
+		return fmt.Errorf("failed to start finalization: %s", err)
 	}
 
 	s.forfeitTxs.push(forfeitTxs)
 
-	log.Debugf("started finalization stage for round: %s", round.Id)
+	log.Debugf(
+		"started finalization stage for round: %s (attempt %d)",
+		round.Id, round.Attempt(),
+	)
+	return nil
+}
+
+// dropUnsignedCosigners drops from the round the payments of the given
+// cosigners and returns the remaining ones.
+func (s *covenantlessService) dropUnsignedCosigners(
+	ctx context.Context, round *domain.Round, unsignedCosigners []string,
+) ([]domain.Payment, error) {
+	unsigned := make(map[string]struct{})
+	for _, pubkey := range unsignedCosigners {
+		unsigned[pubkey] = struct{}{}
+	}
+
+	dropped := make([]domain.Payment, 0, len(unsignedCosigners))
+	remaining := make([]domain.Payment, 0, len(round.Payments))
+	for id, payment := range round.Payments {
+		pubkey, ok := s.currentRoundCosigners[id]
+		if !ok {
+			continue
+		}
+		if _, ok := unsigned[hex.EncodeToString(pubkey.SerializeCompressed())]; ok {
+			dropped = append(dropped, payment)
+			continue
+		}
+		remaining = append(remaining, payment)
+	}
+
+	s.bans.strikePayments(ctx, domain.StrikeUnsignedTree, round.Id, dropped)
+
+	if _, ok := s.dropPayments(
+		round, dropped, "not signing the congestion tree",
+	); !ok {
+		return nil, fmt.Errorf("%d cosigners didn't sign", len(unsignedCosigners))
+	}
+	return remaining, nil
+}
+
+// signCongestionTree runs the musig2 session to sign the given tree. The ASP
+// acts as coordinator and as one of the cosigners: it notifies the others
+// about the session, waits for their nonces, broadcasts the aggregated ones
+// and eventually combines all the partial signatures.
+// If some cosigners don't submit their nonces or valid signatures in time, the
+// tree is not signed and their pubkeys are returned instead.
+func (s *covenantlessService) signCongestionTree(
+	roundId string, unsignedTree tree.CongestionTree, unsignedPoolTx string,
+	cosigners []*secp256k1.PublicKey, aspSigningKey *secp256k1.PrivateKey,
+) (tree.CongestionTree, []string, error) {
+	sweepClosure := bitcointree.CSVSigClosure{
+		Pubkey:  s.pubkey,
+		Seconds: uint(s.roundLifetime),
+	}
+
+	sweepTapLeaf, err := sweepClosure.Leaf()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sweepTapTree := txscript.AssembleTaprootScriptTree(*sweepTapLeaf)
+	root := sweepTapTree.RootNode.TapHash()
+
+	coordinator, err := s.createTreeCoordinatorSession(unsignedTree, cosigners, root)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create tree coordinator: %s", err)
+	}
+
+	aspSigner := bitcointree.NewTreeSignerSession(
+		aspSigningKey, unsignedTree, int64(s.minRelayFee), root.CloneBytes(),
+	)
+	aspNonces, err := aspSigner.GetNonces()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get nonces: %s", err)
+	}
+
+	session := newTreeSigningSession(coordinator, cosigners)
+	s.treeSigningSessionsLock.Lock()
+	s.treeSigningSessions[roundId] = session
+	s.treeSigningSessionsLock.Unlock()
+
+	defer func() {
+		s.treeSigningSessionsLock.Lock()
+		delete(s.treeSigningSessions, roundId)
+		s.treeSigningSessionsLock.Unlock()
+	}()
+
+	if err := session.addNonces(aspSigningKey.PubKey(), aspNonces); err != nil {
+		return nil, nil, fmt.Errorf("failed to add nonces: %s", err)
+	}
+
+	cosignersPubkeys := make([]string, 0, len(cosigners))
+	for _, pubkey := range cosigners {
+		cosignersPubkeys = append(
+			cosignersPubkeys, hex.EncodeToString(pubkey.SerializeCompressed()),
+		)
+	}
+
+	s.eventsCh <- domain.RoundSigningStarted{
+		Id:              roundId,
+		UnsignedTree:    unsignedTree,
+		Cosigners:       cosignersPubkeys,
+		UnsignedRoundTx: unsignedPoolTx,
+	}
+
+	signingTimeout := time.Duration(s.roundInterval/2) * time.Second
+
+	select {
+	case <-session.noncesDoneC:
+	case <-time.After(signingTimeout):
+		missing := session.missingNonces()
+		log.Warnf("timed out waiting for the nonces of %d cosigners", len(missing))
+		return nil, missing, nil
+	}
+
+	aggregatedNonces, err := coordinator.AggregateNonces()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to aggregate nonces: %s", err)
+	}
+
+	var serializedNonces bytes.Buffer
+	if err := aggregatedNonces.Encode(&serializedNonces); err != nil {
+		return nil, nil, fmt.Errorf("failed to encode aggregated nonces: %s", err)
+	}
+
+	s.eventsCh <- domain.RoundSigningNoncesGenerated{
+		Id:     roundId,
+		Nonces: hex.EncodeToString(serializedNonces.Bytes()),
+	}
+
+	if err := aspSigner.SetKeys(cosigners, aggregatedNonces); err != nil {
+		return nil, nil, fmt.Errorf("failed to set keys: %s", err)
+	}
+
+	aspSigs, err := aspSigner.Sign()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign: %s", err)
+	}
+
+	if err := session.addSignatures(aspSigningKey.PubKey(), aspSigs); err != nil {
+		return nil, nil, fmt.Errorf("failed to add signatures: %s", err)
+	}
+
+	select {
+	case <-session.sigsDoneC:
+	case <-time.After(signingTimeout):
+		log.Warn("timed out waiting for cosigners signatures")
+	}
+
+	if missing := session.missingSignatures(); len(missing) > 0 {
+		log.Warnf("missing valid signatures of %d cosigners", len(missing))
+		return nil, missing, nil
+	}
+
+	signedTree, err := coordinator.SignTree()
+	return signedTree, nil, err
+}
+
+func (s *covenantlessService) getTreeSigningSession(
+	roundId string,
+) (*treeSigningSession, bool) {
+	s.treeSigningSessionsLock.Lock()
+	defer s.treeSigningSessionsLock.Unlock()
+
+	session, ok := s.treeSigningSessions[roundId]
+	return session, ok
 }
 
 func (s *covenantlessService) createTreeCoordinatorSession(
@@ -587,11 +1113,17 @@ func (s *covenantlessService) createTreeCoordinatorSession(
 	)
 }
 
-func (s *covenantlessService) finalizeRound() {
-	defer s.startRound()
+func (s *covenantlessService) finalizeRound(round *domain.Round) {
+	var retry bool
+	defer func() {
+		if retry {
+			s.retryFinalization(round)
+			return
+		}
+		s.endFinalization(round)
+	}()
 
 	ctx := context.Background()
-	round := s.currentRound
 	if round.IsFailed() {
 		return
 	}
@@ -606,7 +1138,24 @@ func (s *covenantlessService) finalizeRound() {
 
 	forfeitTxs, leftUnsigned := s.forfeitTxs.pop()
 	if len(leftUnsigned) > 0 {
-		err := fmt.Errorf("%d forfeit txs left to sign", len(leftUnsigned))
+		payments, err := s.getPaymentsOfForfeitTxs(round, leftUnsigned)
+		if err != nil {
+			log.WithError(err).Warn("failed to get payments of unsigned forfeit txs")
+		}
+		s.bans.strikePayments(ctx, domain.StrikeUnsignedForfeit, round.Id, payments)
+
+		err = fmt.Errorf("%d forfeit txs left to sign", len(leftUnsigned))
+
+		if round.Attempt() < maxFinalizationAttempts {
+			changes, retry = s.dropPayments(
+				round, payments, "not signing their forfeit txs",
+			)
+			if retry {
+				log.WithError(err).Warnf("retrying finalization of round %s", round.Id)
+				return
+			}
+		}
+
 		changes = round.Fail(fmt.Errorf("failed to finalize round: %s", err))
 		log.WithError(err).Warn("failed to finalize round")
 		return
@@ -620,21 +1169,99 @@ func (s *covenantlessService) finalizeRound() {
 		return
 	}
 
+	poolTxid, err := getRawTxid(signedPoolTx)
+	if err != nil {
+		changes = round.Fail(fmt.Errorf("failed to parse signed round tx: %s", err))
+		log.WithError(err).Warn("failed to parse signed round tx")
+		return
+	}
+
+	// Store the signed forfeit txs and the pool txid before broadcasting, so
+	// that the round can be ended at restart if the server goes down after
+	// the pool tx has been published.
+	signedEvents, err := round.SignPoolTx(forfeitTxs, poolTxid)
+	if err != nil {
+		changes = round.Fail(fmt.Errorf("failed to sign round: %s", err))
+		log.WithError(err).Warn("failed to sign round")
+		return
+	}
+	if err := s.saveEvents(ctx, round.Id, signedEvents); err != nil {
+		changes = round.Fail(fmt.Errorf("failed to store signed round: %s", err))
+		log.WithError(err).Warn("failed to store signed round")
+		return
+	}
+
 	txid, err := s.wallet.BroadcastTransaction(ctx, signedPoolTx)
 	if err != nil {
 		changes = round.Fail(fmt.Errorf("failed to broadcast pool tx: %s", err))
 		log.WithError(err).Warn("failed to broadcast pool tx")
 		return
 	}
+	s.txMonitor.Track(TxTypePool, txid, signedPoolTx)
 
 	changes, _ = round.EndFinalization(forfeitTxs, txid)
 
 	log.Debugf("finalized round %s with pool tx %s", round.Id, round.Txid)
 }
 
+// getPaymentsOfForfeitTxs returns the payments of the round whose inputs are
+// spent by the given forfeit txs.
+func (s *covenantlessService) getPaymentsOfForfeitTxs(
+	round *domain.Round, forfeitTxs []string,
+) ([]domain.Payment, error) {
+	spentVtxos := make([]domain.VtxoKey, 0, len(forfeitTxs))
+	for _, tx := range forfeitTxs {
+		ptx, err := psbt.NewFromRawBytes(strings.NewReader(tx), true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse forfeit tx: %s", err)
+		}
+		for _, input := range ptx.UnsignedTx.TxIn {
+			spentVtxos = append(spentVtxos, domain.VtxoKey{
+				Txid: input.PreviousOutPoint.Hash.String(),
+				VOut: input.PreviousOutPoint.Index,
+			})
+		}
+	}
+	return getPaymentsSpendingVtxos(round.Payments, spentVtxos), nil
+}
+
+// dropPayments removes the given payments from the round, puts their inputs
+// on cooldown and lets their users know. It returns whether the round can be finalized again
+// with the remaining payments.
+func (s *covenantlessService) dropPayments(
+	round *domain.Round, payments []domain.Payment, reason string,
+) ([]domain.RoundEvent, bool) {
+	if len(payments) <= 0 || len(payments) >= len(round.Payments) {
+		return nil, false
+	}
+
+	paymentIds := make([]string, 0, len(payments))
+	droppedInputs := make([]domain.VtxoKey, 0)
+	for _, payment := range payments {
+		paymentIds = append(paymentIds, payment.Id)
+		for _, vtxo := range payment.Inputs {
+			droppedInputs = append(droppedInputs, vtxo.VtxoKey)
+		}
+	}
+
+	events, err := round.DropPayments(paymentIds)
+	if err != nil {
+		log.WithError(err).Warn("failed to drop payments")
+		return nil, false
+	}
+
+	s.paymentRequests.cooldown(
+		droppedInputs,
+		time.Duration(s.roundInterval*droppedInputsCooldownRounds)*time.Second,
+	)
+	log.Warnf("dropped payments %v for %s", paymentIds, reason)
+	s.eventsCh <- events[0]
+	return events, true
+}
+
 func (s *covenantlessService) listenToOnboarding() {
 	for onboarding := range s.onboardingCh {
-		go s.handleOnboarding(onboarding)
+		go notPaused(s.pauseLock, func() { s.handleOnboarding(onboarding) })()
 	}
 }
 
@@ -673,6 +1300,7 @@ func (s *covenantlessService) handleOnboarding(onboarding onboarding) {
 
 	pubkey := hex.EncodeToString(onboarding.userPubkey.SerializeCompressed())
 	payments := getPaymentsFromOnboardingBitcoin(onboarding.congestionTree, pubkey)
+	dustAmount := estimateDustAmount(ctx, s.wallet, s.network)
 	round := domain.NewFinalizedRound(
 		dustAmount, pubkey, txid, onboarding.tx, onboarding.congestionTree, payments,
 	)
@@ -688,7 +1316,7 @@ func (s *covenantlessService) listenToScannerNotifications() {
 
 	mutx := &sync.Mutex{}
 	for vtxoKeys := range chVtxos {
-		go func(vtxoKeys map[string]ports.VtxoWithValue) {
+		go notPaused(s.pauseLock, func() {
 			vtxosRepo := s.repoManager.Vtxos()
 			roundRepo := s.repoManager.Rounds()
 
@@ -771,8 +1399,9 @@ func (s *covenantlessService) listenToScannerNotifications() {
 				}
 
 				log.Debugf("broadcasted forfeit tx %s", forfeitTxid)
+				s.txMonitor.Track(TxTypeForfeit, forfeitTxid, forfeitTxHex)
 			}
-		}(vtxoKeys)
+		})()
 	}
 }
 
@@ -831,6 +1460,7 @@ func (s *covenantlessService) getNextConnector(
 							return "", 0, err
 						}
 						log.Debugf("broadcasted connector tx %s", connectorTxid)
+						s.txMonitor.Track(TxTypeConnector, connectorTxid, signedConnectorTx)
 
 						// wait for the connector tx to be in the mempool
 						if err := s.wallet.WaitForSync(ctx, connectorTxid); err != nil {
@@ -867,6 +1497,9 @@ func (s *covenantlessService) updateVtxoSet(round *domain.Round) {
 			break
 		}
 	}
+	// Now that the inputs of the round are spent, they can't be registered
+	// anymore.
+	s.paymentRequests.unlockInputs(round.Id)
 
 	newVtxos := s.getNewVtxos(round)
 	if len(newVtxos) > 0 {
@@ -906,8 +1539,14 @@ func (s *covenantlessService) propagateEvents(round *domain.Round) {
 			Connectors:         e.Connectors,
 			PoolTx:             e.PoolTx,
 			UnsignedForfeitTxs: forfeitTxs,
+			Attempt:            e.Attempt,
 		}
-	case domain.RoundFinalized, domain.RoundFailed:
+	case domain.RoundFailed:
+		if s.interruptedRounds.isInterrupted(e.Id) {
+			return
+		}
+		s.eventsCh <- e
+	case domain.RoundFinalized:
 		s.eventsCh <- e
 	}
 }
@@ -918,12 +1557,10 @@ func (s *covenantlessService) scheduleSweepVtxosForRound(round *domain.Round) {
 		return
 	}
 
-	expirationTimestamp := time.Now().Add(
-		time.Duration(s.roundLifetime+30) * time.Second,
-	)
+	expirationTimestamp := s.sweeper.nextExpiration(s.roundLifetime)
 
 	if err := s.sweeper.schedule(
-		expirationTimestamp.Unix(), round.Txid, round.CongestionTree,
+		expirationTimestamp, round.Txid, round.CongestionTree,
 	); err != nil {
 		log.WithError(err).Warn("failed to schedule sweep tx")
 	}
@@ -1041,6 +1678,107 @@ func (s *covenantlessService) restoreWatchingVtxos() error {
 	return nil
 }
 
+// restoreRoundState restores the payments queued before a restart and fails
+// the rounds interrupted by it, queueing their payments again. Rounds whose
+// pool tx was already published are ended instead, and those whose pool tx
+// status can't be fetched are left pending and retried later.
+func (s *covenantlessService) restoreRoundState() error {
+	ctx := context.Background()
+
+	if err := s.paymentRequests.restore(ctx, s.repoManager.Vtxos()); err != nil {
+		return fmt.Errorf("failed to restore payments: %s", err)
+	}
+
+	asyncPayments, err := s.repoManager.PaymentRequests().GetAsyncPaymentRequests(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to restore async payments: %s", err)
+	}
+	for _, asyncPayment := range asyncPayments {
+		s.asyncPaymentsCache[asyncPayment.VtxoKey] = struct {
+			receivers []domain.Receiver
+			expireAt  int64
+		}{
+			receivers: asyncPayment.Receivers,
+			expireAt:  asyncPayment.ExpireAt,
+		}
+	}
+	log.Debugf("restored %d async payments", len(asyncPayments))
+
+	roundIds, err := s.repoManager.Rounds().GetUnfinishedRoundsIds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get unfinished rounds: %s", err)
+	}
+	for _, id := range roundIds {
+		round, err := s.repoManager.Events().Load(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to load round %s: %s", id, err)
+		}
+		if err := s.restoreInterruptedRound(ctx, round); err != nil {
+			log.WithError(err).Warnf(
+				"failed to restore round %s interrupted by server restart, "+
+					"retrying later", id,
+			)
+			s.paymentRequests.lockInputs(id, getRoundPayments(round))
+			go s.retryInterruptedRound(round)
+		}
+	}
+	return nil
+}
+
+// restoreInterruptedRound ends the given round interrupted by a restart if
+// its pool tx was published, otherwise it fails it and queues its payments
+// again.
+func (s *covenantlessService) restoreInterruptedRound(
+	ctx context.Context, round *domain.Round,
+) error {
+	changes, ended, err := restoreInterruptedRound(ctx, s.scanner, round)
+	if err != nil {
+		return err
+	}
+	if !ended && len(changes) > 0 {
+		// recorded before storing the failure so that it's not propagated now
+		s.interruptedRounds.add(round, changes[len(changes)-1])
+	}
+	if err := s.saveEvents(ctx, round.Id, changes); err != nil {
+		return fmt.Errorf("failed to store events: %s", err)
+	}
+	s.paymentRequests.unlockInputs(round.Id)
+
+	if ended {
+		log.Infof(
+			"ended round %s interrupted by server restart with pool tx %s",
+			round.Id, round.Txid,
+		)
+		return nil
+	}
+
+	s.paymentRequests.requeue(
+		ctx, s.repoManager.Vtxos(), getRoundPayments(round),
+	)
+	log.Infof(
+		"failed round %s interrupted by server restart, its payments are "+
+			"queued again", round.Id,
+	)
+	return nil
+}
+
+// retryInterruptedRound restores the given round interrupted by a restart
+// every round interval until it succeeds. The inputs of its payments are
+// locked in the meantime.
+func (s *covenantlessService) retryInterruptedRound(round *domain.Round) {
+	for {
+		time.Sleep(time.Duration(s.roundInterval) * time.Second)
+		err := s.restoreInterruptedRound(context.Background(), round)
+		if err == nil {
+			return
+		}
+		log.WithError(err).Warnf(
+			"failed to restore round %s interrupted by server restart, "+
+				"retrying later", round.Id,
+		)
+	}
+}
+
 func (s *covenantlessService) extractVtxosScripts(vtxos []domain.Vtxo) ([]string, error) {
 	indexedScripts := make(map[string]struct{})
 	for _, vtxo := range vtxos {
@@ -1072,11 +1810,8 @@ func (s *covenantlessService) saveEvents(
 	if len(events) <= 0 {
 		return nil
 	}
-	round, err := s.repoManager.Events().Save(ctx, id, events...)
-	if err != nil {
-		return err
-	}
-	return s.repoManager.Rounds().AddOrUpdateRound(ctx, *round)
+	_, err := s.repoManager.Events().Save(ctx, id, events...)
+	return err
 }
 
 func getPaymentsFromOnboardingBitcoin(
@@ -1118,3 +1853,15 @@ func findForfeitTxBitcoin(
 
 	return "", fmt.Errorf("forfeit tx not found")
 }
+
+func getRawTxid(txhex string) (string, error) {
+	buf, err := hex.DecodeString(txhex)
+	if err != nil {
+		return "", err
+	}
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(buf)); err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+
+	"github.com/ark-network/ark/common"
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Estimated vsize of a vtxo output plus the one of the input spending it
+	// through the unilateral exit path, that is a taproot script path spend
+	// revealing a single-sig leaf and a control block of depth 1.
+	vtxoVsize = 43 + 41 + (1+65+1+36+1+65+1)/4
+	// Below these amounts outputs are considered dust by the network
+	// regardless of the fee rate.
+	minBitcoinDustAmount = 330
+	minLiquidDustAmount  = 450
+)
+
+// estimateDustAmount returns the dust amount for a new round, ie. the min
+// amount of a vtxo that is worth to spend onchain at the current fee rate.
+// It falls back to the network min dust amount if the fee rate can't be
+// estimated.
+func estimateDustAmount(
+	ctx context.Context, wallet ports.WalletService, network common.Network,
+) uint64 {
+	minDustAmount := uint64(minBitcoinDustAmount)
+	if common.IsLiquid(network) {
+		minDustAmount = minLiquidDustAmount
+	}
+
+	feeRate, err := wallet.EstimateFeeRate(ctx)
+	if err != nil {
+		log.WithError(err).Warn(
+			"failed to estimate fee rate, falling back to min dust amount",
+		)
+		return minDustAmount
+	}
+
+	dustAmount := feeRate * vtxoVsize / 1000
+	if dustAmount < minDustAmount {
+		return minDustAmount
+	}
+	return dustAmount
+}
+
+// filterDustPayments splits the given payments between those above the dust
+// amount and those having any receiver below it.
+func filterDustPayments(
+	payments []domain.Payment, dustAmount uint64,
+) ([]domain.Payment, []domain.Payment) {
+	filtered := make([]domain.Payment, 0, len(payments))
+	dust := make([]domain.Payment, 0)
+	for _, payment := range payments {
+		isDust := false
+		for _, receiver := range payment.Receivers {
+			if receiver.Amount < dustAmount {
+				isDust = true
+				break
+			}
+		}
+		if isDust {
+			dust = append(dust, payment)
+			continue
+		}
+		filtered = append(filtered, payment)
+	}
+	return filtered, dust
+}
@@ -0,0 +1,86 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+)
+
+// feeRateUnit is the unit of the proportional service fee, ie. the rate is
+// expressed in parts per million of the input amount.
+const feeRateUnit = 1_000_000
+
+// ServiceFee is a base fee plus a proportional fee charged on the total
+// amount of the inputs of a payment.
+type ServiceFee struct {
+	Base uint64
+	// Rate is the proportional fee in parts per million.
+	Rate uint64
+}
+
+// amount returns the fee charged for spending inputs of the given amount.
+func (f ServiceFee) amount(inputAmount uint64) uint64 {
+	proportional := inputAmount/feeRateUnit*f.Rate +
+		inputAmount%feeRateUnit*f.Rate/feeRateUnit
+	return f.Base + proportional
+}
+
+func (f ServiceFee) validate() error {
+	if f.Rate > feeRateUnit {
+		return fmt.Errorf(
+			"invalid fee rate, must be at most %d ppm", feeRateUnit,
+		)
+	}
+	return nil
+}
+
+// FeeSchedule is the set of service fees charged by the ASP, with separate
+// rates for off-chain transfers, collaborative exits and async payments.
+type FeeSchedule struct {
+	Offchain ServiceFee
+	// Onchain applies to payments with at least one onchain receiver.
+	Onchain ServiceFee
+	Async   ServiceFee
+}
+
+func (s FeeSchedule) validate() error {
+	if err := s.Offchain.validate(); err != nil {
+		return fmt.Errorf("offchain: %s", err)
+	}
+	if err := s.Onchain.validate(); err != nil {
+		return fmt.Errorf("onchain: %s", err)
+	}
+	if err := s.Async.validate(); err != nil {
+		return fmt.Errorf("async: %s", err)
+	}
+	return nil
+}
+
+// paymentFee returns the fee expected for a payment spending the given
+// inputs to the given receivers.
+func (s FeeSchedule) paymentFee(
+	inputs []domain.Vtxo, receivers []domain.Receiver,
+) uint64 {
+	fee := s.Offchain
+	for _, receiver := range receivers {
+		if receiver.IsOnchain() {
+			fee = s.Onchain
+			break
+		}
+	}
+	return fee.amount(sumOfVtxos(inputs))
+}
+
+// asyncPaymentFee returns the fee expected for an async payment spending the
+// given inputs.
+func (s FeeSchedule) asyncPaymentFee(inputs []domain.Vtxo) uint64 {
+	return s.Async.amount(sumOfVtxos(inputs))
+}
+
+func sumOfVtxos(vtxos []domain.Vtxo) uint64 {
+	sum := uint64(0)
+	for _, vtxo := range vtxos {
+		sum += vtxo.Amount
+	}
+	return sum
+}
@@ -0,0 +1,85 @@
+package application
+
+import (
+	"sort"
+)
+
+const defaultLiquidityLookback = int64(24 * 60 * 60)
+
+// LiquidityPoint is the expected liquidity of the main account at a given
+// time, either now or when the outputs of a scheduled sweep expire.
+type LiquidityPoint struct {
+	Timestamp int64
+	// Locked is the amount in the shared outputs not swept yet, plus the one
+	// expected to be spent by the next rounds.
+	Locked    uint64
+	Available uint64
+	// Swept is the amount expected to be swept back at this time.
+	Swept uint64
+	// Alert is true if the available amount is below the alert threshold.
+	Alert bool
+}
+
+type LiquidityForecast struct {
+	MainAccountBalance       Balance
+	ConnectorsAccountBalance Balance
+	// VtxosLiability is the amount of the vtxos neither spent, redeemed nor
+	// swept, ie. owned by the users.
+	VtxosLiability uint64
+	// RecentRoundsAmount is the amount spent by the rounds of the lookback
+	// period, used to estimate the one of the next rounds.
+	RecentRoundsAmount uint64
+	Lookback           int64
+	AlertThreshold     uint64
+	Timeline           []LiquidityPoint
+}
+
+// forecastLiquidity returns the timeline of the expected liquidity, starting
+// from the given amounts. In between the points, the available amount is
+// spent at the given rate (sats/second) and locked until swept back.
+func forecastLiquidity(
+	now int64, available, locked uint64, spendingRate float64,
+	sweepsByTime map[int64]uint64, alertThreshold uint64,
+) []LiquidityPoint {
+	times := make([]int64, 0, len(sweepsByTime))
+	for at := range sweepsByTime {
+		if at > now {
+			times = append(times, at)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	// the sweeps that should have been done already are expected right away
+	sweptNow := uint64(0)
+	for at, amount := range sweepsByTime {
+		if at <= now {
+			sweptNow += amount
+		}
+	}
+
+	timeline := make([]LiquidityPoint, 0, len(times)+1)
+	point := LiquidityPoint{
+		Timestamp: now,
+		Locked:    locked - min(sweptNow, locked),
+		Available: available + sweptNow,
+		Swept:     sweptNow,
+	}
+	point.Alert = point.Available < alertThreshold
+	timeline = append(timeline, point)
+
+	for _, at := range times {
+		spent := min(uint64(spendingRate*float64(at-point.Timestamp)), point.Available)
+		swept := sweepsByTime[at]
+
+		point = LiquidityPoint{
+			Timestamp: at,
+			Locked:    point.Locked + spent - min(swept, point.Locked+spent),
+			Available: point.Available - spent + swept,
+			Swept:     swept,
+		}
+		point.Alert = point.Available < alertThreshold
+		timeline = append(timeline, point)
+	}
+
+	return timeline
+}
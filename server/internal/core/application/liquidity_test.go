@@ -0,0 +1,50 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecastLiquidity(t *testing.T) {
+	now := int64(1700000000)
+
+	t.Run("no sweeps", func(t *testing.T) {
+		timeline := forecastLiquidity(now, 100000, 0, 1, nil, 50000)
+		require.Equal(t, []LiquidityPoint{
+			{Timestamp: now, Available: 100000},
+		}, timeline)
+	})
+
+	t.Run("sweeps", func(t *testing.T) {
+		sweepsByTime := map[int64]uint64{
+			now - 10:  5000, // overdue, expected right away
+			now + 200: 20000,
+			now + 100: 10000,
+		}
+		timeline := forecastLiquidity(now, 100000, 35000, 100, sweepsByTime, 0)
+		require.Equal(t, []LiquidityPoint{
+			{Timestamp: now, Locked: 30000, Available: 105000, Swept: 5000},
+			// 10000 spent by rounds and locked, 10000 swept back
+			{Timestamp: now + 100, Locked: 30000, Available: 105000, Swept: 10000},
+			{Timestamp: now + 200, Locked: 20000, Available: 115000, Swept: 20000},
+		}, timeline)
+	})
+
+	t.Run("alert", func(t *testing.T) {
+		sweepsByTime := map[int64]uint64{
+			now + 100: 10000,
+			now + 300: 50000,
+		}
+		timeline := forecastLiquidity(now, 30000, 60000, 200, sweepsByTime, 25000)
+		require.Equal(t, []LiquidityPoint{
+			{Timestamp: now, Locked: 60000, Available: 30000},
+			{
+				Timestamp: now + 100, Locked: 70000, Available: 20000, Swept: 10000,
+				Alert: true,
+			},
+			// the rounds can't spend more than the available amount
+			{Timestamp: now + 300, Locked: 40000, Available: 50000, Swept: 50000},
+		}, timeline)
+	})
+}
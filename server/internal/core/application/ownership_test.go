@@ -0,0 +1,206 @@
+package application
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/common"
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyInputsOwnership(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	input := domain.VtxoKey{
+		Txid: "d9fd4a0e1fbce81d09af1a8e8b3b8fa1b05e39b0b4e3e5a9a3c8b3dfb1c7a5e2",
+		VOut: 1,
+	}
+	vtxos := []domain.Vtxo{{
+		VtxoKey: input,
+		Receiver: domain.Receiver{
+			Pubkey: hex.EncodeToString(key.PubKey().SerializeCompressed()),
+			Amount: 1000,
+		},
+	}}
+	txid, err := chainhash.NewHashFromStr(input.Txid)
+	require.NoError(t, err)
+	outpoints := []wire.OutPoint{{Hash: *txid, Index: input.VOut}}
+
+	nonce := []byte("nonce")
+
+	sign := func(
+		key *secp256k1.PrivateKey, nonce []byte,
+	) map[string]*schnorr.Signature {
+		sig, err := schnorr.Sign(key, common.PaymentInputsHash(outpoints, nonce))
+		require.NoError(t, err)
+		return map[string]*schnorr.Signature{vtxos[0].Pubkey: sig}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		err := verifyInputsOwnership(
+			[]domain.VtxoKey{input}, vtxos, sign(key, nonce), nonce,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		otherKey, err := secp256k1.GeneratePrivateKey()
+		require.NoError(t, err)
+
+		fixtures := []struct {
+			name        string
+			signatures  map[string]*schnorr.Signature
+			nonce       []byte
+			expectedErr string
+		}{
+			{
+				name:        "missing_nonce",
+				signatures:  sign(key, nonce),
+				nonce:       nil,
+				expectedErr: "missing nonce",
+			},
+			{
+				name:        "missing_signature",
+				signatures:  map[string]*schnorr.Signature{},
+				nonce:       nonce,
+				expectedErr: "missing signature of the owner of input",
+			},
+			{
+				name:        "different_nonce",
+				signatures:  sign(key, []byte("other nonce")),
+				nonce:       nonce,
+				expectedErr: "invalid signature for input",
+			},
+			{
+				name:        "other_signer",
+				signatures:  sign(otherKey, nonce),
+				nonce:       nonce,
+				expectedErr: "invalid signature for input",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				err := verifyInputsOwnership(
+					[]domain.VtxoKey{input}, vtxos, f.signatures, f.nonce,
+				)
+				require.ErrorContains(t, err, f.expectedErr)
+			})
+		}
+	})
+}
+
+func TestPaymentNonces(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		nonces := newPaymentNonces()
+
+		nonce, expireAt, err := nonces.new()
+		require.NoError(t, err)
+		require.NotEmpty(t, nonce)
+		require.Greater(t, expireAt, time.Now().Unix())
+
+		require.NoError(t, nonces.use(nonce))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("unknown", func(t *testing.T) {
+			nonces := newPaymentNonces()
+
+			err := nonces.use("nonce")
+			require.ErrorContains(t, err, "unknown or already used nonce")
+		})
+
+		t.Run("reused", func(t *testing.T) {
+			nonces := newPaymentNonces()
+
+			nonce, _, err := nonces.new()
+			require.NoError(t, err)
+			require.NoError(t, nonces.use(nonce))
+
+			err = nonces.use(nonce)
+			require.ErrorContains(t, err, "unknown or already used nonce")
+		})
+
+		t.Run("expired", func(t *testing.T) {
+			nonces := newPaymentNonces()
+
+			nonce, _, err := nonces.new()
+			require.NoError(t, err)
+			nonces.nonces[nonce] = time.Now().Add(-time.Second)
+
+			err = nonces.use(nonce)
+			require.ErrorContains(t, err, "nonce expired")
+		})
+	})
+}
+
+func TestVerifyPaymentOwners(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	pubkey := hex.EncodeToString(key.PubKey().SerializeCompressed())
+	payment := domain.Payment{
+		Id: "payment-id",
+		Inputs: []domain.Vtxo{{
+			VtxoKey: domain.VtxoKey{
+				Txid: "d9fd4a0e1fbce81d09af1a8e8b3b8fa1b05e39b0b4e3e5a9a3c8b3dfb1c7a5e2",
+				VOut: 1,
+			},
+			Receiver: domain.Receiver{Pubkey: pubkey, Amount: 1000},
+		}},
+	}
+	forfeitTxs := []string{"forfeit"}
+
+	sign := func(
+		key *secp256k1.PrivateKey, paymentId string,
+	) map[string]*schnorr.Signature {
+		sig, err := schnorr.Sign(key, common.FinalizePaymentHash(paymentId, forfeitTxs))
+		require.NoError(t, err)
+		return map[string]*schnorr.Signature{pubkey: sig}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		err := verifyPaymentOwners(payment, forfeitTxs, sign(key, payment.Id))
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fixtures := []struct {
+			name        string
+			signatures  map[string]*schnorr.Signature
+			expectedErr string
+		}{
+			{
+				name:        "missing_signature",
+				signatures:  map[string]*schnorr.Signature{},
+				expectedErr: "missing signature of the owner of input",
+			},
+			{
+				name:        "other_payment",
+				signatures:  sign(key, "other-payment-id"),
+				expectedErr: "invalid signature of the owner of input",
+			},
+			{
+				name:        "other_signer",
+				signatures:  sign(otherKey, payment.Id),
+				expectedErr: "invalid signature of the owner of input",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				err := verifyPaymentOwners(payment, forfeitTxs, f.signatures)
+				require.ErrorContains(t, err, f.expectedErr)
+			})
+		}
+	})
+}
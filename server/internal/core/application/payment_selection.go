@@ -0,0 +1,162 @@
+package application
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+)
+
+const (
+	// PaymentSelectionFIFO selects the oldest payments first.
+	PaymentSelectionFIFO = "fifo"
+	// PaymentSelectionFee selects the payments paying the highest fees first.
+	PaymentSelectionFee = "fee"
+	// PaymentSelectionExpiry selects first the payments spending the vtxos
+	// closest to their expiration.
+	PaymentSelectionExpiry = "expiry"
+)
+
+// Rough estimate of the pool tx weight, assuming taproot inputs and outputs:
+// the base weight accounts for the tx overhead, one wallet input and the
+// shared, connector and change outputs, while every onchain receiver adds an
+// output.
+const (
+	poolTxBaseWeight   = 4 * (11 + 58 + 3*43)
+	poolTxOutputWeight = 4 * 43
+)
+
+// PaymentSelection is the policy that decides which of the ready payments
+// are included in a round. Payments are sorted according to the policy type,
+// then added to the round as long as the congestion tree and the pool tx stay
+// within the given limits. Those left out are deferred to the next round.
+type PaymentSelection struct {
+	Type string
+	// MaxTreeDepth is the max number of levels of the congestion tree.
+	MaxTreeDepth int
+	// MaxPoolTxWeight is the max estimated weight of the pool tx.
+	MaxPoolTxWeight int64
+}
+
+func (s PaymentSelection) validate(fees FeeSchedule) error {
+	switch s.Type {
+	case PaymentSelectionFIFO, PaymentSelectionExpiry:
+	case PaymentSelectionFee:
+		// without service fees all payments pay nothing, and the selection
+		// would silently fall back to fifo
+		if fees == (FeeSchedule{}) {
+			return fmt.Errorf(
+				"payment selection type %s requires service fees", s.Type,
+			)
+		}
+	default:
+		return fmt.Errorf("unknown payment selection type %s", s.Type)
+	}
+	if s.MaxTreeDepth <= 0 {
+		return fmt.Errorf("invalid max tree depth, must be greater than 0")
+	}
+	if s.MaxPoolTxWeight <= poolTxBaseWeight {
+		return fmt.Errorf(
+			"invalid max pool tx weight, must be greater than %d", poolTxBaseWeight,
+		)
+	}
+	return nil
+}
+
+// selectPayments returns the payments that fit in a round, in order of
+// preference, and those that must be deferred to the next one.
+func (s PaymentSelection) selectPayments(
+	payments []timedPayment,
+) (selected, deferred []timedPayment) {
+	sorted := make([]timedPayment, len(payments))
+	copy(sorted, payments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].timestamp.Before(sorted[j].timestamp)
+	})
+
+	switch s.Type {
+	case PaymentSelectionFee:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].Fee > sorted[j].Fee
+		})
+	case PaymentSelectionExpiry:
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return paymentExpiry(sorted[i].Payment) < paymentExpiry(sorted[j].Payment)
+		})
+	}
+
+	selected = make([]timedPayment, 0, len(sorted))
+	deferred = make([]timedPayment, 0)
+	numOfLeaves, numOfOutputs := 0, 0
+	for _, p := range sorted {
+		leaves, outputs := countReceivers(p.Receivers)
+		if treeDepth(numOfLeaves+leaves) > s.MaxTreeDepth ||
+			poolTxWeight(numOfOutputs+outputs) > s.MaxPoolTxWeight {
+			deferred = append(deferred, p)
+			continue
+		}
+		selected = append(selected, p)
+		numOfLeaves += leaves
+		numOfOutputs += outputs
+	}
+	return
+}
+
+// checkReceivers returns an error if a payment with the given receivers
+// exceeds the limits on its own, in which case it could never be selected
+// and would be deferred forever.
+func (s PaymentSelection) checkReceivers(receivers []domain.Receiver) error {
+	leaves, outputs := countReceivers(receivers)
+	if depth := treeDepth(leaves); depth > s.MaxTreeDepth {
+		return fmt.Errorf(
+			"too many offchain receivers, the congestion tree would have %d "+
+				"levels, max %d", depth, s.MaxTreeDepth,
+		)
+	}
+	if weight := poolTxWeight(outputs); weight > s.MaxPoolTxWeight {
+		return fmt.Errorf(
+			"too many onchain receivers, the pool tx would weigh %d, max %d",
+			weight, s.MaxPoolTxWeight,
+		)
+	}
+	return nil
+}
+
+// paymentExpiry returns the expiration of the first of the payment's inputs
+// to expire.
+func paymentExpiry(payment domain.Payment) int64 {
+	expiry := int64(math.MaxInt64)
+	for _, input := range payment.Inputs {
+		if input.ExpireAt < expiry {
+			expiry = input.ExpireAt
+		}
+	}
+	return expiry
+}
+
+// countReceivers returns the number of offchain and onchain receivers,
+// respectively becoming leaves of the tree and outputs of the pool tx.
+func countReceivers(receivers []domain.Receiver) (offchain, onchain int) {
+	for _, receiver := range receivers {
+		if receiver.IsOnchain() {
+			onchain++
+			continue
+		}
+		offchain++
+	}
+	return
+}
+
+// treeDepth returns the number of levels of a binary congestion tree with
+// the given number of leaves.
+func treeDepth(numOfLeaves int) int {
+	if numOfLeaves <= 0 {
+		return 0
+	}
+	return int(math.Ceil(math.Log2(float64(numOfLeaves)))) + 1
+}
+
+func poolTxWeight(numOfOutputs int) int64 {
+	return poolTxBaseWeight + int64(numOfOutputs)*poolTxOutputWeight
+}
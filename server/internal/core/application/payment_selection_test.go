@@ -0,0 +1,286 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentSelectionValidate(t *testing.T) {
+	fees := FeeSchedule{Offchain: ServiceFee{Base: 100}}
+	maxPoolTxWeight := int64(poolTxBaseWeight + poolTxOutputWeight)
+
+	t.Run("valid", func(t *testing.T) {
+		fixtures := []struct {
+			name      string
+			selection PaymentSelection
+			fees      FeeSchedule
+		}{
+			{
+				name: "fifo",
+				selection: PaymentSelection{
+					Type: PaymentSelectionFIFO, MaxTreeDepth: 1,
+					MaxPoolTxWeight: maxPoolTxWeight,
+				},
+			},
+			{
+				name: "expiry",
+				selection: PaymentSelection{
+					Type: PaymentSelectionExpiry, MaxTreeDepth: 1,
+					MaxPoolTxWeight: maxPoolTxWeight,
+				},
+			},
+			{
+				name: "fee",
+				selection: PaymentSelection{
+					Type: PaymentSelectionFee, MaxTreeDepth: 1,
+					MaxPoolTxWeight: maxPoolTxWeight,
+				},
+				fees: fees,
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				require.NoError(t, f.selection.validate(f.fees))
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fixtures := []struct {
+			name        string
+			selection   PaymentSelection
+			fees        FeeSchedule
+			expectedErr string
+		}{
+			{
+				name: "unknown_type",
+				selection: PaymentSelection{
+					Type: "unknown", MaxTreeDepth: 1, MaxPoolTxWeight: maxPoolTxWeight,
+				},
+				expectedErr: "unknown payment selection type unknown",
+			},
+			{
+				name: "fee_without_fees",
+				selection: PaymentSelection{
+					Type: PaymentSelectionFee, MaxTreeDepth: 1,
+					MaxPoolTxWeight: maxPoolTxWeight,
+				},
+				expectedErr: "payment selection type fee requires service fees",
+			},
+			{
+				name: "invalid_max_tree_depth",
+				selection: PaymentSelection{
+					Type: PaymentSelectionFIFO, MaxPoolTxWeight: maxPoolTxWeight,
+				},
+				fees:        fees,
+				expectedErr: "invalid max tree depth, must be greater than 0",
+			},
+			{
+				name: "invalid_max_pool_tx_weight",
+				selection: PaymentSelection{
+					Type: PaymentSelectionFIFO, MaxTreeDepth: 1,
+					MaxPoolTxWeight: poolTxBaseWeight,
+				},
+				fees:        fees,
+				expectedErr: "invalid max pool tx weight, must be greater than 792",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				err := f.selection.validate(f.fees)
+				require.EqualError(t, err, f.expectedErr)
+			})
+		}
+	})
+}
+
+func TestPaymentSelectionSelectPayments(t *testing.T) {
+	now := time.Now()
+	// a is the most recent and the one paying the middle fee, b the oldest
+	// one paying the lowest fee and c the one paying the highest fee.
+	// By expiry, the order is a, c, b.
+	a := newTestTimedPayment("a", now.Add(2*time.Second), 100, 100, 1, 0)
+	b := newTestTimedPayment("b", now, 50, 300, 1, 0)
+	c := newTestTimedPayment("c", now.Add(time.Second), 200, 200, 1, 0)
+	unlimitedWeight := int64(poolTxBaseWeight + 10*poolTxOutputWeight)
+
+	fixtures := []struct {
+		name             string
+		selection        PaymentSelection
+		payments         []timedPayment
+		expectedSelected []string
+		expectedDeferred []string
+	}{
+		{
+			name: "fifo",
+			selection: PaymentSelection{
+				Type: PaymentSelectionFIFO, MaxTreeDepth: 10,
+				MaxPoolTxWeight: unlimitedWeight,
+			},
+			payments:         []timedPayment{a, b, c},
+			expectedSelected: []string{"b", "c", "a"},
+			expectedDeferred: []string{},
+		},
+		{
+			name: "fee",
+			selection: PaymentSelection{
+				Type: PaymentSelectionFee, MaxTreeDepth: 10,
+				MaxPoolTxWeight: unlimitedWeight,
+			},
+			payments:         []timedPayment{a, b, c},
+			expectedSelected: []string{"c", "a", "b"},
+			expectedDeferred: []string{},
+		},
+		{
+			name: "fee_ties_broken_by_age",
+			selection: PaymentSelection{
+				Type: PaymentSelectionFee, MaxTreeDepth: 10,
+				MaxPoolTxWeight: unlimitedWeight,
+			},
+			payments: []timedPayment{
+				newTestTimedPayment("a", now.Add(2*time.Second), 100, 0, 1, 0),
+				newTestTimedPayment("b", now, 100, 0, 1, 0),
+				newTestTimedPayment("c", now.Add(time.Second), 100, 0, 1, 0),
+			},
+			expectedSelected: []string{"b", "c", "a"},
+			expectedDeferred: []string{},
+		},
+		{
+			name: "expiry",
+			selection: PaymentSelection{
+				Type: PaymentSelectionExpiry, MaxTreeDepth: 10,
+				MaxPoolTxWeight: unlimitedWeight,
+			},
+			payments:         []timedPayment{a, b, c},
+			expectedSelected: []string{"a", "c", "b"},
+			expectedDeferred: []string{},
+		},
+		{
+			name: "max_tree_depth",
+			selection: PaymentSelection{
+				Type: PaymentSelectionFIFO, MaxTreeDepth: 2,
+				MaxPoolTxWeight: unlimitedWeight,
+			},
+			payments:         []timedPayment{a, b, c},
+			expectedSelected: []string{"b", "c"},
+			expectedDeferred: []string{"a"},
+		},
+		{
+			name: "smaller_payment_after_deferred_one",
+			selection: PaymentSelection{
+				Type: PaymentSelectionFIFO, MaxTreeDepth: 2,
+				MaxPoolTxWeight: unlimitedWeight,
+			},
+			payments: []timedPayment{
+				a, b, newTestTimedPayment("c", now.Add(time.Second), 0, 0, 2, 0),
+			},
+			expectedSelected: []string{"b", "a"},
+			expectedDeferred: []string{"c"},
+		},
+		{
+			name: "max_pool_tx_weight",
+			selection: PaymentSelection{
+				Type: PaymentSelectionFIFO, MaxTreeDepth: 10,
+				MaxPoolTxWeight: poolTxBaseWeight + poolTxOutputWeight,
+			},
+			payments: []timedPayment{
+				a,
+				newTestTimedPayment("b", now, 0, 0, 0, 1),
+				newTestTimedPayment("c", now.Add(time.Second), 0, 0, 0, 1),
+			},
+			expectedSelected: []string{"b", "a"},
+			expectedDeferred: []string{"c"},
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			selected, deferred := f.selection.selectPayments(f.payments)
+			require.Equal(t, f.expectedSelected, timedPaymentIds(selected))
+			require.Equal(t, f.expectedDeferred, timedPaymentIds(deferred))
+		})
+	}
+}
+
+func TestPaymentSelectionCheckReceivers(t *testing.T) {
+	selection := PaymentSelection{
+		Type: PaymentSelectionFIFO, MaxTreeDepth: 2,
+		MaxPoolTxWeight: poolTxBaseWeight + poolTxOutputWeight,
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, selection.checkReceivers(newTestReceivers(2, 1)))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fixtures := []struct {
+			name        string
+			receivers   []domain.Receiver
+			expectedErr string
+		}{
+			{
+				name:      "too_many_offchain_receivers",
+				receivers: newTestReceivers(3, 0),
+				expectedErr: "too many offchain receivers, the congestion tree " +
+					"would have 3 levels, max 2",
+			},
+			{
+				name:      "too_many_onchain_receivers",
+				receivers: newTestReceivers(0, 2),
+				expectedErr: "too many onchain receivers, the pool tx would " +
+					"weigh 1136, max 964",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				err := selection.checkReceivers(f.receivers)
+				require.EqualError(t, err, f.expectedErr)
+			})
+		}
+	})
+}
+
+// newTestTimedPayment returns a payment with the given id, registered at the
+// given time, paying the given fee and spending a vtxo expiring at the given
+// time to the given number of offchain and onchain receivers.
+func newTestTimedPayment(
+	id string, timestamp time.Time, fee uint64, expireAt int64,
+	offchain, onchain int,
+) timedPayment {
+	return timedPayment{
+		Payment: domain.Payment{
+			Id:        id,
+			Inputs:    []domain.Vtxo{{ExpireAt: expireAt}},
+			Receivers: newTestReceivers(offchain, onchain),
+			Fee:       fee,
+		},
+		timestamp: timestamp,
+	}
+}
+
+func newTestReceivers(offchain, onchain int) []domain.Receiver {
+	receivers := make([]domain.Receiver, 0, offchain+onchain)
+	for i := 0; i < offchain; i++ {
+		receivers = append(receivers, domain.Receiver{Pubkey: "pubkey", Amount: 1000})
+	}
+	for i := 0; i < onchain; i++ {
+		receivers = append(receivers, domain.Receiver{
+			OnchainAddress: "address", Amount: 1000,
+		})
+	}
+	return receivers
+}
+
+func timedPaymentIds(payments []timedPayment) []string {
+	ids := make([]string, 0, len(payments))
+	for _, p := range payments {
+		ids = append(ids, p.Id)
+	}
+	return ids
+}
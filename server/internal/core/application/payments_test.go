@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentsMapPush(t *testing.T) {
+	input := domain.Vtxo{
+		VtxoKey:  domain.VtxoKey{Txid: leafTxid, VOut: 0},
+		Receiver: domain.Receiver{Amount: 1000},
+	}
+	otherInput := domain.Vtxo{
+		VtxoKey:  domain.VtxoKey{Txid: redeemTxid, VOut: 0},
+		Receiver: domain.Receiver{Amount: 1000},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+
+		payment, err := domain.NewPayment([]domain.Vtxo{input})
+		require.NoError(t, err)
+		require.NoError(t, payments.push(*payment))
+
+		otherPayment, err := domain.NewPayment([]domain.Vtxo{otherInput})
+		require.NoError(t, err)
+		require.NoError(t, payments.push(*otherPayment))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("input_queued", func(t *testing.T) {
+			payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+
+			payment, err := domain.NewPayment([]domain.Vtxo{input})
+			require.NoError(t, err)
+			require.NoError(t, payments.push(*payment))
+
+			replayed, err := domain.NewPayment([]domain.Vtxo{otherInput, input})
+			require.NoError(t, err)
+			err = payments.push(*replayed)
+			require.ErrorContains(t, err, "already registered in payment "+payment.Id)
+		})
+
+		t.Run("input_locked", func(t *testing.T) {
+			payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+			payments.lockedInputs[input.Hash()] = "round"
+
+			payment, err := domain.NewPayment([]domain.Vtxo{input})
+			require.NoError(t, err)
+			err = payments.push(*payment)
+			require.ErrorContains(t, err, "already registered in round round")
+		})
+	})
+}
+
+func TestPaymentsMapRequeue(t *testing.T) {
+	ctx := context.Background()
+	repoManager := newTestRepoManager(t)
+	defer repoManager.Close()
+
+	input := domain.Vtxo{VtxoKey: domain.VtxoKey{Txid: leafTxid, VOut: 0}}
+	redeemedInput := domain.Vtxo{VtxoKey: domain.VtxoKey{Txid: redeemTxid, VOut: 0}}
+	require.NoError(t, repoManager.Vtxos().RedeemVtxos(
+		ctx, []domain.VtxoKey{redeemedInput.VtxoKey},
+	))
+
+	payments := newPaymentsMap(repoManager.PaymentRequests())
+	payment, err := domain.NewPayment([]domain.Vtxo{input})
+	require.NoError(t, err)
+	redeemed, err := domain.NewPayment([]domain.Vtxo{redeemedInput})
+	require.NoError(t, err)
+
+	// the payment spending an input redeemed in the meantime is discarded
+	payments.requeue(
+		ctx, repoManager.Vtxos(), []domain.Payment{*payment, *redeemed},
+	)
+	_, ok := payments.view(payment.Id)
+	require.True(t, ok)
+	_, ok = payments.view(redeemed.Id)
+	require.False(t, ok)
+
+	stored, err := repoManager.PaymentRequests().GetPaymentRequests(ctx)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, payment.Id, stored[0].Id)
+
+	// the payments already queued are skipped
+	other, err := domain.NewPayment([]domain.Vtxo{input})
+	require.NoError(t, err)
+	payments.requeue(ctx, repoManager.Vtxos(), []domain.Payment{*other})
+	_, ok = payments.view(other.Id)
+	require.False(t, ok)
+}
@@ -0,0 +1,160 @@
+package application
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentsMapLockedInputs(t *testing.T) {
+	selection := PaymentSelection{
+		Type: PaymentSelectionFIFO, MaxTreeDepth: 10,
+		MaxPoolTxWeight: poolTxBaseWeight + 10*poolTxOutputWeight,
+	}
+
+	t.Run("pop_locks_inputs", func(t *testing.T) {
+		payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+		pushReadyPayment(t, payments, 0, 1000)
+		pushReadyPayment(t, payments, 1, 1000)
+
+		popped, _, deferred := payments.pop("round", selection, false)
+		require.Len(t, popped, 2)
+		require.Empty(t, deferred)
+		require.Len(t, payments.lockedInputs, 2)
+		for _, roundId := range payments.lockedInputs {
+			require.Equal(t, "round", roundId)
+		}
+
+		// the inputs can't be registered for the next round until this one ends
+		replayed := domain.NewPaymentUnsafe(
+			[]domain.Vtxo{{VtxoKey: domain.VtxoKey{Txid: leafTxid, VOut: 0}}}, nil,
+		)
+		err := payments.push(*replayed)
+		require.ErrorContains(t, err, "already registered in round round")
+	})
+
+	t.Run("locked_inputs_not_ready", func(t *testing.T) {
+		payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+		paymentId := pushReadyPayment(t, payments, 0, 1000)
+		payment, ok := payments.view(paymentId)
+		require.True(t, ok)
+		// the payment was restored while the round spending its inputs is
+		// still pending
+		payments.lockInputs("round", []domain.Payment{*payment})
+
+		count, amount := payments.ready(false)
+		require.Zero(t, count)
+		require.Zero(t, amount)
+		popped, _, _ := payments.pop("next_round", selection, false)
+		require.Empty(t, popped)
+
+		// ending another round doesn't release the inputs
+		payments.unlockInputs("other_round")
+		count, _ = payments.ready(false)
+		require.Zero(t, count)
+
+		// drain the notifications so far, unlocking must notify the trigger
+		select {
+		case <-payments.updated:
+		default:
+		}
+		payments.unlockInputs("round")
+		require.Len(t, payments.updated, 1)
+
+		count, amount = payments.ready(false)
+		require.Equal(t, int64(1), count)
+		require.Equal(t, uint64(1000), amount)
+		popped, _, _ = payments.pop("next_round", selection, false)
+		require.Len(t, popped, 1)
+	})
+}
+
+func TestOpenNextRound(t *testing.T) {
+	svc := &covenantlessService{
+		wallet:           &mockedWallet{},
+		roundTrigger:     &blockingTrigger{},
+		roundsLock:       &sync.RWMutex{},
+		finalizationLock: &sync.Mutex{},
+	}
+
+	round := domain.NewRound(0)
+	_, err := round.StartRegistration()
+	require.NoError(t, err)
+	svc.currentRound = round
+
+	// the round being finalized holds the finalization lock until it ends
+	svc.finalizationLock.Lock()
+	svc.openNextRound(round)
+	require.Equal(t, round, svc.getFinalizingRound())
+
+	require.Eventually(t, func() bool {
+		return svc.getCurrentRound() != round
+	}, time.Second, 10*time.Millisecond)
+
+	nextRound := svc.getCurrentRound()
+	require.NotEqual(t, round.Id, nextRound.Id)
+	require.Equal(t, domain.RegistrationStage, nextRound.Stage.Code)
+	require.Equal(t, round, svc.getFinalizingRound())
+}
+
+func TestEndFinalization(t *testing.T) {
+	fixtures := []struct {
+		name           string
+		failed         bool
+		expectedLocked bool
+	}{
+		{
+			// the inputs of a finalized round are released once spent
+			name:           "finalized",
+			expectedLocked: true,
+		},
+		{
+			name:           "failed",
+			failed:         true,
+			expectedLocked: false,
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			svc := &covenantlessService{
+				paymentRequests: newPaymentsMap(
+					newTestRepoManager(t).PaymentRequests(),
+				),
+				roundsLock:       &sync.RWMutex{},
+				finalizationLock: &sync.Mutex{},
+			}
+
+			round := domain.NewRound(0)
+			_, err := round.StartRegistration()
+			require.NoError(t, err)
+			payment := domain.NewPaymentUnsafe(
+				[]domain.Vtxo{{VtxoKey: domain.VtxoKey{Txid: leafTxid, VOut: 0}}},
+				nil,
+			)
+			svc.paymentRequests.lockInputs(round.Id, []domain.Payment{*payment})
+			if f.failed {
+				round.Fail(fmt.Errorf("failed"))
+			}
+
+			svc.finalizationLock.Lock()
+			svc.finalizingRound = round
+			svc.endFinalization(round)
+
+			require.Nil(t, svc.getFinalizingRound())
+			require.True(t, svc.finalizationLock.TryLock())
+			require.Equal(t, f.expectedLocked, len(svc.paymentRequests.lockedInputs) > 0)
+		})
+	}
+}
+
+// blockingTrigger never ends the registration stage.
+type blockingTrigger struct{}
+
+func (t *blockingTrigger) wait() {
+	select {}
+}
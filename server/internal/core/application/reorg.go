@@ -0,0 +1,269 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+// reorgHandler listens for the chain reorganisations notified by the scanner
+// and rolls back the state of the rounds, vtxos and sweeps that relied on the
+// confirmation of the txs not included in the new best chain:
+//   - the txs broadcasted by the ASP are broadcasted again, if still valid and
+//     not in the mempool;
+//   - the sweeps of the rounds whose tx is unconfirmed are rescheduled, since
+//     their shared outputs expire relative to the new confirmation;
+//   - the vtxos of the sweep txs not valid anymore are restored and swept again;
+//   - the vtxos redeemed onchain by txs not valid anymore are restored.
+type reorgHandler struct {
+	scanner       ports.BlockchainScanner
+	repoManager   ports.RepoManager
+	sweeper       *sweeper
+	txMonitor     TxMonitor
+	roundLifetime int64
+	// pauseLock is held for reading while handling a reorg, see notPaused.
+	pauseLock *sync.RWMutex
+
+	cancel context.CancelFunc
+}
+
+func newReorgHandler(
+	scanner ports.BlockchainScanner, repoManager ports.RepoManager,
+	sweeper *sweeper, txMonitor TxMonitor, roundLifetime int64,
+	pauseLock *sync.RWMutex,
+) *reorgHandler {
+	return &reorgHandler{
+		scanner:       scanner,
+		repoManager:   repoManager,
+		sweeper:       sweeper,
+		txMonitor:     txMonitor,
+		roundLifetime: roundLifetime,
+		pauseLock:     pauseLock,
+	}
+}
+
+func (h *reorgHandler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reorgs, err := h.scanner.GetReorgNotificationChannel(ctx)
+	if err != nil {
+		cancel()
+		log.WithError(err).Warn(
+			"failed to listen for chain reorgs, they won't be handled",
+		)
+		return
+	}
+
+	h.cancel = cancel
+	go func() {
+		for reorg := range reorgs {
+			notPaused(h.pauseLock, func() { h.handleReorg(ctx, reorg) })()
+		}
+	}()
+}
+
+func (h *reorgHandler) stop() {
+	if h.cancel != nil {
+		h.cancel()
+	}
+}
+
+func (h *reorgHandler) handleReorg(ctx context.Context, reorg ports.Reorg) {
+	log.Warnf(
+		"chain reorg, %d blocks disconnected with %d txs",
+		reorg.DisconnectedBlocks, len(reorg.Txids),
+	)
+
+	sweepsByTxid, err := h.getSweepsByTxid(ctx)
+	if err != nil {
+		log.WithError(err).Warn("failed to get sweeps")
+	}
+	roundsByTxid, err := h.getRoundsByTxid(ctx)
+	if err != nil {
+		log.WithError(err).Warn("failed to get rounds")
+	}
+	redeemedVtxosByTxid, err := h.getRedeemedVtxosByTxid(ctx)
+	if err != nil {
+		log.WithError(err).Warn("failed to get redeemed vtxos")
+	}
+
+	// only the txs known by the ASP are affected. The txs redeeming vtxos are
+	// always checked since they're not broadcasted by the ASP and the wallet
+	// may not report them.
+	knownTxids := make(map[string]struct{})
+	for _, txid := range reorg.Txids {
+		_, isSweepTx := sweepsByTxid[txid]
+		_, isRoundTx := roundsByTxid[txid]
+		if isSweepTx || isRoundTx {
+			knownTxids[txid] = struct{}{}
+		}
+	}
+	for txid := range redeemedVtxosByTxid {
+		knownTxids[txid] = struct{}{}
+	}
+
+	// of those, only the txs not included again in the new best chain are
+	// affected, and they're still valid if they can be confirmed again.
+	validTxs := make(map[string]bool)
+	for txid := range knownTxids {
+		isConfirmed, _, err := h.scanner.IsTransactionConfirmed(ctx, txid)
+		if err != nil {
+			log.WithError(err).Warnf("failed to check confirmation of tx %s", txid)
+			continue
+		}
+		if !isConfirmed {
+			validTxs[txid] = h.isTxValid(ctx, txid)
+		}
+	}
+	if len(validTxs) <= 0 {
+		return
+	}
+
+	// the sweep txs not valid anymore are rolled back before rescheduling the
+	// sweeps of the rounds, otherwise their retry would override the new
+	// schedule of a round whose tx is unconfirmed as well.
+	for txid, isValid := range validTxs {
+		if isValid {
+			continue
+		}
+		for _, sweep := range sweepsByTxid[txid] {
+			if err := h.sweeper.rollback(ctx, sweep, txid); err != nil {
+				log.WithError(err).Warnf("failed to roll back sweep %s", sweep.Id)
+			}
+		}
+	}
+
+	for txid, isValid := range validTxs {
+		if round, ok := roundsByTxid[txid]; ok {
+			h.rescheduleSweep(&round, isValid)
+		}
+	}
+
+	redeemedVtxos := make([]domain.VtxoKey, 0)
+	for txid, isValid := range validTxs {
+		if !isValid {
+			redeemedVtxos = append(redeemedVtxos, redeemedVtxosByTxid[txid]...)
+		}
+	}
+	if len(redeemedVtxos) > 0 {
+		if err := h.repoManager.Vtxos().UnredeemVtxos(
+			ctx, redeemedVtxos,
+		); err != nil {
+			log.WithError(err).Warn("failed to unredeem vtxos")
+			return
+		}
+		log.Debugf("restored %d vtxos redeemed onchain", len(redeemedVtxos))
+	}
+}
+
+// isTxValid returns whether the given unconfirmed tx can still be confirmed,
+// ie. it's in the mempool, or its inputs are unspent and it's accepted again
+// once broadcasted. Only the txs broadcasted by the ASP can be broadcasted
+// again.
+func (h *reorgHandler) isTxValid(ctx context.Context, txid string) bool {
+	isInMempool, err := h.scanner.IsTransactionPublished(ctx, txid)
+	if err != nil {
+		log.WithError(err).Warnf("failed to check if tx %s is in mempool", txid)
+	}
+	if isInMempool {
+		return true
+	}
+
+	if err := h.txMonitor.Rebroadcast(ctx, txid); err != nil {
+		log.WithError(err).Debugf("failed to broadcast again tx %s", txid)
+		return false
+	}
+	log.Debugf("broadcasted again tx %s", txid)
+	return true
+}
+
+// rescheduleSweep postpones the sweep of the given round, whose tx got
+// unconfirmed, as if it was confirmed in the next block.
+func (h *reorgHandler) rescheduleSweep(round *domain.Round, isValid bool) {
+	if !isValid {
+		log.Warnf(
+			"round %s tx %s disconnected by a reorg, waiting for it to confirm again",
+			round.Id, round.Txid,
+		)
+	}
+	if len(round.CongestionTree) <= 0 {
+		return
+	}
+
+	expirationTimestamp := h.sweeper.nextExpiration(h.roundLifetime)
+	if err := h.sweeper.reschedule(
+		expirationTimestamp, round.Txid, round.CongestionTree,
+	); err != nil {
+		log.WithError(err).Warnf("failed to reschedule sweep of round %s", round.Id)
+	}
+}
+
+// getSweepsByTxid returns the attempted sweeps, mapped by the txid of the
+// sweep txs.
+func (h *reorgHandler) getSweepsByTxid(
+	ctx context.Context,
+) (map[string][]domain.Sweep, error) {
+	sweeps, err := h.repoManager.Sweeps().GetAllSweeps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sweepsByTxid := make(map[string][]domain.Sweep)
+	for _, sweep := range sweeps {
+		sweepTxids := make(map[string]struct{})
+		for _, output := range sweep.Outputs {
+			if len(output.SweepTxid) <= 0 {
+				continue
+			}
+			if _, ok := sweepTxids[output.SweepTxid]; ok {
+				continue
+			}
+			sweepTxids[output.SweepTxid] = struct{}{}
+			sweepsByTxid[output.SweepTxid] = append(
+				sweepsByTxid[output.SweepTxid], sweep,
+			)
+		}
+	}
+	return sweepsByTxid, nil
+}
+
+// getRoundsByTxid returns the rounds not swept yet, including those of the
+// boarding txs, mapped by their txid. The txs of the swept ones are buried too
+// deep to be affected by a reorg.
+func (h *reorgHandler) getRoundsByTxid(
+	ctx context.Context,
+) (map[string]domain.Round, error) {
+	rounds, err := h.repoManager.Rounds().GetSweepableRounds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roundsByTxid := make(map[string]domain.Round)
+	for _, round := range rounds {
+		roundsByTxid[round.Txid] = round
+	}
+	return roundsByTxid, nil
+}
+
+// getRedeemedVtxosByTxid returns the vtxos redeemed onchain, mapped by the
+// txid of their tx.
+func (h *reorgHandler) getRedeemedVtxosByTxid(
+	ctx context.Context,
+) (map[string][]domain.VtxoKey, error) {
+	redeemed := true
+	vtxos, _, err := h.repoManager.Vtxos().ListVtxos(
+		ctx, domain.VtxoFilter{Redeemed: &redeemed}, domain.Page{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vtxosByTxid := make(map[string][]domain.VtxoKey)
+	for _, vtxo := range vtxos {
+		vtxosByTxid[vtxo.Txid] = append(vtxosByTxid[vtxo.Txid], vtxo.VtxoKey)
+	}
+	return vtxosByTxid, nil
+}
@@ -0,0 +1,400 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/common/tree"
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/ark-network/ark/server/internal/infrastructure/db"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	roundTxid       = "0000000000000000000000000000000000000000000000000000000000000001"
+	leafTxid        = "0000000000000000000000000000000000000000000000000000000000000002"
+	sweepTxid       = "0000000000000000000000000000000000000000000000000000000000000003"
+	redeemTxid      = "0000000000000000000000000000000000000000000000000000000000000004"
+	reconfirmedTxid = "0000000000000000000000000000000000000000000000000000000000000005"
+	unknownTxid     = "0000000000000000000000000000000000000000000000000000000000000006"
+	roundLifetime   = int64(512)
+)
+
+func TestReorgHandler(t *testing.T) {
+	t.Run("round tx disconnected", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRepoManager(t)
+		defer repoManager.Close()
+
+		sweep := domain.NewSweep(leafTxid, roundTxid, time.Now().Unix())
+		require.NoError(t, repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep))
+		require.NoError(t, repoManager.Vtxos().RedeemVtxos(
+			ctx, []domain.VtxoKey{{Txid: redeemTxid, VOut: 0}},
+		))
+
+		scanner := newMockedScanner(reconfirmedTxid)
+		txMonitor := newMockedTxMonitor(roundTxid)
+		scheduler := &mockedScheduler{}
+		handler := newTestReorgHandler(repoManager, scanner, txMonitor, scheduler)
+		handler.start()
+		defer handler.stop()
+
+		// the redeem tx is not reported by the wallet, but it's checked anyway
+		scanner.reorgs <- ports.Reorg{
+			DisconnectedBlocks: 2,
+			Txids:              []string{roundTxid, reconfirmedTxid, unknownTxid},
+		}
+
+		require.Eventually(t, func() bool {
+			vtxos, err := repoManager.Vtxos().GetVtxos(
+				ctx, []domain.VtxoKey{{Txid: redeemTxid, VOut: 0}},
+			)
+			return err == nil && len(vtxos) == 1 && !vtxos[0].Redeemed
+		}, 5*time.Second, 100*time.Millisecond)
+
+		require.Equal(t, []string{roundTxid}, txMonitor.rebroadcasted())
+		require.ElementsMatch(
+			t, []string{roundTxid, redeemTxid}, scanner.checkedTxs(),
+		)
+
+		// the sweep of the round is postponed relative to the new confirmation
+		tasks := scheduler.tasks()
+		require.Len(t, tasks, 1)
+		require.GreaterOrEqual(t, tasks[0], sweep.ScheduledAt+roundLifetime)
+
+		sweep, err := repoManager.Sweeps().GetSweep(ctx, leafTxid)
+		require.NoError(t, err)
+		require.Equal(t, domain.SweepScheduled, sweep.Status)
+		require.Equal(t, tasks[0], sweep.ScheduledAt)
+	})
+
+	t.Run("sweep tx disconnected", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRepoManager(t)
+		defer repoManager.Close()
+
+		vtxo := domain.VtxoKey{Txid: leafTxid, VOut: 0}
+		require.NoError(t, repoManager.Vtxos().SweepVtxos(
+			ctx, []domain.VtxoKey{vtxo},
+		))
+		round, err := repoManager.Rounds().GetRoundWithTxid(ctx, roundTxid)
+		require.NoError(t, err)
+		round.Sweep()
+		require.NoError(t, repoManager.Rounds().AddOrUpdateRound(ctx, *round))
+
+		sweep := domain.NewSweep(leafTxid, roundTxid, time.Now().Unix())
+		require.NoError(t, sweep.Complete([]domain.SweepOutput{
+			{VtxoKey: vtxo, Amount: 1000, SweepTxid: sweepTxid},
+		}))
+		require.NoError(t, repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep))
+
+		// the sweep tx is neither in the mempool nor known by the monitor, its
+		// inputs are spent by a tx of the new best chain
+		scanner := newMockedScanner()
+		txMonitor := newMockedTxMonitor()
+		scheduler := &mockedScheduler{}
+		handler := newTestReorgHandler(repoManager, scanner, txMonitor, scheduler)
+		handler.start()
+		defer handler.stop()
+
+		scanner.reorgs <- ports.Reorg{
+			DisconnectedBlocks: 1,
+			Txids:              []string{sweepTxid},
+		}
+
+		require.Eventually(t, func() bool {
+			sweep, err := repoManager.Sweeps().GetSweep(ctx, leafTxid)
+			// the sweep is retried straight away, failing since the tree outputs
+			// are unconfirmed
+			return err == nil && sweep.Status == domain.SweepFailed &&
+				strings.HasPrefix(sweep.Error, "failed to inspect congestion tree")
+		}, 5*time.Second, 100*time.Millisecond)
+
+		vtxos, err := repoManager.Vtxos().GetVtxos(ctx, []domain.VtxoKey{vtxo})
+		require.NoError(t, err)
+		require.Len(t, vtxos, 1)
+		require.False(t, vtxos[0].Swept)
+
+		round, err = repoManager.Rounds().GetRoundWithTxid(ctx, roundTxid)
+		require.NoError(t, err)
+		require.False(t, round.Swept)
+
+		require.Empty(t, txMonitor.rebroadcasted())
+		require.Empty(t, scheduler.tasks())
+	})
+
+	t.Run("txs in mempool", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRepoManager(t)
+		defer repoManager.Close()
+
+		vtxo := domain.VtxoKey{Txid: leafTxid, VOut: 0}
+		require.NoError(t, repoManager.Vtxos().SweepVtxos(
+			ctx, []domain.VtxoKey{vtxo},
+		))
+		sweep := domain.NewSweep(leafTxid, roundTxid, time.Now().Unix())
+		require.NoError(t, sweep.Complete([]domain.SweepOutput{
+			{VtxoKey: vtxo, Amount: 1000, SweepTxid: sweepTxid},
+		}))
+		require.NoError(t, repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep))
+		redeemedVtxo := domain.VtxoKey{Txid: redeemTxid, VOut: 0}
+		require.NoError(t, repoManager.Vtxos().RedeemVtxos(
+			ctx, []domain.VtxoKey{redeemedVtxo},
+		))
+
+		// the txs are valid even if not known by the monitor
+		scanner := newMockedScanner()
+		scanner.mempoolTxs = map[string]struct{}{
+			sweepTxid: {}, redeemTxid: {},
+		}
+		txMonitor := newMockedTxMonitor()
+		scheduler := &mockedScheduler{}
+		handler := newTestReorgHandler(repoManager, scanner, txMonitor, scheduler)
+
+		handler.handleReorg(ctx, ports.Reorg{
+			DisconnectedBlocks: 1,
+			Txids:              []string{sweepTxid},
+		})
+
+		sweep, err := repoManager.Sweeps().GetSweep(ctx, leafTxid)
+		require.NoError(t, err)
+		require.Equal(t, domain.SweepCompleted, sweep.Status)
+
+		vtxos, err := repoManager.Vtxos().GetVtxos(
+			ctx, []domain.VtxoKey{vtxo, redeemedVtxo},
+		)
+		require.NoError(t, err)
+		require.Len(t, vtxos, 2)
+		for _, v := range vtxos {
+			require.True(t, v.Swept || v.Redeemed)
+		}
+
+		require.Empty(t, txMonitor.rebroadcasted())
+		require.Empty(t, scheduler.tasks())
+	})
+
+	t.Run("reconfirmed txs", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRepoManager(t)
+		defer repoManager.Close()
+
+		vtxo := domain.VtxoKey{Txid: redeemTxid, VOut: 0}
+		require.NoError(t, repoManager.Vtxos().RedeemVtxos(
+			ctx, []domain.VtxoKey{vtxo},
+		))
+
+		scanner := newMockedScanner(roundTxid, redeemTxid)
+		txMonitor := newMockedTxMonitor(roundTxid)
+		scheduler := &mockedScheduler{}
+		handler := newTestReorgHandler(repoManager, scanner, txMonitor, scheduler)
+
+		handler.handleReorg(ctx, ports.Reorg{
+			DisconnectedBlocks: 1,
+			Txids:              []string{roundTxid, redeemTxid},
+		})
+
+		vtxos, err := repoManager.Vtxos().GetVtxos(ctx, []domain.VtxoKey{vtxo})
+		require.NoError(t, err)
+		require.Len(t, vtxos, 1)
+		require.True(t, vtxos[0].Redeemed)
+
+		require.Empty(t, txMonitor.rebroadcasted())
+		require.Empty(t, scheduler.tasks())
+	})
+}
+
+// newTestRepoManager returns in-memory repositories with a round, whose
+// congestion tree has a single leaf, and its vtxo plus a redeemed one.
+func newTestRepoManager(t *testing.T) ports.RepoManager {
+	repoManager, err := db.NewService(db.ServiceConfig{
+		EventStoreType:   "badger",
+		DataStoreType:    "badger",
+		EventStoreConfig: []interface{}{"", nil},
+		DataStoreConfig:  []interface{}{"", nil},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	round := domain.Round{
+		Id:    "round",
+		Stage: domain.Stage{Code: domain.FinalizationStage, Ended: true},
+		Txid:  roundTxid,
+		CongestionTree: tree.CongestionTree{
+			{{Txid: leafTxid, ParentTxid: roundTxid, Leaf: true}},
+		},
+	}
+	require.NoError(t, repoManager.Rounds().AddOrUpdateRound(ctx, round))
+
+	require.NoError(t, repoManager.Vtxos().AddVtxos(ctx, []domain.Vtxo{
+		{
+			VtxoKey:  domain.VtxoKey{Txid: leafTxid, VOut: 0},
+			Receiver: domain.Receiver{Amount: 1000},
+			PoolTx:   roundTxid,
+		},
+		{
+			VtxoKey:  domain.VtxoKey{Txid: redeemTxid, VOut: 0},
+			Receiver: domain.Receiver{Amount: 1000},
+			PoolTx:   roundTxid,
+		},
+	}))
+	return repoManager
+}
+
+func newTestReorgHandler(
+	repoManager ports.RepoManager, scanner *mockedScanner,
+	txMonitor *mockedTxMonitor, scheduler *mockedScheduler,
+) *reorgHandler {
+	pauseLock := &sync.RWMutex{}
+	sweeper := newSweeper(
+		&mockedWallet{}, repoManager, nil, scheduler, txMonitor, SweepBatching{},
+		pauseLock,
+	)
+	return newReorgHandler(
+		scanner, repoManager, sweeper, txMonitor, roundLifetime, pauseLock,
+	)
+}
+
+// mockedScanner simulates a chain where only the given txs are confirmed, and
+// the mempool ones are published, and notifies the reorgs sent through its
+// channel.
+type mockedScanner struct {
+	ports.BlockchainScanner
+	confirmedTxs map[string]struct{}
+	mempoolTxs   map[string]struct{}
+	// publishedErr, if set, is returned when checking if a tx is published.
+	publishedErr error
+	reorgs       chan ports.Reorg
+	txids        []string
+	lock         sync.Mutex
+}
+
+func newMockedScanner(confirmedTxids ...string) *mockedScanner {
+	confirmedTxs := make(map[string]struct{})
+	for _, txid := range confirmedTxids {
+		confirmedTxs[txid] = struct{}{}
+	}
+	return &mockedScanner{
+		confirmedTxs: confirmedTxs,
+		reorgs:       make(chan ports.Reorg),
+	}
+}
+
+func (m *mockedScanner) IsTransactionConfirmed(
+	_ context.Context, txid string,
+) (bool, int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.txids = append(m.txids, txid)
+	_, ok := m.confirmedTxs[txid]
+	return ok, 0, nil
+}
+
+func (m *mockedScanner) IsTransactionPublished(
+	_ context.Context, txid string,
+) (bool, error) {
+	if m.publishedErr != nil {
+		return false, m.publishedErr
+	}
+	_, ok := m.mempoolTxs[txid]
+	return ok, nil
+}
+
+func (m *mockedScanner) checkedTxs() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.txids
+}
+
+func (m *mockedScanner) GetReorgNotificationChannel(
+	_ context.Context,
+) (<-chan ports.Reorg, error) {
+	return m.reorgs, nil
+}
+
+// mockedWallet sees no tx confirmed, so that no tree can be swept.
+type mockedWallet struct {
+	ports.WalletService
+}
+
+func (m *mockedWallet) IsTransactionConfirmed(
+	_ context.Context, _ string,
+) (bool, int64, error) {
+	return false, 0, nil
+}
+
+func (m *mockedWallet) EstimateFeeRate(_ context.Context) (uint64, error) {
+	return 0, fmt.Errorf("not implemented")
+}
+
+// mockedTxMonitor can broadcast again only the given txs.
+type mockedTxMonitor struct {
+	TxMonitor
+	knownTxs map[string]struct{}
+	txids    []string
+	lock     sync.Mutex
+}
+
+func newMockedTxMonitor(knownTxids ...string) *mockedTxMonitor {
+	knownTxs := make(map[string]struct{})
+	for _, txid := range knownTxids {
+		knownTxs[txid] = struct{}{}
+	}
+	return &mockedTxMonitor{knownTxs: knownTxs}
+}
+
+func (m *mockedTxMonitor) Rebroadcast(_ context.Context, txid string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.knownTxs[txid]; !ok {
+		return fmt.Errorf("tx %s not found", txid)
+	}
+	m.txids = append(m.txids, txid)
+	return nil
+}
+
+func (m *mockedTxMonitor) rebroadcasted() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.txids
+}
+
+// mockedScheduler records the scheduled tasks without ever running them. Its
+// clock is the wall one, unless a median time is given.
+type mockedScheduler struct {
+	ports.SchedulerService
+	medianTime  int64
+	scheduledAt []int64
+	lock        sync.Mutex
+}
+
+func (m *mockedScheduler) ScheduleTaskOnce(at int64, _ func()) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.scheduledAt = append(m.scheduledAt, at)
+	return nil
+}
+
+func (m *mockedScheduler) Unit() ports.TimeUnit {
+	if m.medianTime > 0 {
+		return ports.MedianTime
+	}
+	return ports.UnixTime
+}
+
+func (m *mockedScheduler) Now() int64 {
+	if m.medianTime > 0 {
+		return m.medianTime
+	}
+	return time.Now().Unix()
+}
+
+func (m *mockedScheduler) tasks() []int64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.scheduledAt
+}
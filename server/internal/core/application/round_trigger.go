@@ -0,0 +1,128 @@
+package application
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// RoundTriggerInterval ends the registration stage of every round after
+	// half the round interval.
+	RoundTriggerInterval = "interval"
+	// RoundTriggerThreshold ends the registration stage as soon as enough
+	// payments, or enough funds, are ready, or when the max wait expires.
+	RoundTriggerThreshold = "threshold"
+	// RoundTriggerOnDemand ends the registration stage as soon as any payment
+	// is ready. Meant for regtest and tests.
+	RoundTriggerOnDemand = "on-demand"
+)
+
+// RoundTrigger is the policy that decides when the registration stage of a
+// round ends and the finalization can start.
+type RoundTrigger struct {
+	Type string
+	// MinPayments and MinAmount are the number of ready payments and their
+	// total amount that start the finalization. Used only by the threshold
+	// policy, where a zero value disables the related check.
+	MinPayments int64
+	MinAmount   uint64
+	// MaxWait is the max time in seconds waited by the threshold policy.
+	MaxWait int64
+}
+
+func (t RoundTrigger) validate() error {
+	switch t.Type {
+	case RoundTriggerInterval, RoundTriggerOnDemand:
+		return nil
+	case RoundTriggerThreshold:
+		if t.MinPayments < 0 {
+			return fmt.Errorf("invalid min payments, must be at least 0")
+		}
+		if t.MinPayments == 0 && t.MinAmount == 0 {
+			return fmt.Errorf("either min payments or min amount must be greater than 0")
+		}
+		if t.MaxWait <= 0 {
+			return fmt.Errorf("invalid max wait, must be greater than 0")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown round trigger type %s", t.Type)
+	}
+}
+
+// roundTrigger blocks until the registration stage of the current round can
+// end.
+type roundTrigger interface {
+	wait()
+}
+
+func newRoundTrigger(
+	config RoundTrigger, roundInterval int64, payments *paymentsMap,
+	withEphemeralKey bool,
+) (roundTrigger, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	switch config.Type {
+	case RoundTriggerThreshold:
+		return &thresholdTrigger{
+			payments:         payments,
+			withEphemeralKey: withEphemeralKey,
+			minPayments:      config.MinPayments,
+			minAmount:        config.MinAmount,
+			maxWait:          time.Duration(config.MaxWait) * time.Second,
+		}, nil
+	case RoundTriggerOnDemand:
+		return &thresholdTrigger{
+			payments:         payments,
+			withEphemeralKey: withEphemeralKey,
+			minPayments:      1,
+		}, nil
+	default:
+		return &intervalTrigger{
+			interval: time.Duration(roundInterval/2) * time.Second,
+		}, nil
+	}
+}
+
+type intervalTrigger struct {
+	interval time.Duration
+}
+
+func (t *intervalTrigger) wait() {
+	time.Sleep(t.interval)
+}
+
+// thresholdTrigger re-evaluates the ready payments every time the queue is
+// updated. A zero max wait means it waits indefinitely for the thresholds.
+type thresholdTrigger struct {
+	payments         *paymentsMap
+	withEphemeralKey bool
+	minPayments      int64
+	minAmount        uint64
+	maxWait          time.Duration
+}
+
+func (t *thresholdTrigger) wait() {
+	var timeout <-chan time.Time
+	if t.maxWait > 0 {
+		timeout = time.After(t.maxWait)
+	}
+
+	for {
+		count, amount := t.payments.ready(t.withEphemeralKey)
+		if t.minPayments > 0 && count >= t.minPayments {
+			return
+		}
+		if t.minAmount > 0 && amount >= t.minAmount {
+			return
+		}
+
+		select {
+		case <-timeout:
+			return
+		case <-t.payments.updated:
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTriggerValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		fixtures := []struct {
+			name    string
+			trigger RoundTrigger
+		}{
+			{
+				name:    "interval",
+				trigger: RoundTrigger{Type: RoundTriggerInterval},
+			},
+			{
+				name:    "on_demand",
+				trigger: RoundTrigger{Type: RoundTriggerOnDemand},
+			},
+			{
+				name: "threshold_min_payments",
+				trigger: RoundTrigger{
+					Type: RoundTriggerThreshold, MinPayments: 2, MaxWait: 10,
+				},
+			},
+			{
+				name: "threshold_min_amount",
+				trigger: RoundTrigger{
+					Type: RoundTriggerThreshold, MinAmount: 1000, MaxWait: 10,
+				},
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				require.NoError(t, f.trigger.validate())
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fixtures := []struct {
+			name        string
+			trigger     RoundTrigger
+			expectedErr string
+		}{
+			{
+				name:        "unknown_type",
+				trigger:     RoundTrigger{Type: "unknown"},
+				expectedErr: "unknown round trigger type unknown",
+			},
+			{
+				name: "negative_min_payments",
+				trigger: RoundTrigger{
+					Type: RoundTriggerThreshold, MinPayments: -1, MaxWait: 10,
+				},
+				expectedErr: "invalid min payments, must be at least 0",
+			},
+			{
+				name:        "no_thresholds",
+				trigger:     RoundTrigger{Type: RoundTriggerThreshold, MaxWait: 10},
+				expectedErr: "either min payments or min amount must be greater than 0",
+			},
+			{
+				name: "no_max_wait",
+				trigger: RoundTrigger{
+					Type: RoundTriggerThreshold, MinPayments: 2,
+				},
+				expectedErr: "invalid max wait, must be greater than 0",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				err := f.trigger.validate()
+				require.EqualError(t, err, f.expectedErr)
+
+				_, err = newRoundTrigger(f.trigger, 10, nil, false)
+				require.EqualError(t, err, f.expectedErr)
+			})
+		}
+	})
+}
+
+func TestNewRoundTrigger(t *testing.T) {
+	payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+
+	fixtures := []struct {
+		name     string
+		config   RoundTrigger
+		expected roundTrigger
+	}{
+		{
+			name:     "interval",
+			config:   RoundTrigger{Type: RoundTriggerInterval},
+			expected: &intervalTrigger{interval: 5 * time.Second},
+		},
+		{
+			name: "threshold",
+			config: RoundTrigger{
+				Type: RoundTriggerThreshold, MinPayments: 2, MinAmount: 1000,
+				MaxWait: 30,
+			},
+			expected: &thresholdTrigger{
+				payments:         payments,
+				withEphemeralKey: true,
+				minPayments:      2,
+				minAmount:        1000,
+				maxWait:          30 * time.Second,
+			},
+		},
+		{
+			name:   "on_demand",
+			config: RoundTrigger{Type: RoundTriggerOnDemand},
+			expected: &thresholdTrigger{
+				payments:         payments,
+				withEphemeralKey: true,
+				minPayments:      1,
+			},
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			trigger, err := newRoundTrigger(f.config, 10, payments, true)
+			require.NoError(t, err)
+			require.Equal(t, f.expected, trigger)
+		})
+	}
+}
+
+func TestThresholdTriggerWait(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	fixtures := []struct {
+		name             string
+		minPayments      int64
+		minAmount        uint64
+		withEphemeralKey bool
+		// amounts are those of the payments pushed while waiting, the trigger
+		// must return only after the last one.
+		amounts []uint64
+	}{
+		{
+			name:        "min_payments",
+			minPayments: 3,
+			amounts:     []uint64{1000, 1000, 1000},
+		},
+		{
+			name:      "min_amount",
+			minAmount: 5000,
+			amounts:   []uint64{2000, 2000, 1000},
+		},
+		{
+			name:        "min_amount_before_min_payments",
+			minPayments: 3,
+			minAmount:   5000,
+			amounts:     []uint64{1000, 4000},
+		},
+		{
+			name:             "with_ephemeral_key",
+			minPayments:      2,
+			withEphemeralKey: true,
+			amounts:          []uint64{1000, 1000},
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+			trigger := &thresholdTrigger{
+				payments:         payments,
+				withEphemeralKey: f.withEphemeralKey,
+				minPayments:      f.minPayments,
+				minAmount:        f.minAmount,
+			}
+
+			done := make(chan struct{})
+			go func() {
+				trigger.wait()
+				close(done)
+			}()
+
+			for i, amount := range f.amounts {
+				requireNotDone(t, done)
+
+				paymentId := pushReadyPayment(t, payments, uint32(i), amount)
+				if f.withEphemeralKey {
+					// a payment without ephemeral key is not counted
+					requireNotDone(t, done)
+					require.NoError(t, payments.pushEphemeralKey(
+						paymentId, key.PubKey(),
+					))
+				}
+			}
+			requireDone(t, done)
+		})
+	}
+
+	t.Run("max_wait", func(t *testing.T) {
+		payments := newPaymentsMap(newTestRepoManager(t).PaymentRequests())
+		trigger := &thresholdTrigger{
+			payments:    payments,
+			minPayments: 2,
+			maxWait:     300 * time.Millisecond,
+		}
+
+		done := make(chan struct{})
+		go func() {
+			trigger.wait()
+			close(done)
+		}()
+
+		pushReadyPayment(t, payments, 0, 1000)
+		requireNotDone(t, done)
+		requireDone(t, done)
+	})
+}
+
+// pushReadyPayment queues a payment with receivers for the given amount and
+// pings it, and returns its id.
+func pushReadyPayment(
+	t *testing.T, payments *paymentsMap, vout uint32, amount uint64,
+) string {
+	payment := domain.NewPaymentUnsafe(
+		[]domain.Vtxo{{
+			VtxoKey:  domain.VtxoKey{Txid: leafTxid, VOut: vout},
+			Receiver: domain.Receiver{Amount: amount},
+		}},
+		[]domain.Receiver{{Pubkey: "pubkey", Amount: amount}},
+	)
+	require.NoError(t, payments.push(*payment))
+	require.NoError(t, payments.updatePingTimestamp(payment.Id))
+	return payment.Id
+}
@@ -3,14 +3,66 @@ package application
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/btcsuite/btcd/wire"
 	log "github.com/sirupsen/logrus"
 )
 
+// Rough estimate of the sweep tx weight: the base weight accounts for the tx
+// overhead and the wallet output, while every input adds its outpoint and the
+// witness revealing the sweep leaf.
+const (
+	sweepTxBaseWeight  = 4 * (11 + 43)
+	sweepInputWeight   = 4 * (36 + 1 + 4)
+	sweepWitnessWeight = 1 + 1 + 64
+)
+
+// SweepBatching configures how the expired shared outputs are aggregated into
+// sweep txs.
+type SweepBatching struct {
+	// Window is the time in seconds waited, once a shared output expires, for
+	// others to expire and be swept in the same tx. Zero disables batching.
+	Window int64
+	// MaxTxWeight is the max estimated weight of a sweep tx, the outputs that
+	// don't fit are swept in other txs.
+	MaxTxWeight int64
+}
+
+func (b SweepBatching) validate() error {
+	if b.Window < 0 {
+		return fmt.Errorf("invalid window, must be at least 0")
+	}
+	if b.MaxTxWeight <= sweepTxBaseWeight {
+		return fmt.Errorf(
+			"invalid max tx weight, must be greater than %d", sweepTxBaseWeight,
+		)
+	}
+	return nil
+}
+
+// pendingSweep is an expired shared output waiting to be swept, along with
+// the vtxos of the round it commits to and the id of the scheduled sweep it
+// was collected by.
+type pendingSweep struct {
+	sweepId   string
+	roundTxid string
+	input     ports.SweepInput
+	vtxos     []domain.VtxoKey
+}
+
+func (p pendingSweep) weight() int64 {
+	leafScript, controlBlock := p.input.GetLeafScript(), p.input.GetControlBlock()
+	witnessWeight := sweepWitnessWeight +
+		wire.VarIntSerializeSize(uint64(len(leafScript))) + len(leafScript) +
+		wire.VarIntSerializeSize(uint64(len(controlBlock))) + len(controlBlock)
+	return int64(sweepInputWeight + witnessWeight)
+}
+
 // sweeper is an unexported service running while the main application service is started
 // it is responsible for sweeping onchain shared outputs that expired
 // it also handles delaying the sweep events in case some parts of the tree are broadcasted
@@ -20,9 +72,20 @@ type sweeper struct {
 	repoManager ports.RepoManager
 	builder     ports.TxBuilder
 	scheduler   ports.SchedulerService
+	txMonitor   TxMonitor
 
 	// cache of scheduled tasks, avoid scheduling the same sweep event multiple times
-	scheduledTasks map[string]struct{}
+	// it maps the tree root txid to the time the task is scheduled at
+	scheduledTasks map[string]int64
+
+	batching SweepBatching
+	// pendingSweeps are the expired shared outputs collected during the
+	// current batching window.
+	pendingSweeps []pendingSweep
+	// lock guards both the scheduled tasks and the pending sweeps.
+	lock *sync.Mutex
+	// pauseLock is held for reading by the sweep tasks, see notPaused.
+	pauseLock *sync.RWMutex
 }
 
 func newSweeper(
@@ -30,25 +93,96 @@ func newSweeper(
 	repoManager ports.RepoManager,
 	builder ports.TxBuilder,
 	scheduler ports.SchedulerService,
+	txMonitor TxMonitor,
+	batching SweepBatching,
+	pauseLock *sync.RWMutex,
 ) *sweeper {
 	return &sweeper{
 		wallet,
 		repoManager,
 		builder,
 		scheduler,
-		make(map[string]struct{}),
+		txMonitor,
+		make(map[string]int64),
+		batching,
+		nil,
+		&sync.Mutex{},
+		pauseLock,
 	}
 }
 
+// start restores the stored schedule of the sweeps still to be done. Those
+// that failed are retried straight away, like the ones whose time came while
+// the service was down.
+// The trees of the rounds without any stored sweep are inspected from scratch.
 func (s *sweeper) start() error {
-	s.scheduler.Start()
+	if err := s.scheduler.Start(); err != nil {
+		return fmt.Errorf("failed to start scheduler: %s", err)
+	}
 
-	allRounds, err := s.repoManager.Rounds().GetSweepableRounds(context.Background())
+	ctx := context.Background()
+	allRounds, err := s.repoManager.Rounds().GetSweepableRounds(ctx)
+	if err != nil {
+		return err
+	}
+	sweeps, err := s.repoManager.Sweeps().GetAllSweeps(ctx)
 	if err != nil {
 		return err
 	}
 
+	roundsByTxid := make(map[string]domain.Round)
+	for _, round := range allRounds {
+		roundsByTxid[round.Txid] = round
+	}
+
+	roundsWithSweeps := make(map[string]struct{})
+	for _, sweep := range sweeps {
+		roundsWithSweeps[sweep.RoundTxid] = struct{}{}
+		if !sweep.IsPending() {
+			continue
+		}
+
+		round, ok := roundsByTxid[sweep.RoundTxid]
+		if !ok {
+			// the round has been swept in the meantime
+			continue
+		}
+
+		congestionTree, err := computeSubTree(round.CongestionTree, sweep.Id)
+		if err != nil {
+			log.WithError(err).Errorf("error while restoring sweep %s", sweep.Id)
+			continue
+		}
+
+		if sweep.Status == domain.SweepFailed ||
+			sweep.ScheduledAt <= s.scheduler.Now() {
+			task := s.createTask(sweep.RoundTxid, congestionTree)
+			task()
+			continue
+		}
+
+		if err := s.scheduleTask(
+			sweep.ScheduledAt, sweep.RoundTxid, congestionTree,
+		); err != nil {
+			log.WithError(err).Errorf("error while restoring sweep %s", sweep.Id)
+		}
+	}
+
 	for _, round := range allRounds {
+		if _, ok := roundsWithSweeps[round.Txid]; ok {
+			continue
+		}
+
+		root, err := round.CongestionTree.Root()
+		if err != nil {
+			continue
+		}
+
+		sweep := domain.NewSweep(root.Txid, round.Txid, s.scheduler.Now())
+		if err := s.repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep); err != nil {
+			log.WithError(err).Warn("failed to store sweep")
+		}
+
 		task := s.createTask(round.Txid, round.CongestionTree)
 		task()
 	}
@@ -60,11 +194,69 @@ func (s *sweeper) stop() {
 	s.scheduler.Stop()
 }
 
+// addTask update the cached map of scheduled tasks
+func (s *sweeper) addTask(treeRootTxid string, scheduledAt int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.scheduledTasks[treeRootTxid] = scheduledAt
+}
+
 // removeTask update the cached map of scheduled tasks
 func (s *sweeper) removeTask(treeRootTxid string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	delete(s.scheduledTasks, treeRootTxid)
 }
 
+func (s *sweeper) isScheduled(treeRootTxid string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, scheduled := s.scheduledTasks[treeRootTxid]
+	return scheduled
+}
+
+func (s *sweeper) isScheduledAt(treeRootTxid string, scheduledAt int64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	at, scheduled := s.scheduledTasks[treeRootTxid]
+	return scheduled && at == scheduledAt
+}
+
+// scheduleTask sets up the sweep task of the given congestion tree at the
+// given timestamp. The task is skipped if, in the meantime, the sweep is
+// rescheduled at a different time.
+func (s *sweeper) scheduleTask(
+	scheduledAt int64, roundTxid string, congestionTree tree.CongestionTree,
+) error {
+	root, err := congestionTree.Root()
+	if err != nil {
+		return err
+	}
+
+	// the task is cached in advance since the scheduler may run it right away
+	s.addTask(root.Txid, scheduledAt)
+	task := s.createTask(roundTxid, congestionTree)
+	if err := s.scheduler.ScheduleTaskOnce(scheduledAt, notPaused(s.pauseLock, func() {
+		if s.isScheduledAt(root.Txid, scheduledAt) {
+			task()
+		}
+	})); err != nil {
+		s.removeTask(root.Txid)
+		return err
+	}
+	return nil
+}
+
+// nextExpiration returns the time, in the clock of the scheduler, at which
+// the outputs with the given relative timelock expire if confirmed in the next
+// block. The wall clock gets a margin since the block may take a while.
+func (s *sweeper) nextExpiration(lifetime int64) int64 {
+	if s.scheduler.Unit() == ports.MedianTime {
+		return s.scheduler.Now() + lifetime
+	}
+	return s.scheduler.Now() + lifetime + 30
+}
+
 // schedule set up a task to be executed once at the given timestamp
 func (s *sweeper) schedule(
 	expirationTimestamp int64, roundTxid string, congestionTree tree.CongestionTree,
@@ -79,19 +271,24 @@ func (s *sweeper) schedule(
 		return err
 	}
 
-	if _, scheduled := s.scheduledTasks[root.Txid]; scheduled {
+	if s.isScheduled(root.Txid) {
 		return nil
 	}
 
-	task := s.createTask(roundTxid, congestionTree)
+	// the sweep is stored in advance since the scheduler may run it right away
+	sweep := domain.NewSweep(root.Txid, roundTxid, expirationTimestamp)
+	if err := s.repoManager.Sweeps().AddOrUpdateSweep(
+		context.Background(), *sweep,
+	); err != nil {
+		log.WithError(err).Warn("failed to store scheduled sweep")
+	}
+
 	fancyTime := time.Unix(expirationTimestamp, 0).Format("2006-01-02 15:04:05")
 	log.Debugf("scheduled sweep for round %s at %s", roundTxid, fancyTime)
-	if err := s.scheduler.ScheduleTaskOnce(expirationTimestamp, task); err != nil {
+	if err := s.scheduleTask(expirationTimestamp, roundTxid, congestionTree); err != nil {
 		return err
 	}
 
-	s.scheduledTasks[root.Txid] = struct{}{}
-
 	if err := s.updateVtxoExpirationTime(congestionTree, expirationTimestamp); err != nil {
 		log.WithError(err).Error("error while updating vtxo expiration time")
 	}
@@ -99,8 +296,102 @@ func (s *sweeper) schedule(
 	return nil
 }
 
+// reschedule replaces the sweep task of the given congestion tree, if any,
+// with one executed at the given timestamp.
+func (s *sweeper) reschedule(
+	expirationTimestamp int64, roundTxid string, congestionTree tree.CongestionTree,
+) error {
+	root, err := congestionTree.Root()
+	if err != nil {
+		return err
+	}
+
+	s.removeTask(root.Txid)
+	return s.schedule(expirationTimestamp, roundTxid, congestionTree)
+}
+
+// rollback restores the vtxos swept by the given tx, disconnected from the
+// chain by a reorg and not valid anymore, and retries the sweep straight away.
+func (s *sweeper) rollback(
+	ctx context.Context, sweep domain.Sweep, sweepTxid string,
+) error {
+	round, err := s.repoManager.Rounds().GetRoundWithTxid(ctx, sweep.RoundTxid)
+	if err != nil {
+		return fmt.Errorf("failed to get round: %s", err)
+	}
+
+	sweptVtxos := make([]domain.VtxoKey, 0)
+	outputs := make([]domain.SweepOutput, 0, len(sweep.Outputs))
+	for _, output := range sweep.Outputs {
+		if output.SweepTxid == sweepTxid {
+			vtxos, err := s.findVtxos(ctx, round.CongestionTree, output.VtxoKey)
+			if err != nil {
+				return err
+			}
+			sweptVtxos = append(sweptVtxos, vtxos...)
+			output.SweepTxid = ""
+		}
+		outputs = append(outputs, output)
+	}
+
+	if err := s.repoManager.Vtxos().UnsweepVtxos(ctx, sweptVtxos); err != nil {
+		return fmt.Errorf("failed to unsweep vtxos: %s", err)
+	}
+	if round.Swept {
+		round.Unsweep()
+		if err := s.repoManager.Rounds().AddOrUpdateRound(ctx, *round); err != nil {
+			return fmt.Errorf("failed to unsweep round: %s", err)
+		}
+	}
+
+	if err := sweep.Fail(
+		outputs, fmt.Sprintf("sweep tx %s disconnected by a reorg", sweepTxid),
+	); err != nil {
+		return err
+	}
+	if err := s.repoManager.Sweeps().AddOrUpdateSweep(ctx, sweep); err != nil {
+		return fmt.Errorf("failed to store sweep: %s", err)
+	}
+	log.Debugf("rolled back %d vtxos swept by tx %s", len(sweptVtxos), sweepTxid)
+
+	congestionTree, err := computeSubTree(round.CongestionTree, sweep.Id)
+	if err != nil {
+		return err
+	}
+	task := s.createTask(sweep.RoundTxid, congestionTree)
+	task()
+	return nil
+}
+
+// findVtxos returns the vtxos committed to by the given shared output of the
+// congestion tree, or the output itself if it's a vtxo.
+func (s *sweeper) findVtxos(
+	ctx context.Context, congestionTree tree.CongestionTree, output domain.VtxoKey,
+) ([]domain.VtxoKey, error) {
+	if vtxos, _ := s.repoManager.Vtxos().GetVtxos(
+		ctx, []domain.VtxoKey{output},
+	); len(vtxos) > 0 {
+		return []domain.VtxoKey{output}, nil
+	}
+
+	leaves, err := s.builder.FindLeaves(congestionTree, output.Txid, output.VOut)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vtxos leaves: %s", err)
+	}
+
+	vtxos := make([]domain.VtxoKey, 0, len(leaves))
+	for _, leaf := range leaves {
+		vtxo, err := extractVtxoOutpoint(leaf)
+		if err != nil {
+			return nil, err
+		}
+		vtxos = append(vtxos, *vtxo)
+	}
+	return vtxos, nil
+}
+
 // createTask returns a function passed as handler in the scheduler
-// it tries to craft a sweep tx containing the onchain outputs of the given congestion tree
+// it collects the expired onchain outputs of the given congestion tree to be swept in the current batch
 // if some parts of the tree have been broadcasted in the meantine, it will schedule the next taskes for the remaining parts of the tree
 func (s *sweeper) createTask(
 	roundTxid string, congestionTree tree.CongestionTree,
@@ -116,19 +407,24 @@ func (s *sweeper) createTask(
 		s.removeTask(root.Txid)
 		log.Debugf("sweeper: %s", root.Txid)
 
-		sweepInputs := make([]ports.SweepInput, 0)
-		vtxoKeys := make([]domain.VtxoKey, 0) // vtxos associated to the sweep inputs
+		sweeps := make([]pendingSweep, 0)
 
 		// inspect the congestion tree to find onchain shared outputs
-		sharedOutputs, err := findSweepableOutputs(ctx, s.wallet, s.builder, congestionTree)
+		sharedOutputs, err := findSweepableOutputs(
+			ctx, s.wallet, s.builder, s.scheduler.Unit(), congestionTree,
+		)
 		if err != nil {
 			log.WithError(err).Error("error while inspecting congestion tree")
+			s.updateSweep(
+				ctx, root.Txid, nil,
+				fmt.Sprintf("failed to inspect congestion tree: %s", err),
+			)
 			return
 		}
 
 		for expiredAt, inputs := range sharedOutputs {
 			// if the shared outputs are not expired, schedule a sweep task for it
-			if time.Unix(expiredAt, 0).After(time.Now()) {
+			if expiredAt > s.scheduler.Now() {
 				subtrees, err := computeSubTrees(congestionTree, inputs)
 				if err != nil {
 					log.WithError(err).Error("error while computing subtrees")
@@ -136,7 +432,10 @@ func (s *sweeper) createTask(
 				}
 
 				for _, subTree := range subtrees {
-					// mitigate the risk to get BIP68 non-final errors by scheduling the task 30 seconds after the expiration time
+					// a block scheduler runs the task once the output is BIP68 final,
+					// while a wall clock one may run it slightly earlier since the
+					// median time past lags behind, in that case the broadcast of the
+					// sweep tx is retried
 					if err := s.schedule(int64(expiredAt), roundTxid, subTree); err != nil {
 						log.WithError(err).Error("error while scheduling sweep task")
 						continue
@@ -190,7 +489,8 @@ func (s *sweeper) createTask(
 					firstVtxo, err := s.repoManager.Vtxos().GetVtxos(ctx, sweepableVtxos[:1])
 					if err != nil {
 						log.Error(fmt.Errorf("error while getting vtxo: %w", err))
-						sweepInputs = append(sweepInputs, input) // add the input anyway in order to try to sweep it
+						// add the input anyway in order to try to sweep it
+						sweeps = append(sweeps, pendingSweep{root.Txid, roundTxid, input, nil})
 						continue
 					}
 
@@ -202,80 +502,247 @@ func (s *sweeper) createTask(
 				}
 
 				if len(sweepableVtxos) > 0 {
-					vtxoKeys = append(vtxoKeys, sweepableVtxos...)
-					sweepInputs = append(sweepInputs, input)
+					sweeps = append(sweeps, pendingSweep{root.Txid, roundTxid, input, sweepableVtxos})
 				}
 			}
 		}
 
-		vtxosRepository := s.repoManager.Vtxos()
-		if len(sweepInputs) > 0 {
-			// build the sweep transaction with all the expired non-swept shared outputs
-			sweepTx, err := s.builder.BuildSweepTx(sweepInputs)
-			if err != nil {
-				log.WithError(err).Error("error while building sweep tx")
-				return
-			}
+		if len(sweeps) <= 0 {
+			// nothing to sweep, the expired outputs have been spent by unilateral
+			// exits and the others, if any, are scheduled as sub trees
+			s.updateSweep(ctx, root.Txid, nil, "")
+			s.updateSweptRound(ctx, roundTxid)
+			return
+		}
+		s.addToBatch(sweeps)
+	}
+}
 
-			err = nil
-			txid := ""
-			// retry until the tx is broadcasted or the error is not BIP68 final
-			for len(txid) == 0 && (err == nil || err == ports.ErrNonFinalBIP68) {
-				if err != nil {
-					log.Debugln("sweep tx not BIP68 final, retrying in 5 seconds")
-					time.Sleep(5 * time.Second)
-				}
+// addToBatch adds the given expired outputs to the current batch. The first
+// ones open the batching window, at the end of which they're all swept.
+func (s *sweeper) addToBatch(sweeps []pendingSweep) {
+	s.lock.Lock()
+	isNewBatch := len(s.pendingSweeps) <= 0
+	for _, sweep := range sweeps {
+		if !s.isPending(sweep.input) {
+			s.pendingSweeps = append(s.pendingSweeps, sweep)
+		}
+	}
+	s.lock.Unlock()
 
-				txid, err = s.wallet.BroadcastTransaction(ctx, sweepTx)
-			}
+	if s.batching.Window <= 0 {
+		s.flush()
+		return
+	}
+	if !isNewBatch {
+		return
+	}
 
+	// the window is measured in wall clock time, not through the scheduler
+	// that may follow the chain time instead
+	time.AfterFunc(
+		time.Duration(s.batching.Window)*time.Second, notPaused(s.pauseLock, s.flush),
+	)
+}
+
+// isPending returns whether the given output is already in the current
+// batch. It must be called with the lock held.
+func (s *sweeper) isPending(input ports.SweepInput) bool {
+	for _, sweep := range s.pendingSweeps {
+		if sweep.input.GetHash() == input.GetHash() &&
+			sweep.input.GetIndex() == input.GetIndex() {
+			return true
+		}
+	}
+	return false
+}
+
+// flush sweeps the outputs of the current batch in as many txs as required
+// to stay within the max tx weight, falling back to one tx per output for
+// those that can't be broadcasted, then records the outcome of the sweeps
+// they were collected by and updates the rounds they belong to.
+func (s *sweeper) flush() {
+	s.lock.Lock()
+	sweeps := s.pendingSweeps
+	s.pendingSweeps = nil
+	s.lock.Unlock()
+
+	if len(sweeps) <= 0 {
+		return
+	}
+
+	ctx := context.Background()
+	outputs := make(map[string][]domain.SweepOutput)
+	failures := make(map[string]string)
+	addOutputs := func(batch []pendingSweep, txid string, err error) {
+		for _, sweep := range batch {
+			outputs[sweep.sweepId] = append(outputs[sweep.sweepId], domain.SweepOutput{
+				VtxoKey: domain.VtxoKey{
+					Txid: sweep.input.GetHash().String(),
+					VOut: sweep.input.GetIndex(),
+				},
+				Amount:    sweep.input.GetAmount(),
+				SweepTxid: txid,
+			})
 			if err != nil {
-				log.WithError(err).Error("error while broadcasting sweep tx")
-				return
+				failures[sweep.sweepId] = err.Error()
 			}
-			if len(txid) > 0 {
-				log.Debugln("sweep tx broadcasted:", txid)
+		}
+	}
 
-				// mark the vtxos as swept
-				if err := vtxosRepository.SweepVtxos(ctx, vtxoKeys); err != nil {
-					log.Error(fmt.Errorf("error while deleting vtxos: %w", err))
-					return
+	for _, batch := range splitSweeps(sweeps, s.batching.MaxTxWeight) {
+		txid, err := s.sweep(ctx, batch)
+		// a single bad output makes the whole batch tx invalid, therefore, if
+		// it couldn't be broadcasted, the outputs are swept one by one so that
+		// only the offending ones fail
+		if err != nil && len(txid) <= 0 && len(batch) > 1 {
+			log.WithError(err).Warn(
+				"failed to sweep batch, sweeping its outputs one by one",
+			)
+			for _, sweep := range batch {
+				single := []pendingSweep{sweep}
+				txid, err := s.sweep(ctx, single)
+				if err != nil {
+					log.WithError(err).Errorf(
+						"error while sweeping output %s:%d",
+						sweep.input.GetHash(), sweep.input.GetIndex(),
+					)
 				}
-
-				log.Debugf("%d vtxos swept", len(vtxoKeys))
+				addOutputs(single, txid, err)
 			}
+			continue
 		}
+		if err != nil {
+			log.WithError(err).Error("error while sweeping batch")
+		}
+		addOutputs(batch, txid, err)
+	}
 
-		roundVtxos, err := vtxosRepository.GetVtxosForRound(ctx, roundTxid)
+	for sweepId, sweepOutputs := range outputs {
+		s.updateSweep(ctx, sweepId, sweepOutputs, failures[sweepId])
+	}
+
+	roundTxids := make(map[string]struct{})
+	for _, sweep := range sweeps {
+		if _, ok := roundTxids[sweep.roundTxid]; ok {
+			continue
+		}
+		roundTxids[sweep.roundTxid] = struct{}{}
+		s.updateSweptRound(ctx, sweep.roundTxid)
+	}
+}
+
+// sweep broadcasts a tx spending the given expired outputs and marks their
+// vtxos as swept. The txid is returned also in case the vtxos couldn't be
+// marked after the broadcast.
+func (s *sweeper) sweep(
+	ctx context.Context, sweeps []pendingSweep,
+) (string, error) {
+	sweepInputs := make([]ports.SweepInput, 0, len(sweeps))
+	vtxoKeys := make([]domain.VtxoKey, 0) // vtxos associated to the sweep inputs
+	for _, sweep := range sweeps {
+		sweepInputs = append(sweepInputs, sweep.input)
+		vtxoKeys = append(vtxoKeys, sweep.vtxos...)
+	}
+
+	sweepTx, err := s.builder.BuildSweepTx(sweepInputs, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to build sweep tx: %s", err)
+	}
+
+	err = nil
+	txid := ""
+	// retry until the tx is broadcasted or the error is not BIP68 final
+	for len(txid) == 0 && (err == nil || err == ports.ErrNonFinalBIP68) {
 		if err != nil {
-			log.WithError(err).Error("error while getting vtxos for round")
-			return
+			log.Debugln("sweep tx not BIP68 final, retrying in 5 seconds")
+			time.Sleep(5 * time.Second)
 		}
 
-		allSwept := true
-		for _, vtxo := range roundVtxos {
-			allSwept = allSwept && (vtxo.Swept || vtxo.Redeemed)
-			if !allSwept {
-				break
-			}
+		txid, err = s.wallet.BroadcastTransaction(ctx, sweepTx)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to broadcast sweep tx: %s", err)
+	}
+
+	log.Debugf("sweep tx broadcasted: %s (%d inputs)", txid, len(sweepInputs))
+	s.txMonitor.Track(TxTypeSweep, txid, sweepTx, sweepInputs...)
+
+	// mark the vtxos as swept
+	if err := s.repoManager.Vtxos().SweepVtxos(ctx, vtxoKeys); err != nil {
+		return txid, fmt.Errorf("failed to mark vtxos as swept: %s", err)
+	}
+
+	log.Debugf("%d vtxos swept", len(vtxoKeys))
+	return txid, nil
+}
+
+// updateSweep records the outcome of the sweep with the given id, either
+// completed or failed for the given reason. Nothing is recorded if the sweep
+// has been scheduled again in the meantime, the outcome of the next attempt
+// will be.
+func (s *sweeper) updateSweep(
+	ctx context.Context, sweepId string, outputs []domain.SweepOutput,
+	failureReason string,
+) {
+	if s.isScheduled(sweepId) {
+		return
+	}
+
+	sweep, err := s.repoManager.Sweeps().GetSweep(ctx, sweepId)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get sweep %s", sweepId)
+		return
+	}
+
+	if len(failureReason) > 0 {
+		err = sweep.Fail(outputs, failureReason)
+	} else {
+		err = sweep.Complete(outputs)
+	}
+	if err != nil {
+		log.WithError(err).Warnf("failed to update sweep %s", sweepId)
+		return
+	}
+
+	if err := s.repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep); err != nil {
+		log.WithError(err).Warnf("failed to store sweep %s", sweepId)
+	}
+}
+
+// updateSweptRound marks the round with the given txid as swept once all
+// its vtxos are either swept or redeemed.
+func (s *sweeper) updateSweptRound(ctx context.Context, roundTxid string) {
+	vtxosRepository := s.repoManager.Vtxos()
+	roundVtxos, err := vtxosRepository.GetVtxosForRound(ctx, roundTxid)
+	if err != nil {
+		log.WithError(err).Error("error while getting vtxos for round")
+		return
+	}
+
+	allSwept := true
+	for _, vtxo := range roundVtxos {
+		allSwept = allSwept && (vtxo.Swept || vtxo.Redeemed)
+		if !allSwept {
+			break
 		}
+	}
 
-		if allSwept {
-			// update the round
-			roundRepo := s.repoManager.Rounds()
-			round, err := roundRepo.GetRoundWithTxid(ctx, roundTxid)
-			if err != nil {
-				log.WithError(err).Error("error while getting round")
-				return
-			}
+	if allSwept {
+		// update the round
+		roundRepo := s.repoManager.Rounds()
+		round, err := roundRepo.GetRoundWithTxid(ctx, roundTxid)
+		if err != nil {
+			log.WithError(err).Error("error while getting round")
+			return
+		}
 
-			log.Debugf("round %s fully swept", roundTxid)
-			round.Sweep()
+		log.Debugf("round %s fully swept", roundTxid)
+		round.Sweep()
 
-			if err := roundRepo.AddOrUpdateRound(ctx, *round); err != nil {
-				log.WithError(err).Error("error while marking round as swept")
-				return
-			}
+		if err := roundRepo.AddOrUpdateRound(ctx, *round); err != nil {
+			log.WithError(err).Error("error while marking round as swept")
+			return
 		}
 	}
 }
@@ -299,6 +766,28 @@ func (s *sweeper) updateVtxoExpirationTime(
 	return s.repoManager.Vtxos().UpdateExpireAt(context.Background(), vtxos, expirationTime)
 }
 
+// splitSweeps groups the given expired outputs so that the estimated weight
+// of every sweep tx doesn't exceed the given max.
+func splitSweeps(sweeps []pendingSweep, maxTxWeight int64) [][]pendingSweep {
+	batches := make([][]pendingSweep, 0)
+	batch := make([]pendingSweep, 0)
+	weight := int64(sweepTxBaseWeight)
+	for _, sweep := range sweeps {
+		sweepWeight := sweep.weight()
+		if len(batch) > 0 && weight+sweepWeight > maxTxWeight {
+			batches = append(batches, batch)
+			batch = make([]pendingSweep, 0)
+			weight = sweepTxBaseWeight
+		}
+		batch = append(batch, sweep)
+		weight += sweepWeight
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
 func computeSubTrees(congestionTree tree.CongestionTree, inputs []ports.SweepInput) ([]tree.CongestionTree, error) {
 	subTrees := make(map[string]tree.CongestionTree, 0)
 
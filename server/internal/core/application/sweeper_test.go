@@ -0,0 +1,207 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweeperNextExpiration(t *testing.T) {
+	medianTime := int64(1720000000)
+
+	fixtures := []struct {
+		name      string
+		scheduler *mockedScheduler
+		min       int64
+		max       int64
+	}{
+		{
+			name:      "wall_clock",
+			scheduler: &mockedScheduler{},
+			min:       time.Now().Unix() + roundLifetime + 30,
+			max:       time.Now().Unix() + roundLifetime + 31,
+		},
+		{
+			name:      "median_time",
+			scheduler: &mockedScheduler{medianTime: medianTime},
+			min:       medianTime + roundLifetime,
+			max:       medianTime + roundLifetime,
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			sweeper := newSweeper(
+				nil, nil, nil, f.scheduler, nil, SweepBatching{}, &sync.RWMutex{},
+			)
+
+			expiration := sweeper.nextExpiration(roundLifetime)
+			require.GreaterOrEqual(t, expiration, f.min)
+			require.LessOrEqual(t, expiration, f.max)
+		})
+	}
+}
+
+func TestSplitSweeps(t *testing.T) {
+	// every sweep without leaf script and control block weighs 232, the max
+	// tx weight fits 2 of them
+	maxTxWeight := int64(sweepTxBaseWeight + 2*232)
+
+	fixtures := []struct {
+		name        string
+		sweeps      []pendingSweep
+		maxTxWeight int64
+		expected    [][]uint32
+	}{
+		{
+			name:        "empty",
+			sweeps:      []pendingSweep{},
+			maxTxWeight: maxTxWeight,
+			expected:    [][]uint32{},
+		},
+		{
+			name: "single_batch",
+			sweeps: []pendingSweep{
+				newTestPendingSweep(t, 0, 0), newTestPendingSweep(t, 1, 0),
+			},
+			maxTxWeight: maxTxWeight,
+			expected:    [][]uint32{{0, 1}},
+		},
+		{
+			name: "multiple_batches",
+			sweeps: []pendingSweep{
+				newTestPendingSweep(t, 0, 0), newTestPendingSweep(t, 1, 0),
+				newTestPendingSweep(t, 2, 0), newTestPendingSweep(t, 3, 0),
+				newTestPendingSweep(t, 4, 0),
+			},
+			maxTxWeight: maxTxWeight,
+			expected:    [][]uint32{{0, 1}, {2, 3}, {4}},
+		},
+		{
+			// the leaf script doubles the weight of the second sweep
+			name: "heavier_sweep",
+			sweeps: []pendingSweep{
+				newTestPendingSweep(t, 0, 0), newTestPendingSweep(t, 1, 232),
+				newTestPendingSweep(t, 2, 0),
+			},
+			maxTxWeight: maxTxWeight,
+			expected:    [][]uint32{{0}, {1}, {2}},
+		},
+		{
+			// a sweep exceeding the max weight on its own is swept anyway
+			name: "oversized_sweeps",
+			sweeps: []pendingSweep{
+				newTestPendingSweep(t, 0, 0), newTestPendingSweep(t, 1, 0),
+			},
+			maxTxWeight: sweepTxBaseWeight + 1,
+			expected:    [][]uint32{{0}, {1}},
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			batches := splitSweeps(f.sweeps, f.maxTxWeight)
+			require.Equal(t, f.expected, pendingSweepsIndexes(batches))
+		})
+	}
+}
+
+func TestSweeperAddToBatch(t *testing.T) {
+	maxTxWeight := int64(sweepTxBaseWeight + 2*232)
+
+	t.Run("batching_window", func(t *testing.T) {
+		builder := &mockedSweepTxBuilder{}
+		sweeper := newSweeper(
+			nil, nil, builder, nil, nil,
+			SweepBatching{Window: 3600, MaxTxWeight: maxTxWeight},
+			&sync.RWMutex{},
+		)
+
+		sweeper.addToBatch([]pendingSweep{
+			newTestPendingSweep(t, 0, 0), newTestPendingSweep(t, 1, 0),
+		})
+		// the outputs already in the batch are not added again
+		sweeper.addToBatch([]pendingSweep{
+			newTestPendingSweep(t, 1, 0), newTestPendingSweep(t, 2, 0),
+		})
+
+		require.Equal(
+			t, [][]uint32{{0, 1, 2}},
+			pendingSweepsIndexes([][]pendingSweep{sweeper.pendingSweeps}),
+		)
+		require.Empty(t, builder.feeRates)
+	})
+
+	t.Run("no_batching_window", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRepoManager(t)
+		defer repoManager.Close()
+
+		sweep := domain.NewSweep(leafTxid, roundTxid, time.Now().Unix())
+		require.NoError(t, repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep))
+
+		wallet := &mockedTxWallet{txid: sweepTxid}
+		builder := &mockedSweepTxBuilder{}
+		txMonitor, err := NewTxMonitor(
+			wallet, builder, newMockedScanner(), repoManager,
+			TxBumping{MaxFeeRate: 10000},
+		)
+		require.NoError(t, err)
+		sweeper := newSweeper(
+			wallet, repoManager, builder, &mockedScheduler{}, txMonitor,
+			SweepBatching{MaxTxWeight: maxTxWeight}, &sync.RWMutex{},
+		)
+
+		// the outputs are swept right away, in as many txs as required
+		sweeper.addToBatch([]pendingSweep{
+			newTestPendingSweep(t, 0, 0), newTestPendingSweep(t, 1, 0),
+			newTestPendingSweep(t, 2, 0),
+		})
+
+		require.Empty(t, sweeper.pendingSweeps)
+		require.Len(t, builder.feeRates, 2)
+		require.Len(t, wallet.broadcasted, 2)
+
+		sweep, err = repoManager.Sweeps().GetSweep(ctx, leafTxid)
+		require.NoError(t, err)
+		require.Equal(t, domain.SweepCompleted, sweep.Status)
+		require.Len(t, sweep.Outputs, 3)
+		for _, output := range sweep.Outputs {
+			require.Equal(t, sweepTxid, output.SweepTxid)
+		}
+	})
+}
+
+// newTestPendingSweep returns a sweep of the output of the leaf tx at the
+// given index, with a leaf script of the given size.
+func newTestPendingSweep(
+	t *testing.T, vout uint32, leafScriptSize int,
+) pendingSweep {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	input, err := newSweepTxInput(domain.SweepTxInput{
+		VtxoKey:     domain.VtxoKey{Txid: leafTxid, VOut: vout},
+		Amount:      1000,
+		LeafScript:  make([]byte, leafScriptSize),
+		InternalKey: key.PubKey().SerializeCompressed(),
+	})
+	require.NoError(t, err)
+	return pendingSweep{sweepId: leafTxid, roundTxid: roundTxid, input: input}
+}
+
+func pendingSweepsIndexes(batches [][]pendingSweep) [][]uint32 {
+	indexes := make([][]uint32, 0, len(batches))
+	for _, batch := range batches {
+		batchIndexes := make([]uint32, 0, len(batch))
+		for _, sweep := range batch {
+			batchIndexes = append(batchIndexes, sweep.input.GetIndex())
+		}
+		indexes = append(indexes, batchIndexes)
+	}
+	return indexes
+}
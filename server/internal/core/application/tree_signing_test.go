@@ -0,0 +1,105 @@
+package application
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ark-network/ark/common/bitcointree"
+	"github.com/ark-network/ark/common/tree"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeSigningSession(t *testing.T) {
+	alice, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	bob, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	cosigners := []*secp256k1.PublicKey{alice.PubKey(), bob.PubKey()}
+	bobKey := hex.EncodeToString(bob.PubKey().SerializeCompressed())
+
+	t.Run("valid", func(t *testing.T) {
+		session := newTreeSigningSession(&mockedCoordinator{}, cosigners)
+
+		require.NoError(t, session.addNonces(alice.PubKey(), nil))
+		require.Exactly(t, []string{bobKey}, session.missingNonces())
+		require.NoError(t, session.addNonces(bob.PubKey(), nil))
+		requireClosed(t, session.noncesDoneC)
+		require.Empty(t, session.missingNonces())
+
+		require.NoError(t, session.addSignatures(alice.PubKey(), nil))
+		require.Exactly(t, []string{bobKey}, session.missingSignatures())
+		require.NoError(t, session.addSignatures(bob.PubKey(), nil))
+		requireClosed(t, session.sigsDoneC)
+		require.Empty(t, session.missingSignatures())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("not_a_cosigner", func(t *testing.T) {
+			session := newTreeSigningSession(&mockedCoordinator{}, cosigners)
+			other, err := secp256k1.GeneratePrivateKey()
+			require.NoError(t, err)
+
+			err = session.addNonces(other.PubKey(), nil)
+			require.ErrorContains(t, err, "is not a cosigner of the tree")
+		})
+
+		t.Run("invalid_signatures", func(t *testing.T) {
+			session := newTreeSigningSession(
+				&mockedCoordinator{invalidSigner: bob.PubKey()}, cosigners,
+			)
+			require.NoError(t, session.addNonces(alice.PubKey(), nil))
+			require.NoError(t, session.addNonces(bob.PubKey(), nil))
+
+			require.NoError(t, session.addSignatures(alice.PubKey(), nil))
+			err := session.addSignatures(bob.PubKey(), nil)
+			require.ErrorIs(t, err, bitcointree.ErrInvalidPartialSignature)
+
+			// the session is done since nobody else is expected to sign, the
+			// invalid signer is reported as missing and can't sign again
+			requireClosed(t, session.sigsDoneC)
+			require.Exactly(t, []string{bobKey}, session.missingSignatures())
+
+			err = session.addSignatures(bob.PubKey(), nil)
+			require.ErrorContains(t, err, "invalid signatures already submitted")
+		})
+	})
+}
+
+func requireClosed(t *testing.T, ch chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	default:
+		t.Fatal("expected channel to be closed")
+	}
+}
+
+// mockedCoordinator accepts any nonces and signatures, except the signatures
+// of the invalid signer.
+type mockedCoordinator struct {
+	invalidSigner *secp256k1.PublicKey
+}
+
+func (m *mockedCoordinator) AddNonce(
+	*secp256k1.PublicKey, bitcointree.TreeNonces,
+) error {
+	return nil
+}
+
+func (m *mockedCoordinator) AggregateNonces() (bitcointree.TreeNonces, error) {
+	return nil, nil
+}
+
+func (m *mockedCoordinator) AddSig(
+	pubkey *secp256k1.PublicKey, _ bitcointree.TreePartialSigs,
+) error {
+	if m.invalidSigner != nil && m.invalidSigner.IsEqual(pubkey) {
+		return bitcointree.ErrInvalidPartialSignature
+	}
+	return nil
+}
+
+func (m *mockedCoordinator) SignTree() (tree.CongestionTree, error) {
+	return nil, nil
+}
@@ -0,0 +1,548 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	TxTypePool      = "pool"
+	TxTypeSweep     = "sweep"
+	TxTypeForfeit   = "forfeit"
+	TxTypeConnector = "connector"
+
+	// txMonitorInterval is how often the confirmation of the pending txs is
+	// checked.
+	txMonitorInterval = time.Minute
+	// feeBumpIncrement is the percentage every bump adds to the highest
+	// between the estimated fee rate and the one of the previous bump.
+	feeBumpIncrement = 25
+	// confirmedTxRetention is how long the confirmed txs are kept, to be
+	// broadcasted again if disconnected from the chain by a reorg.
+	confirmedTxRetention = 24 * time.Hour
+)
+
+// TxBumping configures the fee bumping of the txs broadcasted by the ASP that
+// are stuck in the mempool.
+type TxBumping struct {
+	// Deadline is the time in seconds a tx is given to confirm, since its
+	// broadcast or its last bump, before its fee gets bumped. Zero disables
+	// the automatic bumps, leaving only the manual ones.
+	Deadline int64
+	// MaxFeeRate is the max fee rate, in sats per kvbyte, reachable with the
+	// automatic bumps.
+	MaxFeeRate uint64
+}
+
+func (b TxBumping) validate() error {
+	if b.Deadline < 0 {
+		return fmt.Errorf("invalid deadline, must be at least 0")
+	}
+	if b.MaxFeeRate <= 0 {
+		return fmt.Errorf("invalid max fee rate, must be greater than 0")
+	}
+	return nil
+}
+
+// PendingTx is a tx broadcasted by the ASP and not confirmed yet.
+type PendingTx struct {
+	Txid          string
+	Type          string
+	BroadcastedAt int64
+	// BumpedAt is the time of the last fee bump, zero if never bumped.
+	BumpedAt int64
+	// FeeRate is the fee rate in sats per kvbyte of the last bump.
+	FeeRate uint64
+	// ChildTxid is the txid of the child tx broadcasted with the last bump,
+	// if done with CPFP.
+	ChildTxid string
+}
+
+// TxMonitor keeps track of the txs broadcasted by the ASP until they confirm,
+// and bumps their fee if they don't within the configured deadline. Once
+// confirmed, they're kept for a while to be broadcasted again in case of reorg.
+// The tracked txs are persisted to survive restarts.
+// Sweep txs are replaced (RBF) while the others are bumped by spending their
+// outputs owned by the main account with a child tx (CPFP).
+type TxMonitor interface {
+	Start()
+	Stop()
+	// Track adds a broadcasted tx to the pending ones. The inputs of sweep txs
+	// are required to replace them.
+	Track(txType, txid, txHex string, sweepInputs ...ports.SweepInput)
+	ListPendingTxs() []PendingTx
+	// BumpFee bumps the fee of the pending tx with the given txid up to the
+	// given fee rate in sats per kvbyte, or to the next one if zero. It
+	// returns the txid of the replacement or of the child tx.
+	BumpFee(ctx context.Context, txid string, feeRate uint64) (string, error)
+	// Rebroadcast broadcasts again the tx with the given txid, disconnected
+	// from the chain by a reorg, and tracks it as pending. It fails if the tx
+	// is not valid anymore, or if it's not known, ie. it was not broadcasted
+	// by the ASP or it was confirmed long ago.
+	Rebroadcast(ctx context.Context, txid string) error
+}
+
+type pendingTx struct {
+	PendingTx
+	txHex       string
+	sweepInputs []ports.SweepInput
+	confirmedAt int64
+}
+
+type txMonitor struct {
+	wallet      ports.WalletService
+	builder     ports.TxBuilder
+	scanner     ports.BlockchainScanner
+	repoManager ports.RepoManager
+	config      TxBumping
+
+	pendingTxs   map[string]*pendingTx
+	confirmedTxs map[string]*pendingTx
+	lock         *sync.Mutex
+	quit         chan struct{}
+}
+
+func NewTxMonitor(
+	wallet ports.WalletService, builder ports.TxBuilder,
+	scanner ports.BlockchainScanner, repoManager ports.RepoManager,
+	config TxBumping,
+) (TxMonitor, error) {
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid tx bumping: %s", err)
+	}
+
+	// the txs tracked before the last shutdown are restored
+	txs, err := repoManager.MonitoredTxs().GetAllMonitoredTxs(
+		context.Background(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monitored txs: %s", err)
+	}
+
+	pendingTxs := make(map[string]*pendingTx)
+	confirmedTxs := make(map[string]*pendingTx)
+	for _, tx := range txs {
+		restoredTx, err := newPendingTx(tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore tx %s: %s", tx.Txid, err)
+		}
+		if tx.IsConfirmed() {
+			confirmedTxs[tx.Txid] = restoredTx
+		} else {
+			pendingTxs[tx.Txid] = restoredTx
+		}
+	}
+
+	return &txMonitor{
+		wallet:       wallet,
+		builder:      builder,
+		scanner:      scanner,
+		repoManager:  repoManager,
+		config:       config,
+		pendingTxs:   pendingTxs,
+		confirmedTxs: confirmedTxs,
+		lock:         &sync.Mutex{},
+		quit:         make(chan struct{}),
+	}, nil
+}
+
+func (m *txMonitor) Start() {
+	go func() {
+		ticker := time.NewTicker(txMonitorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-m.quit:
+				return
+			case <-ticker.C:
+				m.checkPendingTxs()
+			}
+		}
+	}()
+}
+
+func (m *txMonitor) Stop() {
+	close(m.quit)
+}
+
+func (m *txMonitor) Track(
+	txType, txid, txHex string, sweepInputs ...ports.SweepInput,
+) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tx := &pendingTx{
+		PendingTx: PendingTx{
+			Txid:          txid,
+			Type:          txType,
+			BroadcastedAt: time.Now().Unix(),
+		},
+		txHex:       txHex,
+		sweepInputs: sweepInputs,
+	}
+	m.pendingTxs[txid] = tx
+	m.saveTx(tx)
+}
+
+func (m *txMonitor) ListPendingTxs() []PendingTx {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	txs := make([]PendingTx, 0, len(m.pendingTxs))
+	for _, tx := range m.pendingTxs {
+		txs = append(txs, tx.PendingTx)
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].BroadcastedAt < txs[j].BroadcastedAt
+	})
+	return txs
+}
+
+func (m *txMonitor) BumpFee(
+	ctx context.Context, txid string, feeRate uint64,
+) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tx, ok := m.pendingTxs[txid]
+	if !ok {
+		return "", fmt.Errorf("pending tx %s not found", txid)
+	}
+
+	if feeRate == 0 {
+		nextFeeRate, err := m.nextFeeRate(ctx, tx.FeeRate)
+		if err != nil {
+			return "", err
+		}
+		feeRate = nextFeeRate
+	}
+	if feeRate <= tx.FeeRate {
+		return "", fmt.Errorf(
+			"fee rate must be greater than the one of the last bump (%d)",
+			tx.FeeRate,
+		)
+	}
+
+	if tx.Type == TxTypeSweep && len(tx.sweepInputs) > 0 {
+		return m.replaceSweepTx(ctx, tx, feeRate)
+	}
+
+	childTxid, err := m.wallet.BumpFeeWithChild(ctx, tx.txHex, feeRate)
+	if err != nil {
+		return "", fmt.Errorf("failed to bump fee with child tx: %s", err)
+	}
+
+	tx.FeeRate = feeRate
+	tx.BumpedAt = time.Now().Unix()
+	tx.ChildTxid = childTxid
+	m.saveTx(tx)
+	return childTxid, nil
+}
+
+func (m *txMonitor) Rebroadcast(ctx context.Context, txid string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tx, ok := m.confirmedTxs[txid]
+	if !ok {
+		tx, ok = m.pendingTxs[txid]
+	}
+	if !ok {
+		return fmt.Errorf("tx %s not found", txid)
+	}
+
+	if _, err := m.wallet.BroadcastTransaction(ctx, tx.txHex); err != nil {
+		return fmt.Errorf("failed to broadcast tx %s: %s", txid, err)
+	}
+
+	delete(m.confirmedTxs, txid)
+	tx.confirmedAt = 0
+	m.pendingTxs[txid] = tx
+	m.saveTx(tx)
+	return nil
+}
+
+// replaceSweepTx broadcasts a new sweep tx spending the same inputs of the
+// given one with a higher fee rate, and tracks it in place of the old one,
+// also in the sweeps that refer to it.
+func (m *txMonitor) replaceSweepTx(
+	ctx context.Context, tx *pendingTx, feeRate uint64,
+) (string, error) {
+	sweepTx, err := m.builder.BuildSweepTx(tx.sweepInputs, feeRate)
+	if err != nil {
+		return "", fmt.Errorf("failed to build replacement sweep tx: %s", err)
+	}
+
+	txid, err := m.wallet.BroadcastTransaction(ctx, sweepTx)
+	if err != nil {
+		return "", fmt.Errorf("failed to broadcast replacement sweep tx: %s", err)
+	}
+
+	replacedTxid := tx.Txid
+	delete(m.pendingTxs, replacedTxid)
+	tx.Txid = txid
+	tx.txHex = sweepTx
+	tx.FeeRate = feeRate
+	tx.BumpedAt = time.Now().Unix()
+	m.pendingTxs[txid] = tx
+	m.saveTx(tx)
+	m.deleteTxs(replacedTxid)
+
+	if err := m.replaceSweepTxid(ctx, replacedTxid, txid); err != nil {
+		log.WithError(err).Warnf(
+			"failed to replace sweep tx %s with %s in sweeps", replacedTxid, txid,
+		)
+	}
+	return txid, nil
+}
+
+// replaceSweepTxid updates the sweep txid of the outputs of the sweeps swept
+// by the replaced tx.
+func (m *txMonitor) replaceSweepTxid(
+	ctx context.Context, replacedTxid, txid string,
+) error {
+	sweeps, err := m.repoManager.Sweeps().GetAllSweeps(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sweep := range sweeps {
+		replaced := false
+		for i, output := range sweep.Outputs {
+			if output.SweepTxid == replacedTxid {
+				sweep.Outputs[i].SweepTxid = txid
+				replaced = true
+			}
+		}
+		if !replaced {
+			continue
+		}
+		if err := m.repoManager.Sweeps().AddOrUpdateSweep(ctx, sweep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextFeeRate returns the fee rate for the next bump of a tx, capped to the
+// configured max.
+func (m *txMonitor) nextFeeRate(
+	ctx context.Context, lastFeeRate uint64,
+) (uint64, error) {
+	feeRate, err := m.wallet.EstimateFeeRate(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate fee rate: %s", err)
+	}
+	if lastFeeRate > feeRate {
+		feeRate = lastFeeRate
+	}
+
+	feeRate = feeRate * (100 + feeBumpIncrement) / 100
+	if feeRate > m.config.MaxFeeRate {
+		feeRate = m.config.MaxFeeRate
+	}
+	if feeRate <= lastFeeRate {
+		return 0, fmt.Errorf("max fee rate (%d) reached", m.config.MaxFeeRate)
+	}
+	return feeRate, nil
+}
+
+// checkPendingTxs stops tracking the confirmed txs and bumps the fee of those
+// past the deadline.
+func (m *txMonitor) checkPendingTxs() {
+	ctx := context.Background()
+	now := time.Now().Unix()
+
+	m.pruneConfirmedTxs(now)
+
+	for _, tx := range m.ListPendingTxs() {
+		isConfirmed, _, err := m.scanner.IsTransactionConfirmed(ctx, tx.Txid)
+		if err != nil {
+			log.WithError(err).Warnf(
+				"failed to check confirmation of %s tx %s", tx.Type, tx.Txid,
+			)
+			continue
+		}
+
+		if isConfirmed {
+			m.lock.Lock()
+			if confirmedTx, ok := m.pendingTxs[tx.Txid]; ok {
+				confirmedTx.confirmedAt = now
+				m.confirmedTxs[tx.Txid] = confirmedTx
+				delete(m.pendingTxs, tx.Txid)
+				m.saveTx(confirmedTx)
+			}
+			m.lock.Unlock()
+			log.Debugf("%s tx %s confirmed", tx.Type, tx.Txid)
+			continue
+		}
+
+		if m.config.Deadline <= 0 {
+			continue
+		}
+
+		lastBroadcast := tx.BroadcastedAt
+		if tx.BumpedAt > 0 {
+			lastBroadcast = tx.BumpedAt
+		}
+		if now-lastBroadcast < m.config.Deadline {
+			continue
+		}
+
+		bumpTxid, err := m.BumpFee(ctx, tx.Txid, 0)
+		if err != nil {
+			log.WithError(err).Warnf(
+				"failed to bump fee of %s tx %s", tx.Type, tx.Txid,
+			)
+			continue
+		}
+		log.Infof("bumped fee of %s tx %s with tx %s", tx.Type, tx.Txid, bumpTxid)
+	}
+}
+
+// pruneConfirmedTxs forgets the txs confirmed before the retention period.
+func (m *txMonitor) pruneConfirmedTxs(now int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	prunedTxids := make([]string, 0)
+	for txid, tx := range m.confirmedTxs {
+		if now-tx.confirmedAt > int64(confirmedTxRetention.Seconds()) {
+			delete(m.confirmedTxs, txid)
+			prunedTxids = append(prunedTxids, txid)
+		}
+	}
+	if len(prunedTxids) > 0 {
+		m.deleteTxs(prunedTxids...)
+	}
+}
+
+// saveTx persists the given tx. A failure is only logged since the tx is
+// still tracked until the next restart.
+func (m *txMonitor) saveTx(tx *pendingTx) {
+	if err := m.repoManager.MonitoredTxs().AddOrUpdateMonitoredTx(
+		context.Background(), tx.toMonitoredTx(),
+	); err != nil {
+		log.WithError(err).Warnf("failed to persist %s tx %s", tx.Type, tx.Txid)
+	}
+}
+
+// deleteTxs stops persisting the given txs.
+func (m *txMonitor) deleteTxs(txids ...string) {
+	if err := m.repoManager.MonitoredTxs().DeleteMonitoredTxs(
+		context.Background(), txids,
+	); err != nil {
+		log.WithError(err).Warnf("failed to delete txs %v", txids)
+	}
+}
+
+func newPendingTx(tx domain.MonitoredTx) (*pendingTx, error) {
+	sweepInputs := make([]ports.SweepInput, 0, len(tx.SweepInputs))
+	for _, input := range tx.SweepInputs {
+		sweepInput, err := newSweepTxInput(input)
+		if err != nil {
+			return nil, err
+		}
+		sweepInputs = append(sweepInputs, sweepInput)
+	}
+
+	return &pendingTx{
+		PendingTx: PendingTx{
+			Txid:          tx.Txid,
+			Type:          tx.Type,
+			BroadcastedAt: tx.BroadcastedAt,
+			BumpedAt:      tx.BumpedAt,
+			FeeRate:       tx.FeeRate,
+			ChildTxid:     tx.ChildTxid,
+		},
+		txHex:       tx.TxHex,
+		sweepInputs: sweepInputs,
+		confirmedAt: tx.ConfirmedAt,
+	}, nil
+}
+
+func (tx *pendingTx) toMonitoredTx() domain.MonitoredTx {
+	sweepInputs := make([]domain.SweepTxInput, 0, len(tx.sweepInputs))
+	for _, input := range tx.sweepInputs {
+		hash := input.GetHash()
+		var internalKey []byte
+		if key := input.GetInternalKey(); key != nil {
+			internalKey = key.SerializeCompressed()
+		}
+		sweepInputs = append(sweepInputs, domain.SweepTxInput{
+			VtxoKey: domain.VtxoKey{
+				Txid: hash.String(),
+				VOut: input.GetIndex(),
+			},
+			Amount:       input.GetAmount(),
+			LeafScript:   input.GetLeafScript(),
+			ControlBlock: input.GetControlBlock(),
+			InternalKey:  internalKey,
+		})
+	}
+
+	return domain.MonitoredTx{
+		Txid:          tx.Txid,
+		Type:          tx.Type,
+		TxHex:         tx.txHex,
+		BroadcastedAt: tx.BroadcastedAt,
+		BumpedAt:      tx.BumpedAt,
+		FeeRate:       tx.FeeRate,
+		ChildTxid:     tx.ChildTxid,
+		SweepInputs:   sweepInputs,
+		ConfirmedAt:   tx.confirmedAt,
+	}
+}
+
+// sweepTxInput is the input of a sweep tx restored from the db.
+type sweepTxInput struct {
+	domain.SweepTxInput
+	hash        chainhash.Hash
+	internalKey *secp256k1.PublicKey
+}
+
+func newSweepTxInput(input domain.SweepTxInput) (*sweepTxInput, error) {
+	hash, err := chainhash.NewHashFromStr(input.Txid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sweep input txid: %s", err)
+	}
+	internalKey, err := secp256k1.ParsePubKey(input.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sweep input internal key: %s", err)
+	}
+	return &sweepTxInput{input, *hash, internalKey}, nil
+}
+
+func (i *sweepTxInput) GetAmount() uint64 {
+	return i.Amount
+}
+
+func (i *sweepTxInput) GetHash() chainhash.Hash {
+	return i.hash
+}
+
+func (i *sweepTxInput) GetIndex() uint32 {
+	return i.VOut
+}
+
+func (i *sweepTxInput) GetLeafScript() []byte {
+	return i.LeafScript
+}
+
+func (i *sweepTxInput) GetControlBlock() []byte {
+	return i.ControlBlock
+}
+
+func (i *sweepTxInput) GetInternalKey() *secp256k1.PublicKey {
+	return i.internalKey
+}
@@ -0,0 +1,185 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	poolTxHex         = "pooltx"
+	sweepTxHex        = "sweeptx"
+	replacedSweepTxid = "0000000000000000000000000000000000000000000000000000000000000007"
+)
+
+func TestTxMonitor(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	sweepInput, err := newSweepTxInput(domain.SweepTxInput{
+		VtxoKey:      domain.VtxoKey{Txid: leafTxid, VOut: 0},
+		Amount:       1000,
+		LeafScript:   []byte{0x01},
+		ControlBlock: []byte{0x02},
+		InternalKey:  key.PubKey().SerializeCompressed(),
+	})
+	require.NoError(t, err)
+	config := TxBumping{MaxFeeRate: 10000}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Run("restored_after_restart", func(t *testing.T) {
+			ctx := context.Background()
+			repoManager := newTestRepoManager(t)
+			defer repoManager.Close()
+
+			wallet := &mockedTxWallet{}
+			scanner := newMockedScanner(roundTxid)
+			monitor, err := NewTxMonitor(wallet, nil, scanner, repoManager, config)
+			require.NoError(t, err)
+
+			monitor.Track(TxTypePool, roundTxid, poolTxHex)
+			monitor.Track(TxTypeSweep, sweepTxid, sweepTxHex, sweepInput)
+			monitor.(*txMonitor).checkPendingTxs()
+
+			restored, err := NewTxMonitor(wallet, nil, scanner, repoManager, config)
+			require.NoError(t, err)
+
+			pendingTxs := restored.ListPendingTxs()
+			require.Len(t, pendingTxs, 1)
+			require.Equal(t, sweepTxid, pendingTxs[0].Txid)
+			require.Equal(t, TxTypeSweep, pendingTxs[0].Type)
+			sweepInputs := restored.(*txMonitor).pendingTxs[sweepTxid].sweepInputs
+			require.Len(t, sweepInputs, 1)
+			require.Equal(t, sweepInput.GetHash(), sweepInputs[0].GetHash())
+			require.True(t, key.PubKey().IsEqual(sweepInputs[0].GetInternalKey()))
+
+			// the confirmed tx can be broadcasted again after the restart
+			require.NoError(t, restored.Rebroadcast(ctx, roundTxid))
+			require.Equal(t, []string{poolTxHex}, wallet.broadcasted)
+			require.Len(t, restored.ListPendingTxs(), 2)
+		})
+
+		t.Run("sweep_tx_replaced", func(t *testing.T) {
+			ctx := context.Background()
+			repoManager := newTestRepoManager(t)
+			defer repoManager.Close()
+
+			sweep := domain.NewSweep(leafTxid, roundTxid, time.Now().Unix())
+			require.NoError(t, sweep.Complete([]domain.SweepOutput{
+				{
+					VtxoKey:   domain.VtxoKey{Txid: leafTxid, VOut: 0},
+					Amount:    1000,
+					SweepTxid: sweepTxid,
+				},
+			}))
+			require.NoError(t, repoManager.Sweeps().AddOrUpdateSweep(ctx, *sweep))
+
+			wallet := &mockedTxWallet{txid: replacedSweepTxid}
+			builder := &mockedSweepTxBuilder{}
+			monitor, err := NewTxMonitor(
+				wallet, builder, newMockedScanner(), repoManager, config,
+			)
+			require.NoError(t, err)
+			monitor.Track(TxTypeSweep, sweepTxid, sweepTxHex, sweepInput)
+
+			txid, err := monitor.BumpFee(ctx, sweepTxid, 2000)
+			require.NoError(t, err)
+			require.Equal(t, replacedSweepTxid, txid)
+			require.Equal(t, []uint64{2000}, builder.feeRates)
+
+			pendingTxs := monitor.ListPendingTxs()
+			require.Len(t, pendingTxs, 1)
+			require.Equal(t, replacedSweepTxid, pendingTxs[0].Txid)
+			require.Equal(t, uint64(2000), pendingTxs[0].FeeRate)
+
+			txs, err := repoManager.MonitoredTxs().GetAllMonitoredTxs(ctx)
+			require.NoError(t, err)
+			require.Len(t, txs, 1)
+			require.Equal(t, replacedSweepTxid, txs[0].Txid)
+
+			sweep, err = repoManager.Sweeps().GetSweep(ctx, leafTxid)
+			require.NoError(t, err)
+			require.Equal(t, replacedSweepTxid, sweep.Outputs[0].SweepTxid)
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ctx := context.Background()
+		repoManager := newTestRepoManager(t)
+		defer repoManager.Close()
+
+		monitor, err := NewTxMonitor(
+			&mockedTxWallet{}, &mockedSweepTxBuilder{}, newMockedScanner(),
+			repoManager, config,
+		)
+		require.NoError(t, err)
+		monitor.Track(TxTypeSweep, sweepTxid, sweepTxHex, sweepInput)
+
+		fixtures := []struct {
+			name        string
+			txid        string
+			feeRate     uint64
+			expectedErr string
+		}{
+			{
+				name:        "unknown_tx",
+				txid:        roundTxid,
+				feeRate:     2000,
+				expectedErr: "pending tx " + roundTxid + " not found",
+			},
+			{
+				name:        "fee_estimation_failed",
+				txid:        sweepTxid,
+				feeRate:     0,
+				expectedErr: "failed to estimate fee rate",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				_, err := monitor.BumpFee(ctx, f.txid, f.feeRate)
+				require.ErrorContains(t, err, f.expectedErr)
+			})
+		}
+
+		err = monitor.Rebroadcast(ctx, roundTxid)
+		require.ErrorContains(t, err, "tx "+roundTxid+" not found")
+	})
+}
+
+// mockedTxWallet broadcasts any tx, returning the given txid, and can't
+// estimate the fee rate.
+type mockedTxWallet struct {
+	ports.WalletService
+	txid        string
+	broadcasted []string
+}
+
+func (m *mockedTxWallet) BroadcastTransaction(
+	_ context.Context, txHex string,
+) (string, error) {
+	m.broadcasted = append(m.broadcasted, txHex)
+	return m.txid, nil
+}
+
+func (m *mockedTxWallet) EstimateFeeRate(_ context.Context) (uint64, error) {
+	return 0, fmt.Errorf("fee estimation not available")
+}
+
+// mockedSweepTxBuilder records the fee rates of the sweep txs built.
+type mockedSweepTxBuilder struct {
+	ports.TxBuilder
+	feeRates []uint64
+}
+
+func (m *mockedSweepTxBuilder) BuildSweepTx(
+	_ []ports.SweepInput, feeRate uint64,
+) (string, error) {
+	m.feeRates = append(m.feeRates, feeRate)
+	return sweepTxHex, nil
+}
@@ -2,23 +2,41 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
 var (
-	paymentsThreshold = int64(128)
-	dustAmount        = uint64(450)
+	// maxFinalizationAttempts bounds the number of times a round is finalized
+	// again after dropping the payments that left their forfeit txs unsigned.
+	maxFinalizationAttempts = 3
+	// droppedInputsCooldownRounds is the number of rounds for which the inputs
+	// of a dropped payment can't be registered again.
+	droppedInputsCooldownRounds = int64(10)
+	// restoreRoundAttempts is the number of times the status of the pool tx of
+	// a round interrupted by a restart is fetched before leaving it pending.
+	restoreRoundAttempts = 3
+	// restoreRoundRetryInterval is the time between those attempts.
+	restoreRoundRetryInterval = 2 * time.Second
 )
 
 type Service interface {
 	Start() error
 	Stop()
-	SpendVtxos(ctx context.Context, inputs []domain.VtxoKey) (string, error)
+	GetPaymentNonce(ctx context.Context) (string, int64, error)
+	SpendVtxos(
+		ctx context.Context, inputs []domain.VtxoKey,
+		signatures map[string]*schnorr.Signature, nonce string,
+	) (string, error)
 	ClaimVtxos(ctx context.Context, creds string, receivers []domain.Receiver) error
-	SignVtxos(ctx context.Context, forfeitTxs []string) error
+	SignVtxos(
+		ctx context.Context, paymentId string, forfeitTxs []string,
+		signatures map[string]*schnorr.Signature,
+	) error
 	GetRoundByTxid(ctx context.Context, poolTxid string) (*domain.Round, error)
 	GetRoundById(ctx context.Context, id string) (*domain.Round, error)
 	GetCurrentRound(ctx context.Context) (*domain.Round, error)
@@ -26,10 +44,18 @@ type Service interface {
 	UpdatePaymentStatus(
 		ctx context.Context, paymentId string,
 	) (unsignedForfeitTxs []string, currentRound *domain.Round, err error)
+	// ListVtxos returns the page of the vtxos of the given pubkey matching the
+	// filter, redeemed ones are excluded unless the filter says otherwise.
 	ListVtxos(
 		ctx context.Context, pubkey *secp256k1.PublicKey,
-	) (spendableVtxos, spentVtxos []domain.Vtxo, err error)
+		filter domain.VtxoFilter, page domain.Page,
+	) (spendableVtxos, spentVtxos []domain.Vtxo, nextCursor string, err error)
 	GetInfo(ctx context.Context) (*ServiceInfo, error)
+	// PauseRounds waits for the round being finalized, if any, and for the
+	// sweeps, the scanner notifications and the async payments being handled
+	// to end, and prevents the next ones from writing to the dbs until resume
+	// is called.
+	PauseRounds(ctx context.Context) (resume func(), err error)
 	Onboard(
 		ctx context.Context, boardingTx string,
 		congestionTree tree.CongestionTree, userPubkey *secp256k1.PublicKey,
@@ -41,6 +67,18 @@ type Service interface {
 	CompleteAsyncPayment(
 		ctx context.Context, redeemTx string, unconditionalForfeitTxs []string,
 	) error
+	// Tree signing methods
+	RegisterCosignerPubkey(
+		ctx context.Context, paymentId string, ephemeralPubkey *secp256k1.PublicKey,
+	) error
+	RegisterCosignerNonces(
+		ctx context.Context, roundId string,
+		pubkey *secp256k1.PublicKey, nonces string, signature *schnorr.Signature,
+	) error
+	RegisterCosignerSignatures(
+		ctx context.Context, roundId string,
+		pubkey *secp256k1.PublicKey, signatures string, signature *schnorr.Signature,
+	) error
 }
 
 type ServiceInfo struct {
@@ -50,6 +88,9 @@ type ServiceInfo struct {
 	RoundInterval       int64
 	Network             string
 	MinRelayFee         int64
+	RoundTrigger        RoundTrigger
+	Fees                FeeSchedule
+	DustAmount          uint64
 }
 
 type WalletStatus struct {
@@ -2,17 +2,95 @@ package application
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"sort"
 	"sync"
 	"time"
 
+	"github.com/ark-network/ark/common"
+	"github.com/ark-network/ark/common/bitcointree"
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/sirupsen/logrus"
 )
 
+// pauseRounds locks the given finalization lock, then the pause lock once the
+// writers running outside of the rounds are done, unless the context is done
+// first, and returns the func to unlock them.
+func pauseRounds(
+	ctx context.Context, finalizationLock *sync.Mutex, pauseLock *sync.RWMutex,
+) (func(), error) {
+	unlock := func() {
+		pauseLock.Unlock()
+		finalizationLock.Unlock()
+	}
+	locked := make(chan struct{})
+	go func() {
+		finalizationLock.Lock()
+		pauseLock.Lock()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		once := &sync.Once{}
+		return func() { once.Do(unlock) }, nil
+	case <-ctx.Done():
+		go func() {
+			<-locked
+			unlock()
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// notPaused wraps the given func, which writes to the dbs outside of the
+// rounds, to run it holding the given pause lock for reading. This way, it
+// waits for the rounds to be resumed, if paused, and it's waited for before
+// pausing them.
+func notPaused(pauseLock *sync.RWMutex, f func()) func() {
+	return func() {
+		pauseLock.RLock()
+		defer pauseLock.RUnlock()
+		f()
+	}
+}
+
+// listVtxos returns the page of the vtxos matching the filter split into
+// spendable and spent ones, redeemed vtxos are excluded if not filtered.
+func listVtxos(
+	ctx context.Context, repoManager ports.RepoManager,
+	filter domain.VtxoFilter, page domain.Page,
+) ([]domain.Vtxo, []domain.Vtxo, string, error) {
+	if filter.Redeemed == nil {
+		redeemed := false
+		filter.Redeemed = &redeemed
+	}
+
+	vtxos, nextCursor, err := repoManager.Vtxos().ListVtxos(ctx, filter, page)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	spendableVtxos := make([]domain.Vtxo, 0, len(vtxos))
+	spentVtxos := make([]domain.Vtxo, 0, len(vtxos))
+	for _, vtxo := range vtxos {
+		if vtxo.Spent {
+			spentVtxos = append(spentVtxos, vtxo)
+		} else {
+			spendableVtxos = append(spendableVtxos, vtxo)
+		}
+	}
+	return spendableVtxos, spentVtxos, nextCursor, nil
+}
+
 type timedPayment struct {
 	domain.Payment
 	timestamp     time.Time
@@ -20,30 +98,145 @@ type timedPayment struct {
 }
 
 type paymentsMap struct {
-	lock     *sync.RWMutex
-	payments map[string]*timedPayment
+	lock          *sync.RWMutex
+	payments      map[string]*timedPayment
+	ephemeralKeys map[string]*secp256k1.PublicKey
+	// cooldowns maps the inputs of dropped payments to the time until which
+	// they can't be registered again.
+	cooldowns map[string]time.Time
+	// lockedInputs maps the inputs of the payments selected for a round to
+	// the id of the round, until it ends. Since rounds overlap, this prevents
+	// the same inputs from being registered again for the next one.
+	lockedInputs map[string]string
+	// repo persists the queued payments so that they survive a restart.
+	repo domain.PaymentRequestRepository
+	// updated is notified every time a payment is added or updated.
+	updated chan struct{}
 }
 
-func newPaymentsMap(payments []domain.Payment) *paymentsMap {
-	paymentsById := make(map[string]*timedPayment)
-	for _, p := range payments {
-		paymentsById[p.Id] = &timedPayment{p, time.Now(), time.Time{}}
-	}
+func newPaymentsMap(repo domain.PaymentRequestRepository) *paymentsMap {
 	lock := &sync.RWMutex{}
-	return &paymentsMap{lock, paymentsById}
+	return &paymentsMap{
+		lock, make(map[string]*timedPayment),
+		make(map[string]*secp256k1.PublicKey), make(map[string]time.Time),
+		make(map[string]string), repo, make(chan struct{}, 1),
+	}
 }
 
-func (m *paymentsMap) len() int64 {
-	m.lock.RLock()
-	defer m.lock.RUnlock()
+// restore loads the payments queued before a restart, discarding those with
+// inputs spent in the meantime. Their users are given the usual minute to
+// notify they're still online.
+func (m *paymentsMap) restore(
+	ctx context.Context, vtxoRepo domain.VtxoRepository,
+) error {
+	requests, err := m.repo.GetPaymentRequests(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	staleIds := make([]string, 0)
+	for _, request := range requests {
+		vtxos, ok := getUnspentInputs(ctx, vtxoRepo, request.Payment)
+		if !ok {
+			staleIds = append(staleIds, request.Id)
+			continue
+		}
+		request.Inputs = vtxos
+
+		m.payments[request.Id] = &timedPayment{
+			request.Payment, time.Unix(request.Timestamp, 0), time.Now(),
+		}
+
+		if len(request.EphemeralPubkey) <= 0 {
+			continue
+		}
+		buf, err := hex.DecodeString(request.EphemeralPubkey)
+		if err != nil {
+			return fmt.Errorf("invalid ephemeral pubkey for payment %s: %s", request.Id, err)
+		}
+		pubkey, err := secp256k1.ParsePubKey(buf)
+		if err != nil {
+			return fmt.Errorf("invalid ephemeral pubkey for payment %s: %s", request.Id, err)
+		}
+		m.ephemeralKeys[request.Id] = pubkey
+	}
+	m.unstore(staleIds)
+	m.notify()
+	return nil
+}
 
-	count := int64(0)
+// requeue puts back in the queue the payments of a round interrupted before
+// they could be included, discarding those with inputs spent or queued in the
+// meantime. Like for the restored ones, their users are given the usual minute
+// to notify they're still online, and must register their ephemeral key
+// again, if required.
+func (m *paymentsMap) requeue(
+	ctx context.Context, vtxoRepo domain.VtxoRepository,
+	payments []domain.Payment,
+) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	queuedInputs := make(map[string]struct{})
 	for _, p := range m.payments {
-		if len(p.Receivers) > 0 {
-			count++
+		for _, input := range p.Inputs {
+			queuedInputs[input.Hash()] = struct{}{}
 		}
 	}
-	return count
+
+	for _, payment := range payments {
+		if _, ok := m.payments[payment.Id]; ok {
+			continue
+		}
+		vtxos, ok := getUnspentInputs(ctx, vtxoRepo, payment)
+		if !ok {
+			continue
+		}
+		isQueued := false
+		for _, vtxo := range vtxos {
+			if _, ok := queuedInputs[vtxo.Hash()]; ok {
+				isQueued = true
+				break
+			}
+		}
+		if isQueued {
+			continue
+		}
+		payment.Inputs = vtxos
+
+		m.payments[payment.Id] = &timedPayment{payment, time.Now(), time.Now()}
+		if err := m.store(payment.Id); err != nil {
+			logrus.WithError(err).Warnf("failed to store payment %s", payment.Id)
+		}
+		for _, vtxo := range vtxos {
+			queuedInputs[vtxo.Hash()] = struct{}{}
+		}
+	}
+	m.notify()
+}
+
+// getUnspentInputs returns the inputs of the given payment as currently
+// stored, unless any of them is missing or spent.
+func getUnspentInputs(
+	ctx context.Context, vtxoRepo domain.VtxoRepository, payment domain.Payment,
+) ([]domain.Vtxo, bool) {
+	inputs := make([]domain.VtxoKey, 0, len(payment.Inputs))
+	for _, input := range payment.Inputs {
+		inputs = append(inputs, input.VtxoKey)
+	}
+	vtxos, err := vtxoRepo.GetVtxos(ctx, inputs)
+	if err != nil || len(vtxos) != len(inputs) {
+		return nil, false
+	}
+	for _, vtxo := range vtxos {
+		if vtxo.Spent || vtxo.Redeemed || vtxo.Swept {
+			return nil, false
+		}
+	}
+	return vtxos, true
 }
 
 func (m *paymentsMap) push(payment domain.Payment) error {
@@ -54,15 +247,107 @@ func (m *paymentsMap) push(payment domain.Payment) error {
 		return fmt.Errorf("duplicated inputs")
 	}
 
+	queuedInputs := make(map[string]string)
+	for id, p := range m.payments {
+		for _, input := range p.Inputs {
+			queuedInputs[input.Hash()] = id
+		}
+	}
+
+	for _, input := range payment.Inputs {
+		if paymentId, ok := queuedInputs[input.Hash()]; ok {
+			return fmt.Errorf(
+				"input %s:%d already registered in payment %s",
+				input.Txid, input.VOut, paymentId,
+			)
+		}
+		if roundId, ok := m.lockedInputs[input.Hash()]; ok {
+			return fmt.Errorf(
+				"input %s:%d already registered in round %s",
+				input.Txid, input.VOut, roundId,
+			)
+		}
+
+		until, ok := m.cooldowns[input.Hash()]
+		if !ok {
+			continue
+		}
+		if time.Now().Before(until) {
+			return fmt.Errorf(
+				"input %s:%d can't be registered until %s",
+				input.Txid, input.VOut, until.Format(time.RFC3339),
+			)
+		}
+		delete(m.cooldowns, input.Hash())
+	}
+
 	m.payments[payment.Id] = &timedPayment{payment, time.Now(), time.Time{}}
+	if err := m.store(payment.Id); err != nil {
+		delete(m.payments, payment.Id)
+		return fmt.Errorf("failed to store payment: %s", err)
+	}
+	m.notify()
+	return nil
+}
+
+func (m *paymentsMap) delete(id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.payments[id]; !ok {
+		return errPaymentNotFound{id}
+	}
+
+	delete(m.payments, id)
+	delete(m.ephemeralKeys, id)
+	m.unstore([]string{id})
 	return nil
 }
 
-func (m *paymentsMap) pop(num int64) []domain.Payment {
+// cooldown prevents the given inputs from being registered again for the
+// given duration.
+func (m *paymentsMap) cooldown(inputs []domain.VtxoKey, duration time.Duration) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	paymentsByTime := make([]timedPayment, 0, len(m.payments))
+	until := time.Now().Add(duration)
+	for _, input := range inputs {
+		m.cooldowns[input.Hash()] = until
+	}
+}
+
+func (m *paymentsMap) pushEphemeralKey(paymentId string, pubkey *secp256k1.PublicKey) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.payments[paymentId]; !ok {
+		return fmt.Errorf("payment %s not found", paymentId)
+	}
+
+	m.ephemeralKeys[paymentId] = pubkey
+	if err := m.store(paymentId); err != nil {
+		delete(m.ephemeralKeys, paymentId)
+		return fmt.Errorf("failed to store payment: %s", err)
+	}
+	m.notify()
+	return nil
+}
+
+// pop returns the payments ready to be included in the given round that are
+// chosen by the given selection policy, along with the ephemeral keys
+// registered for them, if any. If withEphemeralKey is set, the payments
+// without a registered ephemeral key are not ready and are kept in the queue.
+// The ready payments that don't fit in the round are returned as deferred and
+// are kept in the queue.
+// The inputs of the returned payments are locked until unlockInputs is called
+// for the round.
+func (m *paymentsMap) pop(
+	roundId string, selection PaymentSelection, withEphemeralKey bool,
+) ([]domain.Payment, map[string]*secp256k1.PublicKey, []domain.Payment) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	readyPayments := make([]timedPayment, 0, len(m.payments))
 	for _, p := range m.payments {
 		// Skip payments without registered receivers.
 		if len(p.Receivers) <= 0 {
@@ -72,21 +357,105 @@ func (m *paymentsMap) pop(num int64) []domain.Payment {
 		if p.pingTimestamp.IsZero() || time.Since(p.pingTimestamp).Minutes() > 1 {
 			continue
 		}
-		paymentsByTime = append(paymentsByTime, *p)
+		// Skip payments without ephemeral key, if required.
+		if _, ok := m.ephemeralKeys[p.Id]; withEphemeralKey && !ok {
+			continue
+		}
+		// Skip payments spending inputs of a round not yet ended.
+		if m.hasLockedInputs(p.Payment) {
+			continue
+		}
+		readyPayments = append(readyPayments, *p)
+	}
+
+	selectedPayments, deferredPayments := selection.selectPayments(readyPayments)
+
+	payments := make([]domain.Payment, 0, len(selectedPayments))
+	ephemeralKeys := make(map[string]*secp256k1.PublicKey)
+	ids := make([]string, 0, len(selectedPayments))
+	for _, p := range selectedPayments {
+		payments = append(payments, p.Payment)
+		if pubkey, ok := m.ephemeralKeys[p.Id]; ok {
+			ephemeralKeys[p.Id] = pubkey
+		}
+		ids = append(ids, p.Id)
+		delete(m.payments, p.Id)
+		delete(m.ephemeralKeys, p.Id)
+		for _, input := range p.Inputs {
+			m.lockedInputs[input.Hash()] = roundId
+		}
+	}
+	m.unstore(ids)
+
+	deferred := make([]domain.Payment, 0, len(deferredPayments))
+	for _, p := range deferredPayments {
+		deferred = append(deferred, p.Payment)
+	}
+	return payments, ephemeralKeys, deferred
+}
+
+// lockInputs locks the inputs of the given payments for the given round, as
+// if they were popped for it.
+func (m *paymentsMap) lockInputs(roundId string, payments []domain.Payment) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, payment := range payments {
+		for _, input := range payment.Inputs {
+			m.lockedInputs[input.Hash()] = roundId
+		}
+	}
+}
+
+// unlockInputs releases the inputs locked for the given round.
+func (m *paymentsMap) unlockInputs(roundId string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for input, id := range m.lockedInputs {
+		if id == roundId {
+			delete(m.lockedInputs, input)
+		}
 	}
-	sort.SliceStable(paymentsByTime, func(i, j int) bool {
-		return paymentsByTime[i].timestamp.Before(paymentsByTime[j].timestamp)
-	})
+	m.notify()
+}
 
-	if num < 0 || num > int64(len(paymentsByTime)) {
-		num = int64(len(paymentsByTime))
+// hasLockedInputs returns whether any of the inputs of the given payment is
+// locked. It must be called with the lock held.
+func (m *paymentsMap) hasLockedInputs(payment domain.Payment) bool {
+	for _, input := range payment.Inputs {
+		if _, ok := m.lockedInputs[input.Hash()]; ok {
+			return true
+		}
 	}
+	return false
+}
 
-	payments := make([]domain.Payment, 0, num)
-	for _, p := range paymentsByTime[:num] {
+// popUnresponsive removes and returns the payments with registered receivers
+// for which users didn't notify to be online in the last minute.
+func (m *paymentsMap) popUnresponsive() []domain.Payment {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	payments := make([]domain.Payment, 0)
+	ids := make([]string, 0)
+	for _, p := range m.payments {
+		if len(p.Receivers) <= 0 {
+			continue
+		}
+		lastSeen := p.timestamp
+		if p.pingTimestamp.After(lastSeen) {
+			lastSeen = p.pingTimestamp
+		}
+		if time.Since(lastSeen).Minutes() <= 1 {
+			continue
+		}
 		payments = append(payments, p.Payment)
+		ids = append(ids, p.Id)
 		delete(m.payments, p.Id)
+		delete(m.ephemeralKeys, p.Id)
 	}
+	m.unstore(ids)
 	return payments
 }
 
@@ -99,7 +468,13 @@ func (m *paymentsMap) update(payment domain.Payment) error {
 		return fmt.Errorf("payment %s not found", payment.Id)
 	}
 
+	prevPayment := p.Payment
 	p.Payment = payment
+	if err := m.store(payment.Id); err != nil {
+		p.Payment = prevPayment
+		return fmt.Errorf("failed to store payment: %s", err)
+	}
+	m.notify()
 
 	return nil
 }
@@ -114,9 +489,75 @@ func (m *paymentsMap) updatePingTimestamp(id string) error {
 	}
 
 	payment.pingTimestamp = time.Now()
+	m.notify()
 	return nil
 }
 
+// ready returns the number and the total amount of the payments that would be
+// popped, optionally counting only those with a registered ephemeral key.
+func (m *paymentsMap) ready(withEphemeralKey bool) (int64, uint64) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	count, amount := int64(0), uint64(0)
+	for _, p := range m.payments {
+		if len(p.Receivers) <= 0 {
+			continue
+		}
+		if p.pingTimestamp.IsZero() || time.Since(p.pingTimestamp).Minutes() > 1 {
+			continue
+		}
+		if _, ok := m.ephemeralKeys[p.Id]; withEphemeralKey && !ok {
+			continue
+		}
+		if m.hasLockedInputs(p.Payment) {
+			continue
+		}
+		count++
+		for _, receiver := range p.Receivers {
+			amount += receiver.Amount
+		}
+	}
+	return count, amount
+}
+
+// notify signals the waiting round trigger, if any, that the queue changed.
+func (m *paymentsMap) notify() {
+	select {
+	case m.updated <- struct{}{}:
+	default:
+	}
+}
+
+// store persists the payment with the given id, along with its ephemeral key
+// if registered. It must be called with the lock held.
+func (m *paymentsMap) store(id string) error {
+	p := m.payments[id]
+	var ephemeralPubkey string
+	if pubkey, ok := m.ephemeralKeys[id]; ok {
+		ephemeralPubkey = hex.EncodeToString(pubkey.SerializeCompressed())
+	}
+
+	return m.repo.AddOrUpdatePaymentRequest(
+		context.Background(), domain.PaymentRequest{
+			Payment:         p.Payment,
+			EphemeralPubkey: ephemeralPubkey,
+			Timestamp:       p.timestamp.Unix(),
+		},
+	)
+}
+
+// unstore removes the given payments from the db. A failure is only logged
+// since stale payments are anyway discarded when restored.
+func (m *paymentsMap) unstore(ids []string) {
+	if len(ids) <= 0 {
+		return
+	}
+	if err := m.repo.DeletePaymentRequests(context.Background(), ids); err != nil {
+		logrus.WithError(err).Warn("failed to delete stored payments")
+	}
+}
+
 func (m *paymentsMap) view(id string) (*domain.Payment, bool) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -158,27 +599,36 @@ func (m *forfeitTxsMap) push(txs []string) {
 	}
 }
 
-func (m *forfeitTxsMap) sign(txs []string) error {
+// sign stores the given signed forfeit txs and returns those, among the
+// expected ones, with invalid signatures.
+func (m *forfeitTxsMap) sign(txs []string) ([]string, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
+	invalidTxs := make([]string, 0)
 	for _, tx := range txs {
 		valid, txid, err := m.builder.VerifyForfeitTx(tx)
-		if err != nil {
-			return err
+		if err != nil && !errors.Is(err, ports.ErrInvalidForfeitSignature) {
+			return nil, err
 		}
 
-		if _, ok := m.forfeitTxs[txid]; ok {
-			if valid {
-				m.forfeitTxs[txid].tx = tx
-				m.forfeitTxs[txid].signed = true
-			} else {
-				logrus.Warnf("invalid forfeit tx signature (%s)", txid)
-			}
+		if _, ok := m.forfeitTxs[txid]; !ok {
+			continue
+		}
+		if err != nil {
+			logrus.Warnf("invalid forfeit tx signature (%s)", txid)
+			invalidTxs = append(invalidTxs, tx)
+			continue
+		}
+		// unsigned txs are ignored, the missing signatures are struck once
+		// the round is finalized
+		if valid {
+			m.forfeitTxs[txid].tx = tx
+			m.forfeitTxs[txid].signed = true
 		}
 	}
 
-	return nil
+	return invalidTxs, nil
 }
 
 func (m *forfeitTxsMap) pop() (signed, unsigned []string) {
@@ -208,12 +658,160 @@ func (m *forfeitTxsMap) view() []string {
 	return txs
 }
 
+// treeSigningSession collects the nonces and the partial signatures of the
+// cosigners of a congestion tree, the done channels are closed once every
+// cosigner has submitted its share, or once those left have submitted an
+// invalid one.
+type treeSigningSession struct {
+	lock        *sync.Mutex
+	coordinator bitcointree.CoordinatorSession
+	cosigners   map[string]struct{}
+	nonces      map[string]struct{}
+	sigs        map[string]struct{}
+	invalidSigs map[string]struct{}
+	noncesDoneC chan struct{}
+	sigsDoneC   chan struct{}
+}
+
+func newTreeSigningSession(
+	coordinator bitcointree.CoordinatorSession, cosigners []*secp256k1.PublicKey,
+) *treeSigningSession {
+	cosignersByKey := make(map[string]struct{})
+	for _, pubkey := range cosigners {
+		cosignersByKey[hex.EncodeToString(pubkey.SerializeCompressed())] = struct{}{}
+	}
+	return &treeSigningSession{
+		lock:        &sync.Mutex{},
+		coordinator: coordinator,
+		cosigners:   cosignersByKey,
+		nonces:      make(map[string]struct{}),
+		sigs:        make(map[string]struct{}),
+		invalidSigs: make(map[string]struct{}),
+		noncesDoneC: make(chan struct{}),
+		sigsDoneC:   make(chan struct{}),
+	}
+}
+
+func (s *treeSigningSession) addNonces(
+	pubkey *secp256k1.PublicKey, nonces bitcointree.TreeNonces,
+) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := hex.EncodeToString(pubkey.SerializeCompressed())
+	if _, ok := s.cosigners[key]; !ok {
+		return fmt.Errorf("pubkey %s is not a cosigner of the tree", key)
+	}
+	if _, ok := s.nonces[key]; ok {
+		return fmt.Errorf("nonces already submitted for pubkey %s", key)
+	}
+
+	if err := s.coordinator.AddNonce(pubkey, nonces); err != nil {
+		return err
+	}
+
+	s.nonces[key] = struct{}{}
+	if len(s.nonces) == len(s.cosigners) {
+		close(s.noncesDoneC)
+	}
+	return nil
+}
+
+// addSignatures adds the partial signatures of the given cosigner. Those
+// failing verification are rejected and the cosigner is not allowed to submit
+// others.
+func (s *treeSigningSession) addSignatures(
+	pubkey *secp256k1.PublicKey, sigs bitcointree.TreePartialSigs,
+) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := hex.EncodeToString(pubkey.SerializeCompressed())
+	if _, ok := s.cosigners[key]; !ok {
+		return fmt.Errorf("pubkey %s is not a cosigner of the tree", key)
+	}
+	if len(s.nonces) != len(s.cosigners) {
+		return fmt.Errorf("nonces not aggregated yet")
+	}
+	if _, ok := s.sigs[key]; ok {
+		return fmt.Errorf("signatures already submitted for pubkey %s", key)
+	}
+	if _, ok := s.invalidSigs[key]; ok {
+		return fmt.Errorf("invalid signatures already submitted for pubkey %s", key)
+	}
+
+	if err := s.coordinator.AddSig(pubkey, sigs); err != nil {
+		if errors.Is(err, bitcointree.ErrInvalidPartialSignature) {
+			s.invalidSigs[key] = struct{}{}
+			s.checkSigsDone()
+		}
+		return err
+	}
+
+	s.sigs[key] = struct{}{}
+	s.checkSigsDone()
+	return nil
+}
+
+func (s *treeSigningSession) checkSigsDone() {
+	if len(s.sigs)+len(s.invalidSigs) == len(s.cosigners) {
+		close(s.sigsDoneC)
+	}
+}
+
+// missingNonces returns the pubkeys of the cosigners that didn't submit their
+// nonces.
+func (s *treeSigningSession) missingNonces() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return missingCosigners(s.cosigners, s.nonces)
+}
+
+// missingSignatures returns the pubkeys of the cosigners that didn't submit
+// their partial signatures, or submitted invalid ones.
+func (s *treeSigningSession) missingSignatures() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return missingCosigners(s.cosigners, s.sigs)
+}
+
+func missingCosigners(cosigners, submitted map[string]struct{}) []string {
+	missing := make([]string, 0)
+	for key := range cosigners {
+		if _, ok := submitted[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// getConfirmationTime returns whether the given tx is confirmed and, if so,
+// the time of its block in the given unit, ie. the one the relative timelocks
+// of its outputs expire from.
+func getConfirmationTime(
+	ctx context.Context, scanner ports.BlockchainScanner, unit ports.TimeUnit,
+	txid string,
+) (bool, int64, error) {
+	if unit != ports.MedianTime {
+		return scanner.IsTransactionConfirmed(ctx, txid)
+	}
+
+	block, err := scanner.GetTransactionBlock(ctx, txid)
+	if err != nil || block == nil {
+		return false, 0, err
+	}
+	return true, block.MedianTime, nil
+}
+
 // onchainOutputs iterates over all the nodes' outputs in the congestion tree and checks their onchain state
 // returns the sweepable outputs as ports.SweepInput mapped by their expiration time
 func findSweepableOutputs(
 	ctx context.Context,
 	walletSvc ports.WalletService,
 	txbuilder ports.TxBuilder,
+	unit ports.TimeUnit,
 	congestionTree tree.CongestionTree,
 ) (map[int64][]ports.SweepInput, error) {
 	sweepableOutputs := make(map[int64][]ports.SweepInput)
@@ -224,7 +822,7 @@ func findSweepableOutputs(
 		newNodesToCheck := make([]tree.Node, 0)
 
 		for _, node := range nodesToCheck {
-			isConfirmed, blocktime, err := walletSvc.IsTransactionConfirmed(ctx, node.Txid)
+			isConfirmed, blocktime, err := getConfirmationTime(ctx, walletSvc, unit, node.Txid)
 			if err != nil {
 				return nil, err
 			}
@@ -234,7 +832,7 @@ func findSweepableOutputs(
 
 			if !isConfirmed {
 				if _, ok := blocktimeCache[node.ParentTxid]; !ok {
-					isConfirmed, blocktime, err := walletSvc.IsTransactionConfirmed(ctx, node.ParentTxid)
+					isConfirmed, blocktime, err := getConfirmationTime(ctx, walletSvc, unit, node.ParentTxid)
 					if !isConfirmed || err != nil {
 						return nil, fmt.Errorf("tx %s not found", node.Txid)
 					}
@@ -272,6 +870,34 @@ func findSweepableOutputs(
 	return sweepableOutputs, nil
 }
 
+// getPaymentsSpendingVtxos returns the payments that spend any of the given
+// vtxos.
+func getPaymentsSpendingVtxos(
+	payments map[string]domain.Payment, vtxos []domain.VtxoKey,
+) []domain.Payment {
+	paymentIdsByVtxo := make(map[string]string)
+	for _, p := range payments {
+		for _, vtxo := range p.Inputs {
+			paymentIdsByVtxo[vtxo.Hash()] = p.Id
+		}
+	}
+
+	found := make([]domain.Payment, 0)
+	foundIds := make(map[string]struct{})
+	for _, vtxo := range vtxos {
+		id, ok := paymentIdsByVtxo[vtxo.Hash()]
+		if !ok {
+			continue
+		}
+		if _, ok := foundIds[id]; ok {
+			continue
+		}
+		foundIds[id] = struct{}{}
+		found = append(found, payments[id])
+	}
+	return found
+}
+
 func getSpentVtxos(payments map[string]domain.Payment) []domain.VtxoKey {
 	vtxos := make([]domain.VtxoKey, 0)
 	for _, p := range payments {
@@ -281,3 +907,248 @@ func getSpentVtxos(payments map[string]domain.Payment) []domain.VtxoKey {
 	}
 	return vtxos
 }
+
+// paymentNonces are the single-use nonces that the signatures of the inputs
+// of a payment commit to, so that a registration can't be replayed.
+type paymentNonces struct {
+	lock   *sync.Mutex
+	nonces map[string]time.Time
+}
+
+func newPaymentNonces() *paymentNonces {
+	return &paymentNonces{&sync.Mutex{}, make(map[string]time.Time)}
+}
+
+// new returns a random nonce along with its expiry.
+func (n *paymentNonces) new() (string, int64, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", -1, fmt.Errorf("failed to generate nonce: %s", err)
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	now := time.Now()
+	for nonce, expireAt := range n.nonces {
+		if !now.Before(expireAt) {
+			delete(n.nonces, nonce)
+		}
+	}
+
+	nonce := hex.EncodeToString(buf)
+	expireAt := now.Add(common.PaymentNonceValidity)
+	n.nonces[nonce] = expireAt
+	return nonce, expireAt.Unix(), nil
+}
+
+// use consumes the given nonce, failing if it's unknown, already used or
+// expired.
+func (n *paymentNonces) use(nonce string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	expireAt, ok := n.nonces[nonce]
+	if !ok {
+		return fmt.Errorf("unknown or already used nonce")
+	}
+	delete(n.nonces, nonce)
+
+	if !time.Now().Before(expireAt) {
+		return fmt.Errorf("nonce expired")
+	}
+	return nil
+}
+
+// verifyInputsOwnership makes sure that every input is signed by the owner
+// of the related vtxo. The signatures, one for every owner pubkey, are
+// expected to commit to all the outpoints of the payment and to a nonce
+// given by the server.
+func verifyInputsOwnership(
+	inputs []domain.VtxoKey, vtxos []domain.Vtxo,
+	signatures map[string]*schnorr.Signature, nonce []byte,
+) error {
+	if len(nonce) <= 0 {
+		return fmt.Errorf("missing nonce")
+	}
+
+	vtxosByKey := make(map[string]domain.Vtxo)
+	for _, vtxo := range vtxos {
+		vtxosByKey[vtxo.Hash()] = vtxo
+	}
+
+	outpoints := make([]wire.OutPoint, 0, len(inputs))
+	for _, input := range inputs {
+		txid, err := chainhash.NewHashFromStr(input.Txid)
+		if err != nil {
+			return fmt.Errorf("invalid input txid %s: %s", input.Txid, err)
+		}
+		outpoints = append(outpoints, wire.OutPoint{Hash: *txid, Index: input.VOut})
+	}
+	msg := common.PaymentInputsHash(outpoints, nonce)
+
+	verified := make(map[string]struct{})
+	for _, input := range inputs {
+		vtxo, ok := vtxosByKey[input.Hash()]
+		if !ok {
+			return fmt.Errorf("input %s:%d not found", input.Txid, input.VOut)
+		}
+		if _, ok := verified[vtxo.Pubkey]; ok {
+			continue
+		}
+
+		sig, ok := signatures[vtxo.Pubkey]
+		if !ok {
+			return fmt.Errorf("missing signature of the owner of input %s:%d", input.Txid, input.VOut)
+		}
+
+		buf, err := hex.DecodeString(vtxo.Pubkey)
+		if err != nil {
+			return fmt.Errorf("failed to decode pubkey of input %s:%d: %s", input.Txid, input.VOut, err)
+		}
+		pubkey, err := secp256k1.ParsePubKey(buf)
+		if err != nil {
+			return fmt.Errorf("failed to parse pubkey of input %s:%d: %s", input.Txid, input.VOut, err)
+		}
+
+		if !sig.Verify(msg, pubkey) {
+			return fmt.Errorf("invalid signature for input %s:%d", input.Txid, input.VOut)
+		}
+		verified[vtxo.Pubkey] = struct{}{}
+	}
+
+	return nil
+}
+
+// verifyPaymentOwners makes sure that the given forfeit txs are submitted by
+// the owners of every input of the payment, who are expected to sign the hash
+// of the payment id and of the txs.
+func verifyPaymentOwners(
+	payment domain.Payment, forfeitTxs []string,
+	signatures map[string]*schnorr.Signature,
+) error {
+	msg := common.FinalizePaymentHash(payment.Id, forfeitTxs)
+
+	verified := make(map[string]struct{})
+	for _, vtxo := range payment.Inputs {
+		if _, ok := verified[vtxo.Pubkey]; ok {
+			continue
+		}
+
+		sig, ok := signatures[vtxo.Pubkey]
+		if !ok {
+			return fmt.Errorf("missing signature of the owner of input %s:%d", vtxo.Txid, vtxo.VOut)
+		}
+
+		buf, err := hex.DecodeString(vtxo.Pubkey)
+		if err != nil {
+			return fmt.Errorf("failed to decode pubkey of input %s:%d: %s", vtxo.Txid, vtxo.VOut, err)
+		}
+		pubkey, err := secp256k1.ParsePubKey(buf)
+		if err != nil {
+			return fmt.Errorf("failed to parse pubkey of input %s:%d: %s", vtxo.Txid, vtxo.VOut, err)
+		}
+
+		if !sig.Verify(msg, pubkey) {
+			return fmt.Errorf("invalid signature of the owner of input %s:%d", vtxo.Txid, vtxo.VOut)
+		}
+		verified[vtxo.Pubkey] = struct{}{}
+	}
+
+	return nil
+}
+
+// restoreInterruptedRound returns the events to end the given round if its
+// pool tx was signed and published before the interruption, otherwise those
+// to fail it, and whether the round is ended. An error is returned if the
+// status of the pool tx can't be fetched, even after a few attempts, so that
+// the round is left pending rather than failed.
+func restoreInterruptedRound(
+	ctx context.Context, scanner ports.BlockchainScanner, round *domain.Round,
+) ([]domain.RoundEvent, bool, error) {
+	if round.IsPoolTxSigned() {
+		var published bool
+		var err error
+		for i := 0; i < restoreRoundAttempts; i++ {
+			if i > 0 {
+				time.Sleep(restoreRoundRetryInterval)
+			}
+			published, err = scanner.IsTransactionPublished(ctx, round.Txid)
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf(
+				"failed to get status of pool tx %s: %s", round.Txid, err,
+			)
+		}
+		if published {
+			changes, err := round.EndFinalization(round.ForfeitTxs, round.Txid)
+			if err == nil {
+				return changes, true, nil
+			}
+			logrus.WithError(err).Warnf("failed to end round %s", round.Id)
+		}
+	}
+	return round.Fail(fmt.Errorf("round interrupted by server restart")), false, nil
+}
+
+// getRoundPayments returns the payments registered in the given round.
+func getRoundPayments(round *domain.Round) []domain.Payment {
+	payments := make([]domain.Payment, 0, len(round.Payments))
+	for _, payment := range round.Payments {
+		payments = append(payments, payment)
+	}
+	return payments
+}
+
+// interruptedRounds holds the failure events of the rounds interrupted by a
+// restart, whose payments are queued again. They can't be notified at
+// startup since nobody is listening yet, therefore each user is notified once
+// it pings again for its payment.
+type interruptedRounds struct {
+	lock *sync.Mutex
+	// events maps the ids of the payments to the failure of their round.
+	events map[string]domain.RoundEvent
+	// roundIds are the rounds not notified straight away.
+	roundIds map[string]struct{}
+}
+
+func newInterruptedRounds() *interruptedRounds {
+	return &interruptedRounds{
+		&sync.Mutex{}, make(map[string]domain.RoundEvent),
+		make(map[string]struct{}),
+	}
+}
+
+// add records the failure event of the given round for its payments.
+func (r *interruptedRounds) add(round *domain.Round, event domain.RoundEvent) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.roundIds[round.Id] = struct{}{}
+	for id := range round.Payments {
+		r.events[id] = event
+	}
+}
+
+// isInterrupted returns whether the given round is one of those interrupted.
+func (r *interruptedRounds) isInterrupted(roundId string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	_, ok := r.roundIds[roundId]
+	return ok
+}
+
+// pop returns the failure event of the round of the given payment, if not
+// notified yet.
+func (r *interruptedRounds) pop(paymentId string) (domain.RoundEvent, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	event, ok := r.events[paymentId]
+	delete(r.events, paymentId)
+	return event, ok
+}
@@ -0,0 +1,188 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreInterruptedRound(t *testing.T) {
+	restoreRoundRetryInterval = 0
+
+	fixtures := []struct {
+		name          string
+		signed        bool
+		scanner       *mockedScanner
+		expectedEnded bool
+		expectedErr   string
+	}{
+		{
+			name:    "unsigned_pool_tx",
+			scanner: newMockedScanner(),
+		},
+		{
+			name:    "unpublished_pool_tx",
+			signed:  true,
+			scanner: newMockedScanner(),
+		},
+		{
+			name:   "published_pool_tx",
+			signed: true,
+			scanner: &mockedScanner{
+				mempoolTxs: map[string]struct{}{roundTxid: {}},
+			},
+			expectedEnded: true,
+		},
+		{
+			name:   "unknown_pool_tx_status",
+			signed: true,
+			scanner: &mockedScanner{
+				publishedErr: fmt.Errorf("connection refused"),
+			},
+			expectedErr: "failed to get status of pool tx " + roundTxid,
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			round := newTestInterruptedRound(t, f.signed)
+
+			changes, ended, err := restoreInterruptedRound(
+				context.Background(), f.scanner, round,
+			)
+			if f.expectedErr != "" {
+				require.ErrorContains(t, err, f.expectedErr)
+				require.Empty(t, changes)
+				require.False(t, round.IsFailed())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, changes, 1)
+			require.Equal(t, f.expectedEnded, ended)
+			require.Equal(t, f.expectedEnded, round.IsEnded())
+			require.Equal(t, !f.expectedEnded, round.IsFailed())
+		})
+	}
+}
+
+func TestInterruptedRounds(t *testing.T) {
+	round := newTestInterruptedRound(t, false)
+	event := round.Fail(fmt.Errorf("round interrupted by server restart"))[0]
+	payments := getRoundPayments(round)
+
+	rounds := newInterruptedRounds()
+	require.False(t, rounds.isInterrupted(round.Id))
+
+	rounds.add(round, event)
+	require.True(t, rounds.isInterrupted(round.Id))
+
+	// the failure is notified only once per payment
+	popped, ok := rounds.pop(payments[0].Id)
+	require.True(t, ok)
+	require.Equal(t, event, popped)
+	_, ok = rounds.pop(payments[0].Id)
+	require.False(t, ok)
+	require.True(t, rounds.isInterrupted(round.Id))
+}
+
+func TestPauseRounds(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		finalizationLock, pauseLock := &sync.Mutex{}, &sync.RWMutex{}
+
+		// the rounds are paused only once the running writer is done
+		writing, written := make(chan struct{}), make(chan struct{})
+		go notPaused(pauseLock, func() {
+			close(writing)
+			time.Sleep(100 * time.Millisecond)
+			close(written)
+		})()
+		<-writing
+
+		resume, err := pauseRounds(
+			context.Background(), finalizationLock, pauseLock,
+		)
+		require.NoError(t, err)
+		requireDone(t, written)
+
+		// the writers started while paused wait for the rounds to be resumed
+		done := make(chan struct{})
+		go notPaused(pauseLock, func() { close(done) })()
+		requireNotDone(t, done)
+		require.False(t, finalizationLock.TryLock())
+
+		resume()
+		requireDone(t, done)
+		// resuming more than once is a no-op
+		resume()
+		require.True(t, finalizationLock.TryLock())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		finalizationLock, pauseLock := &sync.Mutex{}, &sync.RWMutex{}
+		finalizationLock.Lock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := pauseRounds(ctx, finalizationLock, pauseLock)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// the locks are released as soon as they're acquired
+		finalizationLock.Unlock()
+		require.Eventually(t, func() bool {
+			if !finalizationLock.TryLock() {
+				return false
+			}
+			finalizationLock.Unlock()
+			return pauseLock.TryRLock()
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func requireDone(t *testing.T, ch chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("expected func to be done")
+	}
+}
+
+func requireNotDone(t *testing.T, ch chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+		t.Fatal("expected func not to be done")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// newTestInterruptedRound returns a round being finalized, with its pool tx
+// signed if required.
+func newTestInterruptedRound(t *testing.T, signed bool) *domain.Round {
+	payment := domain.NewPaymentUnsafe(
+		[]domain.Vtxo{{
+			VtxoKey:  domain.VtxoKey{Txid: leafTxid, VOut: 0},
+			Receiver: domain.Receiver{Amount: 1000},
+		}},
+		[]domain.Receiver{{Pubkey: "pubkey", Amount: 1000}},
+	)
+
+	round := domain.NewRound(0)
+	_, err := round.StartRegistration()
+	require.NoError(t, err)
+	_, err = round.RegisterPayments([]domain.Payment{*payment})
+	require.NoError(t, err)
+	_, err = round.StartFinalization("", []string{"connector"}, nil, "pooltx")
+	require.NoError(t, err)
+	if signed {
+		_, err = round.SignPoolTx([]string{"forfeittx"}, roundTxid)
+		require.NoError(t, err)
+	}
+	return round
+}
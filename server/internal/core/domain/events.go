@@ -8,9 +8,15 @@ type RoundEvent interface {
 
 func (r RoundStarted) isEvent()             {}
 func (r RoundFinalizationStarted) isEvent() {}
+func (r PoolTxSigned) isEvent()             {}
 func (r RoundFinalized) isEvent()           {}
 func (r RoundFailed) isEvent()              {}
 func (r PaymentsRegistered) isEvent()       {}
+func (r PaymentsDropped) isEvent()          {}
+
+func (r RoundSigningStarted) isEvent()         {}
+func (r RoundSigningNoncesGenerated) isEvent() {}
+func (r PaymentsDeferred) isEvent()            {}
 
 type RoundStarted struct {
 	Id        string
@@ -24,6 +30,15 @@ type RoundFinalizationStarted struct {
 	ConnectorAddress   string
 	UnsignedForfeitTxs []string
 	PoolTx             string
+	Attempt            int
+}
+
+// PoolTxSigned is raised before broadcasting the pool tx, so that the round
+// can be ended if interrupted once the tx is broadcasted.
+type PoolTxSigned struct {
+	Id         string
+	PoolTxid   string
+	ForfeitTxs []string
 }
 
 type RoundFinalized struct {
@@ -37,9 +52,36 @@ type RoundFailed struct {
 	Id        string
 	Err       string
 	Timestamp int64
+	Attempt   int
 }
 
 type PaymentsRegistered struct {
 	Id       string
 	Payments []Payment
 }
+
+type PaymentsDropped struct {
+	Id         string
+	PaymentIds []string
+}
+
+// RoundSigningStarted, RoundSigningNoncesGenerated and PaymentsDeferred are
+// not part of the round's history, they're only propagated to the users.
+type RoundSigningStarted struct {
+	Id              string
+	UnsignedTree    tree.CongestionTree
+	Cosigners       []string
+	UnsignedRoundTx string
+}
+
+type RoundSigningNoncesGenerated struct {
+	Id     string
+	Nonces string // serialized aggregated nonces
+}
+
+// PaymentsDeferred notifies the users of ready payments that didn't fit in the
+// round that they're kept in the queue for the next one.
+type PaymentsDeferred struct {
+	Id         string
+	PaymentIds []string
+}
@@ -0,0 +1,37 @@
+package domain
+
+// MonitoredTx is a tx broadcasted by the ASP, monitored until it confirms to
+// bump its fee if stuck in the mempool. Once confirmed, it's kept for a while
+// to be broadcasted again in case of reorg.
+type MonitoredTx struct {
+	Txid          string
+	Type          string
+	TxHex         string
+	BroadcastedAt int64
+	// BumpedAt is the time of the last fee bump, zero if never bumped.
+	BumpedAt int64
+	// FeeRate is the fee rate in sats per kvbyte of the last bump.
+	FeeRate uint64
+	// ChildTxid is the txid of the child tx broadcasted with the last bump,
+	// if done with CPFP.
+	ChildTxid string
+	// SweepInputs are the inputs of a sweep tx, required to replace it.
+	SweepInputs []SweepTxInput
+	// ConfirmedAt is the time the tx was found confirmed, zero if pending.
+	ConfirmedAt int64
+}
+
+func (t MonitoredTx) IsConfirmed() bool {
+	return t.ConfirmedAt > 0
+}
+
+// SweepTxInput is an expired output spent by a sweep tx with the sweep leaf
+// of its taproot tree.
+type SweepTxInput struct {
+	VtxoKey
+	Amount       uint64
+	LeafScript   []byte
+	ControlBlock []byte
+	// InternalKey is the compressed internal key of the taproot output.
+	InternalKey []byte
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	StrikeUnsignedForfeit  StrikeReason = "unsigned_forfeit"
+	StrikeUnsignedTree     StrikeReason = "unsigned_tree"
+	StrikeMissedPing       StrikeReason = "missed_ping"
+	StrikeInvalidSignature StrikeReason = "invalid_signature"
+)
+
+type StrikeReason string
+
+type Strike struct {
+	Reason    StrikeReason
+	RoundId   string
+	Timestamp int64
+}
+
+// Offender keeps track of the strikes collected by a round participant,
+// identified either by the pubkey of its vtxos or by one of their outpoints.
+type Offender struct {
+	Key     string
+	Strikes []Strike
+	// BannedUntil is the time until which the offender is banned. Only the
+	// strikes collected afterwards count towards the next ban.
+	BannedUntil int64
+}
+
+func NewOffender(key string) *Offender {
+	return &Offender{
+		Key:     key,
+		Strikes: make([]Strike, 0),
+	}
+}
+
+// AddStrike records a new strike for the offender and bans it for the given
+// duration (in seconds) if it collected the given number of strikes since its
+// last ban. It returns whether the offender got banned. An offender collects
+// at most one strike per round, the others are ignored.
+func (o *Offender) AddStrike(
+	reason StrikeReason, roundId string, threshold int, banDuration int64,
+) (bool, error) {
+	if len(reason) <= 0 {
+		return false, fmt.Errorf("missing strike reason")
+	}
+	if threshold <= 0 {
+		return false, fmt.Errorf("invalid ban threshold, must be greater than 0")
+	}
+	if banDuration <= 0 {
+		return false, fmt.Errorf("invalid ban duration, must be greater than 0")
+	}
+	if o.IsBanned() {
+		return false, fmt.Errorf("offender %s is already banned", o.Key)
+	}
+
+	if len(roundId) > 0 {
+		for _, s := range o.Strikes {
+			if s.RoundId == roundId {
+				return false, nil
+			}
+		}
+	}
+
+	now := time.Now().Unix()
+	o.Strikes = append(o.Strikes, Strike{
+		Reason:    reason,
+		RoundId:   roundId,
+		Timestamp: now,
+	})
+
+	if len(o.ActiveStrikes()) < threshold {
+		return false, nil
+	}
+
+	o.BannedUntil = now + banDuration
+	return true, nil
+}
+
+// ActiveStrikes returns the strikes collected since the last ban.
+func (o *Offender) ActiveStrikes() []Strike {
+	strikes := make([]Strike, 0, len(o.Strikes))
+	for _, s := range o.Strikes {
+		if s.Timestamp > o.BannedUntil {
+			strikes = append(strikes, s)
+		}
+	}
+	return strikes
+}
+
+func (o *Offender) IsBanned() bool {
+	return time.Now().Unix() < o.BannedUntil
+}
+
+// Lift ends the ban of the offender and discards the strikes collected so far.
+func (o *Offender) Lift() error {
+	if !o.IsBanned() {
+		return fmt.Errorf("offender %s is not banned", o.Key)
+	}
+	o.BannedUntil = time.Now().Unix()
+	return nil
+}
@@ -0,0 +1,144 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	offenderKey = "030000000000000000000000000000000000000000000000000000000000000001"
+	banDuration = int64(60)
+)
+
+func TestOffender(t *testing.T) {
+	t.Run("add_strike", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			offender := domain.NewOffender(offenderKey)
+			require.NotNil(t, offender)
+			require.Equal(t, offenderKey, offender.Key)
+			require.Empty(t, offender.Strikes)
+			require.False(t, offender.IsBanned())
+
+			banned, err := offender.AddStrike(
+				domain.StrikeMissedPing, "", 2, banDuration,
+			)
+			require.NoError(t, err)
+			require.False(t, banned)
+			require.False(t, offender.IsBanned())
+			require.Len(t, offender.ActiveStrikes(), 1)
+
+			banned, err = offender.AddStrike(
+				domain.StrikeUnsignedForfeit, "round-id", 2, banDuration,
+			)
+			require.NoError(t, err)
+			require.True(t, banned)
+			require.True(t, offender.IsBanned())
+			require.Len(t, offender.Strikes, 2)
+			require.Empty(t, offender.ActiveStrikes())
+			require.Equal(t, domain.StrikeUnsignedForfeit, offender.Strikes[1].Reason)
+			require.Equal(t, "round-id", offender.Strikes[1].RoundId)
+		})
+
+		t.Run("once per round", func(t *testing.T) {
+			offender := domain.NewOffender(offenderKey)
+
+			banned, err := offender.AddStrike(
+				domain.StrikeInvalidSignature, "round-id", 2, banDuration,
+			)
+			require.NoError(t, err)
+			require.False(t, banned)
+
+			banned, err = offender.AddStrike(
+				domain.StrikeUnsignedForfeit, "round-id", 2, banDuration,
+			)
+			require.NoError(t, err)
+			require.False(t, banned)
+			require.False(t, offender.IsBanned())
+			require.Len(t, offender.Strikes, 1)
+			require.Equal(t, domain.StrikeInvalidSignature, offender.Strikes[0].Reason)
+
+			banned, err = offender.AddStrike(
+				domain.StrikeMissedPing, "other-round-id", 2, banDuration,
+			)
+			require.NoError(t, err)
+			require.True(t, banned)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			bannedOffender := domain.NewOffender(offenderKey)
+			_, err := bannedOffender.AddStrike(
+				domain.StrikeInvalidSignature, "", 1, banDuration,
+			)
+			require.NoError(t, err)
+
+			fixtures := []struct {
+				offender    *domain.Offender
+				reason      domain.StrikeReason
+				threshold   int
+				banDuration int64
+				expectedErr string
+			}{
+				{
+					offender:    domain.NewOffender(offenderKey),
+					reason:      "",
+					threshold:   1,
+					banDuration: banDuration,
+					expectedErr: "missing strike reason",
+				},
+				{
+					offender:    domain.NewOffender(offenderKey),
+					reason:      domain.StrikeMissedPing,
+					threshold:   0,
+					banDuration: banDuration,
+					expectedErr: "invalid ban threshold, must be greater than 0",
+				},
+				{
+					offender:    domain.NewOffender(offenderKey),
+					reason:      domain.StrikeMissedPing,
+					threshold:   1,
+					banDuration: 0,
+					expectedErr: "invalid ban duration, must be greater than 0",
+				},
+				{
+					offender:    bannedOffender,
+					reason:      domain.StrikeMissedPing,
+					threshold:   1,
+					banDuration: banDuration,
+					expectedErr: "offender " + offenderKey + " is already banned",
+				},
+			}
+
+			for _, f := range fixtures {
+				banned, err := f.offender.AddStrike(
+					f.reason, "", f.threshold, f.banDuration,
+				)
+				require.EqualError(t, err, f.expectedErr)
+				require.False(t, banned)
+			}
+		})
+	})
+
+	t.Run("lift", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			offender := domain.NewOffender(offenderKey)
+			banned, err := offender.AddStrike(
+				domain.StrikeUnsignedForfeit, "", 1, banDuration,
+			)
+			require.NoError(t, err)
+			require.True(t, banned)
+
+			err = offender.Lift()
+			require.NoError(t, err)
+			require.False(t, offender.IsBanned())
+			require.Empty(t, offender.ActiveStrikes())
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			offender := domain.NewOffender(offenderKey)
+			err := offender.Lift()
+			require.EqualError(t, err, "offender "+offenderKey+" is not banned")
+		})
+	})
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Page selects a page of a list, Cursor is the one returned along with the
+// previous page, empty for the first one. A zero Size means no limit.
+type Page struct {
+	Cursor string
+	Size   int
+}
+
+// VtxoFilter selects the vtxos of a list, the zero values are ignored. The
+// ranges include their bounds.
+type VtxoFilter struct {
+	Pubkey      string
+	Spent       *bool
+	Swept       *bool
+	Redeemed    *bool
+	MinAmount   uint64
+	MaxAmount   uint64
+	MinExpireAt int64
+	MaxExpireAt int64
+	RoundTxid   string
+}
+
+func (f VtxoFilter) Match(vtxo Vtxo) bool {
+	if len(f.Pubkey) > 0 && vtxo.Pubkey != f.Pubkey {
+		return false
+	}
+	if f.Spent != nil && vtxo.Spent != *f.Spent {
+		return false
+	}
+	if f.Swept != nil && vtxo.Swept != *f.Swept {
+		return false
+	}
+	if f.Redeemed != nil && vtxo.Redeemed != *f.Redeemed {
+		return false
+	}
+	if f.MinAmount > 0 && vtxo.Amount < f.MinAmount {
+		return false
+	}
+	if f.MaxAmount > 0 && vtxo.Amount > f.MaxAmount {
+		return false
+	}
+	if f.MinExpireAt > 0 && vtxo.ExpireAt < f.MinExpireAt {
+		return false
+	}
+	if f.MaxExpireAt > 0 && vtxo.ExpireAt > f.MaxExpireAt {
+		return false
+	}
+	if len(f.RoundTxid) > 0 && vtxo.PoolTx != f.RoundTxid {
+		return false
+	}
+	return true
+}
+
+// RoundFilter selects the rounds of a list by their starting time, the zero
+// values are ignored. The range excludes its bounds.
+type RoundFilter struct {
+	StartedAfter  int64
+	StartedBefore int64
+}
+
+// Vtxos are listed by key, the cursor of a page is made of the key of its
+// last vtxo.
+
+func NewVtxoCursor(key VtxoKey) string {
+	return encodeCursor(fmt.Sprintf("%s:%d", key.Txid, key.VOut))
+}
+
+func ParseVtxoCursor(cursor string) (*VtxoKey, error) {
+	txid, vout, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	index, err := strconv.ParseUint(vout, 10, 32)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &VtxoKey{Txid: txid, VOut: uint32(index)}, nil
+}
+
+// Rounds are listed by starting time and id, the cursor of a page is made of
+// those of its last round.
+
+func NewRoundCursor(startingTimestamp int64, id string) string {
+	return encodeCursor(fmt.Sprintf("%d:%s", startingTimestamp, id))
+}
+
+func ParseRoundCursor(cursor string) (int64, string, error) {
+	timestamp, id, err := decodeCursor(cursor)
+	if err != nil {
+		return 0, "", err
+	}
+	startingTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return 0, "", ErrInvalidCursor
+	}
+	return startingTimestamp, id, nil
+}
+
+func encodeCursor(str string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(str))
+}
+
+func decodeCursor(cursor string) (string, string, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", "", ErrInvalidCursor
+	}
+	first, second, ok := strings.Cut(string(buf), ":")
+	if !ok || len(first) <= 0 || len(second) <= 0 {
+		return "", "", ErrInvalidCursor
+	}
+	return first, second, nil
+}
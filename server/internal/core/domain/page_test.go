@@ -0,0 +1,140 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("vtxo", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			key := inputs[0].VtxoKey
+			key.VOut = 3
+
+			parsedKey, err := domain.ParseVtxoCursor(domain.NewVtxoCursor(key))
+			require.NoError(t, err)
+			require.NotNil(t, parsedKey)
+			require.Equal(t, key, *parsedKey)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			fixtures := []string{
+				"",
+				"not base64!",
+				"dHhpZA",     // txid
+				"dHhpZDp4",   // txid:x
+				"OjA",        // :0
+				"dHhpZDotMQ", // txid:-1
+			}
+
+			for _, cursor := range fixtures {
+				key, err := domain.ParseVtxoCursor(cursor)
+				require.ErrorIs(t, err, domain.ErrInvalidCursor)
+				require.Nil(t, key)
+			}
+		})
+	})
+
+	t.Run("round", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			cursor := domain.NewRoundCursor(1729000000, "round-id")
+
+			timestamp, id, err := domain.ParseRoundCursor(cursor)
+			require.NoError(t, err)
+			require.Equal(t, int64(1729000000), timestamp)
+			require.Equal(t, "round-id", id)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			fixtures := []string{
+				"",
+				"not base64!",
+				"aWQ",    // id
+				"eDppZA", // x:id
+				"MTIzOg", // 123:
+			}
+
+			for _, cursor := range fixtures {
+				_, _, err := domain.ParseRoundCursor(cursor)
+				require.ErrorIs(t, err, domain.ErrInvalidCursor)
+			}
+		})
+	})
+}
+
+func TestVtxoFilter(t *testing.T) {
+	vtxo := inputs[0]
+	vtxo.ExpireAt = 1729000000
+	vtxo.PoolTx = "roundtxid"
+	yes, no := true, false
+
+	fixtures := []struct {
+		name     string
+		filter   domain.VtxoFilter
+		expected bool
+	}{
+		{
+			name:     "empty",
+			filter:   domain.VtxoFilter{},
+			expected: true,
+		},
+		{
+			name: "matching",
+			filter: domain.VtxoFilter{
+				Pubkey:      vtxo.Pubkey,
+				Spent:       &no,
+				Swept:       &no,
+				Redeemed:    &no,
+				MinAmount:   vtxo.Amount,
+				MaxAmount:   vtxo.Amount,
+				MinExpireAt: vtxo.ExpireAt,
+				MaxExpireAt: vtxo.ExpireAt,
+				RoundTxid:   vtxo.PoolTx,
+			},
+			expected: true,
+		},
+		{
+			name:     "other_pubkey",
+			filter:   domain.VtxoFilter{Pubkey: "other"},
+			expected: false,
+		},
+		{
+			name:     "spent",
+			filter:   domain.VtxoFilter{Spent: &yes},
+			expected: false,
+		},
+		{
+			name:     "below_min_amount",
+			filter:   domain.VtxoFilter{MinAmount: vtxo.Amount + 1},
+			expected: false,
+		},
+		{
+			name:     "above_max_amount",
+			filter:   domain.VtxoFilter{MaxAmount: vtxo.Amount - 1},
+			expected: false,
+		},
+		{
+			name:     "below_min_expiry",
+			filter:   domain.VtxoFilter{MinExpireAt: vtxo.ExpireAt + 1},
+			expected: false,
+		},
+		{
+			name:     "above_max_expiry",
+			filter:   domain.VtxoFilter{MaxExpireAt: vtxo.ExpireAt - 1},
+			expected: false,
+		},
+		{
+			name:     "other_round",
+			filter:   domain.VtxoFilter{RoundTxid: "other"},
+			expected: false,
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			require.Equal(t, f.expected, f.filter.Match(vtxo))
+		})
+	}
+}
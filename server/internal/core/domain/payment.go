@@ -9,12 +9,13 @@ import (
 	"github.com/google/uuid"
 )
 
-const dustAmount = 450
-
 type Payment struct {
 	Id        string
 	Inputs    []Vtxo
 	Receivers []Receiver
+	// Fee is the service fee charged by the ASP, that is the part of the input
+	// amount not assigned to any receiver.
+	Fee uint64
 }
 
 func NewPayment(inputs []Vtxo) (*Payment, error) {
@@ -22,7 +23,7 @@ func NewPayment(inputs []Vtxo) (*Payment, error) {
 		Id:     uuid.New().String(),
 		Inputs: inputs,
 	}
-	if err := p.validate(true); err != nil {
+	if err := p.validate(true, 0); err != nil {
 		return nil, err
 	}
 	return p, nil
@@ -36,17 +37,22 @@ func NewPaymentUnsafe(inputs []Vtxo, receivers []Receiver) *Payment {
 	}
 }
 
-func (p *Payment) AddReceivers(receivers []Receiver) (err error) {
+func (p *Payment) AddReceivers(
+	receivers []Receiver, fee, dustAmount uint64,
+) (err error) {
 	if p.Receivers == nil {
 		p.Receivers = make([]Receiver, 0)
 	}
 	p.Receivers = append(p.Receivers, receivers...)
+	prevFee := p.Fee
+	p.Fee = fee
 	defer func() {
 		if err != nil {
 			p.Receivers = p.Receivers[:len(p.Receivers)-len(receivers)]
+			p.Fee = prevFee
 		}
 	}()
-	err = p.validate(false)
+	err = p.validate(false, dustAmount)
 	return
 }
 
@@ -66,7 +72,7 @@ func (p Payment) TotalOutputAmount() uint64 {
 	return tot
 }
 
-func (p Payment) validate(ignoreOuts bool) error {
+func (p Payment) validate(ignoreOuts bool, dustAmount uint64) error {
 	if len(p.Id) <= 0 {
 		return fmt.Errorf("missing id")
 	}
@@ -79,7 +85,7 @@ func (p Payment) validate(ignoreOuts bool) error {
 	if len(p.Receivers) <= 0 {
 		return fmt.Errorf("missing outputs")
 	}
-	// Check that input and output and output amounts match.
+	// Check that input amount matches output amount plus service fee.
 	inAmount := p.TotalInputAmount()
 	outAmount := uint64(0)
 	for _, r := range p.Receivers {
@@ -91,7 +97,7 @@ func (p Payment) validate(ignoreOuts bool) error {
 		}
 		outAmount += r.Amount
 	}
-	if inAmount != outAmount {
+	if inAmount != outAmount+p.Fee {
 		return fmt.Errorf("input and output amounts mismatch")
 	}
 	return nil
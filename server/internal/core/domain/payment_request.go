@@ -0,0 +1,17 @@
+package domain
+
+// PaymentRequest is a payment registered by a user and waiting to be included
+// in a round.
+type PaymentRequest struct {
+	Payment
+	EphemeralPubkey string
+	Timestamp       int64
+}
+
+// AsyncPaymentRequest holds the receivers of an async payment created, but not
+// completed yet, by spending the vtxo it refers to.
+type AsyncPaymentRequest struct {
+	VtxoKey
+	Receivers []Receiver
+	ExpireAt  int64
+}
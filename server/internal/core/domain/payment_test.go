@@ -65,13 +65,30 @@ func TestPayment(t *testing.T) {
 					Pubkey: "020000000000000000000000000000000000000000000000000000000000000002",
 					Amount: 550,
 				},
-			})
+			}, 0, dustAmount)
 			require.NoError(t, err)
+			require.Zero(t, payment.Fee)
+		})
+
+		t.Run("valid_with_fee", func(t *testing.T) {
+			payment, err := domain.NewPayment(inputs)
+			require.NoError(t, err)
+			require.NotNil(t, payment)
+
+			err = payment.AddReceivers([]domain.Receiver{
+				{
+					Pubkey: "030000000000000000000000000000000000000000000000000000000000000001",
+					Amount: 900,
+				},
+			}, 100, dustAmount)
+			require.NoError(t, err)
+			require.Equal(t, 100, int(payment.Fee))
 		})
 
 		t.Run("invalid", func(t *testing.T) {
 			fixtures := []struct {
 				receivers   []domain.Receiver
+				fee         uint64
 				expectedErr string
 			}{
 				{
@@ -96,6 +113,16 @@ func TestPayment(t *testing.T) {
 					},
 					expectedErr: "input and output amounts mismatch",
 				},
+				{
+					receivers: []domain.Receiver{
+						{
+							Pubkey: "030000000000000000000000000000000000000000000000000000000000000001",
+							Amount: 1000,
+						},
+					},
+					fee:         100,
+					expectedErr: "input and output amounts mismatch",
+				},
 			}
 
 			payment, err := domain.NewPayment(inputs)
@@ -103,7 +130,7 @@ func TestPayment(t *testing.T) {
 			require.NotNil(t, payment)
 
 			for _, f := range fixtures {
-				err := payment.AddReceivers(f.receivers)
+				err := payment.AddReceivers(f.receivers, f.fee, dustAmount)
 				require.EqualError(t, err, f.expectedErr)
 			}
 		})
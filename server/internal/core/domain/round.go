@@ -49,6 +49,7 @@ type Round struct {
 	Version           uint
 	Swept             bool // true if all the vtxos are vtxo.Swept or vtxo.Redeemed
 	changes           []RoundEvent
+	attempt           int
 }
 
 func NewRound(dustAmount uint64) *Round {
@@ -78,6 +79,7 @@ func NewFinalizedRound(
 			Id:             r.Id,
 			CongestionTree: congestionTree,
 			PoolTx:         poolTx,
+			Attempt:        1,
 		},
 		RoundFinalized{
 			Id:        r.Id,
@@ -121,6 +123,10 @@ func (r *Round) On(event RoundEvent, replayed bool) {
 		r.Connectors = append([]string{}, e.Connectors...)
 		r.ConnectorAddress = e.ConnectorAddress
 		r.UnsignedTx = e.PoolTx
+		r.attempt++
+	case PoolTxSigned:
+		r.Txid = e.PoolTxid
+		r.ForfeitTxs = append([]string{}, e.ForfeitTxs...)
 	case RoundFinalized:
 		r.Stage.Ended = true
 		r.Txid = e.Txid
@@ -136,6 +142,19 @@ func (r *Round) On(event RoundEvent, replayed bool) {
 		for _, p := range e.Payments {
 			r.Payments[p.Id] = p
 		}
+	case PaymentsDropped:
+		// The round goes back to the registration stage so that it can be
+		// finalized again with the remaining payments.
+		r.Stage.Code = RegistrationStage
+		for _, id := range e.PaymentIds {
+			delete(r.Payments, id)
+		}
+		r.CongestionTree = nil
+		r.Connectors = nil
+		r.ConnectorAddress = ""
+		r.UnsignedTx = ""
+		r.Txid = ""
+		r.ForfeitTxs = nil
 	}
 
 	if replayed {
@@ -166,7 +185,7 @@ func (r *Round) RegisterPayments(payments []Payment) ([]RoundEvent, error) {
 		return nil, fmt.Errorf("missing payments to register")
 	}
 	for _, p := range payments {
-		if err := p.validate(false); err != nil {
+		if err := p.validate(false, r.DustAmount); err != nil {
 			return nil, err
 		}
 	}
@@ -197,12 +216,72 @@ func (r *Round) StartFinalization(connectorAddress string, connectors []string,
 		Connectors:       connectors,
 		ConnectorAddress: connectorAddress,
 		PoolTx:           poolTx,
+		Attempt:          r.attempt + 1,
+	}
+	r.raise(event)
+
+	return []RoundEvent{event}, nil
+}
+
+func (r *Round) DropPayments(paymentIds []string) ([]RoundEvent, error) {
+	if len(paymentIds) <= 0 {
+		return nil, fmt.Errorf("missing payments to drop")
+	}
+	// Payments can be dropped while the congestion tree is being signed, before
+	// the finalization stage, or once their forfeit txs are left unsigned.
+	if r.Stage.Code == UndefinedStage || r.IsFailed() {
+		return nil, fmt.Errorf("not in a valid stage to drop payments")
+	}
+	if r.Stage.Ended {
+		return nil, fmt.Errorf("round already finalized")
+	}
+	for _, id := range paymentIds {
+		if _, ok := r.Payments[id]; !ok {
+			return nil, fmt.Errorf("payment %s not found", id)
+		}
+	}
+
+	event := PaymentsDropped{
+		Id:         r.Id,
+		PaymentIds: paymentIds,
 	}
 	r.raise(event)
 
 	return []RoundEvent{event}, nil
 }
 
+// SignPoolTx records the txid of the signed pool tx along with the signed
+// forfeit txs, before the pool tx is broadcasted.
+func (r *Round) SignPoolTx(forfeitTxs []string, txid string) ([]RoundEvent, error) {
+	if len(forfeitTxs) <= 0 {
+		return nil, fmt.Errorf("missing list of signed forfeit txs")
+	}
+	if len(txid) <= 0 {
+		return nil, fmt.Errorf("missing pool txid")
+	}
+	if r.Stage.Code != FinalizationStage || r.IsFailed() {
+		return nil, fmt.Errorf("not in a valid stage to sign pool tx")
+	}
+	if r.Stage.Ended {
+		return nil, fmt.Errorf("round already finalized")
+	}
+	event := PoolTxSigned{
+		Id:         r.Id,
+		PoolTxid:   txid,
+		ForfeitTxs: forfeitTxs,
+	}
+	r.raise(event)
+
+	return []RoundEvent{event}, nil
+}
+
+// IsPoolTxSigned returns whether the pool tx of the round being finalized has
+// been signed, and so possibly broadcasted.
+func (r *Round) IsPoolTxSigned() bool {
+	return r.Stage.Code == FinalizationStage && !r.Stage.Ended &&
+		!r.Stage.Failed && len(r.Txid) > 0
+}
+
 func (r *Round) EndFinalization(forfeitTxs []string, txid string) ([]RoundEvent, error) {
 	if len(forfeitTxs) <= 0 {
 		return nil, fmt.Errorf("missing list of signed forfeit txs")
@@ -235,6 +314,7 @@ func (r *Round) Fail(err error) []RoundEvent {
 		Id:        r.Id,
 		Err:       err.Error(),
 		Timestamp: time.Now().Unix(),
+		Attempt:   r.attempt,
 	}
 	r.raise(event)
 
@@ -254,6 +334,12 @@ func (r *Round) IsFailed() bool {
 	return r.Stage.Failed
 }
 
+// Attempt returns the number of times the finalization of the round has been
+// started, it's greater than 1 if some payments have been dropped.
+func (r *Round) Attempt() int {
+	return r.attempt
+}
+
 func (r *Round) TotalInputAmount() uint64 {
 	totInputs := 0
 	for _, p := range r.Payments {
@@ -274,6 +360,12 @@ func (r *Round) Sweep() {
 	r.Swept = true
 }
 
+// Unsweep rolls back the sweep of the round once a sweep tx is disconnected
+// from the chain by a reorg.
+func (r *Round) Unsweep() {
+	r.Swept = false
+}
+
 func (r *Round) raise(event RoundEvent) {
 	if r.changes == nil {
 		r.changes = make([]RoundEvent, 0)
@@ -17,7 +17,13 @@ type RoundRepository interface {
 	GetRoundWithTxid(ctx context.Context, txid string) (*Round, error)
 	GetSweepableRounds(ctx context.Context) ([]Round, error)
 	GetRoundsIds(ctx context.Context, startedAfter int64, startedBefore int64) ([]string, error)
+	// ListRoundsIds returns the page of the ids of the ended rounds matching
+	// the filter, sorted by starting time, and the cursor of the next page,
+	// empty if it's the last one.
+	ListRoundsIds(ctx context.Context, filter RoundFilter, page Page) ([]string, string, error)
 	GetSweptRounds(ctx context.Context) ([]Round, error)
+	GetUnfinishedRoundsIds(ctx context.Context) ([]string, error)
+	GetFailedRoundsIds(ctx context.Context) ([]string, error)
 	Close()
 }
 
@@ -28,8 +34,49 @@ type VtxoRepository interface {
 	GetVtxos(ctx context.Context, vtxos []VtxoKey) ([]Vtxo, error)
 	GetVtxosForRound(ctx context.Context, txid string) ([]Vtxo, error)
 	SweepVtxos(ctx context.Context, vtxos []VtxoKey) error
+	// UnredeemVtxos and UnsweepVtxos roll back the state of the given vtxos
+	// once the tx that redeemed or swept them is disconnected by a reorg.
+	UnredeemVtxos(ctx context.Context, vtxos []VtxoKey) error
+	UnsweepVtxos(ctx context.Context, vtxos []VtxoKey) error
 	GetAllVtxos(ctx context.Context, pubkey string) ([]Vtxo, []Vtxo, error)
+	// ListVtxos returns the page of the vtxos matching the filter, sorted by
+	// key, and the cursor of the next page, empty if it's the last one.
+	ListVtxos(ctx context.Context, filter VtxoFilter, page Page) ([]Vtxo, string, error)
 	GetAllSweepableVtxos(ctx context.Context) ([]Vtxo, error)
+	// GetAllVtxoKeys returns the keys of all vtxos, whatever their state.
+	GetAllVtxoKeys(ctx context.Context) ([]VtxoKey, error)
 	UpdateExpireAt(ctx context.Context, vtxos []VtxoKey, expireAt int64) error
 	Close()
 }
+
+type OffenderRepository interface {
+	AddOrUpdateOffender(ctx context.Context, offender Offender) error
+	GetOffender(ctx context.Context, key string) (*Offender, error)
+	GetBannedOffenders(ctx context.Context, bannedAt int64) ([]Offender, error)
+	GetAllOffenders(ctx context.Context) ([]Offender, error)
+	Close()
+}
+
+type PaymentRequestRepository interface {
+	AddOrUpdatePaymentRequest(ctx context.Context, request PaymentRequest) error
+	DeletePaymentRequests(ctx context.Context, ids []string) error
+	GetPaymentRequests(ctx context.Context) ([]PaymentRequest, error)
+	AddAsyncPaymentRequest(ctx context.Context, request AsyncPaymentRequest) error
+	DeleteAsyncPaymentRequest(ctx context.Context, vtxo VtxoKey) error
+	GetAsyncPaymentRequests(ctx context.Context) ([]AsyncPaymentRequest, error)
+	Close()
+}
+
+type SweepRepository interface {
+	AddOrUpdateSweep(ctx context.Context, sweep Sweep) error
+	GetSweep(ctx context.Context, id string) (*Sweep, error)
+	GetAllSweeps(ctx context.Context) ([]Sweep, error)
+	Close()
+}
+
+type MonitoredTxRepository interface {
+	AddOrUpdateMonitoredTx(ctx context.Context, tx MonitoredTx) error
+	DeleteMonitoredTxs(ctx context.Context, txids []string) error
+	GetAllMonitoredTxs(ctx context.Context) ([]MonitoredTx, error)
+	Close()
+}
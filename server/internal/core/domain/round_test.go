@@ -128,6 +128,10 @@ func TestRound(t *testing.T) {
 
 	testStartFinalization(t)
 
+	testDropPayments(t)
+
+	testSignPoolTx(t)
+
 	testEndFinalization(t)
 
 	testFail(t)
@@ -273,6 +277,17 @@ func testRegisterPayments(t *testing.T) {
 					payments:    nil,
 					expectedErr: "missing payments to register",
 				},
+				{
+					round: &domain.Round{
+						Id: "id",
+						Stage: domain.Stage{
+							Code: domain.RegistrationStage,
+						},
+						DustAmount: 1000,
+					},
+					payments:    payments,
+					expectedErr: "receiver amount must be greater than dust",
+				},
 			}
 
 			for _, f := range fixtures {
@@ -309,6 +324,7 @@ func testStartFinalization(t *testing.T) {
 			require.Exactly(t, connectors, event.Connectors)
 			require.Exactly(t, congestionTree, event.CongestionTree)
 			require.Exactly(t, poolTx, event.PoolTx)
+			require.Equal(t, 1, event.Attempt)
 		})
 
 		t.Run("invalid", func(t *testing.T) {
@@ -401,6 +417,276 @@ func testStartFinalization(t *testing.T) {
 	})
 }
 
+func testDropPayments(t *testing.T) {
+	t.Run("drop_payments", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			round := domain.NewRound(dustAmount)
+			events, err := round.StartRegistration()
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			events, err = round.RegisterPayments(payments)
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			events, err = round.StartFinalization("", connectors, congestionTree, poolTx)
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+			require.Equal(t, 1, round.Attempt())
+
+			events, err = round.DropPayments([]string{payments[0].Id})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.True(t, round.IsStarted())
+			require.Equal(t, domain.RegistrationStage, round.Stage.Code)
+			require.Len(t, round.Payments, len(payments)-1)
+			require.NotContains(t, round.Payments, payments[0].Id)
+			require.Empty(t, round.CongestionTree)
+			require.Empty(t, round.Connectors)
+			require.Empty(t, round.UnsignedTx)
+
+			event, ok := events[0].(domain.PaymentsDropped)
+			require.True(t, ok)
+			require.Equal(t, round.Id, event.Id)
+			require.Exactly(t, []string{payments[0].Id}, event.PaymentIds)
+
+			events, err = round.StartFinalization("", connectors, congestionTree, poolTx)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.Equal(t, 2, round.Attempt())
+
+			finalizationEvent, ok := events[0].(domain.RoundFinalizationStarted)
+			require.True(t, ok)
+			require.Equal(t, 2, finalizationEvent.Attempt)
+
+			events = round.Fail(fmt.Errorf("some valid reason"))
+			require.Len(t, events, 1)
+
+			failedEvent, ok := events[0].(domain.RoundFailed)
+			require.True(t, ok)
+			require.Equal(t, 2, failedEvent.Attempt)
+
+			replayed := domain.NewRoundFromEvents(round.Events())
+			require.Equal(t, round.Attempt(), replayed.Attempt())
+			require.Len(t, replayed.Payments, len(round.Payments))
+		})
+
+		t.Run("valid before finalization", func(t *testing.T) {
+			round := domain.NewRound(dustAmount)
+			events, err := round.StartRegistration()
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			events, err = round.RegisterPayments(payments)
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			// payments of cosigners that didn't sign the congestion tree are
+			// dropped before starting the finalization
+			events, err = round.DropPayments([]string{payments[0].Id})
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.Equal(t, domain.RegistrationStage, round.Stage.Code)
+			require.Len(t, round.Payments, len(payments)-1)
+			require.Equal(t, 0, round.Attempt())
+
+			events, err = round.StartFinalization("", connectors, congestionTree, poolTx)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.Equal(t, 1, round.Attempt())
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			paymentsById := map[string]domain.Payment{}
+			for _, p := range payments {
+				paymentsById[p.Id] = p
+			}
+			fixtures := []struct {
+				round       *domain.Round
+				paymentIds  []string
+				expectedErr string
+			}{
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code: domain.FinalizationStage,
+						},
+						Payments: paymentsById,
+					},
+					paymentIds:  nil,
+					expectedErr: "missing payments to drop",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code: domain.UndefinedStage,
+						},
+						Payments: paymentsById,
+					},
+					paymentIds:  []string{payments[0].Id},
+					expectedErr: "not in a valid stage to drop payments",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code:   domain.FinalizationStage,
+							Failed: true,
+						},
+						Payments: paymentsById,
+					},
+					paymentIds:  []string{payments[0].Id},
+					expectedErr: "not in a valid stage to drop payments",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code:  domain.FinalizationStage,
+							Ended: true,
+						},
+						Payments: paymentsById,
+					},
+					paymentIds:  []string{payments[0].Id},
+					expectedErr: "round already finalized",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code: domain.FinalizationStage,
+						},
+						Payments: paymentsById,
+					},
+					paymentIds:  []string{"unknown"},
+					expectedErr: "payment unknown not found",
+				},
+			}
+
+			for _, f := range fixtures {
+				events, err := f.round.DropPayments(f.paymentIds)
+				require.EqualError(t, err, f.expectedErr)
+				require.Empty(t, events)
+			}
+		})
+	})
+}
+
+func testSignPoolTx(t *testing.T) {
+	t.Run("sign_pool_tx", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			round := domain.NewRound(dustAmount)
+			events, err := round.StartRegistration()
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			events, err = round.RegisterPayments(payments)
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+
+			events, err = round.StartFinalization("", connectors, congestionTree, poolTx)
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+			require.False(t, round.IsPoolTxSigned())
+
+			events, err = round.SignPoolTx(forfeitTxs, txid)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.True(t, round.IsPoolTxSigned())
+			require.False(t, round.IsEnded())
+			require.Exactly(t, txid, round.Txid)
+			require.Exactly(t, forfeitTxs, round.ForfeitTxs)
+
+			event, ok := events[0].(domain.PoolTxSigned)
+			require.True(t, ok)
+			require.Equal(t, round.Id, event.Id)
+			require.Exactly(t, txid, event.PoolTxid)
+			require.Exactly(t, forfeitTxs, event.ForfeitTxs)
+
+			events, err = round.EndFinalization(round.ForfeitTxs, round.Txid)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.True(t, round.IsEnded())
+			require.False(t, round.IsPoolTxSigned())
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			fixtures := []struct {
+				round       *domain.Round
+				forfeitTxs  []string
+				txid        string
+				expectedErr string
+			}{
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code: domain.FinalizationStage,
+						},
+					},
+					forfeitTxs:  nil,
+					txid:        txid,
+					expectedErr: "missing list of signed forfeit txs",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code: domain.FinalizationStage,
+						},
+					},
+					forfeitTxs:  forfeitTxs,
+					txid:        "",
+					expectedErr: "missing pool txid",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code: domain.RegistrationStage,
+						},
+					},
+					forfeitTxs:  forfeitTxs,
+					txid:        txid,
+					expectedErr: "not in a valid stage to sign pool tx",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code:   domain.FinalizationStage,
+							Failed: true,
+						},
+					},
+					forfeitTxs:  forfeitTxs,
+					txid:        txid,
+					expectedErr: "not in a valid stage to sign pool tx",
+				},
+				{
+					round: &domain.Round{
+						Id: "0",
+						Stage: domain.Stage{
+							Code:  domain.FinalizationStage,
+							Ended: true,
+						},
+					},
+					forfeitTxs:  forfeitTxs,
+					txid:        txid,
+					expectedErr: "round already finalized",
+				},
+			}
+
+			for _, f := range fixtures {
+				events, err := f.round.SignPoolTx(f.forfeitTxs, f.txid)
+				require.EqualError(t, err, f.expectedErr)
+				require.Empty(t, events)
+			}
+		})
+	})
+}
+
 func testEndFinalization(t *testing.T) {
 	t.Run("end_registration", func(t *testing.T) {
 		t.Run("valid", func(t *testing.T) {
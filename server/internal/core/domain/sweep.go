@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	SweepScheduled SweepStatus = "scheduled"
+	SweepCompleted SweepStatus = "completed"
+	SweepFailed    SweepStatus = "failed"
+)
+
+type SweepStatus string
+
+// SweepOutput is an expired shared output (or vtxo) of a congestion tree
+// included in a sweep.
+type SweepOutput struct {
+	VtxoKey
+	Amount uint64
+	// SweepTxid is the txid of the tx spending the output, empty if it
+	// couldn't be broadcasted.
+	SweepTxid string
+}
+
+// Sweep is the sweep of the shared outputs of a round's congestion tree, or of
+// one of its sub trees once some parts of it have been broadcasted, scheduled
+// at the time they expire. Once attempted, it records the outcome.
+type Sweep struct {
+	// Id is the txid of the root of the (sub) tree.
+	Id          string
+	RoundTxid   string
+	ScheduledAt int64
+	Status      SweepStatus
+	// AttemptedAt is the time of the last attempt, zero if never attempted.
+	AttemptedAt int64
+	Outputs     []SweepOutput
+	Error       string
+}
+
+func NewSweep(id, roundTxid string, scheduledAt int64) *Sweep {
+	return &Sweep{
+		Id:          id,
+		RoundTxid:   roundTxid,
+		ScheduledAt: scheduledAt,
+		Status:      SweepScheduled,
+		Outputs:     make([]SweepOutput, 0),
+	}
+}
+
+// Complete records the outputs swept, if any. There may be none if they were
+// already spent by unilateral exits.
+func (s *Sweep) Complete(outputs []SweepOutput) error {
+	for _, output := range outputs {
+		if len(output.SweepTxid) <= 0 {
+			return fmt.Errorf(
+				"missing sweep txid for output %s:%d", output.Txid, output.VOut,
+			)
+		}
+	}
+
+	s.Status = SweepCompleted
+	s.AttemptedAt = time.Now().Unix()
+	s.Outputs = outputs
+	s.Error = ""
+	return nil
+}
+
+// Fail records the reason why the sweep failed, along with the outputs it
+// tried to sweep. Those broadcasted in a separate tx may have the txid set.
+func (s *Sweep) Fail(outputs []SweepOutput, reason string) error {
+	if len(reason) <= 0 {
+		return fmt.Errorf("missing failure reason")
+	}
+
+	s.Status = SweepFailed
+	s.AttemptedAt = time.Now().Unix()
+	s.Outputs = outputs
+	s.Error = reason
+	return nil
+}
+
+// IsPending returns whether the sweep is still to be attempted, or to be
+// retried because it failed.
+func (s *Sweep) IsPending() bool {
+	return s.Status == SweepScheduled || s.Status == SweepFailed
+}
+
+func (s *Sweep) Amount() uint64 {
+	amount := uint64(0)
+	for _, output := range s.Outputs {
+		amount += output.Amount
+	}
+	return amount
+}
@@ -0,0 +1,109 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sweepTreeRootTxid = "0000000000000000000000000000000000000000000000000000000000000001"
+	sweepTxid         = "0000000000000000000000000000000000000000000000000000000000000002"
+	sweepScheduledAt  = int64(1700000000)
+)
+
+func TestSweep(t *testing.T) {
+	t.Run("new", func(t *testing.T) {
+		sweep := domain.NewSweep(sweepTreeRootTxid, txid, sweepScheduledAt)
+		require.NotNil(t, sweep)
+		require.Equal(t, sweepTreeRootTxid, sweep.Id)
+		require.Equal(t, txid, sweep.RoundTxid)
+		require.Equal(t, sweepScheduledAt, sweep.ScheduledAt)
+		require.Equal(t, domain.SweepScheduled, sweep.Status)
+		require.True(t, sweep.IsPending())
+		require.Zero(t, sweep.AttemptedAt)
+		require.Empty(t, sweep.Outputs)
+		require.Zero(t, sweep.Amount())
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			sweep := domain.NewSweep(sweepTreeRootTxid, txid, sweepScheduledAt)
+			err := sweep.Complete([]domain.SweepOutput{
+				{
+					VtxoKey:   domain.VtxoKey{Txid: sweepTreeRootTxid, VOut: 0},
+					Amount:    1000,
+					SweepTxid: sweepTxid,
+				},
+				{
+					VtxoKey:   domain.VtxoKey{Txid: sweepTreeRootTxid, VOut: 1},
+					Amount:    2000,
+					SweepTxid: sweepTxid,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, domain.SweepCompleted, sweep.Status)
+			require.False(t, sweep.IsPending())
+			require.NotZero(t, sweep.AttemptedAt)
+			require.Len(t, sweep.Outputs, 2)
+			require.Equal(t, uint64(3000), sweep.Amount())
+			require.Empty(t, sweep.Error)
+		})
+
+		t.Run("nothing to sweep", func(t *testing.T) {
+			sweep := domain.NewSweep(sweepTreeRootTxid, txid, sweepScheduledAt)
+			err := sweep.Complete(nil)
+			require.NoError(t, err)
+			require.Equal(t, domain.SweepCompleted, sweep.Status)
+			require.Zero(t, sweep.Amount())
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			sweep := domain.NewSweep(sweepTreeRootTxid, txid, sweepScheduledAt)
+			err := sweep.Complete([]domain.SweepOutput{
+				{
+					VtxoKey: domain.VtxoKey{Txid: sweepTreeRootTxid, VOut: 0},
+					Amount:  1000,
+				},
+			})
+			require.EqualError(
+				t, err, "missing sweep txid for output "+sweepTreeRootTxid+":0",
+			)
+			require.Equal(t, domain.SweepScheduled, sweep.Status)
+		})
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		t.Run("valid", func(t *testing.T) {
+			sweep := domain.NewSweep(sweepTreeRootTxid, txid, sweepScheduledAt)
+			outputs := []domain.SweepOutput{
+				{
+					VtxoKey: domain.VtxoKey{Txid: sweepTreeRootTxid, VOut: 0},
+					Amount:  1000,
+				},
+			}
+			err := sweep.Fail(outputs, "failed to broadcast sweep tx")
+			require.NoError(t, err)
+			require.Equal(t, domain.SweepFailed, sweep.Status)
+			require.True(t, sweep.IsPending())
+			require.NotZero(t, sweep.AttemptedAt)
+			require.Equal(t, "failed to broadcast sweep tx", sweep.Error)
+			require.Equal(t, outputs, sweep.Outputs)
+
+			// a failed sweep can be completed with a retry
+			outputs[0].SweepTxid = sweepTxid
+			err = sweep.Complete(outputs)
+			require.NoError(t, err)
+			require.Equal(t, domain.SweepCompleted, sweep.Status)
+			require.Empty(t, sweep.Error)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			sweep := domain.NewSweep(sweepTreeRootTxid, txid, sweepScheduledAt)
+			err := sweep.Fail(nil, "")
+			require.EqualError(t, err, "missing failure reason")
+			require.Equal(t, domain.SweepScheduled, sweep.Status)
+		})
+	})
+}
@@ -1,11 +1,22 @@
 package ports
 
-import "github.com/ark-network/ark/server/internal/core/domain"
+import (
+	"context"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+)
 
 type RepoManager interface {
 	Events() domain.RoundEventRepository
 	Rounds() domain.RoundRepository
 	Vtxos() domain.VtxoRepository
+	Offenders() domain.OffenderRepository
+	PaymentRequests() domain.PaymentRequestRepository
+	Sweeps() domain.SweepRepository
+	MonitoredTxs() domain.MonitoredTxRepository
 	RegisterEventsHandler(func(*domain.Round))
+	// Backup writes a consistent copy of the stores into the given dir, with
+	// the same layout of the db dir.
+	Backup(ctx context.Context, dir string) error
 	Close()
 }
@@ -10,9 +10,39 @@ type VtxoWithValue struct {
 	Value uint64
 }
 
+// BlockInfo identifies a block of the chain by its height and its median time
+// past, ie. the time relative timelocks are checked against.
+type BlockInfo struct {
+	Height     int64
+	MedianTime int64
+}
+
+// Reorg is a chain reorganisation, notified once the blocks of the new best
+// chain are connected. Some of the txs of the disconnected blocks may be
+// included again in the new ones.
+type Reorg struct {
+	DisconnectedBlocks int
+	// Txids are the txs of the wallet unconfirmed after the reorg, ie. those
+	// of the disconnected blocks not included again in the new ones. The txs
+	// of the watched scripts may be missing.
+	Txids []string
+}
+
 type BlockchainScanner interface {
 	WatchScripts(ctx context.Context, scripts []string) error
 	UnwatchScripts(ctx context.Context, scripts []string) error
 	GetNotificationChannel(ctx context.Context) <-chan map[string]VtxoWithValue
 	IsTransactionConfirmed(ctx context.Context, txid string) (isConfirmed bool, blocktime int64, err error)
+	// GetTransactionBlock returns the block that confirmed the given tx, nil if
+	// the tx is unconfirmed.
+	GetTransactionBlock(ctx context.Context, txid string) (*BlockInfo, error)
+	// IsTransactionPublished returns whether the tx is either in the mempool
+	// or in the chain.
+	IsTransactionPublished(ctx context.Context, txid string) (bool, error)
+	// GetBlockNotificationChannel returns a channel notifying the current tip
+	// of the chain, and then every new block, until the context is done.
+	GetBlockNotificationChannel(ctx context.Context) (<-chan BlockInfo, error)
+	// GetReorgNotificationChannel returns a channel notifying the chain
+	// reorganisations, until the context is done.
+	GetReorgNotificationChannel(ctx context.Context) (<-chan Reorg, error)
 }
@@ -1,9 +1,24 @@
 package ports
 
+// TimeUnit is the clock the times of the scheduled tasks refer to.
+type TimeUnit int
+
+const (
+	// UnixTime is the wall clock.
+	UnixTime TimeUnit = iota
+	// MedianTime is the median time past of the chain tip, ie. the time
+	// relative timelocks are checked against.
+	MedianTime
+)
+
 type SchedulerService interface {
-	Start()
+	Start() error
 	Stop()
 
 	ScheduleTask(interval int64, immediate bool, task func()) error
-	ScheduleTaskOnce(delay int64, task func()) error
+	ScheduleTaskOnce(at int64, task func()) error
+	// Unit returns the clock of the scheduler.
+	Unit() TimeUnit
+	// Now returns the current time of the scheduler clock.
+	Now() int64
 }
@@ -1,12 +1,18 @@
 package ports
 
 import (
+	"errors"
+
 	"github.com/ark-network/ark/common/tree"
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
+// ErrInvalidForfeitSignature is returned by VerifyForfeitTx if the forfeit tx
+// carries a signature that isn't valid.
+var ErrInvalidForfeitSignature = errors.New("invalid forfeit tx signature")
+
 type SweepInput interface {
 	GetAmount() uint64
 	GetHash() chainhash.Hash
@@ -22,9 +28,13 @@ type TxBuilder interface {
 		cosigners ...*secp256k1.PublicKey,
 	) (poolTx string, congestionTree tree.CongestionTree, connectorAddress string, err error)
 	BuildForfeitTxs(aspPubkey *secp256k1.PublicKey, poolTx string, payments []domain.Payment, minRelayFee uint64) (connectors []string, forfeitTxs []string, err error)
-	BuildSweepTx(inputs []SweepInput) (signedSweepTx string, err error)
+	// BuildSweepTx builds a tx spending the given expired outputs to the wallet.
+	// The fee rate is in sats per kvbyte, if zero the wallet estimation is used.
+	BuildSweepTx(inputs []SweepInput, feeRate uint64) (signedSweepTx string, err error)
 	GetVtxoScript(userPubkey, aspPubkey *secp256k1.PublicKey) ([]byte, error)
 	GetSweepInput(parentblocktime int64, node tree.Node) (expirationtime int64, sweepInput SweepInput, err error)
+	// VerifyForfeitTx returns whether the forfeit tx is signed, or
+	// ErrInvalidForfeitSignature if its signature isn't valid
 	VerifyForfeitTx(tx string) (valid bool, txid string, err error)
 	FinalizeAndExtractForfeit(tx string) (txhex string, err error)
 	// FindLeaves returns all the leaves txs that are reachable from the given outpoint
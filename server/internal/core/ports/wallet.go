@@ -27,12 +27,35 @@ type WalletService interface {
 	SignTransactionTapscript(ctx context.Context, pset string, inputIndexes []int) (string, error) // inputIndexes == nil means sign all inputs
 	SelectUtxos(ctx context.Context, asset string, amount uint64) ([]TxInput, uint64, error)
 	BroadcastTransaction(ctx context.Context, txHex string) (string, error)
+	// BumpFeeWithChild broadcasts a child tx spending the outputs of the given
+	// unconfirmed tx owned by the main account, paying enough fees for the
+	// package to reach the given fee rate in sats per kvbyte (CPFP). Missing
+	// funds are taken from the main account. It returns the txid of the child.
+	BumpFeeWithChild(ctx context.Context, txHex string, feeRate uint64) (string, error)
 	WaitForSync(ctx context.Context, txid string) error
 	EstimateFees(ctx context.Context, psbt string) (uint64, error)
+	// EstimateFeeRate returns the current fee rate in sats per kvbyte.
+	EstimateFeeRate(ctx context.Context) (uint64, error)
 	ListConnectorUtxos(ctx context.Context, connectorAddress string) ([]TxInput, error)
 	MainAccountBalance(ctx context.Context) (uint64, uint64, error)
 	ConnectorsAccountBalance(ctx context.Context) (uint64, uint64, error)
 	LockConnectorUtxos(ctx context.Context, utxos []TxOutpoint) error
+	// Withdraw sends the given amount from the main account to the given
+	// address, paying the given fee rate in sats per kvbyte, or the estimated
+	// one if zero. It returns the txid.
+	Withdraw(ctx context.Context, address string, amount, feeRate uint64) (string, error)
+	// Consolidate merges up to maxInputs utxos of the main account, the
+	// smallest first, into a single one, paying the given fee rate in sats per
+	// kvbyte, or the estimated one if zero. It returns the txid.
+	Consolidate(ctx context.Context, maxInputs uint32, feeRate uint64) (string, error)
+	// ReleaseConnectorUtxos sends the given utxos of the connectors account,
+	// not needed anymore by any forfeit tx, back to the main account, paying
+	// the given fee rate in sats per kvbyte, or the estimated one if zero. It
+	// returns the txid.
+	ReleaseConnectorUtxos(ctx context.Context, utxos []TxInput, feeRate uint64) (string, error)
+	// Backup writes a consistent copy of the wallet db, if held locally, into
+	// the given dir, with the same layout of the wallet datadir.
+	Backup(ctx context.Context, dir string) error
 	Close()
 }
 
@@ -0,0 +1,253 @@
+package backup
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	Version = 1
+
+	manifestFile = "manifest.json"
+)
+
+// Entry is a file of the archive, its path is relative to the datadir.
+type Entry struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// Manifest describes the content of the archive and the ASP it belongs to.
+type Manifest struct {
+	Version   int     `json:"version"`
+	CreatedAt int64   `json:"created_at"`
+	Network   string  `json:"network"`
+	Pubkey    string  `json:"pubkey"`
+	Entries   []Entry `json:"entries"`
+}
+
+// Write archives all the files of the given dir, along with the manifest,
+// whose entries are set from the files, and encrypts the archive with the
+// given password.
+func Write(w io.Writer, dir string, manifest *Manifest, password string) error {
+	if len(password) <= 0 {
+		return fmt.Errorf("missing password")
+	}
+
+	entries, err := listEntries(dir)
+	if err != nil {
+		return err
+	}
+	manifest.Version = Version
+	manifest.Entries = entries
+
+	buf, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to serialize manifest: %s", err)
+	}
+
+	encWriter, err := newEncryptWriter(w, password)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt archive: %s", err)
+	}
+	tarWriter := tar.NewWriter(encWriter)
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: manifestFile, Mode: 0600, Size: int64(len(buf)),
+	}); err != nil {
+		return err
+	}
+	if _, err := tarWriter.Write(buf); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := writeEntry(tarWriter, dir, entry); err != nil {
+			return fmt.Errorf("failed to archive %s: %s", entry.Path, err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return encWriter.Close()
+}
+
+// ReadManifest decrypts the archive only to return its manifest.
+func ReadManifest(r io.Reader, password string) (*Manifest, error) {
+	decReader, err := newDecryptReader(r, password)
+	if err != nil {
+		return nil, err
+	}
+	return readManifest(tar.NewReader(decReader))
+}
+
+// Extract decrypts the archive and extracts its files into the given dir. It
+// fails if any file doesn't match its entry of the manifest.
+func Extract(r io.Reader, password, dir string) (*Manifest, error) {
+	decReader, err := newDecryptReader(r, password)
+	if err != nil {
+		return nil, err
+	}
+	tarReader := tar.NewReader(decReader)
+
+	manifest, err := readManifest(tarReader)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]Entry)
+	for _, entry := range manifest.Entries {
+		entries[entry.Path] = entry
+	}
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entry, ok := entries[header.Name]
+		if !ok {
+			return nil, fmt.Errorf("file %s not found in manifest", header.Name)
+		}
+		if err := extractEntry(tarReader, dir, entry); err != nil {
+			return nil, fmt.Errorf("failed to extract %s: %w", entry.Path, err)
+		}
+		delete(entries, header.Name)
+	}
+
+	if len(entries) > 0 {
+		return nil, fmt.Errorf("archive is missing %d files of manifest", len(entries))
+	}
+
+	// Make sure the archive is not truncated.
+	if _, err := io.Copy(io.Discard, decReader); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func readManifest(tarReader *tar.Reader) (*Manifest, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, err
+	}
+	if header.Name != manifestFile {
+		return nil, fmt.Errorf("invalid archive, manifest not found")
+	}
+
+	manifest := &Manifest{}
+	if err := json.NewDecoder(tarReader).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %s", err)
+	}
+	if manifest.Version != Version {
+		return nil, fmt.Errorf("unsupported archive version %d", manifest.Version)
+	}
+	return manifest, nil
+}
+
+func listEntries(dir string) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		size, checksum, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, Entry{
+			Path:   filepath.ToSlash(relPath),
+			Size:   size,
+			Sha256: checksum,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %s", err)
+	}
+	return entries, nil
+}
+
+func hashFile(path string) (int64, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	// nolint
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func writeEntry(tarWriter *tar.Writer, dir string, entry Entry) error {
+	f, err := os.Open(filepath.Join(dir, filepath.FromSlash(entry.Path)))
+	if err != nil {
+		return err
+	}
+	// nolint
+	defer f.Close()
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: entry.Path, Mode: 0600, Size: entry.Size,
+	}); err != nil {
+		return err
+	}
+	_, err = io.CopyN(tarWriter, f, entry.Size)
+	return err
+}
+
+func extractEntry(r io.Reader, dir string, entry Entry) error {
+	relPath := filepath.FromSlash(entry.Path)
+	if !filepath.IsLocal(relPath) {
+		return fmt.Errorf("invalid path")
+	}
+	path := filepath.Join(dir, relPath)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	// nolint
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), r)
+	if err != nil {
+		return err
+	}
+	if size != entry.Size || hex.EncodeToString(hash.Sum(nil)) != entry.Sha256 {
+		return fmt.Errorf("file doesn't match manifest")
+	}
+	return f.Sync()
+}
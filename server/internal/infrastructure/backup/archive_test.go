@@ -0,0 +1,78 @@
+package backup_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ark-network/ark/server/internal/infrastructure/backup"
+	"github.com/stretchr/testify/require"
+)
+
+const password = "password"
+
+func TestArchive(t *testing.T) {
+	srcDir := t.TempDir()
+	files := map[string][]byte{
+		"macaroons.db":             randomBytes(t, 100),
+		"db/sqlite.db":             randomBytes(t, 200*1024),
+		"db/round-events/000.vlog": {},
+	}
+	for path, content := range files {
+		path = filepath.Join(srcDir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, content, 0600))
+	}
+
+	archive := &bytes.Buffer{}
+	manifest := &backup.Manifest{
+		CreatedAt: 1729000000,
+		Network:   "regtest",
+		Pubkey:    "0250929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0",
+	}
+	require.NoError(t, backup.Write(archive, srcDir, manifest, password))
+	require.Len(t, manifest.Entries, len(files))
+	require.Equal(t, backup.Version, manifest.Version)
+
+	t.Run("valid", func(t *testing.T) {
+		readManifest, err := backup.ReadManifest(bytes.NewReader(archive.Bytes()), password)
+		require.NoError(t, err)
+		require.Equal(t, manifest, readManifest)
+
+		dstDir := t.TempDir()
+		extractedManifest, err := backup.Extract(
+			bytes.NewReader(archive.Bytes()), password, dstDir,
+		)
+		require.NoError(t, err)
+		require.Equal(t, manifest, extractedManifest)
+
+		for path, content := range files {
+			buf, err := os.ReadFile(filepath.Join(dstDir, filepath.FromSlash(path)))
+			require.NoError(t, err)
+			require.Equal(t, content, buf)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := backup.ReadManifest(bytes.NewReader(archive.Bytes()), "wrong")
+		require.ErrorIs(t, err, backup.ErrInvalidPassword)
+
+		tampered := bytes.Clone(archive.Bytes())
+		tampered[len(tampered)/2] ^= 0xff
+		_, err = backup.Extract(bytes.NewReader(tampered), password, t.TempDir())
+		require.ErrorIs(t, err, backup.ErrInvalidPassword)
+
+		truncated := archive.Bytes()[:archive.Len()-100]
+		_, err = backup.Extract(bytes.NewReader(truncated), password, t.TempDir())
+		require.Error(t, err)
+	})
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	buf := make([]byte, size)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	return buf
+}
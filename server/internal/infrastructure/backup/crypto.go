@@ -0,0 +1,185 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	magic     = "ARKBKP01"
+	saltSize  = 16
+	keySize   = 32
+	chunkSize = 64 * 1024
+
+	// scrypt params
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	chunkFlag = byte(0)
+	lastFlag  = byte(1)
+)
+
+var ErrInvalidPassword = errors.New("invalid password or corrupted archive")
+
+// The archive is made of the magic bytes, the salt used to derive the key
+// from the password and a sequence of chunks encrypted with AES-GCM, each
+// prefixed by a flag, telling if it's the last one, and by its length. The
+// nonce of every chunk is its position in the sequence, the key being unique
+// per archive, and the flag is authenticated to detect truncated archives.
+
+func newCipher(password string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(aead cipher.AEAD, counter uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], counter)
+	return nonce
+}
+
+// encryptWriter encrypts the data written into chunks, Close must be called to
+// write the last one.
+type encryptWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+}
+
+func newEncryptWriter(w io.Writer, password string) (*encryptWriter, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newCipher(password, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(append([]byte(magic), salt...)); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{w: w, aead: aead, buf: make([]byte, 0, chunkSize)}, nil
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(chunkSize-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(e.buf) == chunkSize {
+			if err := e.writeChunk(chunkFlag); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (e *encryptWriter) Close() error {
+	return e.writeChunk(lastFlag)
+}
+
+func (e *encryptWriter) writeChunk(flag byte) error {
+	ciphertext := e.aead.Seal(
+		nil, chunkNonce(e.aead, e.counter), e.buf, []byte{flag},
+	)
+	e.counter++
+	e.buf = e.buf[:0]
+
+	header := make([]byte, 5)
+	header[0] = flag
+	binary.BigEndian.PutUint32(header[1:], uint32(len(ciphertext)))
+	if _, err := e.w.Write(header); err != nil {
+		return err
+	}
+	_, err := e.w.Write(ciphertext)
+	return err
+}
+
+// decryptReader returns the data decrypted from the chunks, it fails if the
+// archive ends before the last chunk.
+type decryptReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	buf     *bytes.Reader
+	counter uint64
+	done    bool
+}
+
+func newDecryptReader(r io.Reader, password string) (*decryptReader, error) {
+	header := make([]byte, len(magic)+saltSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read archive header: %s", err)
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, fmt.Errorf("invalid archive format")
+	}
+	aead, err := newCipher(password, header[len(magic):])
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{r: r, aead: aead, buf: bytes.NewReader(nil)}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for d.buf.Len() <= 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	return d.buf.Read(p)
+}
+
+func (d *decryptReader) readChunk() error {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(d.r, header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	flag := header[0]
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > chunkSize+uint32(d.aead.Overhead()) {
+		return ErrInvalidPassword
+	}
+
+	ciphertext := make([]byte, size)
+	if _, err := io.ReadFull(d.r, ciphertext); err != nil {
+		return err
+	}
+	plaintext, err := d.aead.Open(
+		nil, chunkNonce(d.aead, d.counter), ciphertext, []byte{flag},
+	)
+	if err != nil {
+		return ErrInvalidPassword
+	}
+	d.counter++
+	d.done = flag == lastFlag
+	d.buf = bytes.NewReader(plaintext)
+	return nil
+}
@@ -0,0 +1,81 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func(dir string) db.ServiceConfig
+	}{
+		{
+			name: "badger_stores",
+			config: func(dir string) db.ServiceConfig {
+				return db.ServiceConfig{
+					EventStoreType:   "badger",
+					DataStoreType:    "badger",
+					EventStoreConfig: []interface{}{dir, nil},
+					DataStoreConfig:  []interface{}{dir, nil},
+				}
+			},
+		},
+		{
+			name: "sqlite_stores",
+			config: func(dir string) db.ServiceConfig {
+				return db.ServiceConfig{
+					EventStoreType:   "badger",
+					DataStoreType:    "sqlite",
+					EventStoreConfig: []interface{}{dir, nil},
+					DataStoreConfig:  []interface{}{dir, "file://sqlite/migration"},
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			svc, err := db.NewService(tt.config(t.TempDir()))
+			require.NoError(t, err)
+
+			roundId := uuid.New().String()
+			_, err = svc.Events().Save(ctx, roundId, domain.RoundStarted{
+				Id: roundId, Timestamp: time.Now().Unix(),
+			})
+			require.NoError(t, err)
+
+			vtxo := domain.Vtxo{
+				VtxoKey:  domain.VtxoKey{Txid: randomString(32), VOut: 0},
+				Receiver: domain.Receiver{Pubkey: randomString(36), Amount: 1000},
+				PoolTx:   randomString(32),
+				ExpireAt: 7980322,
+			}
+			require.NoError(t, svc.Vtxos().AddVtxos(ctx, []domain.Vtxo{vtxo}))
+
+			backupDir := t.TempDir()
+			require.NoError(t, svc.Backup(ctx, backupDir))
+			svc.Close()
+
+			restored, err := db.NewService(tt.config(backupDir))
+			require.NoError(t, err)
+			defer restored.Close()
+
+			round, err := restored.Events().Load(ctx, roundId)
+			require.NoError(t, err)
+			require.Equal(t, roundId, round.Id)
+
+			vtxos, err := restored.Vtxos().GetVtxos(ctx, []domain.VtxoKey{vtxo.VtxoKey})
+			require.NoError(t, err)
+			require.Equal(t, []domain.Vtxo{vtxo}, vtxos)
+		})
+	}
+}
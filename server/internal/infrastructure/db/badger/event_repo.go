@@ -90,6 +90,11 @@ func (r *eventRepository) RegisterEventsHandler(
 	r.handler = handler
 }
 
+// Backup writes a copy of the store into the given dir.
+func (r *eventRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, eventStoreDir))
+}
+
 func (r *eventRepository) Close() {
 	close(r.chUpdates)
 	r.store.Close()
@@ -0,0 +1,103 @@
+package badgerdb
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+const monitoredTxStoreDir = "monitored_txs"
+
+type monitoredTxRepository struct {
+	store *badgerhold.Store
+}
+
+func NewMonitoredTxRepository(
+	config ...interface{},
+) (domain.MonitoredTxRepository, error) {
+	if len(config) != 2 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	baseDir, ok := config[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid base directory")
+	}
+	var logger badger.Logger
+	if config[1] != nil {
+		logger, ok = config[1].(badger.Logger)
+		if !ok {
+			return nil, fmt.Errorf("invalid logger")
+		}
+	}
+
+	var dir string
+	if len(baseDir) > 0 {
+		dir = filepath.Join(baseDir, monitoredTxStoreDir)
+	}
+	store, err := createDB(dir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open monitored tx store: %s", err)
+	}
+
+	return &monitoredTxRepository{store}, nil
+}
+
+func (r *monitoredTxRepository) AddOrUpdateMonitoredTx(
+	ctx context.Context, tx domain.MonitoredTx,
+) (err error) {
+	if ctx.Value("tx") != nil {
+		dbtx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpsert(dbtx, tx.Txid, tx)
+	} else {
+		err = r.store.Upsert(tx.Txid, tx)
+	}
+	return
+}
+
+func (r *monitoredTxRepository) DeleteMonitoredTxs(
+	ctx context.Context, txids []string,
+) error {
+	for _, txid := range txids {
+		var err error
+		if ctx.Value("tx") != nil {
+			dbtx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxDelete(dbtx, txid, domain.MonitoredTx{})
+		} else {
+			err = r.store.Delete(txid, domain.MonitoredTx{})
+		}
+		if err != nil && err != badgerhold.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *monitoredTxRepository) GetAllMonitoredTxs(
+	ctx context.Context,
+) ([]domain.MonitoredTx, error) {
+	txs := make([]domain.MonitoredTx, 0)
+	query := (&badgerhold.Query{}).SortBy("BroadcastedAt")
+	var err error
+
+	if ctx.Value("tx") != nil {
+		dbtx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(dbtx, &txs, query)
+	} else {
+		err = r.store.Find(&txs, query)
+	}
+
+	return txs, err
+}
+
+// Backup writes a copy of the store into the given dir.
+func (r *monitoredTxRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, monitoredTxStoreDir))
+}
+
+func (r *monitoredTxRepository) Close() {
+	r.store.Close()
+}
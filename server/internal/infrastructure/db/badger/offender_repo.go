@@ -0,0 +1,109 @@
+package badgerdb
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+const offenderStoreDir = "offenders"
+
+type offenderRepository struct {
+	store *badgerhold.Store
+}
+
+func NewOffenderRepository(config ...interface{}) (domain.OffenderRepository, error) {
+	if len(config) != 2 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	baseDir, ok := config[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid base directory")
+	}
+	var logger badger.Logger
+	if config[1] != nil {
+		logger, ok = config[1].(badger.Logger)
+		if !ok {
+			return nil, fmt.Errorf("invalid logger")
+		}
+	}
+
+	var dir string
+	if len(baseDir) > 0 {
+		dir = filepath.Join(baseDir, offenderStoreDir)
+	}
+	store, err := createDB(dir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open offender store: %s", err)
+	}
+
+	return &offenderRepository{store}, nil
+}
+
+func (r *offenderRepository) AddOrUpdateOffender(
+	ctx context.Context, offender domain.Offender,
+) (err error) {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpsert(tx, offender.Key, offender)
+	} else {
+		err = r.store.Upsert(offender.Key, offender)
+	}
+	return
+}
+
+func (r *offenderRepository) GetOffender(
+	ctx context.Context, key string,
+) (*domain.Offender, error) {
+	query := badgerhold.Where("Key").Eq(key)
+	offenders, err := r.findOffenders(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(offenders) <= 0 {
+		return nil, fmt.Errorf("offender %s not found", key)
+	}
+	return &offenders[0], nil
+}
+
+func (r *offenderRepository) GetBannedOffenders(
+	ctx context.Context, bannedAt int64,
+) ([]domain.Offender, error) {
+	query := badgerhold.Where("BannedUntil").Gt(bannedAt)
+	return r.findOffenders(ctx, query)
+}
+
+func (r *offenderRepository) GetAllOffenders(
+	ctx context.Context,
+) ([]domain.Offender, error) {
+	return r.findOffenders(ctx, nil)
+}
+
+// Backup writes a copy of the store into the given dir.
+func (r *offenderRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, offenderStoreDir))
+}
+
+func (r *offenderRepository) Close() {
+	r.store.Close()
+}
+
+func (r *offenderRepository) findOffenders(
+	ctx context.Context, query *badgerhold.Query,
+) ([]domain.Offender, error) {
+	offenders := make([]domain.Offender, 0)
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &offenders, query)
+	} else {
+		err = r.store.Find(&offenders, query)
+	}
+
+	return offenders, err
+}
@@ -0,0 +1,146 @@
+package badgerdb
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+const paymentRequestStoreDir = "payment_requests"
+
+type paymentRequestRepository struct {
+	store *badgerhold.Store
+}
+
+func NewPaymentRequestRepository(
+	config ...interface{},
+) (domain.PaymentRequestRepository, error) {
+	if len(config) != 2 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	baseDir, ok := config[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid base directory")
+	}
+	var logger badger.Logger
+	if config[1] != nil {
+		logger, ok = config[1].(badger.Logger)
+		if !ok {
+			return nil, fmt.Errorf("invalid logger")
+		}
+	}
+
+	var dir string
+	if len(baseDir) > 0 {
+		dir = filepath.Join(baseDir, paymentRequestStoreDir)
+	}
+	store, err := createDB(dir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open payment request store: %s", err)
+	}
+
+	return &paymentRequestRepository{store}, nil
+}
+
+func (r *paymentRequestRepository) AddOrUpdatePaymentRequest(
+	ctx context.Context, request domain.PaymentRequest,
+) (err error) {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpsert(tx, request.Id, request)
+	} else {
+		err = r.store.Upsert(request.Id, request)
+	}
+	return
+}
+
+func (r *paymentRequestRepository) DeletePaymentRequests(
+	ctx context.Context, ids []string,
+) error {
+	for _, id := range ids {
+		var err error
+		if ctx.Value("tx") != nil {
+			tx := ctx.Value("tx").(*badger.Txn)
+			err = r.store.TxDelete(tx, id, domain.PaymentRequest{})
+		} else {
+			err = r.store.Delete(id, domain.PaymentRequest{})
+		}
+		if err != nil && err != badgerhold.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *paymentRequestRepository) GetPaymentRequests(
+	ctx context.Context,
+) ([]domain.PaymentRequest, error) {
+	requests := make([]domain.PaymentRequest, 0)
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &requests, nil)
+	} else {
+		err = r.store.Find(&requests, nil)
+	}
+
+	return requests, err
+}
+
+func (r *paymentRequestRepository) AddAsyncPaymentRequest(
+	ctx context.Context, request domain.AsyncPaymentRequest,
+) (err error) {
+	key := request.VtxoKey.Hash()
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpsert(tx, key, request)
+	} else {
+		err = r.store.Upsert(key, request)
+	}
+	return
+}
+
+func (r *paymentRequestRepository) DeleteAsyncPaymentRequest(
+	ctx context.Context, vtxo domain.VtxoKey,
+) (err error) {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxDelete(tx, vtxo.Hash(), domain.AsyncPaymentRequest{})
+	} else {
+		err = r.store.Delete(vtxo.Hash(), domain.AsyncPaymentRequest{})
+	}
+	if err == badgerhold.ErrNotFound {
+		err = nil
+	}
+	return
+}
+
+func (r *paymentRequestRepository) GetAsyncPaymentRequests(
+	ctx context.Context,
+) ([]domain.AsyncPaymentRequest, error) {
+	requests := make([]domain.AsyncPaymentRequest, 0)
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &requests, nil)
+	} else {
+		err = r.store.Find(&requests, nil)
+	}
+
+	return requests, err
+}
+
+// Backup writes a copy of the store into the given dir.
+func (r *paymentRequestRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, paymentRequestStoreDir))
+}
+
+func (r *paymentRequestRepository) Close() {
+	r.store.Close()
+}
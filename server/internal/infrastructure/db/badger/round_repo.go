@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/dgraph-io/badger/v4"
@@ -118,6 +119,108 @@ func (r *roundRepository) GetRoundsIds(ctx context.Context, startedAfter int64,
 	return ids, nil
 }
 
+func (r *roundRepository) ListRoundsIds(
+	ctx context.Context, filter domain.RoundFilter, page domain.Page,
+) ([]string, string, error) {
+	var afterTimestamp int64
+	var afterId string
+	if len(page.Cursor) > 0 {
+		timestamp, id, err := domain.ParseRoundCursor(page.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		afterTimestamp, afterId = timestamp, id
+	}
+
+	query := badgerhold.Where("Stage.Ended").Eq(true)
+	if filter.StartedAfter > 0 {
+		query = query.And("StartingTimestamp").Gt(filter.StartedAfter)
+	}
+	if filter.StartedBefore > 0 {
+		query = query.And("StartingTimestamp").Lt(filter.StartedBefore)
+	}
+	if afterTimestamp > 0 {
+		query = query.And("StartingTimestamp").Ge(afterTimestamp)
+	}
+
+	rounds, err := r.findRound(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	after := domain.Round{StartingTimestamp: afterTimestamp, Id: afterId}
+	filteredRounds := make([]domain.Round, 0, len(rounds))
+	for _, round := range rounds {
+		if len(afterId) > 0 && !roundLess(after, round) {
+			continue
+		}
+		filteredRounds = append(filteredRounds, round)
+	}
+	sort.Slice(filteredRounds, func(i, j int) bool {
+		return roundLess(filteredRounds[i], filteredRounds[j])
+	})
+
+	cursor := ""
+	if page.Size > 0 && len(filteredRounds) > page.Size {
+		filteredRounds = filteredRounds[:page.Size]
+		last := filteredRounds[page.Size-1]
+		cursor = domain.NewRoundCursor(last.StartingTimestamp, last.Id)
+	}
+
+	ids := make([]string, 0, len(filteredRounds))
+	for _, round := range filteredRounds {
+		ids = append(ids, round.Id)
+	}
+	return ids, cursor, nil
+}
+
+// roundLess sorts rounds by starting time and id.
+func roundLess(a, b domain.Round) bool {
+	if a.StartingTimestamp != b.StartingTimestamp {
+		return a.StartingTimestamp < b.StartingTimestamp
+	}
+	return a.Id < b.Id
+}
+
+func (r *roundRepository) GetUnfinishedRoundsIds(
+	ctx context.Context,
+) ([]string, error) {
+	query := badgerhold.Where("Stage.Ended").Eq(false).And("Stage.Failed").Eq(false)
+	rounds, err := r.findRound(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rounds))
+	for _, round := range rounds {
+		ids = append(ids, round.Id)
+	}
+
+	return ids, nil
+}
+
+func (r *roundRepository) GetFailedRoundsIds(
+	ctx context.Context,
+) ([]string, error) {
+	query := badgerhold.Where("Stage.Failed").Eq(true)
+	rounds, err := r.findRound(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rounds))
+	for _, round := range rounds {
+		ids = append(ids, round.Id)
+	}
+
+	return ids, nil
+}
+
+// Backup writes a copy of the store into the given dir.
+func (r *roundRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, roundStoreDir))
+}
+
 func (r *roundRepository) Close() {
 	r.store.Close()
 }
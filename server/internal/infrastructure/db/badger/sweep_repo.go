@@ -0,0 +1,102 @@
+package badgerdb
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+const sweepStoreDir = "sweeps"
+
+type sweepRepository struct {
+	store *badgerhold.Store
+}
+
+func NewSweepRepository(config ...interface{}) (domain.SweepRepository, error) {
+	if len(config) != 2 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	baseDir, ok := config[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid base directory")
+	}
+	var logger badger.Logger
+	if config[1] != nil {
+		logger, ok = config[1].(badger.Logger)
+		if !ok {
+			return nil, fmt.Errorf("invalid logger")
+		}
+	}
+
+	var dir string
+	if len(baseDir) > 0 {
+		dir = filepath.Join(baseDir, sweepStoreDir)
+	}
+	store, err := createDB(dir, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sweep store: %s", err)
+	}
+
+	return &sweepRepository{store}, nil
+}
+
+func (r *sweepRepository) AddOrUpdateSweep(
+	ctx context.Context, sweep domain.Sweep,
+) (err error) {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxUpsert(tx, sweep.Id, sweep)
+	} else {
+		err = r.store.Upsert(sweep.Id, sweep)
+	}
+	return
+}
+
+func (r *sweepRepository) GetSweep(
+	ctx context.Context, id string,
+) (*domain.Sweep, error) {
+	query := badgerhold.Where("Id").Eq(id)
+	sweeps, err := r.findSweeps(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(sweeps) <= 0 {
+		return nil, fmt.Errorf("sweep %s not found", id)
+	}
+	return &sweeps[0], nil
+}
+
+func (r *sweepRepository) GetAllSweeps(
+	ctx context.Context,
+) ([]domain.Sweep, error) {
+	return r.findSweeps(ctx, (&badgerhold.Query{}).SortBy("ScheduledAt"))
+}
+
+// Backup writes a copy of the store into the given dir.
+func (r *sweepRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, sweepStoreDir))
+}
+
+func (r *sweepRepository) Close() {
+	r.store.Close()
+}
+
+func (r *sweepRepository) findSweeps(
+	ctx context.Context, query *badgerhold.Query,
+) ([]domain.Sweep, error) {
+	sweeps := make([]domain.Sweep, 0)
+	var err error
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = r.store.TxFind(tx, &sweeps, query)
+	} else {
+		err = r.store.Find(&sweeps, query)
+	}
+
+	return sweeps, err
+}
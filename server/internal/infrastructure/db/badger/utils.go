@@ -3,6 +3,7 @@ package badgerdb
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/ark-network/ark/server/internal/core/domain"
@@ -11,6 +12,8 @@ import (
 	"github.com/timshannon/badgerhold/v4"
 )
 
+const maxPendingWrites = 256
+
 func createDB(dbDir string, logger badger.Logger) (*badgerhold.Store, error) {
 	isInMemory := len(dbDir) <= 0
 
@@ -49,6 +52,35 @@ func createDB(dbDir string, logger badger.Logger) (*badgerhold.Store, error) {
 	return db, nil
 }
 
+// backupDB copies the given store into a new badger db in the given dir, that
+// can replace the original one as is.
+func backupDB(store *badgerhold.Store, dir string) error {
+	opts := badger.DefaultOptions(dir)
+	opts.Logger = nil
+	opts.Compression = options.ZSTD
+
+	db, err := badger.Open(opts)
+	if err != nil {
+		return fmt.Errorf("failed to open backup db: %s", err)
+	}
+	// nolint
+	defer db.Close()
+
+	reader, writer := io.Pipe()
+	go func() {
+		_, err := store.Badger().Backup(writer, 0)
+		// nolint
+		writer.CloseWithError(err)
+	}()
+
+	if err := db.Load(reader, maxPendingWrites); err != nil {
+		// nolint
+		reader.CloseWithError(err)
+		return fmt.Errorf("failed to copy db: %s", err)
+	}
+	return nil
+}
+
 func serializeEvents(events []domain.RoundEvent) (*eventsDTO, error) {
 	rawEvents := make([][]byte, 0, len(events))
 	for _, event := range events {
@@ -87,6 +119,12 @@ func deserializeEvent(buf []byte) (domain.RoundEvent, error) {
 			return event, nil
 		}
 	}
+	{
+		var event = domain.PoolTxSigned{}
+		if err := json.Unmarshal(buf, &event); err == nil && len(event.PoolTxid) > 0 {
+			return event, nil
+		}
+	}
 	{
 		var event = domain.RoundFinalized{}
 		if err := json.Unmarshal(buf, &event); err == nil && len(event.Txid) > 0 {
@@ -105,6 +143,12 @@ func deserializeEvent(buf []byte) (domain.RoundEvent, error) {
 			return event, nil
 		}
 	}
+	{
+		var event = domain.PaymentsDropped{}
+		if err := json.Unmarshal(buf, &event); err == nil && len(event.PaymentIds) > 0 {
+			return event, nil
+		}
+	}
 	{
 		var event = domain.RoundStarted{}
 		if err := json.Unmarshal(buf, &event); err == nil && event.Timestamp > 0 {
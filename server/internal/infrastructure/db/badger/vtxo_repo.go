@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/ark-network/ark/server/internal/core/domain"
@@ -119,6 +120,63 @@ func (r *vtxoRepository) GetAllVtxos(
 	return unspentVtxos, spentVtxos, nil
 }
 
+func (r *vtxoRepository) ListVtxos(
+	ctx context.Context, filter domain.VtxoFilter, page domain.Page,
+) ([]domain.Vtxo, string, error) {
+	var after *domain.VtxoKey
+	if len(page.Cursor) > 0 {
+		key, err := domain.ParseVtxoCursor(page.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		after = key
+	}
+
+	var query *badgerhold.Query
+	if len(filter.Pubkey) > 0 {
+		query = badgerhold.Where("Pubkey").Eq(filter.Pubkey)
+	}
+	vtxos, err := r.findVtxos(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filteredVtxos := make([]domain.Vtxo, 0, len(vtxos))
+	for _, vtxo := range vtxos {
+		if after != nil && !vtxoKeyLess(*after, vtxo.VtxoKey) {
+			continue
+		}
+		if filter.Match(vtxo) {
+			filteredVtxos = append(filteredVtxos, vtxo)
+		}
+	}
+	sort.Slice(filteredVtxos, func(i, j int) bool {
+		return vtxoKeyLess(filteredVtxos[i].VtxoKey, filteredVtxos[j].VtxoKey)
+	})
+
+	if page.Size <= 0 || len(filteredVtxos) <= page.Size {
+		return filteredVtxos, "", nil
+	}
+	filteredVtxos = filteredVtxos[:page.Size]
+	cursor := domain.NewVtxoCursor(filteredVtxos[page.Size-1].VtxoKey)
+	return filteredVtxos, cursor, nil
+}
+
+func (r *vtxoRepository) GetAllVtxoKeys(
+	ctx context.Context,
+) ([]domain.VtxoKey, error) {
+	vtxos, err := r.findVtxos(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]domain.VtxoKey, 0, len(vtxos))
+	for _, vtxo := range vtxos {
+		keys = append(keys, vtxo.VtxoKey)
+	}
+	return keys, nil
+}
+
 func (r *vtxoRepository) GetAllSweepableVtxos(ctx context.Context) ([]domain.Vtxo, error) {
 	query := badgerhold.Where("Redeemed").Eq(false).And("Swept").Eq(false)
 	return r.findVtxos(ctx, query)
@@ -135,6 +193,40 @@ func (r *vtxoRepository) SweepVtxos(
 	return nil
 }
 
+func (r *vtxoRepository) UnredeemVtxos(
+	ctx context.Context, vtxoKeys []domain.VtxoKey,
+) error {
+	for _, vtxoKey := range vtxoKeys {
+		if err := r.updateVtxo(ctx, vtxoKey, func(vtxo *domain.Vtxo) bool {
+			if !vtxo.Redeemed {
+				return false
+			}
+			vtxo.Redeemed = false
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *vtxoRepository) UnsweepVtxos(
+	ctx context.Context, vtxoKeys []domain.VtxoKey,
+) error {
+	for _, vtxoKey := range vtxoKeys {
+		if err := r.updateVtxo(ctx, vtxoKey, func(vtxo *domain.Vtxo) bool {
+			if !vtxo.Swept {
+				return false
+			}
+			vtxo.Swept = false
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *vtxoRepository) UpdateExpireAt(ctx context.Context, vtxos []domain.VtxoKey, expireAt int64) error {
 	tx := r.store.Badger().NewTransaction(true)
 	defer tx.Discard()
@@ -153,6 +245,11 @@ func (r *vtxoRepository) UpdateExpireAt(ctx context.Context, vtxos []domain.Vtxo
 	return tx.Commit()
 }
 
+// Backup writes a copy of the store into the given dir.
+func (r *vtxoRepository) Backup(_ context.Context, dir string) error {
+	return backupDB(r.store, filepath.Join(dir, vtxoStoreDir))
+}
+
 func (r *vtxoRepository) Close() {
 	r.store.Close()
 }
@@ -242,6 +339,13 @@ func (r *vtxoRepository) redeemVtxo(ctx context.Context, vtxoKey domain.VtxoKey)
 	return vtxo, nil
 }
 
+func vtxoKeyLess(a, b domain.VtxoKey) bool {
+	if a.Txid != b.Txid {
+		return a.Txid < b.Txid
+	}
+	return a.VOut < b.VOut
+}
+
 func (r *vtxoRepository) findVtxos(ctx context.Context, query *badgerhold.Query) ([]domain.Vtxo, error) {
 	vtxos := make([]domain.Vtxo, 0)
 	var err error
@@ -277,3 +381,23 @@ func (r *vtxoRepository) sweepVtxo(ctx context.Context, vtxoKey domain.VtxoKey)
 	}
 	return nil
 }
+
+// updateVtxo applies the given change to the vtxo, and stores it only if the
+// change reports it actually modified it.
+func (r *vtxoRepository) updateVtxo(
+	ctx context.Context, vtxoKey domain.VtxoKey, change func(*domain.Vtxo) bool,
+) error {
+	vtxo, err := r.getVtxo(ctx, vtxoKey)
+	if err != nil {
+		return err
+	}
+	if !change(vtxo) {
+		return nil
+	}
+
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		return r.store.TxUpdate(tx, vtxoKey.Hash(), *vtxo)
+	}
+	return r.store.Update(vtxoKey.Hash(), *vtxo)
+}
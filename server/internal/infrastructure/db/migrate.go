@@ -0,0 +1,846 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"sort"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/core/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+const migrationBatchSize = 100
+
+// MigrationStats reports the outcome of the migration of one kind of item.
+type MigrationStats struct {
+	// Count is the number of items found in the source.
+	Count int
+	// Copied is the number of items written to the target, the others were
+	// already there from a previous, interrupted, run.
+	Copied int
+	// Checksum is the one of the source items, matching that of the target.
+	Checksum string
+}
+
+func (s MigrationStats) String() string {
+	return fmt.Sprintf(
+		"%d items (%d copied, %d already migrated), checksum %s",
+		s.Count, s.Copied, s.Count-s.Copied, s.Checksum,
+	)
+}
+
+type MigrationSummary struct {
+	Vtxos                MigrationStats
+	Events               MigrationStats
+	Rounds               MigrationStats
+	Sweeps               MigrationStats
+	Offenders            MigrationStats
+	PaymentRequests      MigrationStats
+	AsyncPaymentRequests MigrationStats
+	MonitoredTxs         MigrationStats
+}
+
+// Migrate copies the vtxos, with their unconditional forfeit txs, the rounds,
+// failed ones included, and their events, the sweeps, the offenders, the
+// pending payment requests and the monitored txs from one repo manager into
+// another, then checks that the target holds the same items as the source by
+// comparing their counts and checksums.
+// Items that are already in the target and equal to those of the source are
+// skipped, this way an interrupted migration can be resumed by running it
+// again.
+func Migrate(
+	ctx context.Context, from, to ports.RepoManager,
+) (*MigrationSummary, error) {
+	vtxoKeys, err := from.Vtxos().GetAllVtxoKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source vtxos: %s", err)
+	}
+	sort.SliceStable(vtxoKeys, func(i, j int) bool {
+		if vtxoKeys[i].Txid == vtxoKeys[j].Txid {
+			return vtxoKeys[i].VOut < vtxoKeys[j].VOut
+		}
+		return vtxoKeys[i].Txid < vtxoKeys[j].Txid
+	})
+
+	roundIds, err := getAllRoundIds(ctx, from.Rounds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source rounds: %s", err)
+	}
+
+	// Vtxos are copied first because some round stores link them to the
+	// payments of the rounds.
+	vtxoStats, err := migrateVtxos(ctx, from.Vtxos(), to.Vtxos(), vtxoKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate vtxos: %s", err)
+	}
+	log.Infof("migrated vtxos: %s", vtxoStats)
+
+	eventStats, err := migrateEvents(ctx, from.Events(), to.Events(), roundIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate round events: %s", err)
+	}
+	log.Infof("migrated round events: %s", eventStats)
+
+	// Rounds are copied after the events since the event store might update
+	// the rounds on its own, with a projection that can lag behind the source.
+	roundStats, err := migrateRounds(ctx, from.Rounds(), to.Rounds(), roundIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate rounds: %s", err)
+	}
+	log.Infof("migrated rounds: %s", roundStats)
+
+	summary := &MigrationSummary{
+		Vtxos:  *vtxoStats,
+		Events: *eventStats,
+		Rounds: *roundStats,
+	}
+	// Payment requests are copied after the vtxos they spend since some
+	// stores link them together.
+	stores := listedStores(summary)
+	for _, s := range stores {
+		stats, err := s.store.migrate(ctx, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate %s: %s", s.name, err)
+		}
+		log.Infof("migrated %s: %s", s.name, stats)
+		*s.stats = *stats
+	}
+
+	if err := verifyMigration(ctx, to, vtxoKeys, roundIds, summary); err != nil {
+		return nil, err
+	}
+	for _, s := range stores {
+		if err := s.store.verify(ctx, to, s.name, *s.stats); err != nil {
+			return nil, err
+		}
+	}
+	return summary, nil
+}
+
+type listedStoreMigration interface {
+	migrate(ctx context.Context, from, to ports.RepoManager) (*MigrationStats, error)
+	verify(ctx context.Context, to ports.RepoManager, name string, stats MigrationStats) error
+}
+
+// listedStore is a store whose items are few enough to be listed at once,
+// unlike the vtxos and rounds that are read in batches.
+type listedStore[T any] struct {
+	list   func(ctx context.Context, repo ports.RepoManager) ([]T, error)
+	add    func(ctx context.Context, repo ports.RepoManager, item T) error
+	key    func(item T) string
+	digest func(item T) interface{}
+}
+
+func (s listedStore[T]) migrate(
+	ctx context.Context, from, to ports.RepoManager,
+) (*MigrationStats, error) {
+	items, err := s.listSorted(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source items: %s", err)
+	}
+	migratedItems, err := s.list(ctx, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list target items: %s", err)
+	}
+	migratedDigests := make(map[string]interface{})
+	for _, item := range migratedItems {
+		migratedDigests[s.key(item)] = s.digest(item)
+	}
+
+	checksum := sha256.New()
+	copied := 0
+	for _, item := range items {
+		digest := s.digest(item)
+		if err := writeDigest(checksum, digest); err != nil {
+			return nil, err
+		}
+
+		migratedDigest, ok := migratedDigests[s.key(item)]
+		if ok && digestsMatch(digest, migratedDigest) {
+			continue
+		}
+		if err := s.add(ctx, to, item); err != nil {
+			return nil, err
+		}
+		copied++
+	}
+
+	return &MigrationStats{
+		Count:    len(items),
+		Copied:   copied,
+		Checksum: hex.EncodeToString(checksum.Sum(nil)),
+	}, nil
+}
+
+// verify reads back the migrated items from the target and checks their
+// count and checksum against those of the source.
+func (s listedStore[T]) verify(
+	ctx context.Context, to ports.RepoManager, name string, stats MigrationStats,
+) error {
+	items, err := s.listSorted(ctx, to)
+	if err != nil {
+		return fmt.Errorf("failed to get target %s: %s", name, err)
+	}
+	if len(items) != stats.Count {
+		return fmt.Errorf(
+			"%s count mismatch: source %d, target %d", name, stats.Count, len(items),
+		)
+	}
+
+	checksum := sha256.New()
+	for _, item := range items {
+		if err := writeDigest(checksum, s.digest(item)); err != nil {
+			return err
+		}
+	}
+	if got := hex.EncodeToString(checksum.Sum(nil)); got != stats.Checksum {
+		return fmt.Errorf(
+			"%s checksum mismatch: source %s, target %s", name, stats.Checksum, got,
+		)
+	}
+	return nil
+}
+
+func (s listedStore[T]) listSorted(
+	ctx context.Context, repo ports.RepoManager,
+) ([]T, error) {
+	items, err := s.list(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return s.key(items[i]) < s.key(items[j])
+	})
+	return items, nil
+}
+
+type listedStoreStats struct {
+	name  string
+	store listedStoreMigration
+	stats *MigrationStats
+}
+
+func listedStores(summary *MigrationSummary) []listedStoreStats {
+	return []listedStoreStats{
+		{"sweeps", sweepStore, &summary.Sweeps},
+		{"offenders", offenderStore, &summary.Offenders},
+		{"payment requests", paymentRequestStore, &summary.PaymentRequests},
+		{"async payment requests", asyncPaymentRequestStore, &summary.AsyncPaymentRequests},
+		{"monitored txs", monitoredTxStore, &summary.MonitoredTxs},
+	}
+}
+
+var (
+	sweepStore = listedStore[domain.Sweep]{
+		list: func(ctx context.Context, repo ports.RepoManager) ([]domain.Sweep, error) {
+			return repo.Sweeps().GetAllSweeps(ctx)
+		},
+		add: func(ctx context.Context, repo ports.RepoManager, sweep domain.Sweep) error {
+			return repo.Sweeps().AddOrUpdateSweep(ctx, sweep)
+		},
+		key:    func(sweep domain.Sweep) string { return sweep.Id },
+		digest: func(sweep domain.Sweep) interface{} { return sweepDigest(sweep) },
+	}
+	offenderStore = listedStore[domain.Offender]{
+		list: func(ctx context.Context, repo ports.RepoManager) ([]domain.Offender, error) {
+			return repo.Offenders().GetAllOffenders(ctx)
+		},
+		add: func(ctx context.Context, repo ports.RepoManager, offender domain.Offender) error {
+			return repo.Offenders().AddOrUpdateOffender(ctx, offender)
+		},
+		key:    func(offender domain.Offender) string { return offender.Key },
+		digest: func(offender domain.Offender) interface{} { return offenderDigest(offender) },
+	}
+	paymentRequestStore = listedStore[domain.PaymentRequest]{
+		list: func(ctx context.Context, repo ports.RepoManager) ([]domain.PaymentRequest, error) {
+			return repo.PaymentRequests().GetPaymentRequests(ctx)
+		},
+		add: func(ctx context.Context, repo ports.RepoManager, request domain.PaymentRequest) error {
+			return repo.PaymentRequests().AddOrUpdatePaymentRequest(ctx, request)
+		},
+		key: func(request domain.PaymentRequest) string { return request.Id },
+		digest: func(request domain.PaymentRequest) interface{} {
+			return paymentRequestDigest(request)
+		},
+	}
+	asyncPaymentRequestStore = listedStore[domain.AsyncPaymentRequest]{
+		list: func(ctx context.Context, repo ports.RepoManager) ([]domain.AsyncPaymentRequest, error) {
+			return repo.PaymentRequests().GetAsyncPaymentRequests(ctx)
+		},
+		add: func(ctx context.Context, repo ports.RepoManager, request domain.AsyncPaymentRequest) error {
+			return repo.PaymentRequests().AddAsyncPaymentRequest(ctx, request)
+		},
+		key: func(request domain.AsyncPaymentRequest) string {
+			return fmt.Sprintf("%s:%010d", request.Txid, request.VOut)
+		},
+		digest: func(request domain.AsyncPaymentRequest) interface{} {
+			return asyncPaymentRequestDigest(request)
+		},
+	}
+	monitoredTxStore = listedStore[domain.MonitoredTx]{
+		list: func(ctx context.Context, repo ports.RepoManager) ([]domain.MonitoredTx, error) {
+			return repo.MonitoredTxs().GetAllMonitoredTxs(ctx)
+		},
+		add: func(ctx context.Context, repo ports.RepoManager, tx domain.MonitoredTx) error {
+			return repo.MonitoredTxs().AddOrUpdateMonitoredTx(ctx, tx)
+		},
+		key:    func(tx domain.MonitoredTx) string { return tx.Txid },
+		digest: func(tx domain.MonitoredTx) interface{} { return monitoredTxDigest(tx) },
+	}
+)
+
+func migrateVtxos(
+	ctx context.Context, from, to domain.VtxoRepository, keys []domain.VtxoKey,
+) (*MigrationStats, error) {
+	checksum := sha256.New()
+	copied := 0
+	for i := 0; i < len(keys); i += migrationBatchSize {
+		end := min(i+migrationBatchSize, len(keys))
+		vtxos, err := from.GetVtxos(ctx, keys[i:end])
+		if err != nil {
+			return nil, err
+		}
+
+		missingVtxos := make([]domain.Vtxo, 0, len(vtxos))
+		for _, vtxo := range vtxos {
+			digest := vtxoDigest(vtxo)
+			if err := writeDigest(checksum, digest); err != nil {
+				return nil, err
+			}
+
+			// Stores return an error if the vtxo is not found.
+			found, err := to.GetVtxos(ctx, []domain.VtxoKey{vtxo.VtxoKey})
+			if err == nil && len(found) == 1 && digestsMatch(digest, vtxoDigest(found[0])) {
+				continue
+			}
+			missingVtxos = append(missingVtxos, vtxo)
+		}
+
+		if len(missingVtxos) > 0 {
+			if err := to.AddVtxos(ctx, missingVtxos); err != nil {
+				return nil, err
+			}
+			copied += len(missingVtxos)
+		}
+		log.Debugf("migrated %d/%d vtxos", end, len(keys))
+	}
+
+	return &MigrationStats{
+		Count:    len(keys),
+		Copied:   copied,
+		Checksum: hex.EncodeToString(checksum.Sum(nil)),
+	}, nil
+}
+
+func migrateEvents(
+	ctx context.Context, from, to domain.RoundEventRepository, roundIds []string,
+) (*MigrationStats, error) {
+	checksum := sha256.New()
+	count, copied := 0, 0
+	for i, id := range roundIds {
+		round, err := from.Load(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		events := round.Events()
+		count += len(events)
+		if len(events) <= 0 {
+			continue
+		}
+
+		digest := eventsDigest(events)
+		if err := writeDigest(checksum, digest); err != nil {
+			return nil, err
+		}
+
+		// Events of a round are saved at once, therefore the target has either
+		// none or all of them.
+		migratedRound, err := to.Load(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if migratedEvents := migratedRound.Events(); len(migratedEvents) > 0 {
+			if !digestsMatch(digest, eventsDigest(migratedEvents)) {
+				return nil, fmt.Errorf(
+					"events of round %s in target don't match those in source", id,
+				)
+			}
+			continue
+		}
+
+		if _, err := to.Save(ctx, id, events...); err != nil {
+			return nil, err
+		}
+		copied += len(events)
+		log.Debugf("migrated events of %d/%d rounds", i+1, len(roundIds))
+	}
+
+	return &MigrationStats{
+		Count:    count,
+		Copied:   copied,
+		Checksum: hex.EncodeToString(checksum.Sum(nil)),
+	}, nil
+}
+
+func migrateRounds(
+	ctx context.Context, from, to domain.RoundRepository, roundIds []string,
+) (*MigrationStats, error) {
+	checksum := sha256.New()
+	copied := 0
+	for i, id := range roundIds {
+		round, err := from.GetRoundWithId(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		digest := roundDigest(*round)
+		if err := writeDigest(checksum, digest); err != nil {
+			return nil, err
+		}
+
+		// Stores return an error if the round is not found.
+		migratedRound, err := to.GetRoundWithId(ctx, id)
+		if err == nil && digestsMatch(digest, roundDigest(*migratedRound)) {
+			continue
+		}
+
+		if err := to.AddOrUpdateRound(ctx, *round); err != nil {
+			return nil, err
+		}
+		copied++
+		log.Debugf("migrated %d/%d rounds", i+1, len(roundIds))
+	}
+
+	return &MigrationStats{
+		Count:    len(roundIds),
+		Copied:   copied,
+		Checksum: hex.EncodeToString(checksum.Sum(nil)),
+	}, nil
+}
+
+// verifyMigration reads back all migrated items from the target and checks
+// their counts and checksums against those of the source.
+func verifyMigration(
+	ctx context.Context, to ports.RepoManager,
+	vtxoKeys []domain.VtxoKey, roundIds []string, summary *MigrationSummary,
+) error {
+	migratedVtxoKeys, err := to.Vtxos().GetAllVtxoKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get target vtxos: %s", err)
+	}
+	if len(migratedVtxoKeys) != len(vtxoKeys) {
+		return fmt.Errorf(
+			"vtxo count mismatch: source %d, target %d",
+			len(vtxoKeys), len(migratedVtxoKeys),
+		)
+	}
+	migratedRoundIds, err := getAllRoundIds(ctx, to.Rounds())
+	if err != nil {
+		return fmt.Errorf("failed to get target rounds: %s", err)
+	}
+	if len(migratedRoundIds) != len(roundIds) {
+		return fmt.Errorf(
+			"round count mismatch: source %d, target %d",
+			len(roundIds), len(migratedRoundIds),
+		)
+	}
+
+	vtxosChecksum := sha256.New()
+	for i := 0; i < len(vtxoKeys); i += migrationBatchSize {
+		end := min(i+migrationBatchSize, len(vtxoKeys))
+		vtxos, err := to.Vtxos().GetVtxos(ctx, vtxoKeys[i:end])
+		if err != nil {
+			return fmt.Errorf("failed to get target vtxos: %s", err)
+		}
+		for _, vtxo := range vtxos {
+			if err := writeDigest(vtxosChecksum, vtxoDigest(vtxo)); err != nil {
+				return err
+			}
+		}
+	}
+
+	eventsChecksum := sha256.New()
+	roundsChecksum := sha256.New()
+	eventCount := 0
+	for _, id := range roundIds {
+		round, err := to.Events().Load(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get target round events: %s", err)
+		}
+		if events := round.Events(); len(events) > 0 {
+			eventCount += len(events)
+			if err := writeDigest(eventsChecksum, eventsDigest(events)); err != nil {
+				return err
+			}
+		}
+
+		round, err = to.Rounds().GetRoundWithId(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get target round: %s", err)
+		}
+		if err := writeDigest(roundsChecksum, roundDigest(*round)); err != nil {
+			return err
+		}
+	}
+	if eventCount != summary.Events.Count {
+		return fmt.Errorf(
+			"round event count mismatch: source %d, target %d",
+			summary.Events.Count, eventCount,
+		)
+	}
+
+	for _, c := range []struct {
+		name     string
+		expected string
+		got      hash.Hash
+	}{
+		{"vtxo", summary.Vtxos.Checksum, vtxosChecksum},
+		{"round event", summary.Events.Checksum, eventsChecksum},
+		{"round", summary.Rounds.Checksum, roundsChecksum},
+	} {
+		if got := hex.EncodeToString(c.got.Sum(nil)); got != c.expected {
+			return fmt.Errorf(
+				"%s checksum mismatch: source %s, target %s", c.name, c.expected, got,
+			)
+		}
+	}
+	return nil
+}
+
+func getAllRoundIds(
+	ctx context.Context, repo domain.RoundRepository,
+) ([]string, error) {
+	ids, err := repo.GetRoundsIds(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	unfinishedIds, err := repo.GetUnfinishedRoundsIds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	failedIds, err := repo.GetFailedRoundsIds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	idsMap := make(map[string]struct{})
+	for _, id := range append(append(ids, unfinishedIds...), failedIds...) {
+		idsMap[id] = struct{}{}
+	}
+	allIds := make([]string, 0, len(idsMap))
+	for id := range idsMap {
+		allIds = append(allIds, id)
+	}
+	sort.Strings(allIds)
+	return allIds, nil
+}
+
+// The digests below contain only what all stores persist, in a canonical
+// order, so that the same item read from different stores has the same one.
+
+type vtxoDigestData struct {
+	Txid       string
+	VOut       uint32
+	Pubkey     string
+	Amount     uint64
+	PoolTx     string
+	SpentBy    string
+	Spent      bool
+	Redeemed   bool
+	Swept      bool
+	ExpireAt   int64
+	RedeemTx   string
+	ForfeitTxs []string
+}
+
+func vtxoDigest(vtxo domain.Vtxo) vtxoDigestData {
+	digest := vtxoDigestData{
+		Txid:     vtxo.Txid,
+		VOut:     vtxo.VOut,
+		Pubkey:   vtxo.Pubkey,
+		Amount:   vtxo.Amount,
+		PoolTx:   vtxo.PoolTx,
+		SpentBy:  vtxo.SpentBy,
+		Spent:    vtxo.Spent,
+		Redeemed: vtxo.Redeemed,
+		Swept:    vtxo.Swept,
+		ExpireAt: vtxo.ExpireAt,
+	}
+	if vtxo.AsyncPayment != nil && len(vtxo.AsyncPayment.UnconditionalForfeitTxs) > 0 {
+		digest.RedeemTx = vtxo.AsyncPayment.RedeemTx
+		digest.ForfeitTxs = vtxo.AsyncPayment.UnconditionalForfeitTxs
+	}
+	return digest
+}
+
+type eventDigestData struct {
+	Type  string
+	Event domain.RoundEvent
+}
+
+func eventsDigest(events []domain.RoundEvent) []eventDigestData {
+	digest := make([]eventDigestData, 0, len(events))
+	for _, event := range events {
+		digest = append(digest, eventDigestData{fmt.Sprintf("%T", event), event})
+	}
+	return digest
+}
+
+type paymentDigestData struct {
+	Id        string
+	Receivers []domain.Receiver
+	Fee       uint64
+}
+
+type roundDigestData struct {
+	Id                string
+	StartingTimestamp int64
+	EndingTimestamp   int64
+	Stage             domain.Stage
+	Payments          []paymentDigestData
+	Txid              string
+	UnsignedTx        string
+	ForfeitTxs        []string
+	CongestionTree    [][]treeNodeDigestData
+	Connectors        []string
+	ConnectorAddress  string
+	DustAmount        uint64
+	Version           uint
+	Swept             bool
+}
+
+type treeNodeDigestData struct {
+	Txid       string
+	Tx         string
+	ParentTxid string
+	Leaf       bool
+}
+
+// roundDigest doesn't include the inputs of the payments since their state
+// is tracked by the vtxo store, which is checked on its own.
+func roundDigest(round domain.Round) roundDigestData {
+	payments := make([]paymentDigestData, 0, len(round.Payments))
+	for _, payment := range round.Payments {
+		receivers := append([]domain.Receiver{}, payment.Receivers...)
+		sort.SliceStable(receivers, func(i, j int) bool {
+			if receivers[i].Pubkey == receivers[j].Pubkey {
+				return receivers[i].OnchainAddress < receivers[j].OnchainAddress
+			}
+			return receivers[i].Pubkey < receivers[j].Pubkey
+		})
+		payments = append(payments, paymentDigestData{
+			payment.Id, receivers, payment.Fee,
+		})
+	}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].Id < payments[j].Id
+	})
+
+	congestionTree := make([][]treeNodeDigestData, 0, len(round.CongestionTree))
+	for _, level := range round.CongestionTree {
+		nodes := make([]treeNodeDigestData, 0, len(level))
+		for _, node := range level {
+			nodes = append(nodes, treeNodeDigestData{
+				node.Txid, node.Tx, node.ParentTxid, node.Leaf,
+			})
+		}
+		congestionTree = append(congestionTree, nodes)
+	}
+
+	forfeitTxs := append([]string{}, round.ForfeitTxs...)
+	sort.Strings(forfeitTxs)
+	connectors := append([]string{}, round.Connectors...)
+	sort.Strings(connectors)
+
+	return roundDigestData{
+		Id:                round.Id,
+		StartingTimestamp: round.StartingTimestamp,
+		EndingTimestamp:   round.EndingTimestamp,
+		Stage:             round.Stage,
+		Payments:          payments,
+		Txid:              round.Txid,
+		UnsignedTx:        round.UnsignedTx,
+		ForfeitTxs:        forfeitTxs,
+		CongestionTree:    congestionTree,
+		Connectors:        connectors,
+		ConnectorAddress:  round.ConnectorAddress,
+		DustAmount:        round.DustAmount,
+		Version:           round.Version,
+		Swept:             round.Swept,
+	}
+}
+
+type sweepDigestData struct {
+	Id          string
+	RoundTxid   string
+	ScheduledAt int64
+	Status      domain.SweepStatus
+	AttemptedAt int64
+	Outputs     []domain.SweepOutput
+	Error       string
+}
+
+func sweepDigest(sweep domain.Sweep) sweepDigestData {
+	outputs := append([]domain.SweepOutput{}, sweep.Outputs...)
+	sort.SliceStable(outputs, func(i, j int) bool {
+		if outputs[i].Txid == outputs[j].Txid {
+			return outputs[i].VOut < outputs[j].VOut
+		}
+		return outputs[i].Txid < outputs[j].Txid
+	})
+	return sweepDigestData{
+		Id:          sweep.Id,
+		RoundTxid:   sweep.RoundTxid,
+		ScheduledAt: sweep.ScheduledAt,
+		Status:      sweep.Status,
+		AttemptedAt: sweep.AttemptedAt,
+		Outputs:     outputs,
+		Error:       sweep.Error,
+	}
+}
+
+type offenderDigestData struct {
+	Key         string
+	Strikes     []domain.Strike
+	BannedUntil int64
+}
+
+// offenderDigest keeps the strikes in the order they were collected.
+func offenderDigest(offender domain.Offender) offenderDigestData {
+	return offenderDigestData{
+		Key:         offender.Key,
+		Strikes:     append([]domain.Strike{}, offender.Strikes...),
+		BannedUntil: offender.BannedUntil,
+	}
+}
+
+type paymentRequestDigestData struct {
+	Id              string
+	Inputs          []domain.VtxoKey
+	Receivers       []domain.Receiver
+	Fee             uint64
+	EphemeralPubkey string
+	Timestamp       int64
+}
+
+// paymentRequestDigest includes only the keys of the inputs since their state
+// is tracked by the vtxo store, which is checked on its own.
+func paymentRequestDigest(request domain.PaymentRequest) paymentRequestDigestData {
+	inputs := make([]domain.VtxoKey, 0, len(request.Inputs))
+	for _, input := range request.Inputs {
+		inputs = append(inputs, input.VtxoKey)
+	}
+	sort.SliceStable(inputs, func(i, j int) bool {
+		if inputs[i].Txid == inputs[j].Txid {
+			return inputs[i].VOut < inputs[j].VOut
+		}
+		return inputs[i].Txid < inputs[j].Txid
+	})
+	return paymentRequestDigestData{
+		Id:              request.Id,
+		Inputs:          inputs,
+		Receivers:       sortedReceivers(request.Receivers),
+		Fee:             request.Fee,
+		EphemeralPubkey: request.EphemeralPubkey,
+		Timestamp:       request.Timestamp,
+	}
+}
+
+type asyncPaymentRequestDigestData struct {
+	Txid      string
+	VOut      uint32
+	Receivers []domain.Receiver
+	ExpireAt  int64
+}
+
+func asyncPaymentRequestDigest(
+	request domain.AsyncPaymentRequest,
+) asyncPaymentRequestDigestData {
+	return asyncPaymentRequestDigestData{
+		Txid:      request.Txid,
+		VOut:      request.VOut,
+		Receivers: sortedReceivers(request.Receivers),
+		ExpireAt:  request.ExpireAt,
+	}
+}
+
+type monitoredTxDigestData struct {
+	Txid          string
+	Type          string
+	TxHex         string
+	BroadcastedAt int64
+	BumpedAt      int64
+	FeeRate       uint64
+	ChildTxid     string
+	SweepInputs   []sweepTxInputDigestData
+	ConfirmedAt   int64
+}
+
+type sweepTxInputDigestData struct {
+	Txid         string
+	VOut         uint32
+	Amount       uint64
+	LeafScript   string
+	ControlBlock string
+	InternalKey  string
+}
+
+// monitoredTxDigest keeps the sweep inputs in the order of the sweep tx.
+func monitoredTxDigest(tx domain.MonitoredTx) monitoredTxDigestData {
+	inputs := make([]sweepTxInputDigestData, 0, len(tx.SweepInputs))
+	for _, input := range tx.SweepInputs {
+		inputs = append(inputs, sweepTxInputDigestData{
+			Txid:         input.Txid,
+			VOut:         input.VOut,
+			Amount:       input.Amount,
+			LeafScript:   hex.EncodeToString(input.LeafScript),
+			ControlBlock: hex.EncodeToString(input.ControlBlock),
+			InternalKey:  hex.EncodeToString(input.InternalKey),
+		})
+	}
+	return monitoredTxDigestData{
+		Txid:          tx.Txid,
+		Type:          tx.Type,
+		TxHex:         tx.TxHex,
+		BroadcastedAt: tx.BroadcastedAt,
+		BumpedAt:      tx.BumpedAt,
+		FeeRate:       tx.FeeRate,
+		ChildTxid:     tx.ChildTxid,
+		SweepInputs:   inputs,
+		ConfirmedAt:   tx.ConfirmedAt,
+	}
+}
+
+func sortedReceivers(receivers []domain.Receiver) []domain.Receiver {
+	sorted := append([]domain.Receiver{}, receivers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Pubkey == sorted[j].Pubkey {
+			if sorted[i].OnchainAddress == sorted[j].OnchainAddress {
+				return sorted[i].Amount < sorted[j].Amount
+			}
+			return sorted[i].OnchainAddress < sorted[j].OnchainAddress
+		}
+		return sorted[i].Pubkey < sorted[j].Pubkey
+	})
+	return sorted
+}
+
+func writeDigest(h hash.Hash, digest interface{}) error {
+	if err := json.NewEncoder(h).Encode(digest); err != nil {
+		return fmt.Errorf("failed to serialize digest: %s", err)
+	}
+	return nil
+}
+
+func digestsMatch(a, b interface{}) bool {
+	ha, hb := sha256.New(), sha256.New()
+	if writeDigest(ha, a) != nil || writeDigest(hb, b) != nil {
+		return false
+	}
+	return string(ha.Sum(nil)) == string(hb.Sum(nil))
+}
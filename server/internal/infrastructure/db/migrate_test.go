@@ -0,0 +1,203 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+
+	from, err := db.NewService(db.ServiceConfig{
+		EventStoreType:   "badger",
+		DataStoreType:    "badger",
+		EventStoreConfig: []interface{}{"", nil},
+		DataStoreConfig:  []interface{}{"", nil},
+	})
+	require.NoError(t, err)
+	defer from.Close()
+
+	to, err := db.NewService(db.ServiceConfig{
+		EventStoreType:  "sqlite",
+		DataStoreType:   "sqlite",
+		DataStoreConfig: []interface{}{t.TempDir(), "file://sqlite/migration"},
+	})
+	require.NoError(t, err)
+	defer to.Close()
+
+	roundId := uuid.New().String()
+	now := time.Now()
+	input := domain.Vtxo{
+		VtxoKey:  domain.VtxoKey{Txid: randomString(32), VOut: 0},
+		Receiver: domain.Receiver{Pubkey: randomString(36), Amount: 1000},
+		PoolTx:   randomString(32),
+		ExpireAt: 7980322,
+	}
+	events := []domain.RoundEvent{
+		domain.RoundStarted{Id: roundId, Timestamp: now.Unix()},
+		domain.PaymentsRegistered{
+			Id: roundId,
+			Payments: []domain.Payment{{
+				Id:        uuid.New().String(),
+				Inputs:    []domain.Vtxo{input},
+				Receivers: []domain.Receiver{{Pubkey: randomString(36), Amount: 1000}},
+			}},
+		},
+		domain.RoundFinalizationStarted{
+			Id:             roundId,
+			CongestionTree: congestionTree,
+			Connectors:     []string{emptyPtx},
+			PoolTx:         emptyTx,
+		},
+		domain.RoundFinalized{
+			Id:         roundId,
+			Txid:       randomString(32),
+			ForfeitTxs: []string{emptyPtx},
+			Timestamp:  now.Add(time.Minute).Unix(),
+		},
+	}
+
+	round, err := from.Events().Save(ctx, roundId, events...)
+	require.NoError(t, err)
+	require.NoError(t, from.Rounds().AddOrUpdateRound(ctx, *round))
+
+	asyncVtxo := domain.Vtxo{
+		VtxoKey:  domain.VtxoKey{Txid: randomString(32), VOut: 1},
+		Receiver: domain.Receiver{Pubkey: randomString(36), Amount: 500},
+		PoolTx:   randomString(32),
+		ExpireAt: 7980322,
+		AsyncPayment: &domain.AsyncPaymentTxs{
+			RedeemTx:                emptyPtx,
+			UnconditionalForfeitTxs: []string{emptyPtx, emptyPtx},
+		},
+	}
+	require.NoError(t, from.Vtxos().AddVtxos(ctx, []domain.Vtxo{input, asyncVtxo}))
+
+	failedRoundId := uuid.New().String()
+	failedEvents := []domain.RoundEvent{
+		domain.RoundStarted{Id: failedRoundId, Timestamp: now.Unix()},
+		domain.RoundFailed{
+			Id:        failedRoundId,
+			Err:       "not enough payments registered",
+			Timestamp: now.Add(time.Minute).Unix(),
+		},
+	}
+	failedRound, err := from.Events().Save(ctx, failedRoundId, failedEvents...)
+	require.NoError(t, err)
+	require.NoError(t, from.Rounds().AddOrUpdateRound(ctx, *failedRound))
+
+	sweep := domain.NewSweep(randomString(32), round.Txid, now.Unix())
+	require.NoError(t, sweep.Complete([]domain.SweepOutput{{
+		VtxoKey:   domain.VtxoKey{Txid: randomString(32), VOut: 0},
+		Amount:    1000,
+		SweepTxid: randomString(32),
+	}}))
+	require.NoError(t, from.Sweeps().AddOrUpdateSweep(ctx, *sweep))
+
+	offender := domain.NewOffender(randomString(36))
+	_, err = offender.AddStrike(domain.StrikeMissedPing, roundId, 3, 60)
+	require.NoError(t, err)
+	require.NoError(t, from.Offenders().AddOrUpdateOffender(ctx, *offender))
+
+	paymentRequest := domain.PaymentRequest{
+		Payment: domain.Payment{
+			Id:        uuid.New().String(),
+			Inputs:    []domain.Vtxo{input},
+			Receivers: []domain.Receiver{{Pubkey: randomString(36), Amount: 1000}},
+		},
+		EphemeralPubkey: randomString(36),
+		Timestamp:       now.Unix(),
+	}
+	require.NoError(t, from.PaymentRequests().AddOrUpdatePaymentRequest(ctx, paymentRequest))
+	asyncPaymentRequest := domain.AsyncPaymentRequest{
+		VtxoKey:   asyncVtxo.VtxoKey,
+		Receivers: []domain.Receiver{{Pubkey: randomString(36), Amount: 500}},
+		ExpireAt:  now.Add(time.Hour).Unix(),
+	}
+	require.NoError(t, from.PaymentRequests().AddAsyncPaymentRequest(ctx, asyncPaymentRequest))
+
+	monitoredTx := domain.MonitoredTx{
+		Txid:          randomString(32),
+		Type:          "sweep",
+		TxHex:         emptyTx,
+		BroadcastedAt: now.Unix(),
+		SweepInputs: []domain.SweepTxInput{{
+			VtxoKey:      sweep.Outputs[0].VtxoKey,
+			Amount:       1000,
+			LeafScript:   []byte{0x01},
+			ControlBlock: []byte{0x02},
+			InternalKey:  []byte{0x03},
+		}},
+	}
+	require.NoError(t, from.MonitoredTxs().AddOrUpdateMonitoredTx(ctx, monitoredTx))
+
+	summary, err := db.Migrate(ctx, from, to)
+	require.NoError(t, err)
+	require.NotNil(t, summary)
+	require.Equal(t, 2, summary.Vtxos.Count)
+	require.Equal(t, 2, summary.Vtxos.Copied)
+	require.Equal(t, len(events)+len(failedEvents), summary.Events.Count)
+	require.Equal(t, len(events)+len(failedEvents), summary.Events.Copied)
+	require.Equal(t, 2, summary.Rounds.Count)
+	for _, stats := range []db.MigrationStats{
+		summary.Sweeps, summary.Offenders, summary.PaymentRequests,
+		summary.AsyncPaymentRequests, summary.MonitoredTxs,
+	} {
+		require.Equal(t, 1, stats.Count)
+		require.Equal(t, 1, stats.Copied)
+	}
+	// The sqlite event store already updated the round along with its events.
+	require.Zero(t, summary.Rounds.Copied)
+
+	migratedRound, err := to.Rounds().GetRoundWithId(ctx, roundId)
+	require.NoError(t, err)
+	require.Condition(t, roundsMatch(*round, *migratedRound))
+
+	migratedVtxos, err := to.Vtxos().GetVtxos(ctx, []domain.VtxoKey{asyncVtxo.VtxoKey})
+	require.NoError(t, err)
+	require.Len(t, migratedVtxos, 1)
+	require.Equal(t, asyncVtxo, migratedVtxos[0])
+
+	migratedFailedRound, err := to.Rounds().GetRoundWithId(ctx, failedRoundId)
+	require.NoError(t, err)
+	require.True(t, migratedFailedRound.IsFailed())
+
+	migratedSweep, err := to.Sweeps().GetSweep(ctx, sweep.Id)
+	require.NoError(t, err)
+	require.Equal(t, *sweep, *migratedSweep)
+
+	migratedOffender, err := to.Offenders().GetOffender(ctx, offender.Key)
+	require.NoError(t, err)
+	require.Equal(t, *offender, *migratedOffender)
+
+	migratedRequests, err := to.PaymentRequests().GetPaymentRequests(ctx)
+	require.NoError(t, err)
+	require.Len(t, migratedRequests, 1)
+	require.Equal(t, paymentRequest.Id, migratedRequests[0].Id)
+
+	migratedTxs, err := to.MonitoredTxs().GetAllMonitoredTxs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []domain.MonitoredTx{monitoredTx}, migratedTxs)
+
+	// Running the migration again must not copy anything.
+	resumedSummary, err := db.Migrate(ctx, from, to)
+	require.NoError(t, err)
+	require.NotNil(t, resumedSummary)
+	require.Zero(t, resumedSummary.Vtxos.Copied)
+	require.Zero(t, resumedSummary.Events.Copied)
+	require.Zero(t, resumedSummary.Rounds.Copied)
+	require.Zero(t, resumedSummary.Sweeps.Copied)
+	require.Zero(t, resumedSummary.Offenders.Copied)
+	require.Zero(t, resumedSummary.PaymentRequests.Copied)
+	require.Zero(t, resumedSummary.AsyncPaymentRequests.Copied)
+	require.Zero(t, resumedSummary.MonitoredTxs.Copied)
+	require.Equal(t, summary.Vtxos.Checksum, resumedSummary.Vtxos.Checksum)
+	require.Equal(t, summary.Events.Checksum, resumedSummary.Events.Checksum)
+	require.Equal(t, summary.Rounds.Checksum, resumedSummary.Rounds.Checksum)
+}
@@ -0,0 +1,24 @@
+DROP VIEW IF EXISTS sweep_output_vw;
+DROP VIEW IF EXISTS offender_strike_vw;
+DROP VIEW IF EXISTS uncond_forfeit_tx_vw;
+DROP VIEW IF EXISTS payment_vtxo_vw;
+DROP VIEW IF EXISTS payment_receiver_vw;
+DROP VIEW IF EXISTS round_tx_vw;
+DROP VIEW IF EXISTS round_payment_vw;
+
+DROP TABLE IF EXISTS round_event;
+DROP TABLE IF EXISTS sweep_output;
+DROP TABLE IF EXISTS sweep;
+DROP TABLE IF EXISTS async_payment_request_receiver;
+DROP TABLE IF EXISTS async_payment_request;
+DROP TABLE IF EXISTS payment_request_receiver;
+DROP TABLE IF EXISTS payment_request_input;
+DROP TABLE IF EXISTS payment_request;
+DROP TABLE IF EXISTS strike;
+DROP TABLE IF EXISTS offender;
+DROP TABLE IF EXISTS uncond_forfeit_tx;
+DROP TABLE IF EXISTS vtxo;
+DROP TABLE IF EXISTS tx;
+DROP TABLE IF EXISTS receiver;
+DROP TABLE IF EXISTS payment;
+DROP TABLE IF EXISTS round;
@@ -0,0 +1,187 @@
+CREATE TABLE IF NOT EXISTS round (
+    id TEXT PRIMARY KEY,
+    starting_timestamp BIGINT NOT NULL,
+    ending_timestamp BIGINT NOT NULL,
+    ended BOOLEAN NOT NULL,
+    failed BOOLEAN NOT NULL,
+    stage_code BIGINT NOT NULL,
+    txid TEXT NOT NULL,
+    unsigned_tx TEXT NOT NULL,
+    connector_address TEXT NOT NULL,
+    dust_amount BIGINT NOT NULL,
+    version BIGINT NOT NULL,
+    swept BOOLEAN NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS payment (
+    id TEXT PRIMARY KEY,
+    round_id TEXT NOT NULL,
+    fee BIGINT NOT NULL DEFAULT 0,
+    FOREIGN KEY (round_id) REFERENCES round(id)
+);
+
+CREATE TABLE IF NOT EXISTS receiver (
+    payment_id TEXT NOT NULL,
+    pubkey TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    onchain_address TEXT NOT NULL,
+    FOREIGN KEY (payment_id) REFERENCES payment(id),
+    PRIMARY KEY (payment_id, pubkey)
+);
+
+CREATE TABLE IF NOT EXISTS tx (
+    id BIGSERIAL PRIMARY KEY,
+    tx TEXT NOT NULL,
+    round_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    position BIGINT NOT NULL,
+    txid TEXT,
+    tree_level BIGINT,
+    parent_txid TEXT,
+    is_leaf BOOLEAN,
+    FOREIGN KEY (round_id) REFERENCES round(id)
+);
+
+CREATE TABLE IF NOT EXISTS vtxo (
+    txid TEXT NOT NULL,
+    vout BIGINT NOT NULL,
+    pubkey TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    pool_tx TEXT NOT NULL,
+    spent_by TEXT NOT NULL,
+    spent BOOLEAN NOT NULL,
+    redeemed BOOLEAN NOT NULL,
+    swept BOOLEAN NOT NULL,
+    expire_at BIGINT NOT NULL,
+    payment_id TEXT,
+    redeem_tx TEXT,
+    PRIMARY KEY (txid, vout),
+    FOREIGN KEY (payment_id) REFERENCES payment(id)
+);
+
+CREATE TABLE IF NOT EXISTS uncond_forfeit_tx (
+    id BIGSERIAL PRIMARY KEY,
+    tx TEXT NOT NULL,
+    vtxo_txid TEXT NOT NULL,
+    vtxo_vout BIGINT NOT NULL,
+    position BIGINT NOT NULL,
+    FOREIGN KEY (vtxo_txid, vtxo_vout) REFERENCES vtxo(txid, vout)
+);
+
+CREATE TABLE IF NOT EXISTS offender (
+    id TEXT PRIMARY KEY,
+    banned_until BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS strike (
+    id BIGSERIAL PRIMARY KEY,
+    offender_id TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    round_id TEXT NOT NULL,
+    timestamp BIGINT NOT NULL,
+    FOREIGN KEY (offender_id) REFERENCES offender(id)
+);
+
+CREATE TABLE IF NOT EXISTS payment_request (
+    id TEXT PRIMARY KEY,
+    ephemeral_pubkey TEXT NOT NULL,
+    timestamp BIGINT NOT NULL,
+    fee BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS payment_request_input (
+    request_id TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    vout BIGINT NOT NULL,
+    PRIMARY KEY (request_id, txid, vout),
+    FOREIGN KEY (request_id) REFERENCES payment_request(id)
+);
+
+CREATE TABLE IF NOT EXISTS payment_request_receiver (
+    id BIGSERIAL PRIMARY KEY,
+    request_id TEXT NOT NULL,
+    pubkey TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    onchain_address TEXT NOT NULL,
+    FOREIGN KEY (request_id) REFERENCES payment_request(id)
+);
+
+CREATE TABLE IF NOT EXISTS async_payment_request (
+    txid TEXT NOT NULL,
+    vout BIGINT NOT NULL,
+    expire_at BIGINT NOT NULL,
+    PRIMARY KEY (txid, vout)
+);
+
+CREATE TABLE IF NOT EXISTS async_payment_request_receiver (
+    id BIGSERIAL PRIMARY KEY,
+    txid TEXT NOT NULL,
+    vout BIGINT NOT NULL,
+    pubkey TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    onchain_address TEXT NOT NULL,
+    FOREIGN KEY (txid, vout) REFERENCES async_payment_request(txid, vout)
+);
+
+CREATE TABLE IF NOT EXISTS sweep (
+    id TEXT PRIMARY KEY,
+    round_txid TEXT NOT NULL,
+    scheduled_at BIGINT NOT NULL,
+    status TEXT NOT NULL,
+    attempted_at BIGINT NOT NULL,
+    error TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sweep_output (
+    id BIGSERIAL PRIMARY KEY,
+    sweep_id TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    vout BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    sweep_txid TEXT NOT NULL,
+    FOREIGN KEY (sweep_id) REFERENCES sweep(id)
+);
+
+CREATE TABLE IF NOT EXISTS round_event (
+    id BIGSERIAL PRIMARY KEY,
+    round_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    event BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS round_event_round_id_idx ON round_event(round_id);
+
+CREATE VIEW round_payment_vw AS SELECT payment.*
+FROM round
+LEFT OUTER JOIN payment
+ON round.id=payment.round_id;
+
+CREATE VIEW round_tx_vw AS SELECT tx.*
+FROM round
+LEFT OUTER JOIN tx
+ON round.id=tx.round_id;
+
+CREATE VIEW payment_receiver_vw AS SELECT receiver.*
+FROM payment
+LEFT OUTER JOIN receiver
+ON payment.id=receiver.payment_id;
+
+CREATE VIEW payment_vtxo_vw AS SELECT vtxo.*
+FROM payment
+LEFT OUTER JOIN vtxo
+ON payment.id=vtxo.payment_id;
+
+CREATE VIEW uncond_forfeit_tx_vw AS SELECT uncond_forfeit_tx.*
+FROM vtxo
+LEFT OUTER JOIN uncond_forfeit_tx
+ON vtxo.txid=uncond_forfeit_tx.vtxo_txid AND vtxo.vout=uncond_forfeit_tx.vtxo_vout;
+
+CREATE VIEW offender_strike_vw AS SELECT strike.*
+FROM offender
+LEFT OUTER JOIN strike
+ON offender.id=strike.offender_id;
+
+CREATE VIEW sweep_output_vw AS SELECT sweep_output.*
+FROM sweep
+LEFT OUTER JOIN sweep_output
+ON sweep.id=sweep_output.sweep_id;
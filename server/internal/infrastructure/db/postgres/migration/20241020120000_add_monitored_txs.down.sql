@@ -0,0 +1,5 @@
+DROP VIEW IF EXISTS monitored_tx_sweep_input_vw;
+
+DROP TABLE IF EXISTS monitored_tx_sweep_input;
+
+DROP TABLE IF EXISTS monitored_tx;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS monitored_tx (
+    txid TEXT PRIMARY KEY,
+    type TEXT NOT NULL,
+    tx_hex TEXT NOT NULL,
+    broadcasted_at BIGINT NOT NULL,
+    bumped_at BIGINT NOT NULL,
+    fee_rate BIGINT NOT NULL,
+    child_txid TEXT NOT NULL,
+    confirmed_at BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS monitored_tx_sweep_input (
+    id BIGSERIAL PRIMARY KEY,
+    monitored_txid TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    vout BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    leaf_script BYTEA NOT NULL,
+    control_block BYTEA NOT NULL,
+    internal_key BYTEA NOT NULL,
+    FOREIGN KEY (monitored_txid) REFERENCES monitored_tx(txid)
+);
+
+CREATE VIEW monitored_tx_sweep_input_vw AS SELECT monitored_tx_sweep_input.*
+FROM monitored_tx
+LEFT OUTER JOIN monitored_tx_sweep_input
+ON monitored_tx.txid=monitored_tx_sweep_input.monitored_txid;
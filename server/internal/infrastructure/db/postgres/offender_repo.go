@@ -0,0 +1,164 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db/postgres/sqlc/queries"
+)
+
+type offenderRepository struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewOffenderRepository(config ...interface{}) (domain.OffenderRepository, error) {
+	if len(config) != 1 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	db, ok := config[0].(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("cannot open offender repository: invalid config, expected db at 0")
+	}
+
+	return &offenderRepository{
+		db:      db,
+		querier: queries.New(db),
+	}, nil
+}
+
+func (r *offenderRepository) Close() {
+	_ = r.db.Close()
+}
+
+func (r *offenderRepository) AddOrUpdateOffender(
+	ctx context.Context, offender domain.Offender,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		if err := querierWithTx.UpsertOffender(
+			ctx, queries.UpsertOffenderParams{
+				ID:          offender.Key,
+				BannedUntil: offender.BannedUntil,
+			},
+		); err != nil {
+			return fmt.Errorf("failed to upsert offender: %w", err)
+		}
+
+		if err := querierWithTx.DeleteOffenderStrikes(ctx, offender.Key); err != nil {
+			return fmt.Errorf("failed to delete strikes: %w", err)
+		}
+
+		for _, strike := range offender.Strikes {
+			if err := querierWithTx.InsertStrike(
+				ctx, queries.InsertStrikeParams{
+					OffenderID: offender.Key,
+					Reason:     string(strike.Reason),
+					RoundID:    strike.RoundId,
+					Timestamp:  strike.Timestamp,
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert strike: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *offenderRepository) GetOffender(
+	ctx context.Context, key string,
+) (*domain.Offender, error) {
+	rows, err := r.querier.SelectOffender(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	ovs := make([]offenderStrikeRow, 0, len(rows))
+	for _, row := range rows {
+		ovs = append(ovs, offenderStrikeRow{
+			offender: row.Offender,
+			strike:   row.OffenderStrikeVw,
+		})
+	}
+
+	offenders := readOffenderRows(ovs)
+	if len(offenders) <= 0 {
+		return nil, fmt.Errorf("offender %s not found", key)
+	}
+	return &offenders[0], nil
+}
+
+func (r *offenderRepository) GetBannedOffenders(
+	ctx context.Context, bannedAt int64,
+) ([]domain.Offender, error) {
+	rows, err := r.querier.SelectBannedOffenders(ctx, bannedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	ovs := make([]offenderStrikeRow, 0, len(rows))
+	for _, row := range rows {
+		ovs = append(ovs, offenderStrikeRow{
+			offender: row.Offender,
+			strike:   row.OffenderStrikeVw,
+		})
+	}
+
+	return readOffenderRows(ovs), nil
+}
+
+func (r *offenderRepository) GetAllOffenders(
+	ctx context.Context,
+) ([]domain.Offender, error) {
+	rows, err := r.querier.SelectAllOffenders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ovs := make([]offenderStrikeRow, 0, len(rows))
+	for _, row := range rows {
+		ovs = append(ovs, offenderStrikeRow{
+			offender: row.Offender,
+			strike:   row.OffenderStrikeVw,
+		})
+	}
+
+	return readOffenderRows(ovs), nil
+}
+
+type offenderStrikeRow struct {
+	offender queries.Offender
+	strike   queries.OffenderStrikeVw
+}
+
+func readOffenderRows(rows []offenderStrikeRow) []domain.Offender {
+	offenders := make([]domain.Offender, 0)
+	offendersByKey := make(map[string]int)
+
+	for _, row := range rows {
+		i, ok := offendersByKey[row.offender.ID]
+		if !ok {
+			offenders = append(offenders, domain.Offender{
+				Key:         row.offender.ID,
+				Strikes:     make([]domain.Strike, 0),
+				BannedUntil: row.offender.BannedUntil,
+			})
+			i = len(offenders) - 1
+			offendersByKey[row.offender.ID] = i
+		}
+
+		if row.strike.ID.Valid {
+			offenders[i].Strikes = append(offenders[i].Strikes, domain.Strike{
+				Reason:    domain.StrikeReason(row.strike.Reason.String),
+				RoundId:   row.strike.RoundID.String,
+				Timestamp: row.strike.Timestamp.Int64,
+			})
+		}
+	}
+
+	return offenders
+}
@@ -0,0 +1,398 @@
+-- name: UpsertTransaction :exec
+INSERT INTO tx (
+    tx, round_id, type, position, txid, tree_level, parent_txid, is_leaf
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT(id) DO UPDATE SET
+    tx = EXCLUDED.tx,
+    round_id = EXCLUDED.round_id,
+    type = EXCLUDED.type,
+    position = EXCLUDED.position,
+    txid = EXCLUDED.txid,
+    tree_level = EXCLUDED.tree_level,
+    parent_txid = EXCLUDED.parent_txid,
+    is_leaf = EXCLUDED.is_leaf;
+
+-- name: UpsertRound :exec
+INSERT INTO round (
+    id,
+    starting_timestamp,
+    ending_timestamp,
+    ended, failed,
+    stage_code,
+    txid,
+    unsigned_tx,
+    connector_address,
+    dust_amount,
+    version,
+    swept
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT(id) DO UPDATE SET
+    starting_timestamp = EXCLUDED.starting_timestamp,
+    ending_timestamp = EXCLUDED.ending_timestamp,
+    ended = EXCLUDED.ended,
+    failed = EXCLUDED.failed,
+    stage_code = EXCLUDED.stage_code,
+    txid = EXCLUDED.txid,
+    unsigned_tx = EXCLUDED.unsigned_tx,
+    connector_address = EXCLUDED.connector_address,
+    dust_amount = EXCLUDED.dust_amount,
+    version = EXCLUDED.version,
+    swept = EXCLUDED.swept;
+
+-- name: UpsertPayment :exec
+INSERT INTO payment (id, round_id, fee) VALUES ($1, $2, $3)
+ON CONFLICT(id) DO UPDATE SET
+    round_id = EXCLUDED.round_id,
+    fee = EXCLUDED.fee;
+
+-- name: UpsertReceiver :exec
+INSERT INTO receiver (payment_id, pubkey, amount, onchain_address) VALUES ($1, $2, $3, $4)
+ON CONFLICT(payment_id, pubkey) DO UPDATE SET
+    amount = EXCLUDED.amount,
+    onchain_address = EXCLUDED.onchain_address,
+    pubkey = EXCLUDED.pubkey;
+
+-- name: UpdateVtxoPaymentId :exec
+UPDATE vtxo SET payment_id = $1 WHERE txid = $2 AND vout = $3;
+
+-- name: ResetVtxosPaymentId :exec
+UPDATE vtxo SET payment_id = NULL WHERE payment_id = $1;
+
+-- name: SelectRoundPaymentIds :many
+SELECT id FROM payment WHERE round_id = $1;
+
+-- name: DeletePaymentReceivers :exec
+DELETE FROM receiver WHERE payment_id = $1;
+
+-- name: DeletePayment :exec
+DELETE FROM payment WHERE id = $1;
+
+-- name: DeleteRoundTxs :exec
+DELETE FROM tx WHERE round_id = $1;
+
+-- name: SelectRoundWithRoundId :many
+SELECT sqlc.embed(round),
+       sqlc.embed(round_payment_vw),
+       sqlc.embed(round_tx_vw),
+       sqlc.embed(payment_receiver_vw),
+       sqlc.embed(payment_vtxo_vw)
+FROM round
+         LEFT OUTER JOIN round_payment_vw ON round.id=round_payment_vw.round_id
+         LEFT OUTER JOIN round_tx_vw ON round.id=round_tx_vw.round_id
+         LEFT OUTER JOIN payment_receiver_vw ON round_payment_vw.id=payment_receiver_vw.payment_id
+         LEFT OUTER JOIN payment_vtxo_vw ON round_payment_vw.id=payment_vtxo_vw.payment_id
+WHERE round.id = $1;
+
+-- name: SelectRoundWithRoundTxId :many
+SELECT sqlc.embed(round),
+       sqlc.embed(round_payment_vw),
+       sqlc.embed(round_tx_vw),
+       sqlc.embed(payment_receiver_vw),
+       sqlc.embed(payment_vtxo_vw)
+FROM round
+         LEFT OUTER JOIN round_payment_vw ON round.id=round_payment_vw.round_id
+         LEFT OUTER JOIN round_tx_vw ON round.id=round_tx_vw.round_id
+         LEFT OUTER JOIN payment_receiver_vw ON round_payment_vw.id=payment_receiver_vw.payment_id
+         LEFT OUTER JOIN payment_vtxo_vw ON round_payment_vw.id=payment_vtxo_vw.payment_id
+WHERE round.txid = $1;
+
+-- name: SelectSweepableRounds :many
+SELECT sqlc.embed(round),
+       sqlc.embed(round_payment_vw),
+       sqlc.embed(round_tx_vw),
+       sqlc.embed(payment_receiver_vw),
+       sqlc.embed(payment_vtxo_vw)
+FROM round
+         LEFT OUTER JOIN round_payment_vw ON round.id=round_payment_vw.round_id
+         LEFT OUTER JOIN round_tx_vw ON round.id=round_tx_vw.round_id
+         LEFT OUTER JOIN payment_receiver_vw ON round_payment_vw.id=payment_receiver_vw.payment_id
+         LEFT OUTER JOIN payment_vtxo_vw ON round_payment_vw.id=payment_vtxo_vw.payment_id
+WHERE round.swept = false AND round.ended = true AND round.failed = false;
+
+-- name: SelectSweptRounds :many
+SELECT sqlc.embed(round),
+       sqlc.embed(round_payment_vw),
+       sqlc.embed(round_tx_vw),
+       sqlc.embed(payment_receiver_vw),
+       sqlc.embed(payment_vtxo_vw)
+FROM round
+         LEFT OUTER JOIN round_payment_vw ON round.id=round_payment_vw.round_id
+         LEFT OUTER JOIN round_tx_vw ON round.id=round_tx_vw.round_id
+         LEFT OUTER JOIN payment_receiver_vw ON round_payment_vw.id=payment_receiver_vw.payment_id
+         LEFT OUTER JOIN payment_vtxo_vw ON round_payment_vw.id=payment_vtxo_vw.payment_id
+WHERE round.swept = true AND round.failed = false AND round.ended = true AND round.connector_address <> '';
+
+-- name: SelectRoundIdsInRange :many
+SELECT id FROM round WHERE starting_timestamp > $1 AND starting_timestamp < $2;
+
+-- name: SelectRoundIds :many
+SELECT id FROM round;
+
+-- name: SelectEndedRoundIdsWithFilter :many
+SELECT id, starting_timestamp FROM round
+WHERE ended = true
+    AND (sqlc.narg('started_after')::bigint IS NULL OR starting_timestamp > sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before')::bigint IS NULL OR starting_timestamp < sqlc.narg('started_before'))
+    AND (starting_timestamp > sqlc.arg('after_timestamp') OR (starting_timestamp = sqlc.arg('after_timestamp') AND id > sqlc.arg('after_id')))
+ORDER BY starting_timestamp, id
+LIMIT sqlc.narg('limit');
+
+-- name: SelectUnfinishedRoundIds :many
+SELECT id FROM round WHERE ended = false AND failed = false;
+
+-- name: SelectFailedRoundIds :many
+SELECT id FROM round WHERE failed = true;
+
+-- name: UpsertUnconditionalForfeitTx :exec
+INSERT INTO uncond_forfeit_tx (tx, vtxo_txid, vtxo_vout, position)
+VALUES ($1, $2, $3, $4) ON CONFLICT(id) DO UPDATE SET
+    tx = EXCLUDED.tx,
+    vtxo_txid = EXCLUDED.vtxo_txid,
+    vtxo_vout = EXCLUDED.vtxo_vout,
+    position = EXCLUDED.position;
+
+-- name: UpsertVtxo :exec
+INSERT INTO vtxo (txid, vout, pubkey, amount, pool_tx, spent_by, spent, redeemed, swept, expire_at, redeem_tx)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT(txid, vout) DO UPDATE SET
+    pubkey = EXCLUDED.pubkey,
+    amount = EXCLUDED.amount,
+    pool_tx = EXCLUDED.pool_tx,
+    spent_by = EXCLUDED.spent_by,
+    spent = EXCLUDED.spent,
+    redeemed = EXCLUDED.redeemed,
+    swept = EXCLUDED.swept,
+    expire_at = EXCLUDED.expire_at,
+    redeem_tx = EXCLUDED.redeem_tx;
+
+-- name: SelectSweepableVtxos :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE redeemed = false AND swept = false;
+
+-- name: SelectNotRedeemedVtxos :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE redeemed = false;
+
+-- name: SelectNotRedeemedVtxosWithPubkey :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE redeemed = false AND pubkey = $1;
+
+-- name: SelectAllVtxoKeys :many
+SELECT txid, vout FROM vtxo ORDER BY txid, vout;
+
+-- name: SelectVtxosWithFilter :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE (vtxo.txid, vtxo.vout) IN (
+    SELECT v.txid, v.vout FROM vtxo v
+    WHERE (sqlc.narg('pubkey')::text IS NULL OR v.pubkey = sqlc.narg('pubkey'))
+        AND (sqlc.narg('spent')::boolean IS NULL OR v.spent = sqlc.narg('spent'))
+        AND (sqlc.narg('swept')::boolean IS NULL OR v.swept = sqlc.narg('swept'))
+        AND (sqlc.narg('redeemed')::boolean IS NULL OR v.redeemed = sqlc.narg('redeemed'))
+        AND (sqlc.narg('min_amount')::bigint IS NULL OR v.amount >= sqlc.narg('min_amount'))
+        AND (sqlc.narg('max_amount')::bigint IS NULL OR v.amount <= sqlc.narg('max_amount'))
+        AND (sqlc.narg('min_expire_at')::bigint IS NULL OR v.expire_at >= sqlc.narg('min_expire_at'))
+        AND (sqlc.narg('max_expire_at')::bigint IS NULL OR v.expire_at <= sqlc.narg('max_expire_at'))
+        AND (sqlc.narg('pool_tx')::text IS NULL OR v.pool_tx = sqlc.narg('pool_tx'))
+        AND (v.txid > sqlc.arg('after_txid') OR (v.txid = sqlc.arg('after_txid') AND v.vout > sqlc.arg('after_vout')))
+    ORDER BY v.txid, v.vout
+    LIMIT sqlc.narg('limit')
+)
+ORDER BY vtxo.txid, vtxo.vout;
+
+-- name: SelectVtxoByOutpoint :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE txid = $1 AND vout = $2;
+
+-- name: SelectVtxosByPoolTxid :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE pool_tx = $1;
+
+-- name: MarkVtxoAsNotRedeemed :exec
+UPDATE vtxo SET redeemed = false WHERE txid = $1 AND vout = $2;
+
+-- name: MarkVtxoAsNotSwept :exec
+UPDATE vtxo SET swept = false WHERE txid = $1 AND vout = $2;
+
+-- name: MarkVtxoAsRedeemed :exec
+UPDATE vtxo SET redeemed = true WHERE txid = $1 AND vout = $2;
+
+-- name: MarkVtxoAsSwept :exec
+UPDATE vtxo SET swept = true WHERE txid = $1 AND vout = $2;
+
+-- name: MarkVtxoAsSpent :exec
+UPDATE vtxo SET spent = true, spent_by = $1 WHERE txid = $2 AND vout = $3;
+
+-- name: UpdateVtxoExpireAt :exec
+UPDATE vtxo SET expire_at = $1 WHERE txid = $2 AND vout = $3;
+
+-- name: UpsertOffender :exec
+INSERT INTO offender (id, banned_until) VALUES ($1, $2)
+ON CONFLICT(id) DO UPDATE SET banned_until = EXCLUDED.banned_until;
+
+-- name: InsertStrike :exec
+INSERT INTO strike (offender_id, reason, round_id, timestamp) VALUES ($1, $2, $3, $4);
+
+-- name: DeleteOffenderStrikes :exec
+DELETE FROM strike WHERE offender_id = $1;
+
+-- name: SelectOffender :many
+SELECT sqlc.embed(offender),
+       sqlc.embed(offender_strike_vw)
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+WHERE offender.id = $1
+ORDER BY offender_strike_vw.id;
+
+-- name: SelectBannedOffenders :many
+SELECT sqlc.embed(offender),
+       sqlc.embed(offender_strike_vw)
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+WHERE offender.banned_until > $1
+ORDER BY offender_strike_vw.id;
+
+-- name: SelectAllOffenders :many
+SELECT sqlc.embed(offender),
+       sqlc.embed(offender_strike_vw)
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+ORDER BY offender_strike_vw.id;
+
+-- name: UpsertPaymentRequest :exec
+INSERT INTO payment_request (id, ephemeral_pubkey, timestamp, fee) VALUES ($1, $2, $3, $4)
+ON CONFLICT(id) DO UPDATE SET
+    ephemeral_pubkey = EXCLUDED.ephemeral_pubkey,
+    timestamp = EXCLUDED.timestamp,
+    fee = EXCLUDED.fee;
+
+-- name: InsertPaymentRequestInput :exec
+INSERT INTO payment_request_input (request_id, txid, vout) VALUES ($1, $2, $3);
+
+-- name: InsertPaymentRequestReceiver :exec
+INSERT INTO payment_request_receiver (request_id, pubkey, amount, onchain_address)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeletePaymentRequestInputs :exec
+DELETE FROM payment_request_input WHERE request_id = $1;
+
+-- name: DeletePaymentRequestReceivers :exec
+DELETE FROM payment_request_receiver WHERE request_id = $1;
+
+-- name: DeletePaymentRequest :exec
+DELETE FROM payment_request WHERE id = $1;
+
+-- name: SelectPaymentRequests :many
+SELECT * FROM payment_request;
+
+-- name: SelectPaymentRequestInputs :many
+SELECT payment_request_input.request_id,
+       sqlc.embed(vtxo)
+FROM payment_request_input
+         INNER JOIN vtxo ON payment_request_input.txid=vtxo.txid AND payment_request_input.vout=vtxo.vout;
+
+-- name: SelectPaymentRequestReceivers :many
+SELECT * FROM payment_request_receiver ORDER BY id;
+
+-- name: UpsertAsyncPaymentRequest :exec
+INSERT INTO async_payment_request (txid, vout, expire_at) VALUES ($1, $2, $3)
+ON CONFLICT(txid, vout) DO UPDATE SET expire_at = EXCLUDED.expire_at;
+
+-- name: InsertAsyncPaymentRequestReceiver :exec
+INSERT INTO async_payment_request_receiver (txid, vout, pubkey, amount, onchain_address)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: DeleteAsyncPaymentRequestReceivers :exec
+DELETE FROM async_payment_request_receiver WHERE txid = $1 AND vout = $2;
+
+-- name: DeleteAsyncPaymentRequest :exec
+DELETE FROM async_payment_request WHERE txid = $1 AND vout = $2;
+
+-- name: SelectAsyncPaymentRequests :many
+SELECT sqlc.embed(async_payment_request),
+       sqlc.embed(async_payment_request_receiver)
+FROM async_payment_request
+         INNER JOIN async_payment_request_receiver ON async_payment_request.txid=async_payment_request_receiver.txid AND async_payment_request.vout=async_payment_request_receiver.vout
+ORDER BY async_payment_request_receiver.id;
+
+-- name: UpsertSweep :exec
+INSERT INTO sweep (id, round_txid, scheduled_at, status, attempted_at, error)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT(id) DO UPDATE SET
+    round_txid = EXCLUDED.round_txid,
+    scheduled_at = EXCLUDED.scheduled_at,
+    status = EXCLUDED.status,
+    attempted_at = EXCLUDED.attempted_at,
+    error = EXCLUDED.error;
+
+-- name: InsertSweepOutput :exec
+INSERT INTO sweep_output (sweep_id, txid, vout, amount, sweep_txid) VALUES ($1, $2, $3, $4, $5);
+
+-- name: DeleteSweepOutputs :exec
+DELETE FROM sweep_output WHERE sweep_id = $1;
+
+-- name: SelectSweep :many
+SELECT sqlc.embed(sweep),
+       sqlc.embed(sweep_output_vw)
+FROM sweep
+         LEFT OUTER JOIN sweep_output_vw ON sweep.id=sweep_output_vw.sweep_id
+WHERE sweep.id = $1
+ORDER BY sweep_output_vw.id;
+
+-- name: SelectAllSweeps :many
+SELECT sqlc.embed(sweep),
+       sqlc.embed(sweep_output_vw)
+FROM sweep
+         LEFT OUTER JOIN sweep_output_vw ON sweep.id=sweep_output_vw.sweep_id
+ORDER BY sweep.scheduled_at, sweep_output_vw.id;
+
+-- name: InsertRoundEvent :exec
+INSERT INTO round_event (round_id, type, event) VALUES ($1, $2, $3);
+
+-- name: SelectRoundEvents :many
+SELECT * FROM round_event WHERE round_id = $1 ORDER BY id;
+
+-- name: UpsertMonitoredTx :exec
+INSERT INTO monitored_tx (txid, type, tx_hex, broadcasted_at, bumped_at, fee_rate, child_txid, confirmed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT(txid) DO UPDATE SET
+    type = EXCLUDED.type,
+    tx_hex = EXCLUDED.tx_hex,
+    broadcasted_at = EXCLUDED.broadcasted_at,
+    bumped_at = EXCLUDED.bumped_at,
+    fee_rate = EXCLUDED.fee_rate,
+    child_txid = EXCLUDED.child_txid,
+    confirmed_at = EXCLUDED.confirmed_at;
+
+-- name: InsertMonitoredTxSweepInput :exec
+INSERT INTO monitored_tx_sweep_input (monitored_txid, txid, vout, amount, leaf_script, control_block, internal_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: DeleteMonitoredTxSweepInputs :exec
+DELETE FROM monitored_tx_sweep_input WHERE monitored_txid = $1;
+
+-- name: DeleteMonitoredTx :exec
+DELETE FROM monitored_tx WHERE txid = $1;
+
+-- name: SelectAllMonitoredTxs :many
+SELECT sqlc.embed(monitored_tx),
+       sqlc.embed(monitored_tx_sweep_input_vw)
+FROM monitored_tx
+         LEFT OUTER JOIN monitored_tx_sweep_input_vw ON monitored_tx.txid=monitored_tx_sweep_input_vw.monitored_txid
+ORDER BY monitored_tx.broadcasted_at, monitored_tx_sweep_input_vw.id;
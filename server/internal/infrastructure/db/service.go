@@ -1,6 +1,8 @@
 package db
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -8,23 +10,49 @@ import (
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
 	badgerdb "github.com/ark-network/ark/server/internal/infrastructure/db/badger"
+	pgdb "github.com/ark-network/ark/server/internal/infrastructure/db/postgres"
 	sqlitedb "github.com/ark-network/ark/server/internal/infrastructure/db/sqlite"
 	"github.com/golang-migrate/migrate/v4"
+	pgmigrate "github.com/golang-migrate/migrate/v4/database/postgres"
 	sqlitemigrate "github.com/golang-migrate/migrate/v4/database/sqlite"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
 var (
 	eventStoreTypes = map[string]func(...interface{}) (domain.RoundEventRepository, error){
-		"badger": badgerdb.NewRoundEventRepository,
+		"badger":   badgerdb.NewRoundEventRepository,
+		"sqlite":   sqlitedb.NewRoundEventRepository,
+		"postgres": pgdb.NewRoundEventRepository,
 	}
 	roundStoreTypes = map[string]func(...interface{}) (domain.RoundRepository, error){
-		"badger": badgerdb.NewRoundRepository,
-		"sqlite": sqlitedb.NewRoundRepository,
+		"badger":   badgerdb.NewRoundRepository,
+		"sqlite":   sqlitedb.NewRoundRepository,
+		"postgres": pgdb.NewRoundRepository,
 	}
 	vtxoStoreTypes = map[string]func(...interface{}) (domain.VtxoRepository, error){
-		"badger": badgerdb.NewVtxoRepository,
-		"sqlite": sqlitedb.NewVtxoRepository,
+		"badger":   badgerdb.NewVtxoRepository,
+		"sqlite":   sqlitedb.NewVtxoRepository,
+		"postgres": pgdb.NewVtxoRepository,
+	}
+	offenderStoreTypes = map[string]func(...interface{}) (domain.OffenderRepository, error){
+		"badger":   badgerdb.NewOffenderRepository,
+		"sqlite":   sqlitedb.NewOffenderRepository,
+		"postgres": pgdb.NewOffenderRepository,
+	}
+	paymentRequestStoreTypes = map[string]func(...interface{}) (domain.PaymentRequestRepository, error){
+		"badger":   badgerdb.NewPaymentRequestRepository,
+		"sqlite":   sqlitedb.NewPaymentRequestRepository,
+		"postgres": pgdb.NewPaymentRequestRepository,
+	}
+	sweepStoreTypes = map[string]func(...interface{}) (domain.SweepRepository, error){
+		"badger":   badgerdb.NewSweepRepository,
+		"sqlite":   sqlitedb.NewSweepRepository,
+		"postgres": pgdb.NewSweepRepository,
+	}
+	monitoredTxStoreTypes = map[string]func(...interface{}) (domain.MonitoredTxRepository, error){
+		"badger":   badgerdb.NewMonitoredTxRepository,
+		"sqlite":   sqlitedb.NewMonitoredTxRepository,
+		"postgres": pgdb.NewMonitoredTxRepository,
 	}
 )
 
@@ -41,9 +69,40 @@ type ServiceConfig struct {
 }
 
 type service struct {
-	eventStore domain.RoundEventRepository
+	eventStore          domain.RoundEventRepository
+	roundStore          domain.RoundRepository
+	vtxoStore           domain.VtxoRepository
+	offenderStore       domain.OffenderRepository
+	paymentRequestStore domain.PaymentRequestRepository
+	sweepStore          domain.SweepRepository
+	monitoredTxStore    domain.MonitoredTxRepository
+	// backups write a copy of the event and data stores into a given dir.
+	backups []func(ctx context.Context, dir string) error
+}
+
+// projectingEventStore updates the round read by the round store every time
+// new events of the round are saved.
+type projectingEventStore struct {
+	domain.RoundEventRepository
 	roundStore domain.RoundRepository
-	vtxoStore  domain.VtxoRepository
+}
+
+func (s *projectingEventStore) Save(
+	ctx context.Context, id string, events ...domain.RoundEvent,
+) (*domain.Round, error) {
+	round, err := s.RoundEventRepository.Save(ctx, id, events...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.roundStore.AddOrUpdateRound(ctx, *round); err != nil {
+		return nil, err
+	}
+	return round, nil
+}
+
+// badgerStore is implemented by the badger repositories.
+type badgerStore interface {
+	Backup(ctx context.Context, dir string) error
 }
 
 func NewService(config ServiceConfig) (ports.RepoManager, error) {
@@ -59,10 +118,31 @@ func NewService(config ServiceConfig) (ports.RepoManager, error) {
 	if !ok {
 		return nil, fmt.Errorf("vtxo store type not supported")
 	}
+	offenderStoreFactory, ok := offenderStoreTypes[config.DataStoreType]
+	if !ok {
+		return nil, fmt.Errorf("offender store type not supported")
+	}
+	paymentRequestStoreFactory, ok := paymentRequestStoreTypes[config.DataStoreType]
+	if !ok {
+		return nil, fmt.Errorf("payment request store type not supported")
+	}
+	sweepStoreFactory, ok := sweepStoreTypes[config.DataStoreType]
+	if !ok {
+		return nil, fmt.Errorf("sweep store type not supported")
+	}
+	monitoredTxStoreFactory, ok := monitoredTxStoreTypes[config.DataStoreType]
+	if !ok {
+		return nil, fmt.Errorf("monitored tx store type not supported")
+	}
 
 	var eventStore domain.RoundEventRepository
 	var roundStore domain.RoundRepository
 	var vtxoStore domain.VtxoRepository
+	var offenderStore domain.OffenderRepository
+	var paymentRequestStore domain.PaymentRequestRepository
+	var sweepStore domain.SweepRepository
+	var monitoredTxStore domain.MonitoredTxRepository
+	var backups []func(ctx context.Context, dir string) error
 	var err error
 
 	switch config.EventStoreType {
@@ -71,6 +151,24 @@ func NewService(config ServiceConfig) (ports.RepoManager, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to open event store: %s", err)
 		}
+		backups = append(backups, eventStore.(badgerStore).Backup)
+	case "sqlite":
+		// The event store shares the db with the data stores to update the
+		// round projection along with the events, it's opened below.
+		if config.DataStoreType != "sqlite" {
+			return nil, fmt.Errorf("sqlite event store requires sqlite data store")
+		}
+	case "postgres":
+		db, err := openPostgresDb(config.EventStoreConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		eventStore, err = eventStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open event store: %s", err)
+		}
+		backups = append(backups, postgresBackup)
 	default:
 		return nil, fmt.Errorf("unknown event store db type")
 	}
@@ -85,6 +183,28 @@ func NewService(config ServiceConfig) (ports.RepoManager, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to open vtxo store: %s", err)
 		}
+		offenderStore, err = offenderStoreFactory(config.DataStoreConfig...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open offender store: %s", err)
+		}
+		paymentRequestStore, err = paymentRequestStoreFactory(config.DataStoreConfig...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open payment request store: %s", err)
+		}
+		sweepStore, err = sweepStoreFactory(config.DataStoreConfig...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sweep store: %s", err)
+		}
+		monitoredTxStore, err = monitoredTxStoreFactory(config.DataStoreConfig...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open monitored tx store: %s", err)
+		}
+		for _, store := range []interface{}{
+			roundStore, vtxoStore, offenderStore, paymentRequestStore, sweepStore,
+			monitoredTxStore,
+		} {
+			backups = append(backups, store.(badgerStore).Backup)
+		}
 	case "sqlite":
 		if len(config.DataStoreConfig) != 2 {
 			return nil, fmt.Errorf("invalid data store config")
@@ -132,10 +252,74 @@ func NewService(config ServiceConfig) (ports.RepoManager, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to open vtxo store: %s", err)
 		}
+		offenderStore, err = offenderStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open offender store: %s", err)
+		}
+		paymentRequestStore, err = paymentRequestStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open payment request store: %s", err)
+		}
+		sweepStore, err = sweepStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sweep store: %s", err)
+		}
+		monitoredTxStore, err = monitoredTxStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open monitored tx store: %s", err)
+		}
+		if config.EventStoreType == "sqlite" {
+			eventStore, err = eventStoreFactory(db)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open event store: %s", err)
+			}
+		}
+		backups = append(backups, func(ctx context.Context, dir string) error {
+			return sqlitedb.Backup(ctx, db, filepath.Join(dir, sqliteDbFile))
+		})
+	case "postgres":
+		db, err := openPostgresDb(config.DataStoreConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		roundStore, err = roundStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open round store: %s", err)
+		}
+		vtxoStore, err = vtxoStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open vtxo store: %s", err)
+		}
+		offenderStore, err = offenderStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open offender store: %s", err)
+		}
+		paymentRequestStore, err = paymentRequestStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open payment request store: %s", err)
+		}
+		sweepStore, err = sweepStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open sweep store: %s", err)
+		}
+		monitoredTxStore, err = monitoredTxStoreFactory(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open monitored tx store: %s", err)
+		}
+		backups = append(backups, postgresBackup)
+	}
 
+	// The sqlite event store updates the round projection on its own, within
+	// the same transaction, the others are wrapped to do it after saving.
+	if config.EventStoreType != "sqlite" {
+		eventStore = &projectingEventStore{eventStore, roundStore}
 	}
 
-	return &service{eventStore, roundStore, vtxoStore}, nil
+	return &service{
+		eventStore, roundStore, vtxoStore, offenderStore, paymentRequestStore,
+		sweepStore, monitoredTxStore, backups,
+	}, nil
 }
 
 func (s *service) RegisterEventsHandler(handler func(round *domain.Round)) {
@@ -154,8 +338,86 @@ func (s *service) Vtxos() domain.VtxoRepository {
 	return s.vtxoStore
 }
 
+func (s *service) Offenders() domain.OffenderRepository {
+	return s.offenderStore
+}
+
+func (s *service) PaymentRequests() domain.PaymentRequestRepository {
+	return s.paymentRequestStore
+}
+
+func (s *service) Sweeps() domain.SweepRepository {
+	return s.sweepStore
+}
+
+func (s *service) MonitoredTxs() domain.MonitoredTxRepository {
+	return s.monitoredTxStore
+}
+
+func (s *service) Backup(ctx context.Context, dir string) error {
+	for _, backup := range s.backups {
+		if err := backup(ctx, dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *service) Close() {
 	s.eventStore.Close()
 	s.roundStore.Close()
 	s.vtxoStore.Close()
+	s.offenderStore.Close()
+	s.paymentRequestStore.Close()
+	s.sweepStore.Close()
+	s.monitoredTxStore.Close()
+}
+
+// postgresBackup is the backup of the postgres stores, they're expected to be
+// backed up with the tools of the db server, like pg_dump.
+func postgresBackup(_ context.Context, _ string) error {
+	return fmt.Errorf("backup of postgres db not supported, use pg_dump instead")
+}
+
+// openPostgresDb connects to the postgres db and runs the migrations, the
+// given config is expected to be made of the db url and the migration path.
+func openPostgresDb(config []interface{}) (*sql.DB, error) {
+	if len(config) != 2 {
+		return nil, fmt.Errorf("invalid postgres store config")
+	}
+
+	dbUrl, ok := config[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid db url")
+	}
+
+	migrationPath, ok := config[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid migration path")
+	}
+
+	db, err := pgdb.OpenDb(dbUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db: %s", err)
+	}
+
+	driver, err := pgmigrate.WithInstance(db, &pgmigrate.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := migrate.NewWithDatabaseInstance(
+		migrationPath,
+		"arkdb",
+		driver,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration instance: %s", err)
+	}
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, fmt.Errorf("failed to run migrations: %s", err)
+	}
+
+	return db, nil
 }
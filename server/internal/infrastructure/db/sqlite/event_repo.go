@@ -0,0 +1,210 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db/sqlite/sqlc/queries"
+)
+
+const (
+	roundStartedEvent             = "round_started"
+	roundFinalizationStartedEvent = "round_finalization_started"
+	poolTxSignedEvent             = "pool_tx_signed"
+	roundFinalizedEvent           = "round_finalized"
+	roundFailedEvent              = "round_failed"
+	paymentsRegisteredEvent       = "payments_registered"
+	paymentsDroppedEvent          = "payments_dropped"
+)
+
+// eventRepository appends round events to the same db of the data stores and,
+// within the same transaction, updates the round projection read by the round
+// repository and notifies the registered handler.
+type eventRepository struct {
+	db      *sql.DB
+	querier *queries.Queries
+	lock    *sync.Mutex
+	handler func(round *domain.Round)
+}
+
+func NewRoundEventRepository(config ...interface{}) (domain.RoundEventRepository, error) {
+	if len(config) != 1 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	db, ok := config[0].(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("cannot open round event repository: invalid config, expected db at 0")
+	}
+
+	return &eventRepository{
+		db:      db,
+		querier: queries.New(db),
+		lock:    &sync.Mutex{},
+	}, nil
+}
+
+// Save appends the given events to those of the round, updates the round
+// projection and runs the events handler in a single transaction.
+// The handler is run while the transaction still holds the only connection
+// of the db, therefore it must not access the db synchronously.
+func (r *eventRepository) Save(
+	ctx context.Context, id string, events ...domain.RoundEvent,
+) (*domain.Round, error) {
+	var round *domain.Round
+	txBody := func(querierWithTx *queries.Queries) error {
+		for _, event := range events {
+			eventType, buf, err := serializeEvent(event)
+			if err != nil {
+				return err
+			}
+			if err := querierWithTx.InsertRoundEvent(
+				ctx, queries.InsertRoundEventParams{
+					RoundID: id,
+					Type:    eventType,
+					Event:   buf,
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert round event: %w", err)
+			}
+		}
+
+		rows, err := querierWithTx.SelectRoundEvents(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get round events: %w", err)
+		}
+		allEvents, err := readEventRows(rows)
+		if err != nil {
+			return err
+		}
+
+		round = domain.NewRoundFromEvents(allEvents)
+		if err := upsertRound(ctx, querierWithTx, *round); err != nil {
+			return err
+		}
+
+		r.runHandler(domain.NewRoundFromEvents(allEvents))
+		return nil
+	}
+
+	if err := execTx(ctx, r.db, txBody); err != nil {
+		return nil, err
+	}
+
+	return round, nil
+}
+
+func (r *eventRepository) Load(
+	ctx context.Context, id string,
+) (*domain.Round, error) {
+	rows, err := r.querier.SelectRoundEvents(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events with id %s: %s", id, err)
+	}
+	events, err := readEventRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewRoundFromEvents(events), nil
+}
+
+func (r *eventRepository) RegisterEventsHandler(
+	handler func(round *domain.Round),
+) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.handler = handler
+}
+
+func (r *eventRepository) Close() {
+	_ = r.db.Close()
+}
+
+func (r *eventRepository) runHandler(round *domain.Round) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.handler == nil {
+		return
+	}
+	r.handler(round)
+}
+
+func readEventRows(rows []queries.RoundEvent) ([]domain.RoundEvent, error) {
+	events := make([]domain.RoundEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := deserializeEvent(row.Type, row.Event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func serializeEvent(event domain.RoundEvent) (string, []byte, error) {
+	var eventType string
+	switch event.(type) {
+	case domain.RoundStarted:
+		eventType = roundStartedEvent
+	case domain.RoundFinalizationStarted:
+		eventType = roundFinalizationStartedEvent
+	case domain.PoolTxSigned:
+		eventType = poolTxSignedEvent
+	case domain.RoundFinalized:
+		eventType = roundFinalizedEvent
+	case domain.RoundFailed:
+		eventType = roundFailedEvent
+	case domain.PaymentsRegistered:
+		eventType = paymentsRegisteredEvent
+	case domain.PaymentsDropped:
+		eventType = paymentsDroppedEvent
+	default:
+		return "", nil, fmt.Errorf("unknown event %T", event)
+	}
+
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to serialize event: %s", err)
+	}
+	return eventType, buf, nil
+}
+
+func deserializeEvent(eventType string, buf []byte) (domain.RoundEvent, error) {
+	switch eventType {
+	case roundStartedEvent:
+		var event domain.RoundStarted
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	case roundFinalizationStartedEvent:
+		var event domain.RoundFinalizationStarted
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	case poolTxSignedEvent:
+		var event domain.PoolTxSigned
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	case roundFinalizedEvent:
+		var event domain.RoundFinalized
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	case roundFailedEvent:
+		var event domain.RoundFailed
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	case paymentsRegisteredEvent:
+		var event domain.PaymentsRegistered
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	case paymentsDroppedEvent:
+		var event domain.PaymentsDropped
+		err := json.Unmarshal(buf, &event)
+		return event, err
+	default:
+		return nil, fmt.Errorf("unknown event type %s", eventType)
+	}
+}
@@ -0,0 +1,5 @@
+DROP VIEW IF EXISTS offender_strike_vw;
+
+DROP TABLE IF EXISTS strike;
+
+DROP TABLE IF EXISTS offender;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS offender (
+    id TEXT PRIMARY KEY,
+    banned_until INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS strike (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    offender_id TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    round_id TEXT NOT NULL,
+    timestamp INTEGER NOT NULL,
+    FOREIGN KEY (offender_id) REFERENCES offender(id)
+);
+
+CREATE VIEW offender_strike_vw AS SELECT strike.*
+FROM offender
+LEFT OUTER JOIN strike
+ON offender.id=strike.offender_id;
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS async_payment_request_receiver;
+
+DROP TABLE IF EXISTS async_payment_request;
+
+DROP TABLE IF EXISTS payment_request_receiver;
+
+DROP TABLE IF EXISTS payment_request_input;
+
+DROP TABLE IF EXISTS payment_request;
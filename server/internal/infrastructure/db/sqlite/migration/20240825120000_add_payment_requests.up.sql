@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS payment_request (
+    id TEXT PRIMARY KEY,
+    ephemeral_pubkey TEXT NOT NULL,
+    timestamp INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS payment_request_input (
+    request_id TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    vout INTEGER NOT NULL,
+    PRIMARY KEY (request_id, txid, vout),
+    FOREIGN KEY (request_id) REFERENCES payment_request(id)
+);
+
+CREATE TABLE IF NOT EXISTS payment_request_receiver (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    request_id TEXT NOT NULL,
+    pubkey TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+    onchain_address TEXT NOT NULL,
+    FOREIGN KEY (request_id) REFERENCES payment_request(id)
+);
+
+CREATE TABLE IF NOT EXISTS async_payment_request (
+    txid TEXT NOT NULL,
+    vout INTEGER NOT NULL,
+    expire_at INTEGER NOT NULL,
+    PRIMARY KEY (txid, vout)
+);
+
+CREATE TABLE IF NOT EXISTS async_payment_request_receiver (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    txid TEXT NOT NULL,
+    vout INTEGER NOT NULL,
+    pubkey TEXT NOT NULL,
+    amount INTEGER NOT NULL,
+    onchain_address TEXT NOT NULL,
+    FOREIGN KEY (txid, vout) REFERENCES async_payment_request(txid, vout)
+);
@@ -0,0 +1,10 @@
+DROP VIEW IF EXISTS round_payment_vw;
+
+ALTER TABLE payment_request DROP COLUMN fee;
+
+ALTER TABLE payment DROP COLUMN fee;
+
+CREATE VIEW round_payment_vw AS SELECT payment.*
+FROM round
+LEFT OUTER JOIN payment
+ON round.id=payment.round_id;
@@ -0,0 +1,10 @@
+ALTER TABLE payment ADD COLUMN fee INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE payment_request ADD COLUMN fee INTEGER NOT NULL DEFAULT 0;
+
+DROP VIEW IF EXISTS round_payment_vw;
+
+CREATE VIEW round_payment_vw AS SELECT payment.*
+FROM round
+LEFT OUTER JOIN payment
+ON round.id=payment.round_id;
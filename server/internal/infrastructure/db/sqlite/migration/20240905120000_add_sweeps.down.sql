@@ -0,0 +1,5 @@
+DROP VIEW IF EXISTS sweep_output_vw;
+
+DROP TABLE IF EXISTS sweep_output;
+
+DROP TABLE IF EXISTS sweep;
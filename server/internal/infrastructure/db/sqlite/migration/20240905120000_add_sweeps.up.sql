@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS sweep (
+    id TEXT PRIMARY KEY,
+    round_txid TEXT NOT NULL,
+    scheduled_at INTEGER NOT NULL,
+    status TEXT NOT NULL,
+    attempted_at INTEGER NOT NULL,
+    error TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sweep_output (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    sweep_id TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    vout INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    sweep_txid TEXT NOT NULL,
+    FOREIGN KEY (sweep_id) REFERENCES sweep(id)
+);
+
+CREATE VIEW sweep_output_vw AS SELECT sweep_output.*
+FROM sweep
+LEFT OUTER JOIN sweep_output
+ON sweep.id=sweep_output.sweep_id;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS round_event_round_id_idx;
+
+DROP TABLE IF EXISTS round_event;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS round_event (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    round_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    event BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS round_event_round_id_idx ON round_event(round_id);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS monitored_tx (
+    txid TEXT PRIMARY KEY,
+    type TEXT NOT NULL,
+    tx_hex TEXT NOT NULL,
+    broadcasted_at INTEGER NOT NULL,
+    bumped_at INTEGER NOT NULL,
+    fee_rate INTEGER NOT NULL,
+    child_txid TEXT NOT NULL,
+    confirmed_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS monitored_tx_sweep_input (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    monitored_txid TEXT NOT NULL,
+    txid TEXT NOT NULL,
+    vout INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    leaf_script BLOB NOT NULL,
+    control_block BLOB NOT NULL,
+    internal_key BLOB NOT NULL,
+    FOREIGN KEY (monitored_txid) REFERENCES monitored_tx(txid)
+);
+
+CREATE VIEW monitored_tx_sweep_input_vw AS SELECT monitored_tx_sweep_input.*
+FROM monitored_tx
+LEFT OUTER JOIN monitored_tx_sweep_input
+ON monitored_tx.txid=monitored_tx_sweep_input.monitored_txid;
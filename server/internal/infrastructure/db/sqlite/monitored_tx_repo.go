@@ -0,0 +1,150 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db/sqlite/sqlc/queries"
+)
+
+type monitoredTxRepository struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewMonitoredTxRepository(
+	config ...interface{},
+) (domain.MonitoredTxRepository, error) {
+	if len(config) != 1 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	db, ok := config[0].(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("cannot open monitored tx repository: invalid config, expected db at 0")
+	}
+
+	return &monitoredTxRepository{
+		db:      db,
+		querier: queries.New(db),
+	}, nil
+}
+
+func (r *monitoredTxRepository) Close() {
+	_ = r.db.Close()
+}
+
+func (r *monitoredTxRepository) AddOrUpdateMonitoredTx(
+	ctx context.Context, tx domain.MonitoredTx,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		if err := querierWithTx.UpsertMonitoredTx(
+			ctx, queries.UpsertMonitoredTxParams{
+				Txid:          tx.Txid,
+				Type:          tx.Type,
+				TxHex:         tx.TxHex,
+				BroadcastedAt: tx.BroadcastedAt,
+				BumpedAt:      tx.BumpedAt,
+				FeeRate:       int64(tx.FeeRate),
+				ChildTxid:     tx.ChildTxid,
+				ConfirmedAt:   tx.ConfirmedAt,
+			},
+		); err != nil {
+			return fmt.Errorf("failed to upsert monitored tx: %w", err)
+		}
+
+		if err := querierWithTx.DeleteMonitoredTxSweepInputs(
+			ctx, tx.Txid,
+		); err != nil {
+			return fmt.Errorf("failed to delete sweep inputs: %w", err)
+		}
+
+		for _, input := range tx.SweepInputs {
+			if err := querierWithTx.InsertMonitoredTxSweepInput(
+				ctx, queries.InsertMonitoredTxSweepInputParams{
+					MonitoredTxid: tx.Txid,
+					Txid:          input.Txid,
+					Vout:          int64(input.VOut),
+					Amount:        int64(input.Amount),
+					LeafScript:    input.LeafScript,
+					ControlBlock:  input.ControlBlock,
+					InternalKey:   input.InternalKey,
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert sweep input: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *monitoredTxRepository) DeleteMonitoredTxs(
+	ctx context.Context, txids []string,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		for _, txid := range txids {
+			if err := querierWithTx.DeleteMonitoredTxSweepInputs(
+				ctx, txid,
+			); err != nil {
+				return fmt.Errorf("failed to delete sweep inputs: %w", err)
+			}
+			if err := querierWithTx.DeleteMonitoredTx(ctx, txid); err != nil {
+				return fmt.Errorf("failed to delete monitored tx: %w", err)
+			}
+		}
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *monitoredTxRepository) GetAllMonitoredTxs(
+	ctx context.Context,
+) ([]domain.MonitoredTx, error) {
+	rows, err := r.querier.SelectAllMonitoredTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]domain.MonitoredTx, 0)
+	txsByTxid := make(map[string]int)
+
+	for _, row := range rows {
+		i, ok := txsByTxid[row.MonitoredTx.Txid]
+		if !ok {
+			txs = append(txs, domain.MonitoredTx{
+				Txid:          row.MonitoredTx.Txid,
+				Type:          row.MonitoredTx.Type,
+				TxHex:         row.MonitoredTx.TxHex,
+				BroadcastedAt: row.MonitoredTx.BroadcastedAt,
+				BumpedAt:      row.MonitoredTx.BumpedAt,
+				FeeRate:       uint64(row.MonitoredTx.FeeRate),
+				ChildTxid:     row.MonitoredTx.ChildTxid,
+				SweepInputs:   make([]domain.SweepTxInput, 0),
+				ConfirmedAt:   row.MonitoredTx.ConfirmedAt,
+			})
+			i = len(txs) - 1
+			txsByTxid[row.MonitoredTx.Txid] = i
+		}
+
+		input := row.MonitoredTxSweepInputVw
+		if input.ID.Valid {
+			txs[i].SweepInputs = append(txs[i].SweepInputs, domain.SweepTxInput{
+				VtxoKey: domain.VtxoKey{
+					Txid: input.Txid.String,
+					VOut: uint32(input.Vout.Int64),
+				},
+				Amount:       uint64(input.Amount.Int64),
+				LeafScript:   input.LeafScript,
+				ControlBlock: input.ControlBlock,
+				InternalKey:  input.InternalKey,
+			})
+		}
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,258 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db/sqlite/sqlc/queries"
+)
+
+type paymentRequestRepository struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewPaymentRequestRepository(
+	config ...interface{},
+) (domain.PaymentRequestRepository, error) {
+	if len(config) != 1 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	db, ok := config[0].(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("cannot open payment request repository: invalid config, expected db at 0")
+	}
+
+	return &paymentRequestRepository{
+		db:      db,
+		querier: queries.New(db),
+	}, nil
+}
+
+func (r *paymentRequestRepository) Close() {
+	_ = r.db.Close()
+}
+
+func (r *paymentRequestRepository) AddOrUpdatePaymentRequest(
+	ctx context.Context, request domain.PaymentRequest,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		if err := querierWithTx.UpsertPaymentRequest(
+			ctx, queries.UpsertPaymentRequestParams{
+				ID:              request.Id,
+				EphemeralPubkey: request.EphemeralPubkey,
+				Timestamp:       request.Timestamp,
+				Fee:             int64(request.Fee),
+			},
+		); err != nil {
+			return fmt.Errorf("failed to upsert payment request: %w", err)
+		}
+
+		if err := querierWithTx.DeletePaymentRequestInputs(ctx, request.Id); err != nil {
+			return fmt.Errorf("failed to delete payment request inputs: %w", err)
+		}
+		for _, input := range request.Inputs {
+			if err := querierWithTx.InsertPaymentRequestInput(
+				ctx, queries.InsertPaymentRequestInputParams{
+					RequestID: request.Id,
+					Txid:      input.Txid,
+					Vout:      int64(input.VOut),
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert payment request input: %w", err)
+			}
+		}
+
+		if err := querierWithTx.DeletePaymentRequestReceivers(ctx, request.Id); err != nil {
+			return fmt.Errorf("failed to delete payment request receivers: %w", err)
+		}
+		for _, receiver := range request.Receivers {
+			if err := querierWithTx.InsertPaymentRequestReceiver(
+				ctx, queries.InsertPaymentRequestReceiverParams{
+					RequestID:      request.Id,
+					Pubkey:         receiver.Pubkey,
+					Amount:         int64(receiver.Amount),
+					OnchainAddress: receiver.OnchainAddress,
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert payment request receiver: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *paymentRequestRepository) DeletePaymentRequests(
+	ctx context.Context, ids []string,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		for _, id := range ids {
+			if err := querierWithTx.DeletePaymentRequestInputs(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete payment request inputs: %w", err)
+			}
+			if err := querierWithTx.DeletePaymentRequestReceivers(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete payment request receivers: %w", err)
+			}
+			if err := querierWithTx.DeletePaymentRequest(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete payment request: %w", err)
+			}
+		}
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *paymentRequestRepository) GetPaymentRequests(
+	ctx context.Context,
+) ([]domain.PaymentRequest, error) {
+	rows, err := r.querier.SelectPaymentRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inputRows, err := r.querier.SelectPaymentRequestInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	receiverRows, err := r.querier.SelectPaymentRequestReceivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make(map[string][]domain.Vtxo)
+	for _, row := range inputRows {
+		inputs[row.RequestID] = append(inputs[row.RequestID], rowToVtxo(row.Vtxo, nil))
+	}
+	receivers := make(map[string][]domain.Receiver)
+	for _, row := range receiverRows {
+		receivers[row.RequestID] = append(receivers[row.RequestID], domain.Receiver{
+			Pubkey:         row.Pubkey,
+			Amount:         uint64(row.Amount),
+			OnchainAddress: row.OnchainAddress,
+		})
+	}
+
+	requests := make([]domain.PaymentRequest, 0, len(rows))
+	for _, row := range rows {
+		requests = append(requests, domain.PaymentRequest{
+			Payment: domain.Payment{
+				Id:        row.ID,
+				Inputs:    inputs[row.ID],
+				Receivers: receivers[row.ID],
+				Fee:       uint64(row.Fee),
+			},
+			EphemeralPubkey: row.EphemeralPubkey,
+			Timestamp:       row.Timestamp,
+		})
+	}
+	return requests, nil
+}
+
+func (r *paymentRequestRepository) AddAsyncPaymentRequest(
+	ctx context.Context, request domain.AsyncPaymentRequest,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		if err := querierWithTx.UpsertAsyncPaymentRequest(
+			ctx, queries.UpsertAsyncPaymentRequestParams{
+				Txid:     request.Txid,
+				Vout:     int64(request.VOut),
+				ExpireAt: request.ExpireAt,
+			},
+		); err != nil {
+			return fmt.Errorf("failed to upsert async payment request: %w", err)
+		}
+
+		if err := querierWithTx.DeleteAsyncPaymentRequestReceivers(
+			ctx, queries.DeleteAsyncPaymentRequestReceiversParams{
+				Txid: request.Txid,
+				Vout: int64(request.VOut),
+			},
+		); err != nil {
+			return fmt.Errorf("failed to delete async payment request receivers: %w", err)
+		}
+		for _, receiver := range request.Receivers {
+			if err := querierWithTx.InsertAsyncPaymentRequestReceiver(
+				ctx, queries.InsertAsyncPaymentRequestReceiverParams{
+					Txid:           request.Txid,
+					Vout:           int64(request.VOut),
+					Pubkey:         receiver.Pubkey,
+					Amount:         int64(receiver.Amount),
+					OnchainAddress: receiver.OnchainAddress,
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert async payment request receiver: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *paymentRequestRepository) DeleteAsyncPaymentRequest(
+	ctx context.Context, vtxo domain.VtxoKey,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		if err := querierWithTx.DeleteAsyncPaymentRequestReceivers(
+			ctx, queries.DeleteAsyncPaymentRequestReceiversParams{
+				Txid: vtxo.Txid,
+				Vout: int64(vtxo.VOut),
+			},
+		); err != nil {
+			return fmt.Errorf("failed to delete async payment request receivers: %w", err)
+		}
+		if err := querierWithTx.DeleteAsyncPaymentRequest(
+			ctx, queries.DeleteAsyncPaymentRequestParams{
+				Txid: vtxo.Txid,
+				Vout: int64(vtxo.VOut),
+			},
+		); err != nil {
+			return fmt.Errorf("failed to delete async payment request: %w", err)
+		}
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *paymentRequestRepository) GetAsyncPaymentRequests(
+	ctx context.Context,
+) ([]domain.AsyncPaymentRequest, error) {
+	rows, err := r.querier.SelectAsyncPaymentRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]domain.AsyncPaymentRequest, 0)
+	requestsByKey := make(map[string]int)
+	for _, row := range rows {
+		vtxoKey := domain.VtxoKey{
+			Txid: row.AsyncPaymentRequest.Txid,
+			VOut: uint32(row.AsyncPaymentRequest.Vout),
+		}
+		i, ok := requestsByKey[vtxoKey.Hash()]
+		if !ok {
+			requests = append(requests, domain.AsyncPaymentRequest{
+				VtxoKey:   vtxoKey,
+				Receivers: make([]domain.Receiver, 0),
+				ExpireAt:  row.AsyncPaymentRequest.ExpireAt,
+			})
+			i = len(requests) - 1
+			requestsByKey[vtxoKey.Hash()] = i
+		}
+
+		requests[i].Receivers = append(requests[i].Receivers, domain.Receiver{
+			Pubkey:         row.AsyncPaymentRequestReceiver.Pubkey,
+			Amount:         uint64(row.AsyncPaymentRequestReceiver.Amount),
+			OnchainAddress: row.AsyncPaymentRequestReceiver.OnchainAddress,
+		})
+	}
+
+	return requests, nil
+}
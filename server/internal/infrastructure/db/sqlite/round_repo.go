@@ -64,135 +64,63 @@ func (r *roundRepository) GetRoundsIds(
 	return roundIDs, nil
 }
 
-func (r *roundRepository) AddOrUpdateRound(ctx context.Context, round domain.Round) error {
-	txBody := func(querierWithTx *queries.Queries) error {
-		if err := querierWithTx.UpsertRound(
-			ctx,
-			queries.UpsertRoundParams{
-				ID:                round.Id,
-				StartingTimestamp: round.StartingTimestamp,
-				EndingTimestamp:   round.EndingTimestamp,
-				Ended:             round.Stage.Ended,
-				Failed:            round.Stage.Failed,
-				StageCode:         int64(round.Stage.Code),
-				Txid:              round.Txid,
-				UnsignedTx:        round.UnsignedTx,
-				ConnectorAddress:  round.ConnectorAddress,
-				DustAmount:        int64(round.DustAmount),
-				Version:           int64(round.Version),
-				Swept:             round.Swept,
-			},
-		); err != nil {
-			return fmt.Errorf("failed to upsert round: %w", err)
+func (r *roundRepository) ListRoundsIds(
+	ctx context.Context, filter domain.RoundFilter, page domain.Page,
+) ([]string, string, error) {
+	params := queries.SelectEndedRoundIdsWithFilterParams{Limit: -1}
+	if len(page.Cursor) > 0 {
+		timestamp, id, err := domain.ParseRoundCursor(page.Cursor)
+		if err != nil {
+			return nil, "", err
 		}
+		params.AfterTimestamp = timestamp
+		params.AfterID = id
+	}
+	// One more round is fetched to know whether there's a next page.
+	if page.Size > 0 {
+		params.Limit = int64(page.Size) + 1
+	}
+	if filter.StartedAfter > 0 {
+		params.StartedAfter = filter.StartedAfter
+	}
+	if filter.StartedBefore > 0 {
+		params.StartedBefore = filter.StartedBefore
+	}
 
-		if len(round.ForfeitTxs) > 0 || len(round.Connectors) > 0 || len(round.CongestionTree) > 0 {
-			for pos, tx := range round.ForfeitTxs {
-				if err := querierWithTx.UpsertTransaction(
-					ctx,
-					queries.UpsertTransactionParams{
-						Tx:       tx,
-						RoundID:  round.Id,
-						Type:     "forfeit",
-						Position: int64(pos),
-					},
-				); err != nil {
-					return fmt.Errorf("failed to upsert forfeit transaction: %w", err)
-				}
-			}
-
-			for pos, tx := range round.Connectors {
-				if err := querierWithTx.UpsertTransaction(
-					ctx,
-					queries.UpsertTransactionParams{
-						Tx:       tx,
-						RoundID:  round.Id,
-						Type:     "connector",
-						Position: int64(pos),
-					},
-				); err != nil {
-					return fmt.Errorf("failed to upsert connector transaction: %w", err)
-				}
-			}
+	rows, err := r.querier.SelectEndedRoundIdsWithFilter(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
 
-			for level, levelTxs := range round.CongestionTree {
-				for pos, tx := range levelTxs {
-					if err := querierWithTx.UpsertTransaction(
-						ctx,
-						queries.UpsertTransactionParams{
-							Tx:       tx.Tx,
-							RoundID:  round.Id,
-							Type:     "tree",
-							Position: int64(pos),
-							Txid: sql.NullString{
-								String: tx.Txid,
-								Valid:  true,
-							},
-							TreeLevel: sql.NullInt64{
-								Int64: int64(level),
-								Valid: true,
-							},
-							ParentTxid: sql.NullString{
-								String: tx.ParentTxid,
-								Valid:  true,
-							},
-							IsLeaf: sql.NullBool{
-								Bool:  tx.Leaf,
-								Valid: true,
-							},
-						},
-					); err != nil {
-						return fmt.Errorf("failed to upsert tree transaction: %w", err)
-					}
-				}
-			}
-		}
+	cursor := ""
+	if page.Size > 0 && len(rows) > page.Size {
+		rows = rows[:page.Size]
+		last := rows[page.Size-1]
+		cursor = domain.NewRoundCursor(last.StartingTimestamp, last.ID)
+	}
 
-		if len(round.Payments) > 0 {
-			for _, payment := range round.Payments {
-				if err := querierWithTx.UpsertPayment(
-					ctx,
-					queries.UpsertPaymentParams{
-						ID:      payment.Id,
-						RoundID: round.Id,
-					},
-				); err != nil {
-					return fmt.Errorf("failed to upsert payment: %w", err)
-				}
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	return ids, cursor, nil
+}
 
-				for _, receiver := range payment.Receivers {
-					if err := querierWithTx.UpsertReceiver(
-						ctx,
-						queries.UpsertReceiverParams{
-							PaymentID:      payment.Id,
-							Pubkey:         receiver.Pubkey,
-							Amount:         int64(receiver.Amount),
-							OnchainAddress: receiver.OnchainAddress,
-						},
-					); err != nil {
-						return fmt.Errorf("failed to upsert receiver: %w", err)
-					}
-				}
+func (r *roundRepository) GetUnfinishedRoundsIds(
+	ctx context.Context,
+) ([]string, error) {
+	return r.querier.SelectUnfinishedRoundIds(ctx)
+}
 
-				for _, input := range payment.Inputs {
-					if err := querierWithTx.UpdateVtxoPaymentId(
-						ctx,
-						queries.UpdateVtxoPaymentIdParams{
-							PaymentID: sql.NullString{
-								String: payment.Id,
-								Valid:  true,
-							},
-							Txid: input.Txid,
-							Vout: int64(input.VOut),
-						},
-					); err != nil {
-						return fmt.Errorf("failed to update vtxo payment id: %w", err)
-					}
-				}
-			}
-		}
+func (r *roundRepository) GetFailedRoundsIds(
+	ctx context.Context,
+) ([]string, error) {
+	return r.querier.SelectFailedRoundIds(ctx)
+}
 
-		return nil
+func (r *roundRepository) AddOrUpdateRound(ctx context.Context, round domain.Round) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		return upsertRound(ctx, querierWithTx, round)
 	}
 
 	return execTx(ctx, r.db, txBody)
@@ -334,6 +262,174 @@ type roundPaymentTxReceiverVtxoRow struct {
 	vtxo     queries.PaymentVtxoVw
 }
 
+// upsertRound writes the given round to the db with the given tx querier.
+func upsertRound(
+	ctx context.Context, querierWithTx *queries.Queries, round domain.Round,
+) error {
+	if err := querierWithTx.UpsertRound(
+		ctx,
+		queries.UpsertRoundParams{
+			ID:                round.Id,
+			StartingTimestamp: round.StartingTimestamp,
+			EndingTimestamp:   round.EndingTimestamp,
+			Ended:             round.Stage.Ended,
+			Failed:            round.Stage.Failed,
+			StageCode:         int64(round.Stage.Code),
+			Txid:              round.Txid,
+			UnsignedTx:        round.UnsignedTx,
+			ConnectorAddress:  round.ConnectorAddress,
+			DustAmount:        int64(round.DustAmount),
+			Version:           int64(round.Version),
+			Swept:             round.Swept,
+		},
+	); err != nil {
+		return fmt.Errorf("failed to upsert round: %w", err)
+	}
+
+	// Txs are replaced since the round's ones change if the round is
+	// finalized again after dropping some of its payments.
+	if err := querierWithTx.DeleteRoundTxs(ctx, round.Id); err != nil {
+		return fmt.Errorf("failed to delete round transactions: %w", err)
+	}
+
+	paymentIds, err := querierWithTx.SelectRoundPaymentIds(ctx, round.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get round payments: %w", err)
+	}
+	for _, id := range paymentIds {
+		if _, ok := round.Payments[id]; ok {
+			continue
+		}
+		if err := deletePayment(ctx, querierWithTx, id); err != nil {
+			return err
+		}
+	}
+
+	if len(round.ForfeitTxs) > 0 || len(round.Connectors) > 0 || len(round.CongestionTree) > 0 {
+		for pos, tx := range round.ForfeitTxs {
+			if err := querierWithTx.UpsertTransaction(
+				ctx,
+				queries.UpsertTransactionParams{
+					Tx:       tx,
+					RoundID:  round.Id,
+					Type:     "forfeit",
+					Position: int64(pos),
+				},
+			); err != nil {
+				return fmt.Errorf("failed to upsert forfeit transaction: %w", err)
+			}
+		}
+
+		for pos, tx := range round.Connectors {
+			if err := querierWithTx.UpsertTransaction(
+				ctx,
+				queries.UpsertTransactionParams{
+					Tx:       tx,
+					RoundID:  round.Id,
+					Type:     "connector",
+					Position: int64(pos),
+				},
+			); err != nil {
+				return fmt.Errorf("failed to upsert connector transaction: %w", err)
+			}
+		}
+
+		for level, levelTxs := range round.CongestionTree {
+			for pos, tx := range levelTxs {
+				if err := querierWithTx.UpsertTransaction(
+					ctx,
+					queries.UpsertTransactionParams{
+						Tx:       tx.Tx,
+						RoundID:  round.Id,
+						Type:     "tree",
+						Position: int64(pos),
+						Txid: sql.NullString{
+							String: tx.Txid,
+							Valid:  true,
+						},
+						TreeLevel: sql.NullInt64{
+							Int64: int64(level),
+							Valid: true,
+						},
+						ParentTxid: sql.NullString{
+							String: tx.ParentTxid,
+							Valid:  true,
+						},
+						IsLeaf: sql.NullBool{
+							Bool:  tx.Leaf,
+							Valid: true,
+						},
+					},
+				); err != nil {
+					return fmt.Errorf("failed to upsert tree transaction: %w", err)
+				}
+			}
+		}
+	}
+
+	if len(round.Payments) > 0 {
+		for _, payment := range round.Payments {
+			if err := querierWithTx.UpsertPayment(
+				ctx,
+				queries.UpsertPaymentParams{
+					ID:      payment.Id,
+					RoundID: round.Id,
+					Fee:     int64(payment.Fee),
+				},
+			); err != nil {
+				return fmt.Errorf("failed to upsert payment: %w", err)
+			}
+
+			for _, receiver := range payment.Receivers {
+				if err := querierWithTx.UpsertReceiver(
+					ctx,
+					queries.UpsertReceiverParams{
+						PaymentID:      payment.Id,
+						Pubkey:         receiver.Pubkey,
+						Amount:         int64(receiver.Amount),
+						OnchainAddress: receiver.OnchainAddress,
+					},
+				); err != nil {
+					return fmt.Errorf("failed to upsert receiver: %w", err)
+				}
+			}
+
+			for _, input := range payment.Inputs {
+				if err := querierWithTx.UpdateVtxoPaymentId(
+					ctx,
+					queries.UpdateVtxoPaymentIdParams{
+						PaymentID: sql.NullString{
+							String: payment.Id,
+							Valid:  true,
+						},
+						Txid: input.Txid,
+						Vout: int64(input.VOut),
+					},
+				); err != nil {
+					return fmt.Errorf("failed to update vtxo payment id: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func deletePayment(ctx context.Context, querierWithTx *queries.Queries, id string) error {
+	if err := querierWithTx.ResetVtxosPaymentId(
+		ctx, sql.NullString{String: id, Valid: true},
+	); err != nil {
+		return fmt.Errorf("failed to reset vtxo payment id: %w", err)
+	}
+	if err := querierWithTx.DeletePaymentReceivers(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete payment receivers: %w", err)
+	}
+	if err := querierWithTx.DeletePayment(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete payment: %w", err)
+	}
+	return nil
+}
+
 func readRoundRows(rows []roundPaymentTxReceiverVtxoRow) ([]*domain.Round, error) {
 	rounds := make(map[string]*domain.Round)
 
@@ -369,6 +465,7 @@ func readRoundRows(rows []roundPaymentTxReceiverVtxoRow) ([]*domain.Round, error
 					Id:        v.payment.ID.String,
 					Inputs:    make([]domain.Vtxo, 0),
 					Receivers: make([]domain.Receiver, 0),
+					Fee:       uint64(v.payment.Fee.Int64),
 				}
 				round.Payments[v.payment.ID.String] = payment
 			}
@@ -8,9 +8,71 @@ import (
 	"database/sql"
 )
 
+type AsyncPaymentRequest struct {
+	Txid     string
+	Vout     int64
+	ExpireAt int64
+}
+
+type AsyncPaymentRequestReceiver struct {
+	ID             int64
+	Txid           string
+	Vout           int64
+	Pubkey         string
+	Amount         int64
+	OnchainAddress string
+}
+
+type MonitoredTx struct {
+	Txid          string
+	Type          string
+	TxHex         string
+	BroadcastedAt int64
+	BumpedAt      int64
+	FeeRate       int64
+	ChildTxid     string
+	ConfirmedAt   int64
+}
+
+type MonitoredTxSweepInput struct {
+	ID            int64
+	MonitoredTxid string
+	Txid          string
+	Vout          int64
+	Amount        int64
+	LeafScript    []byte
+	ControlBlock  []byte
+	InternalKey   []byte
+}
+
+type MonitoredTxSweepInputVw struct {
+	ID            sql.NullInt64
+	MonitoredTxid sql.NullString
+	Txid          sql.NullString
+	Vout          sql.NullInt64
+	Amount        sql.NullInt64
+	LeafScript    []byte
+	ControlBlock  []byte
+	InternalKey   []byte
+}
+
+type Offender struct {
+	ID          string
+	BannedUntil int64
+}
+
+type OffenderStrikeVw struct {
+	ID         sql.NullInt64
+	OffenderID sql.NullString
+	Reason     sql.NullString
+	RoundID    sql.NullString
+	Timestamp  sql.NullInt64
+}
+
 type Payment struct {
 	ID      string
 	RoundID string
+	Fee     int64
 }
 
 type PaymentReceiverVw struct {
@@ -20,6 +82,27 @@ type PaymentReceiverVw struct {
 	OnchainAddress sql.NullString
 }
 
+type PaymentRequest struct {
+	ID              string
+	EphemeralPubkey string
+	Timestamp       int64
+	Fee             int64
+}
+
+type PaymentRequestInput struct {
+	RequestID string
+	Txid      string
+	Vout      int64
+}
+
+type PaymentRequestReceiver struct {
+	ID             int64
+	RequestID      string
+	Pubkey         string
+	Amount         int64
+	OnchainAddress string
+}
+
 type PaymentVtxoVw struct {
 	Txid      sql.NullString
 	Vout      sql.NullInt64
@@ -57,9 +140,17 @@ type Round struct {
 	Swept             bool
 }
 
+type RoundEvent struct {
+	ID      int64
+	RoundID string
+	Type    string
+	Event   []byte
+}
+
 type RoundPaymentVw struct {
 	ID      sql.NullString
 	RoundID sql.NullString
+	Fee     sql.NullInt64
 }
 
 type RoundTxVw struct {
@@ -74,6 +165,41 @@ type RoundTxVw struct {
 	IsLeaf     sql.NullBool
 }
 
+type Strike struct {
+	ID         int64
+	OffenderID string
+	Reason     string
+	RoundID    string
+	Timestamp  int64
+}
+
+type Sweep struct {
+	ID          string
+	RoundTxid   string
+	ScheduledAt int64
+	Status      string
+	AttemptedAt int64
+	Error       string
+}
+
+type SweepOutput struct {
+	ID        int64
+	SweepID   string
+	Txid      string
+	Vout      int64
+	Amount    int64
+	SweepTxid string
+}
+
+type SweepOutputVw struct {
+	ID        sql.NullInt64
+	SweepID   sql.NullString
+	Txid      sql.NullString
+	Vout      sql.NullInt64
+	Amount    sql.NullInt64
+	SweepTxid sql.NullString
+}
+
 type Tx struct {
 	ID         int64
 	Tx         string
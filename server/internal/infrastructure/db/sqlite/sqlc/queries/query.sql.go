@@ -10,6 +10,300 @@ import (
 	"database/sql"
 )
 
+const deleteAsyncPaymentRequest = `-- name: DeleteAsyncPaymentRequest :exec
+DELETE FROM async_payment_request WHERE txid = ? AND vout = ?
+`
+
+type DeleteAsyncPaymentRequestParams struct {
+	Txid string
+	Vout int64
+}
+
+func (q *Queries) DeleteAsyncPaymentRequest(ctx context.Context, arg DeleteAsyncPaymentRequestParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAsyncPaymentRequest, arg.Txid, arg.Vout)
+	return err
+}
+
+const deleteAsyncPaymentRequestReceivers = `-- name: DeleteAsyncPaymentRequestReceivers :exec
+DELETE FROM async_payment_request_receiver WHERE txid = ? AND vout = ?
+`
+
+type DeleteAsyncPaymentRequestReceiversParams struct {
+	Txid string
+	Vout int64
+}
+
+func (q *Queries) DeleteAsyncPaymentRequestReceivers(ctx context.Context, arg DeleteAsyncPaymentRequestReceiversParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAsyncPaymentRequestReceivers, arg.Txid, arg.Vout)
+	return err
+}
+
+const deleteMonitoredTx = `-- name: DeleteMonitoredTx :exec
+DELETE FROM monitored_tx WHERE txid = ?
+`
+
+func (q *Queries) DeleteMonitoredTx(ctx context.Context, txid string) error {
+	_, err := q.db.ExecContext(ctx, deleteMonitoredTx, txid)
+	return err
+}
+
+const deleteMonitoredTxSweepInputs = `-- name: DeleteMonitoredTxSweepInputs :exec
+DELETE FROM monitored_tx_sweep_input WHERE monitored_txid = ?
+`
+
+func (q *Queries) DeleteMonitoredTxSweepInputs(ctx context.Context, monitoredTxid string) error {
+	_, err := q.db.ExecContext(ctx, deleteMonitoredTxSweepInputs, monitoredTxid)
+	return err
+}
+
+const deleteOffenderStrikes = `-- name: DeleteOffenderStrikes :exec
+DELETE FROM strike WHERE offender_id = ?
+`
+
+func (q *Queries) DeleteOffenderStrikes(ctx context.Context, offenderID string) error {
+	_, err := q.db.ExecContext(ctx, deleteOffenderStrikes, offenderID)
+	return err
+}
+
+const deletePayment = `-- name: DeletePayment :exec
+DELETE FROM payment WHERE id = ?
+`
+
+func (q *Queries) DeletePayment(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deletePayment, id)
+	return err
+}
+
+const deletePaymentReceivers = `-- name: DeletePaymentReceivers :exec
+DELETE FROM receiver WHERE payment_id = ?
+`
+
+func (q *Queries) DeletePaymentReceivers(ctx context.Context, paymentID string) error {
+	_, err := q.db.ExecContext(ctx, deletePaymentReceivers, paymentID)
+	return err
+}
+
+const deletePaymentRequest = `-- name: DeletePaymentRequest :exec
+DELETE FROM payment_request WHERE id = ?
+`
+
+func (q *Queries) DeletePaymentRequest(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deletePaymentRequest, id)
+	return err
+}
+
+const deletePaymentRequestInputs = `-- name: DeletePaymentRequestInputs :exec
+DELETE FROM payment_request_input WHERE request_id = ?
+`
+
+func (q *Queries) DeletePaymentRequestInputs(ctx context.Context, requestID string) error {
+	_, err := q.db.ExecContext(ctx, deletePaymentRequestInputs, requestID)
+	return err
+}
+
+const deletePaymentRequestReceivers = `-- name: DeletePaymentRequestReceivers :exec
+DELETE FROM payment_request_receiver WHERE request_id = ?
+`
+
+func (q *Queries) DeletePaymentRequestReceivers(ctx context.Context, requestID string) error {
+	_, err := q.db.ExecContext(ctx, deletePaymentRequestReceivers, requestID)
+	return err
+}
+
+const deleteRoundTxs = `-- name: DeleteRoundTxs :exec
+DELETE FROM tx WHERE round_id = ?
+`
+
+func (q *Queries) DeleteRoundTxs(ctx context.Context, roundID string) error {
+	_, err := q.db.ExecContext(ctx, deleteRoundTxs, roundID)
+	return err
+}
+
+const deleteSweepOutputs = `-- name: DeleteSweepOutputs :exec
+DELETE FROM sweep_output WHERE sweep_id = ?
+`
+
+func (q *Queries) DeleteSweepOutputs(ctx context.Context, sweepID string) error {
+	_, err := q.db.ExecContext(ctx, deleteSweepOutputs, sweepID)
+	return err
+}
+
+const insertAsyncPaymentRequestReceiver = `-- name: InsertAsyncPaymentRequestReceiver :exec
+INSERT INTO async_payment_request_receiver (txid, vout, pubkey, amount, onchain_address)
+VALUES (?, ?, ?, ?, ?)
+`
+
+type InsertAsyncPaymentRequestReceiverParams struct {
+	Txid           string
+	Vout           int64
+	Pubkey         string
+	Amount         int64
+	OnchainAddress string
+}
+
+func (q *Queries) InsertAsyncPaymentRequestReceiver(ctx context.Context, arg InsertAsyncPaymentRequestReceiverParams) error {
+	_, err := q.db.ExecContext(ctx, insertAsyncPaymentRequestReceiver,
+		arg.Txid,
+		arg.Vout,
+		arg.Pubkey,
+		arg.Amount,
+		arg.OnchainAddress,
+	)
+	return err
+}
+
+const insertMonitoredTxSweepInput = `-- name: InsertMonitoredTxSweepInput :exec
+INSERT INTO monitored_tx_sweep_input (monitored_txid, txid, vout, amount, leaf_script, control_block, internal_key)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertMonitoredTxSweepInputParams struct {
+	MonitoredTxid string
+	Txid          string
+	Vout          int64
+	Amount        int64
+	LeafScript    []byte
+	ControlBlock  []byte
+	InternalKey   []byte
+}
+
+func (q *Queries) InsertMonitoredTxSweepInput(ctx context.Context, arg InsertMonitoredTxSweepInputParams) error {
+	_, err := q.db.ExecContext(ctx, insertMonitoredTxSweepInput,
+		arg.MonitoredTxid,
+		arg.Txid,
+		arg.Vout,
+		arg.Amount,
+		arg.LeafScript,
+		arg.ControlBlock,
+		arg.InternalKey,
+	)
+	return err
+}
+
+const insertPaymentRequestInput = `-- name: InsertPaymentRequestInput :exec
+INSERT INTO payment_request_input (request_id, txid, vout) VALUES (?, ?, ?)
+`
+
+type InsertPaymentRequestInputParams struct {
+	RequestID string
+	Txid      string
+	Vout      int64
+}
+
+func (q *Queries) InsertPaymentRequestInput(ctx context.Context, arg InsertPaymentRequestInputParams) error {
+	_, err := q.db.ExecContext(ctx, insertPaymentRequestInput, arg.RequestID, arg.Txid, arg.Vout)
+	return err
+}
+
+const insertPaymentRequestReceiver = `-- name: InsertPaymentRequestReceiver :exec
+INSERT INTO payment_request_receiver (request_id, pubkey, amount, onchain_address)
+VALUES (?, ?, ?, ?)
+`
+
+type InsertPaymentRequestReceiverParams struct {
+	RequestID      string
+	Pubkey         string
+	Amount         int64
+	OnchainAddress string
+}
+
+func (q *Queries) InsertPaymentRequestReceiver(ctx context.Context, arg InsertPaymentRequestReceiverParams) error {
+	_, err := q.db.ExecContext(ctx, insertPaymentRequestReceiver,
+		arg.RequestID,
+		arg.Pubkey,
+		arg.Amount,
+		arg.OnchainAddress,
+	)
+	return err
+}
+
+const insertRoundEvent = `-- name: InsertRoundEvent :exec
+INSERT INTO round_event (round_id, type, event) VALUES (?, ?, ?)
+`
+
+type InsertRoundEventParams struct {
+	RoundID string
+	Type    string
+	Event   []byte
+}
+
+func (q *Queries) InsertRoundEvent(ctx context.Context, arg InsertRoundEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertRoundEvent, arg.RoundID, arg.Type, arg.Event)
+	return err
+}
+
+const insertStrike = `-- name: InsertStrike :exec
+INSERT INTO strike (offender_id, reason, round_id, timestamp) VALUES (?, ?, ?, ?)
+`
+
+type InsertStrikeParams struct {
+	OffenderID string
+	Reason     string
+	RoundID    string
+	Timestamp  int64
+}
+
+func (q *Queries) InsertStrike(ctx context.Context, arg InsertStrikeParams) error {
+	_, err := q.db.ExecContext(ctx, insertStrike,
+		arg.OffenderID,
+		arg.Reason,
+		arg.RoundID,
+		arg.Timestamp,
+	)
+	return err
+}
+
+const insertSweepOutput = `-- name: InsertSweepOutput :exec
+INSERT INTO sweep_output (sweep_id, txid, vout, amount, sweep_txid) VALUES (?, ?, ?, ?, ?)
+`
+
+type InsertSweepOutputParams struct {
+	SweepID   string
+	Txid      string
+	Vout      int64
+	Amount    int64
+	SweepTxid string
+}
+
+func (q *Queries) InsertSweepOutput(ctx context.Context, arg InsertSweepOutputParams) error {
+	_, err := q.db.ExecContext(ctx, insertSweepOutput,
+		arg.SweepID,
+		arg.Txid,
+		arg.Vout,
+		arg.Amount,
+		arg.SweepTxid,
+	)
+	return err
+}
+
+const markVtxoAsNotRedeemed = `-- name: MarkVtxoAsNotRedeemed :exec
+UPDATE vtxo SET redeemed = false WHERE txid = ? AND vout = ?
+`
+
+type MarkVtxoAsNotRedeemedParams struct {
+	Txid string
+	Vout int64
+}
+
+func (q *Queries) MarkVtxoAsNotRedeemed(ctx context.Context, arg MarkVtxoAsNotRedeemedParams) error {
+	_, err := q.db.ExecContext(ctx, markVtxoAsNotRedeemed, arg.Txid, arg.Vout)
+	return err
+}
+
+const markVtxoAsNotSwept = `-- name: MarkVtxoAsNotSwept :exec
+UPDATE vtxo SET swept = false WHERE txid = ? AND vout = ?
+`
+
+type MarkVtxoAsNotSweptParams struct {
+	Txid string
+	Vout int64
+}
+
+func (q *Queries) MarkVtxoAsNotSwept(ctx context.Context, arg MarkVtxoAsNotSweptParams) error {
+	_, err := q.db.ExecContext(ctx, markVtxoAsNotSwept, arg.Txid, arg.Vout)
+	return err
+}
+
 const markVtxoAsRedeemed = `-- name: MarkVtxoAsRedeemed :exec
 UPDATE vtxo SET redeemed = true WHERE txid = ? AND vout = ?
 `
@@ -53,6 +347,363 @@ func (q *Queries) MarkVtxoAsSwept(ctx context.Context, arg MarkVtxoAsSweptParams
 	return err
 }
 
+const resetVtxosPaymentId = `-- name: ResetVtxosPaymentId :exec
+UPDATE vtxo SET payment_id = NULL WHERE payment_id = ?
+`
+
+func (q *Queries) ResetVtxosPaymentId(ctx context.Context, paymentID sql.NullString) error {
+	_, err := q.db.ExecContext(ctx, resetVtxosPaymentId, paymentID)
+	return err
+}
+
+const selectAllMonitoredTxs = `-- name: SelectAllMonitoredTxs :many
+SELECT monitored_tx.txid, monitored_tx.type, monitored_tx.tx_hex, monitored_tx.broadcasted_at, monitored_tx.bumped_at, monitored_tx.fee_rate, monitored_tx.child_txid, monitored_tx.confirmed_at,
+       monitored_tx_sweep_input_vw.id, monitored_tx_sweep_input_vw.monitored_txid, monitored_tx_sweep_input_vw.txid, monitored_tx_sweep_input_vw.vout, monitored_tx_sweep_input_vw.amount, monitored_tx_sweep_input_vw.leaf_script, monitored_tx_sweep_input_vw.control_block, monitored_tx_sweep_input_vw.internal_key
+FROM monitored_tx
+         LEFT OUTER JOIN monitored_tx_sweep_input_vw ON monitored_tx.txid=monitored_tx_sweep_input_vw.monitored_txid
+ORDER BY monitored_tx.broadcasted_at, monitored_tx_sweep_input_vw.id
+`
+
+type SelectAllMonitoredTxsRow struct {
+	MonitoredTx             MonitoredTx
+	MonitoredTxSweepInputVw MonitoredTxSweepInputVw
+}
+
+func (q *Queries) SelectAllMonitoredTxs(ctx context.Context) ([]SelectAllMonitoredTxsRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectAllMonitoredTxs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectAllMonitoredTxsRow
+	for rows.Next() {
+		var i SelectAllMonitoredTxsRow
+		if err := rows.Scan(
+			&i.MonitoredTx.Txid,
+			&i.MonitoredTx.Type,
+			&i.MonitoredTx.TxHex,
+			&i.MonitoredTx.BroadcastedAt,
+			&i.MonitoredTx.BumpedAt,
+			&i.MonitoredTx.FeeRate,
+			&i.MonitoredTx.ChildTxid,
+			&i.MonitoredTx.ConfirmedAt,
+			&i.MonitoredTxSweepInputVw.ID,
+			&i.MonitoredTxSweepInputVw.MonitoredTxid,
+			&i.MonitoredTxSweepInputVw.Txid,
+			&i.MonitoredTxSweepInputVw.Vout,
+			&i.MonitoredTxSweepInputVw.Amount,
+			&i.MonitoredTxSweepInputVw.LeafScript,
+			&i.MonitoredTxSweepInputVw.ControlBlock,
+			&i.MonitoredTxSweepInputVw.InternalKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectAllOffenders = `-- name: SelectAllOffenders :many
+SELECT offender.id, offender.banned_until,
+       offender_strike_vw.id, offender_strike_vw.offender_id, offender_strike_vw.reason, offender_strike_vw.round_id, offender_strike_vw.timestamp
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+ORDER BY offender_strike_vw.id
+`
+
+type SelectAllOffendersRow struct {
+	Offender         Offender
+	OffenderStrikeVw OffenderStrikeVw
+}
+
+func (q *Queries) SelectAllOffenders(ctx context.Context) ([]SelectAllOffendersRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectAllOffenders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectAllOffendersRow
+	for rows.Next() {
+		var i SelectAllOffendersRow
+		if err := rows.Scan(
+			&i.Offender.ID,
+			&i.Offender.BannedUntil,
+			&i.OffenderStrikeVw.ID,
+			&i.OffenderStrikeVw.OffenderID,
+			&i.OffenderStrikeVw.Reason,
+			&i.OffenderStrikeVw.RoundID,
+			&i.OffenderStrikeVw.Timestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectAllSweeps = `-- name: SelectAllSweeps :many
+SELECT sweep.id, sweep.round_txid, sweep.scheduled_at, sweep.status, sweep.attempted_at, sweep.error,
+       sweep_output_vw.id, sweep_output_vw.sweep_id, sweep_output_vw.txid, sweep_output_vw.vout, sweep_output_vw.amount, sweep_output_vw.sweep_txid
+FROM sweep
+         LEFT OUTER JOIN sweep_output_vw ON sweep.id=sweep_output_vw.sweep_id
+ORDER BY sweep.scheduled_at, sweep_output_vw.id
+`
+
+type SelectAllSweepsRow struct {
+	Sweep         Sweep
+	SweepOutputVw SweepOutputVw
+}
+
+func (q *Queries) SelectAllSweeps(ctx context.Context) ([]SelectAllSweepsRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectAllSweeps)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectAllSweepsRow
+	for rows.Next() {
+		var i SelectAllSweepsRow
+		if err := rows.Scan(
+			&i.Sweep.ID,
+			&i.Sweep.RoundTxid,
+			&i.Sweep.ScheduledAt,
+			&i.Sweep.Status,
+			&i.Sweep.AttemptedAt,
+			&i.Sweep.Error,
+			&i.SweepOutputVw.ID,
+			&i.SweepOutputVw.SweepID,
+			&i.SweepOutputVw.Txid,
+			&i.SweepOutputVw.Vout,
+			&i.SweepOutputVw.Amount,
+			&i.SweepOutputVw.SweepTxid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectAllVtxoKeys = `-- name: SelectAllVtxoKeys :many
+SELECT txid, vout FROM vtxo ORDER BY txid, vout
+`
+
+type SelectAllVtxoKeysRow struct {
+	Txid string
+	Vout int64
+}
+
+func (q *Queries) SelectAllVtxoKeys(ctx context.Context) ([]SelectAllVtxoKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectAllVtxoKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectAllVtxoKeysRow
+	for rows.Next() {
+		var i SelectAllVtxoKeysRow
+		if err := rows.Scan(&i.Txid, &i.Vout); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectAsyncPaymentRequests = `-- name: SelectAsyncPaymentRequests :many
+SELECT async_payment_request.txid, async_payment_request.vout, async_payment_request.expire_at,
+       async_payment_request_receiver.id, async_payment_request_receiver.txid, async_payment_request_receiver.vout, async_payment_request_receiver.pubkey, async_payment_request_receiver.amount, async_payment_request_receiver.onchain_address
+FROM async_payment_request
+         INNER JOIN async_payment_request_receiver ON async_payment_request.txid=async_payment_request_receiver.txid AND async_payment_request.vout=async_payment_request_receiver.vout
+ORDER BY async_payment_request_receiver.id
+`
+
+type SelectAsyncPaymentRequestsRow struct {
+	AsyncPaymentRequest         AsyncPaymentRequest
+	AsyncPaymentRequestReceiver AsyncPaymentRequestReceiver
+}
+
+func (q *Queries) SelectAsyncPaymentRequests(ctx context.Context) ([]SelectAsyncPaymentRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectAsyncPaymentRequests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectAsyncPaymentRequestsRow
+	for rows.Next() {
+		var i SelectAsyncPaymentRequestsRow
+		if err := rows.Scan(
+			&i.AsyncPaymentRequest.Txid,
+			&i.AsyncPaymentRequest.Vout,
+			&i.AsyncPaymentRequest.ExpireAt,
+			&i.AsyncPaymentRequestReceiver.ID,
+			&i.AsyncPaymentRequestReceiver.Txid,
+			&i.AsyncPaymentRequestReceiver.Vout,
+			&i.AsyncPaymentRequestReceiver.Pubkey,
+			&i.AsyncPaymentRequestReceiver.Amount,
+			&i.AsyncPaymentRequestReceiver.OnchainAddress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectBannedOffenders = `-- name: SelectBannedOffenders :many
+SELECT offender.id, offender.banned_until,
+       offender_strike_vw.id, offender_strike_vw.offender_id, offender_strike_vw.reason, offender_strike_vw.round_id, offender_strike_vw.timestamp
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+WHERE offender.banned_until > ?
+ORDER BY offender_strike_vw.id
+`
+
+type SelectBannedOffendersRow struct {
+	Offender         Offender
+	OffenderStrikeVw OffenderStrikeVw
+}
+
+func (q *Queries) SelectBannedOffenders(ctx context.Context, bannedUntil int64) ([]SelectBannedOffendersRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectBannedOffenders, bannedUntil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectBannedOffendersRow
+	for rows.Next() {
+		var i SelectBannedOffendersRow
+		if err := rows.Scan(
+			&i.Offender.ID,
+			&i.Offender.BannedUntil,
+			&i.OffenderStrikeVw.ID,
+			&i.OffenderStrikeVw.OffenderID,
+			&i.OffenderStrikeVw.Reason,
+			&i.OffenderStrikeVw.RoundID,
+			&i.OffenderStrikeVw.Timestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectEndedRoundIdsWithFilter = `-- name: SelectEndedRoundIdsWithFilter :many
+SELECT id, starting_timestamp FROM round
+WHERE ended = true
+    AND (?1 IS NULL OR starting_timestamp > ?1)
+    AND (?2 IS NULL OR starting_timestamp < ?2)
+    AND (starting_timestamp > ?3 OR (starting_timestamp = ?3 AND id > ?4))
+ORDER BY starting_timestamp, id
+LIMIT ?5
+`
+
+type SelectEndedRoundIdsWithFilterParams struct {
+	StartedAfter   interface{}
+	StartedBefore  interface{}
+	AfterTimestamp int64
+	AfterID        string
+	Limit          int64
+}
+
+type SelectEndedRoundIdsWithFilterRow struct {
+	ID                string
+	StartingTimestamp int64
+}
+
+func (q *Queries) SelectEndedRoundIdsWithFilter(ctx context.Context, arg SelectEndedRoundIdsWithFilterParams) ([]SelectEndedRoundIdsWithFilterRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectEndedRoundIdsWithFilter,
+		arg.StartedAfter,
+		arg.StartedBefore,
+		arg.AfterTimestamp,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectEndedRoundIdsWithFilterRow
+	for rows.Next() {
+		var i SelectEndedRoundIdsWithFilterRow
+		if err := rows.Scan(&i.ID, &i.StartingTimestamp); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectFailedRoundIds = `-- name: SelectFailedRoundIds :many
+SELECT id FROM round WHERE failed = true
+`
+
+func (q *Queries) SelectFailedRoundIds(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, selectFailedRoundIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectNotRedeemedVtxos = `-- name: SelectNotRedeemedVtxos :many
 SELECT  vtxo.txid, vtxo.vout, vtxo.pubkey, vtxo.amount, vtxo.pool_tx, vtxo.spent_by, vtxo.spent, vtxo.redeemed, vtxo.swept, vtxo.expire_at, vtxo.payment_id, vtxo.redeem_tx,
         uncond_forfeit_tx_vw.id, uncond_forfeit_tx_vw.tx, uncond_forfeit_tx_vw.vtxo_txid, uncond_forfeit_tx_vw.vtxo_vout, uncond_forfeit_tx_vw.position
@@ -107,46 +758,237 @@ func (q *Queries) SelectNotRedeemedVtxos(ctx context.Context) ([]SelectNotRedeem
 	return items, nil
 }
 
-const selectNotRedeemedVtxosWithPubkey = `-- name: SelectNotRedeemedVtxosWithPubkey :many
-SELECT  vtxo.txid, vtxo.vout, vtxo.pubkey, vtxo.amount, vtxo.pool_tx, vtxo.spent_by, vtxo.spent, vtxo.redeemed, vtxo.swept, vtxo.expire_at, vtxo.payment_id, vtxo.redeem_tx,
-        uncond_forfeit_tx_vw.id, uncond_forfeit_tx_vw.tx, uncond_forfeit_tx_vw.vtxo_txid, uncond_forfeit_tx_vw.vtxo_vout, uncond_forfeit_tx_vw.position
-FROM vtxo
-        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
-WHERE redeemed = false AND pubkey = ?
+const selectNotRedeemedVtxosWithPubkey = `-- name: SelectNotRedeemedVtxosWithPubkey :many
+SELECT  vtxo.txid, vtxo.vout, vtxo.pubkey, vtxo.amount, vtxo.pool_tx, vtxo.spent_by, vtxo.spent, vtxo.redeemed, vtxo.swept, vtxo.expire_at, vtxo.payment_id, vtxo.redeem_tx,
+        uncond_forfeit_tx_vw.id, uncond_forfeit_tx_vw.tx, uncond_forfeit_tx_vw.vtxo_txid, uncond_forfeit_tx_vw.vtxo_vout, uncond_forfeit_tx_vw.position
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE redeemed = false AND pubkey = ?
+`
+
+type SelectNotRedeemedVtxosWithPubkeyRow struct {
+	Vtxo              Vtxo
+	UncondForfeitTxVw UncondForfeitTxVw
+}
+
+func (q *Queries) SelectNotRedeemedVtxosWithPubkey(ctx context.Context, pubkey string) ([]SelectNotRedeemedVtxosWithPubkeyRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectNotRedeemedVtxosWithPubkey, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectNotRedeemedVtxosWithPubkeyRow
+	for rows.Next() {
+		var i SelectNotRedeemedVtxosWithPubkeyRow
+		if err := rows.Scan(
+			&i.Vtxo.Txid,
+			&i.Vtxo.Vout,
+			&i.Vtxo.Pubkey,
+			&i.Vtxo.Amount,
+			&i.Vtxo.PoolTx,
+			&i.Vtxo.SpentBy,
+			&i.Vtxo.Spent,
+			&i.Vtxo.Redeemed,
+			&i.Vtxo.Swept,
+			&i.Vtxo.ExpireAt,
+			&i.Vtxo.PaymentID,
+			&i.Vtxo.RedeemTx,
+			&i.UncondForfeitTxVw.ID,
+			&i.UncondForfeitTxVw.Tx,
+			&i.UncondForfeitTxVw.VtxoTxid,
+			&i.UncondForfeitTxVw.VtxoVout,
+			&i.UncondForfeitTxVw.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectOffender = `-- name: SelectOffender :many
+SELECT offender.id, offender.banned_until,
+       offender_strike_vw.id, offender_strike_vw.offender_id, offender_strike_vw.reason, offender_strike_vw.round_id, offender_strike_vw.timestamp
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+WHERE offender.id = ?
+ORDER BY offender_strike_vw.id
+`
+
+type SelectOffenderRow struct {
+	Offender         Offender
+	OffenderStrikeVw OffenderStrikeVw
+}
+
+func (q *Queries) SelectOffender(ctx context.Context, id string) ([]SelectOffenderRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectOffender, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectOffenderRow
+	for rows.Next() {
+		var i SelectOffenderRow
+		if err := rows.Scan(
+			&i.Offender.ID,
+			&i.Offender.BannedUntil,
+			&i.OffenderStrikeVw.ID,
+			&i.OffenderStrikeVw.OffenderID,
+			&i.OffenderStrikeVw.Reason,
+			&i.OffenderStrikeVw.RoundID,
+			&i.OffenderStrikeVw.Timestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectPaymentRequestInputs = `-- name: SelectPaymentRequestInputs :many
+SELECT payment_request_input.request_id,
+       vtxo.txid, vtxo.vout, vtxo.pubkey, vtxo.amount, vtxo.pool_tx, vtxo.spent_by, vtxo.spent, vtxo.redeemed, vtxo.swept, vtxo.expire_at, vtxo.payment_id, vtxo.redeem_tx
+FROM payment_request_input
+         INNER JOIN vtxo ON payment_request_input.txid=vtxo.txid AND payment_request_input.vout=vtxo.vout
+`
+
+type SelectPaymentRequestInputsRow struct {
+	RequestID string
+	Vtxo      Vtxo
+}
+
+func (q *Queries) SelectPaymentRequestInputs(ctx context.Context) ([]SelectPaymentRequestInputsRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectPaymentRequestInputs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectPaymentRequestInputsRow
+	for rows.Next() {
+		var i SelectPaymentRequestInputsRow
+		if err := rows.Scan(
+			&i.RequestID,
+			&i.Vtxo.Txid,
+			&i.Vtxo.Vout,
+			&i.Vtxo.Pubkey,
+			&i.Vtxo.Amount,
+			&i.Vtxo.PoolTx,
+			&i.Vtxo.SpentBy,
+			&i.Vtxo.Spent,
+			&i.Vtxo.Redeemed,
+			&i.Vtxo.Swept,
+			&i.Vtxo.ExpireAt,
+			&i.Vtxo.PaymentID,
+			&i.Vtxo.RedeemTx,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectPaymentRequestReceivers = `-- name: SelectPaymentRequestReceivers :many
+SELECT id, request_id, pubkey, amount, onchain_address FROM payment_request_receiver ORDER BY id
+`
+
+func (q *Queries) SelectPaymentRequestReceivers(ctx context.Context) ([]PaymentRequestReceiver, error) {
+	rows, err := q.db.QueryContext(ctx, selectPaymentRequestReceivers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentRequestReceiver
+	for rows.Next() {
+		var i PaymentRequestReceiver
+		if err := rows.Scan(
+			&i.ID,
+			&i.RequestID,
+			&i.Pubkey,
+			&i.Amount,
+			&i.OnchainAddress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectPaymentRequests = `-- name: SelectPaymentRequests :many
+SELECT id, ephemeral_pubkey, timestamp, fee FROM payment_request
+`
+
+func (q *Queries) SelectPaymentRequests(ctx context.Context) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, selectPaymentRequests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentRequest
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.EphemeralPubkey,
+			&i.Timestamp,
+			&i.Fee,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectRoundEvents = `-- name: SelectRoundEvents :many
+SELECT id, round_id, type, event FROM round_event WHERE round_id = ? ORDER BY id
 `
 
-type SelectNotRedeemedVtxosWithPubkeyRow struct {
-	Vtxo              Vtxo
-	UncondForfeitTxVw UncondForfeitTxVw
-}
-
-func (q *Queries) SelectNotRedeemedVtxosWithPubkey(ctx context.Context, pubkey string) ([]SelectNotRedeemedVtxosWithPubkeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, selectNotRedeemedVtxosWithPubkey, pubkey)
+func (q *Queries) SelectRoundEvents(ctx context.Context, roundID string) ([]RoundEvent, error) {
+	rows, err := q.db.QueryContext(ctx, selectRoundEvents, roundID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SelectNotRedeemedVtxosWithPubkeyRow
+	var items []RoundEvent
 	for rows.Next() {
-		var i SelectNotRedeemedVtxosWithPubkeyRow
+		var i RoundEvent
 		if err := rows.Scan(
-			&i.Vtxo.Txid,
-			&i.Vtxo.Vout,
-			&i.Vtxo.Pubkey,
-			&i.Vtxo.Amount,
-			&i.Vtxo.PoolTx,
-			&i.Vtxo.SpentBy,
-			&i.Vtxo.Spent,
-			&i.Vtxo.Redeemed,
-			&i.Vtxo.Swept,
-			&i.Vtxo.ExpireAt,
-			&i.Vtxo.PaymentID,
-			&i.Vtxo.RedeemTx,
-			&i.UncondForfeitTxVw.ID,
-			&i.UncondForfeitTxVw.Tx,
-			&i.UncondForfeitTxVw.VtxoTxid,
-			&i.UncondForfeitTxVw.VtxoVout,
-			&i.UncondForfeitTxVw.Position,
+			&i.ID,
+			&i.RoundID,
+			&i.Type,
+			&i.Event,
 		); err != nil {
 			return nil, err
 		}
@@ -220,9 +1062,36 @@ func (q *Queries) SelectRoundIdsInRange(ctx context.Context, arg SelectRoundIdsI
 	return items, nil
 }
 
+const selectRoundPaymentIds = `-- name: SelectRoundPaymentIds :many
+SELECT id FROM payment WHERE round_id = ?
+`
+
+func (q *Queries) SelectRoundPaymentIds(ctx context.Context, roundID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, selectRoundPaymentIds, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectRoundWithRoundId = `-- name: SelectRoundWithRoundId :many
 SELECT round.id, round.starting_timestamp, round.ending_timestamp, round.ended, round.failed, round.stage_code, round.txid, round.unsigned_tx, round.connector_address, round.dust_amount, round.version, round.swept,
-       round_payment_vw.id, round_payment_vw.round_id,
+       round_payment_vw.id, round_payment_vw.round_id, round_payment_vw.fee,
        round_tx_vw.id, round_tx_vw.tx, round_tx_vw.round_id, round_tx_vw.type, round_tx_vw.position, round_tx_vw.txid, round_tx_vw.tree_level, round_tx_vw.parent_txid, round_tx_vw.is_leaf,
        payment_receiver_vw.payment_id, payment_receiver_vw.pubkey, payment_receiver_vw.amount, payment_receiver_vw.onchain_address,
        payment_vtxo_vw.txid, payment_vtxo_vw.vout, payment_vtxo_vw.pubkey, payment_vtxo_vw.amount, payment_vtxo_vw.pool_tx, payment_vtxo_vw.spent_by, payment_vtxo_vw.spent, payment_vtxo_vw.redeemed, payment_vtxo_vw.swept, payment_vtxo_vw.expire_at, payment_vtxo_vw.payment_id, payment_vtxo_vw.redeem_tx
@@ -266,6 +1135,7 @@ func (q *Queries) SelectRoundWithRoundId(ctx context.Context, id string) ([]Sele
 			&i.Round.Swept,
 			&i.RoundPaymentVw.ID,
 			&i.RoundPaymentVw.RoundID,
+			&i.RoundPaymentVw.Fee,
 			&i.RoundTxVw.ID,
 			&i.RoundTxVw.Tx,
 			&i.RoundTxVw.RoundID,
@@ -307,7 +1177,7 @@ func (q *Queries) SelectRoundWithRoundId(ctx context.Context, id string) ([]Sele
 
 const selectRoundWithRoundTxId = `-- name: SelectRoundWithRoundTxId :many
 SELECT round.id, round.starting_timestamp, round.ending_timestamp, round.ended, round.failed, round.stage_code, round.txid, round.unsigned_tx, round.connector_address, round.dust_amount, round.version, round.swept,
-       round_payment_vw.id, round_payment_vw.round_id,
+       round_payment_vw.id, round_payment_vw.round_id, round_payment_vw.fee,
        round_tx_vw.id, round_tx_vw.tx, round_tx_vw.round_id, round_tx_vw.type, round_tx_vw.position, round_tx_vw.txid, round_tx_vw.tree_level, round_tx_vw.parent_txid, round_tx_vw.is_leaf,
        payment_receiver_vw.payment_id, payment_receiver_vw.pubkey, payment_receiver_vw.amount, payment_receiver_vw.onchain_address,
        payment_vtxo_vw.txid, payment_vtxo_vw.vout, payment_vtxo_vw.pubkey, payment_vtxo_vw.amount, payment_vtxo_vw.pool_tx, payment_vtxo_vw.spent_by, payment_vtxo_vw.spent, payment_vtxo_vw.redeemed, payment_vtxo_vw.swept, payment_vtxo_vw.expire_at, payment_vtxo_vw.payment_id, payment_vtxo_vw.redeem_tx
@@ -351,6 +1221,7 @@ func (q *Queries) SelectRoundWithRoundTxId(ctx context.Context, txid string) ([]
 			&i.Round.Swept,
 			&i.RoundPaymentVw.ID,
 			&i.RoundPaymentVw.RoundID,
+			&i.RoundPaymentVw.Fee,
 			&i.RoundTxVw.ID,
 			&i.RoundTxVw.Tx,
 			&i.RoundTxVw.RoundID,
@@ -390,9 +1261,59 @@ func (q *Queries) SelectRoundWithRoundTxId(ctx context.Context, txid string) ([]
 	return items, nil
 }
 
+const selectSweep = `-- name: SelectSweep :many
+SELECT sweep.id, sweep.round_txid, sweep.scheduled_at, sweep.status, sweep.attempted_at, sweep.error,
+       sweep_output_vw.id, sweep_output_vw.sweep_id, sweep_output_vw.txid, sweep_output_vw.vout, sweep_output_vw.amount, sweep_output_vw.sweep_txid
+FROM sweep
+         LEFT OUTER JOIN sweep_output_vw ON sweep.id=sweep_output_vw.sweep_id
+WHERE sweep.id = ?
+ORDER BY sweep_output_vw.id
+`
+
+type SelectSweepRow struct {
+	Sweep         Sweep
+	SweepOutputVw SweepOutputVw
+}
+
+func (q *Queries) SelectSweep(ctx context.Context, id string) ([]SelectSweepRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectSweep, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectSweepRow
+	for rows.Next() {
+		var i SelectSweepRow
+		if err := rows.Scan(
+			&i.Sweep.ID,
+			&i.Sweep.RoundTxid,
+			&i.Sweep.ScheduledAt,
+			&i.Sweep.Status,
+			&i.Sweep.AttemptedAt,
+			&i.Sweep.Error,
+			&i.SweepOutputVw.ID,
+			&i.SweepOutputVw.SweepID,
+			&i.SweepOutputVw.Txid,
+			&i.SweepOutputVw.Vout,
+			&i.SweepOutputVw.Amount,
+			&i.SweepOutputVw.SweepTxid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectSweepableRounds = `-- name: SelectSweepableRounds :many
 SELECT round.id, round.starting_timestamp, round.ending_timestamp, round.ended, round.failed, round.stage_code, round.txid, round.unsigned_tx, round.connector_address, round.dust_amount, round.version, round.swept,
-       round_payment_vw.id, round_payment_vw.round_id,
+       round_payment_vw.id, round_payment_vw.round_id, round_payment_vw.fee,
        round_tx_vw.id, round_tx_vw.tx, round_tx_vw.round_id, round_tx_vw.type, round_tx_vw.position, round_tx_vw.txid, round_tx_vw.tree_level, round_tx_vw.parent_txid, round_tx_vw.is_leaf,
        payment_receiver_vw.payment_id, payment_receiver_vw.pubkey, payment_receiver_vw.amount, payment_receiver_vw.onchain_address,
        payment_vtxo_vw.txid, payment_vtxo_vw.vout, payment_vtxo_vw.pubkey, payment_vtxo_vw.amount, payment_vtxo_vw.pool_tx, payment_vtxo_vw.spent_by, payment_vtxo_vw.spent, payment_vtxo_vw.redeemed, payment_vtxo_vw.swept, payment_vtxo_vw.expire_at, payment_vtxo_vw.payment_id, payment_vtxo_vw.redeem_tx
@@ -436,6 +1357,7 @@ func (q *Queries) SelectSweepableRounds(ctx context.Context) ([]SelectSweepableR
 			&i.Round.Swept,
 			&i.RoundPaymentVw.ID,
 			&i.RoundPaymentVw.RoundID,
+			&i.RoundPaymentVw.Fee,
 			&i.RoundTxVw.ID,
 			&i.RoundTxVw.Tx,
 			&i.RoundTxVw.RoundID,
@@ -531,7 +1453,7 @@ func (q *Queries) SelectSweepableVtxos(ctx context.Context) ([]SelectSweepableVt
 
 const selectSweptRounds = `-- name: SelectSweptRounds :many
 SELECT round.id, round.starting_timestamp, round.ending_timestamp, round.ended, round.failed, round.stage_code, round.txid, round.unsigned_tx, round.connector_address, round.dust_amount, round.version, round.swept,
-       round_payment_vw.id, round_payment_vw.round_id,
+       round_payment_vw.id, round_payment_vw.round_id, round_payment_vw.fee,
        round_tx_vw.id, round_tx_vw.tx, round_tx_vw.round_id, round_tx_vw.type, round_tx_vw.position, round_tx_vw.txid, round_tx_vw.tree_level, round_tx_vw.parent_txid, round_tx_vw.is_leaf,
        payment_receiver_vw.payment_id, payment_receiver_vw.pubkey, payment_receiver_vw.amount, payment_receiver_vw.onchain_address,
        payment_vtxo_vw.txid, payment_vtxo_vw.vout, payment_vtxo_vw.pubkey, payment_vtxo_vw.amount, payment_vtxo_vw.pool_tx, payment_vtxo_vw.spent_by, payment_vtxo_vw.spent, payment_vtxo_vw.redeemed, payment_vtxo_vw.swept, payment_vtxo_vw.expire_at, payment_vtxo_vw.payment_id, payment_vtxo_vw.redeem_tx
@@ -575,6 +1497,7 @@ func (q *Queries) SelectSweptRounds(ctx context.Context) ([]SelectSweptRoundsRow
 			&i.Round.Swept,
 			&i.RoundPaymentVw.ID,
 			&i.RoundPaymentVw.RoundID,
+			&i.RoundPaymentVw.Fee,
 			&i.RoundTxVw.ID,
 			&i.RoundTxVw.Tx,
 			&i.RoundTxVw.RoundID,
@@ -614,7 +1537,34 @@ func (q *Queries) SelectSweptRounds(ctx context.Context) ([]SelectSweptRoundsRow
 	return items, nil
 }
 
-const selectVtxoByOutpoint = `-- name: SelectVtxoByOutpoint :one
+const selectUnfinishedRoundIds = `-- name: SelectUnfinishedRoundIds :many
+SELECT id FROM round WHERE ended = false AND failed = false
+`
+
+func (q *Queries) SelectUnfinishedRoundIds(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, selectUnfinishedRoundIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectVtxoByOutpoint = `-- name: SelectVtxoByOutpoint :many
 SELECT  vtxo.txid, vtxo.vout, vtxo.pubkey, vtxo.amount, vtxo.pool_tx, vtxo.spent_by, vtxo.spent, vtxo.redeemed, vtxo.swept, vtxo.expire_at, vtxo.payment_id, vtxo.redeem_tx,
         uncond_forfeit_tx_vw.id, uncond_forfeit_tx_vw.tx, uncond_forfeit_tx_vw.vtxo_txid, uncond_forfeit_tx_vw.vtxo_vout, uncond_forfeit_tx_vw.position
 FROM vtxo
@@ -632,29 +1582,45 @@ type SelectVtxoByOutpointRow struct {
 	UncondForfeitTxVw UncondForfeitTxVw
 }
 
-func (q *Queries) SelectVtxoByOutpoint(ctx context.Context, arg SelectVtxoByOutpointParams) (SelectVtxoByOutpointRow, error) {
-	row := q.db.QueryRowContext(ctx, selectVtxoByOutpoint, arg.Txid, arg.Vout)
-	var i SelectVtxoByOutpointRow
-	err := row.Scan(
-		&i.Vtxo.Txid,
-		&i.Vtxo.Vout,
-		&i.Vtxo.Pubkey,
-		&i.Vtxo.Amount,
-		&i.Vtxo.PoolTx,
-		&i.Vtxo.SpentBy,
-		&i.Vtxo.Spent,
-		&i.Vtxo.Redeemed,
-		&i.Vtxo.Swept,
-		&i.Vtxo.ExpireAt,
-		&i.Vtxo.PaymentID,
-		&i.Vtxo.RedeemTx,
-		&i.UncondForfeitTxVw.ID,
-		&i.UncondForfeitTxVw.Tx,
-		&i.UncondForfeitTxVw.VtxoTxid,
-		&i.UncondForfeitTxVw.VtxoVout,
-		&i.UncondForfeitTxVw.Position,
-	)
-	return i, err
+func (q *Queries) SelectVtxoByOutpoint(ctx context.Context, arg SelectVtxoByOutpointParams) ([]SelectVtxoByOutpointRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectVtxoByOutpoint, arg.Txid, arg.Vout)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectVtxoByOutpointRow
+	for rows.Next() {
+		var i SelectVtxoByOutpointRow
+		if err := rows.Scan(
+			&i.Vtxo.Txid,
+			&i.Vtxo.Vout,
+			&i.Vtxo.Pubkey,
+			&i.Vtxo.Amount,
+			&i.Vtxo.PoolTx,
+			&i.Vtxo.SpentBy,
+			&i.Vtxo.Spent,
+			&i.Vtxo.Redeemed,
+			&i.Vtxo.Swept,
+			&i.Vtxo.ExpireAt,
+			&i.Vtxo.PaymentID,
+			&i.Vtxo.RedeemTx,
+			&i.UncondForfeitTxVw.ID,
+			&i.UncondForfeitTxVw.Tx,
+			&i.UncondForfeitTxVw.VtxoTxid,
+			&i.UncondForfeitTxVw.VtxoVout,
+			&i.UncondForfeitTxVw.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const selectVtxosByPoolTxid = `-- name: SelectVtxosByPoolTxid :many
@@ -711,6 +1677,103 @@ func (q *Queries) SelectVtxosByPoolTxid(ctx context.Context, poolTx string) ([]S
 	return items, nil
 }
 
+const selectVtxosWithFilter = `-- name: SelectVtxosWithFilter :many
+SELECT  vtxo.txid, vtxo.vout, vtxo.pubkey, vtxo.amount, vtxo.pool_tx, vtxo.spent_by, vtxo.spent, vtxo.redeemed, vtxo.swept, vtxo.expire_at, vtxo.payment_id, vtxo.redeem_tx,
+        uncond_forfeit_tx_vw.id, uncond_forfeit_tx_vw.tx, uncond_forfeit_tx_vw.vtxo_txid, uncond_forfeit_tx_vw.vtxo_vout, uncond_forfeit_tx_vw.position
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE (vtxo.txid, vtxo.vout) IN (
+    SELECT v.txid, v.vout FROM vtxo v
+    WHERE (?1 IS NULL OR v.pubkey = ?1)
+        AND (?2 IS NULL OR v.spent = ?2)
+        AND (?3 IS NULL OR v.swept = ?3)
+        AND (?4 IS NULL OR v.redeemed = ?4)
+        AND (?5 IS NULL OR v.amount >= ?5)
+        AND (?6 IS NULL OR v.amount <= ?6)
+        AND (?7 IS NULL OR v.expire_at >= ?7)
+        AND (?8 IS NULL OR v.expire_at <= ?8)
+        AND (?9 IS NULL OR v.pool_tx = ?9)
+        AND (v.txid > ?10 OR (v.txid = ?10 AND v.vout > ?11))
+    ORDER BY v.txid, v.vout
+    LIMIT ?12
+)
+ORDER BY vtxo.txid, vtxo.vout
+`
+
+type SelectVtxosWithFilterParams struct {
+	Pubkey      interface{}
+	Spent       interface{}
+	Swept       interface{}
+	Redeemed    interface{}
+	MinAmount   interface{}
+	MaxAmount   interface{}
+	MinExpireAt interface{}
+	MaxExpireAt interface{}
+	PoolTx      interface{}
+	AfterTxid   string
+	AfterVout   int64
+	Limit       int64
+}
+
+type SelectVtxosWithFilterRow struct {
+	Vtxo              Vtxo
+	UncondForfeitTxVw UncondForfeitTxVw
+}
+
+func (q *Queries) SelectVtxosWithFilter(ctx context.Context, arg SelectVtxosWithFilterParams) ([]SelectVtxosWithFilterRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectVtxosWithFilter,
+		arg.Pubkey,
+		arg.Spent,
+		arg.Swept,
+		arg.Redeemed,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.MinExpireAt,
+		arg.MaxExpireAt,
+		arg.PoolTx,
+		arg.AfterTxid,
+		arg.AfterVout,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectVtxosWithFilterRow
+	for rows.Next() {
+		var i SelectVtxosWithFilterRow
+		if err := rows.Scan(
+			&i.Vtxo.Txid,
+			&i.Vtxo.Vout,
+			&i.Vtxo.Pubkey,
+			&i.Vtxo.Amount,
+			&i.Vtxo.PoolTx,
+			&i.Vtxo.SpentBy,
+			&i.Vtxo.Spent,
+			&i.Vtxo.Redeemed,
+			&i.Vtxo.Swept,
+			&i.Vtxo.ExpireAt,
+			&i.Vtxo.PaymentID,
+			&i.Vtxo.RedeemTx,
+			&i.UncondForfeitTxVw.ID,
+			&i.UncondForfeitTxVw.Tx,
+			&i.UncondForfeitTxVw.VtxoTxid,
+			&i.UncondForfeitTxVw.VtxoVout,
+			&i.UncondForfeitTxVw.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateVtxoExpireAt = `-- name: UpdateVtxoExpireAt :exec
 UPDATE vtxo SET expire_at = ? WHERE txid = ? AND vout = ?
 `
@@ -741,18 +1804,115 @@ func (q *Queries) UpdateVtxoPaymentId(ctx context.Context, arg UpdateVtxoPayment
 	return err
 }
 
+const upsertAsyncPaymentRequest = `-- name: UpsertAsyncPaymentRequest :exec
+INSERT INTO async_payment_request (txid, vout, expire_at) VALUES (?, ?, ?)
+ON CONFLICT(txid, vout) DO UPDATE SET expire_at = EXCLUDED.expire_at
+`
+
+type UpsertAsyncPaymentRequestParams struct {
+	Txid     string
+	Vout     int64
+	ExpireAt int64
+}
+
+func (q *Queries) UpsertAsyncPaymentRequest(ctx context.Context, arg UpsertAsyncPaymentRequestParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAsyncPaymentRequest, arg.Txid, arg.Vout, arg.ExpireAt)
+	return err
+}
+
+const upsertMonitoredTx = `-- name: UpsertMonitoredTx :exec
+INSERT INTO monitored_tx (txid, type, tx_hex, broadcasted_at, bumped_at, fee_rate, child_txid, confirmed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(txid) DO UPDATE SET
+    type = EXCLUDED.type,
+    tx_hex = EXCLUDED.tx_hex,
+    broadcasted_at = EXCLUDED.broadcasted_at,
+    bumped_at = EXCLUDED.bumped_at,
+    fee_rate = EXCLUDED.fee_rate,
+    child_txid = EXCLUDED.child_txid,
+    confirmed_at = EXCLUDED.confirmed_at
+`
+
+type UpsertMonitoredTxParams struct {
+	Txid          string
+	Type          string
+	TxHex         string
+	BroadcastedAt int64
+	BumpedAt      int64
+	FeeRate       int64
+	ChildTxid     string
+	ConfirmedAt   int64
+}
+
+func (q *Queries) UpsertMonitoredTx(ctx context.Context, arg UpsertMonitoredTxParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMonitoredTx,
+		arg.Txid,
+		arg.Type,
+		arg.TxHex,
+		arg.BroadcastedAt,
+		arg.BumpedAt,
+		arg.FeeRate,
+		arg.ChildTxid,
+		arg.ConfirmedAt,
+	)
+	return err
+}
+
+const upsertOffender = `-- name: UpsertOffender :exec
+INSERT INTO offender (id, banned_until) VALUES (?, ?)
+ON CONFLICT(id) DO UPDATE SET banned_until = EXCLUDED.banned_until
+`
+
+type UpsertOffenderParams struct {
+	ID          string
+	BannedUntil int64
+}
+
+func (q *Queries) UpsertOffender(ctx context.Context, arg UpsertOffenderParams) error {
+	_, err := q.db.ExecContext(ctx, upsertOffender, arg.ID, arg.BannedUntil)
+	return err
+}
+
 const upsertPayment = `-- name: UpsertPayment :exec
-INSERT INTO payment (id, round_id) VALUES (?, ?)
-ON CONFLICT(id) DO UPDATE SET round_id = EXCLUDED.round_id
+INSERT INTO payment (id, round_id, fee) VALUES (?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    round_id = EXCLUDED.round_id,
+    fee = EXCLUDED.fee
 `
 
 type UpsertPaymentParams struct {
 	ID      string
 	RoundID string
+	Fee     int64
 }
 
 func (q *Queries) UpsertPayment(ctx context.Context, arg UpsertPaymentParams) error {
-	_, err := q.db.ExecContext(ctx, upsertPayment, arg.ID, arg.RoundID)
+	_, err := q.db.ExecContext(ctx, upsertPayment, arg.ID, arg.RoundID, arg.Fee)
+	return err
+}
+
+const upsertPaymentRequest = `-- name: UpsertPaymentRequest :exec
+INSERT INTO payment_request (id, ephemeral_pubkey, timestamp, fee) VALUES (?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    ephemeral_pubkey = EXCLUDED.ephemeral_pubkey,
+    timestamp = EXCLUDED.timestamp,
+    fee = EXCLUDED.fee
+`
+
+type UpsertPaymentRequestParams struct {
+	ID              string
+	EphemeralPubkey string
+	Timestamp       int64
+	Fee             int64
+}
+
+func (q *Queries) UpsertPaymentRequest(ctx context.Context, arg UpsertPaymentRequestParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPaymentRequest,
+		arg.ID,
+		arg.EphemeralPubkey,
+		arg.Timestamp,
+		arg.Fee,
+	)
 	return err
 }
 
@@ -842,6 +2002,38 @@ func (q *Queries) UpsertRound(ctx context.Context, arg UpsertRoundParams) error
 	return err
 }
 
+const upsertSweep = `-- name: UpsertSweep :exec
+INSERT INTO sweep (id, round_txid, scheduled_at, status, attempted_at, error)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    round_txid = EXCLUDED.round_txid,
+    scheduled_at = EXCLUDED.scheduled_at,
+    status = EXCLUDED.status,
+    attempted_at = EXCLUDED.attempted_at,
+    error = EXCLUDED.error
+`
+
+type UpsertSweepParams struct {
+	ID          string
+	RoundTxid   string
+	ScheduledAt int64
+	Status      string
+	AttemptedAt int64
+	Error       string
+}
+
+func (q *Queries) UpsertSweep(ctx context.Context, arg UpsertSweepParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSweep,
+		arg.ID,
+		arg.RoundTxid,
+		arg.ScheduledAt,
+		arg.Status,
+		arg.AttemptedAt,
+		arg.Error,
+	)
+	return err
+}
+
 const upsertTransaction = `-- name: UpsertTransaction :exec
 INSERT INTO tx (
     tx, round_id, type, position, txid, tree_level, parent_txid, is_leaf
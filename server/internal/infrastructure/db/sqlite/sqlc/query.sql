@@ -40,8 +40,10 @@ ON CONFLICT(id) DO UPDATE SET
     swept = EXCLUDED.swept;
 
 -- name: UpsertPayment :exec
-INSERT INTO payment (id, round_id) VALUES (?, ?)
-ON CONFLICT(id) DO UPDATE SET round_id = EXCLUDED.round_id;
+INSERT INTO payment (id, round_id, fee) VALUES (?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    round_id = EXCLUDED.round_id,
+    fee = EXCLUDED.fee;
 
 -- name: UpsertReceiver :exec
 INSERT INTO receiver (payment_id, pubkey, amount, onchain_address) VALUES (?, ?, ?, ?)
@@ -53,6 +55,21 @@ ON CONFLICT(payment_id, pubkey) DO UPDATE SET
 -- name: UpdateVtxoPaymentId :exec
 UPDATE vtxo SET payment_id = ? WHERE txid = ? AND vout = ?;
 
+-- name: ResetVtxosPaymentId :exec
+UPDATE vtxo SET payment_id = NULL WHERE payment_id = ?;
+
+-- name: SelectRoundPaymentIds :many
+SELECT id FROM payment WHERE round_id = ?;
+
+-- name: DeletePaymentReceivers :exec
+DELETE FROM receiver WHERE payment_id = ?;
+
+-- name: DeletePayment :exec
+DELETE FROM payment WHERE id = ?;
+
+-- name: DeleteRoundTxs :exec
+DELETE FROM tx WHERE round_id = ?;
+
 -- name: SelectRoundWithRoundId :many
 SELECT sqlc.embed(round),
        sqlc.embed(round_payment_vw),
@@ -111,6 +128,21 @@ SELECT id FROM round WHERE starting_timestamp > ? AND starting_timestamp < ?;
 -- name: SelectRoundIds :many
 SELECT id FROM round;
 
+-- name: SelectEndedRoundIdsWithFilter :many
+SELECT id, starting_timestamp FROM round
+WHERE ended = true
+    AND (sqlc.narg('started_after') IS NULL OR starting_timestamp > sqlc.narg('started_after'))
+    AND (sqlc.narg('started_before') IS NULL OR starting_timestamp < sqlc.narg('started_before'))
+    AND (starting_timestamp > sqlc.arg('after_timestamp') OR (starting_timestamp = sqlc.arg('after_timestamp') AND id > sqlc.arg('after_id')))
+ORDER BY starting_timestamp, id
+LIMIT sqlc.arg('limit');
+
+-- name: SelectUnfinishedRoundIds :many
+SELECT id FROM round WHERE ended = false AND failed = false;
+
+-- name: SelectFailedRoundIds :many
+SELECT id FROM round WHERE failed = true;
+
 -- name: UpsertUnconditionalForfeitTx :exec
 INSERT INTO uncond_forfeit_tx (tx, vtxo_txid, vtxo_vout, position)
 VALUES (?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET
@@ -153,7 +185,32 @@ FROM vtxo
         LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
 WHERE redeemed = false AND pubkey = ?;
 
--- name: SelectVtxoByOutpoint :one
+-- name: SelectAllVtxoKeys :many
+SELECT txid, vout FROM vtxo ORDER BY txid, vout;
+
+-- name: SelectVtxosWithFilter :many
+SELECT  sqlc.embed(vtxo),
+        sqlc.embed(uncond_forfeit_tx_vw)
+FROM vtxo
+        LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
+WHERE (vtxo.txid, vtxo.vout) IN (
+    SELECT v.txid, v.vout FROM vtxo v
+    WHERE (sqlc.narg('pubkey') IS NULL OR v.pubkey = sqlc.narg('pubkey'))
+        AND (sqlc.narg('spent') IS NULL OR v.spent = sqlc.narg('spent'))
+        AND (sqlc.narg('swept') IS NULL OR v.swept = sqlc.narg('swept'))
+        AND (sqlc.narg('redeemed') IS NULL OR v.redeemed = sqlc.narg('redeemed'))
+        AND (sqlc.narg('min_amount') IS NULL OR v.amount >= sqlc.narg('min_amount'))
+        AND (sqlc.narg('max_amount') IS NULL OR v.amount <= sqlc.narg('max_amount'))
+        AND (sqlc.narg('min_expire_at') IS NULL OR v.expire_at >= sqlc.narg('min_expire_at'))
+        AND (sqlc.narg('max_expire_at') IS NULL OR v.expire_at <= sqlc.narg('max_expire_at'))
+        AND (sqlc.narg('pool_tx') IS NULL OR v.pool_tx = sqlc.narg('pool_tx'))
+        AND (v.txid > sqlc.arg('after_txid') OR (v.txid = sqlc.arg('after_txid') AND v.vout > sqlc.arg('after_vout')))
+    ORDER BY v.txid, v.vout
+    LIMIT sqlc.arg('limit')
+)
+ORDER BY vtxo.txid, vtxo.vout;
+
+-- name: SelectVtxoByOutpoint :many
 SELECT  sqlc.embed(vtxo),
         sqlc.embed(uncond_forfeit_tx_vw)
 FROM vtxo
@@ -167,6 +224,12 @@ FROM vtxo
         LEFT OUTER JOIN uncond_forfeit_tx_vw ON vtxo.txid=uncond_forfeit_tx_vw.vtxo_txid AND vtxo.vout=uncond_forfeit_tx_vw.vtxo_vout
 WHERE pool_tx = ?;
 
+-- name: MarkVtxoAsNotRedeemed :exec
+UPDATE vtxo SET redeemed = false WHERE txid = ? AND vout = ?;
+
+-- name: MarkVtxoAsNotSwept :exec
+UPDATE vtxo SET swept = false WHERE txid = ? AND vout = ?;
+
 -- name: MarkVtxoAsRedeemed :exec
 UPDATE vtxo SET redeemed = true WHERE txid = ? AND vout = ?;
 
@@ -178,3 +241,158 @@ UPDATE vtxo SET spent = true, spent_by = ? WHERE txid = ? AND vout = ?;
 
 -- name: UpdateVtxoExpireAt :exec
 UPDATE vtxo SET expire_at = ? WHERE txid = ? AND vout = ?;
+
+-- name: UpsertOffender :exec
+INSERT INTO offender (id, banned_until) VALUES (?, ?)
+ON CONFLICT(id) DO UPDATE SET banned_until = EXCLUDED.banned_until;
+
+-- name: InsertStrike :exec
+INSERT INTO strike (offender_id, reason, round_id, timestamp) VALUES (?, ?, ?, ?);
+
+-- name: DeleteOffenderStrikes :exec
+DELETE FROM strike WHERE offender_id = ?;
+
+-- name: SelectOffender :many
+SELECT sqlc.embed(offender),
+       sqlc.embed(offender_strike_vw)
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+WHERE offender.id = ?
+ORDER BY offender_strike_vw.id;
+
+-- name: SelectBannedOffenders :many
+SELECT sqlc.embed(offender),
+       sqlc.embed(offender_strike_vw)
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+WHERE offender.banned_until > ?
+ORDER BY offender_strike_vw.id;
+
+-- name: SelectAllOffenders :many
+SELECT sqlc.embed(offender),
+       sqlc.embed(offender_strike_vw)
+FROM offender
+         LEFT OUTER JOIN offender_strike_vw ON offender.id=offender_strike_vw.offender_id
+ORDER BY offender_strike_vw.id;
+
+-- name: UpsertPaymentRequest :exec
+INSERT INTO payment_request (id, ephemeral_pubkey, timestamp, fee) VALUES (?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    ephemeral_pubkey = EXCLUDED.ephemeral_pubkey,
+    timestamp = EXCLUDED.timestamp,
+    fee = EXCLUDED.fee;
+
+-- name: InsertPaymentRequestInput :exec
+INSERT INTO payment_request_input (request_id, txid, vout) VALUES (?, ?, ?);
+
+-- name: InsertPaymentRequestReceiver :exec
+INSERT INTO payment_request_receiver (request_id, pubkey, amount, onchain_address)
+VALUES (?, ?, ?, ?);
+
+-- name: DeletePaymentRequestInputs :exec
+DELETE FROM payment_request_input WHERE request_id = ?;
+
+-- name: DeletePaymentRequestReceivers :exec
+DELETE FROM payment_request_receiver WHERE request_id = ?;
+
+-- name: DeletePaymentRequest :exec
+DELETE FROM payment_request WHERE id = ?;
+
+-- name: SelectPaymentRequests :many
+SELECT * FROM payment_request;
+
+-- name: SelectPaymentRequestInputs :many
+SELECT payment_request_input.request_id,
+       sqlc.embed(vtxo)
+FROM payment_request_input
+         INNER JOIN vtxo ON payment_request_input.txid=vtxo.txid AND payment_request_input.vout=vtxo.vout;
+
+-- name: SelectPaymentRequestReceivers :many
+SELECT * FROM payment_request_receiver ORDER BY id;
+
+-- name: UpsertAsyncPaymentRequest :exec
+INSERT INTO async_payment_request (txid, vout, expire_at) VALUES (?, ?, ?)
+ON CONFLICT(txid, vout) DO UPDATE SET expire_at = EXCLUDED.expire_at;
+
+-- name: InsertAsyncPaymentRequestReceiver :exec
+INSERT INTO async_payment_request_receiver (txid, vout, pubkey, amount, onchain_address)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: DeleteAsyncPaymentRequestReceivers :exec
+DELETE FROM async_payment_request_receiver WHERE txid = ? AND vout = ?;
+
+-- name: DeleteAsyncPaymentRequest :exec
+DELETE FROM async_payment_request WHERE txid = ? AND vout = ?;
+
+-- name: SelectAsyncPaymentRequests :many
+SELECT sqlc.embed(async_payment_request),
+       sqlc.embed(async_payment_request_receiver)
+FROM async_payment_request
+         INNER JOIN async_payment_request_receiver ON async_payment_request.txid=async_payment_request_receiver.txid AND async_payment_request.vout=async_payment_request_receiver.vout
+ORDER BY async_payment_request_receiver.id;
+
+-- name: UpsertSweep :exec
+INSERT INTO sweep (id, round_txid, scheduled_at, status, attempted_at, error)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    round_txid = EXCLUDED.round_txid,
+    scheduled_at = EXCLUDED.scheduled_at,
+    status = EXCLUDED.status,
+    attempted_at = EXCLUDED.attempted_at,
+    error = EXCLUDED.error;
+
+-- name: InsertSweepOutput :exec
+INSERT INTO sweep_output (sweep_id, txid, vout, amount, sweep_txid) VALUES (?, ?, ?, ?, ?);
+
+-- name: DeleteSweepOutputs :exec
+DELETE FROM sweep_output WHERE sweep_id = ?;
+
+-- name: SelectSweep :many
+SELECT sqlc.embed(sweep),
+       sqlc.embed(sweep_output_vw)
+FROM sweep
+         LEFT OUTER JOIN sweep_output_vw ON sweep.id=sweep_output_vw.sweep_id
+WHERE sweep.id = ?
+ORDER BY sweep_output_vw.id;
+
+-- name: SelectAllSweeps :many
+SELECT sqlc.embed(sweep),
+       sqlc.embed(sweep_output_vw)
+FROM sweep
+         LEFT OUTER JOIN sweep_output_vw ON sweep.id=sweep_output_vw.sweep_id
+ORDER BY sweep.scheduled_at, sweep_output_vw.id;
+
+-- name: InsertRoundEvent :exec
+INSERT INTO round_event (round_id, type, event) VALUES (?, ?, ?);
+
+-- name: SelectRoundEvents :many
+SELECT * FROM round_event WHERE round_id = ? ORDER BY id;
+
+-- name: UpsertMonitoredTx :exec
+INSERT INTO monitored_tx (txid, type, tx_hex, broadcasted_at, bumped_at, fee_rate, child_txid, confirmed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(txid) DO UPDATE SET
+    type = EXCLUDED.type,
+    tx_hex = EXCLUDED.tx_hex,
+    broadcasted_at = EXCLUDED.broadcasted_at,
+    bumped_at = EXCLUDED.bumped_at,
+    fee_rate = EXCLUDED.fee_rate,
+    child_txid = EXCLUDED.child_txid,
+    confirmed_at = EXCLUDED.confirmed_at;
+
+-- name: InsertMonitoredTxSweepInput :exec
+INSERT INTO monitored_tx_sweep_input (monitored_txid, txid, vout, amount, leaf_script, control_block, internal_key)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteMonitoredTxSweepInputs :exec
+DELETE FROM monitored_tx_sweep_input WHERE monitored_txid = ?;
+
+-- name: DeleteMonitoredTx :exec
+DELETE FROM monitored_tx WHERE txid = ?;
+
+-- name: SelectAllMonitoredTxs :many
+SELECT sqlc.embed(monitored_tx),
+       sqlc.embed(monitored_tx_sweep_input_vw)
+FROM monitored_tx
+         LEFT OUTER JOIN monitored_tx_sweep_input_vw ON monitored_tx.txid=monitored_tx_sweep_input_vw.monitored_txid
+ORDER BY monitored_tx.broadcasted_at, monitored_tx_sweep_input_vw.id;
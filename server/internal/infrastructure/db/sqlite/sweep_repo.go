@@ -0,0 +1,157 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/db/sqlite/sqlc/queries"
+)
+
+type sweepRepository struct {
+	db      *sql.DB
+	querier *queries.Queries
+}
+
+func NewSweepRepository(config ...interface{}) (domain.SweepRepository, error) {
+	if len(config) != 1 {
+		return nil, fmt.Errorf("invalid config")
+	}
+	db, ok := config[0].(*sql.DB)
+	if !ok {
+		return nil, fmt.Errorf("cannot open sweep repository: invalid config, expected db at 0")
+	}
+
+	return &sweepRepository{
+		db:      db,
+		querier: queries.New(db),
+	}, nil
+}
+
+func (r *sweepRepository) Close() {
+	_ = r.db.Close()
+}
+
+func (r *sweepRepository) AddOrUpdateSweep(
+	ctx context.Context, sweep domain.Sweep,
+) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		if err := querierWithTx.UpsertSweep(
+			ctx, queries.UpsertSweepParams{
+				ID:          sweep.Id,
+				RoundTxid:   sweep.RoundTxid,
+				ScheduledAt: sweep.ScheduledAt,
+				Status:      string(sweep.Status),
+				AttemptedAt: sweep.AttemptedAt,
+				Error:       sweep.Error,
+			},
+		); err != nil {
+			return fmt.Errorf("failed to upsert sweep: %w", err)
+		}
+
+		if err := querierWithTx.DeleteSweepOutputs(ctx, sweep.Id); err != nil {
+			return fmt.Errorf("failed to delete sweep outputs: %w", err)
+		}
+
+		for _, output := range sweep.Outputs {
+			if err := querierWithTx.InsertSweepOutput(
+				ctx, queries.InsertSweepOutputParams{
+					SweepID:   sweep.Id,
+					Txid:      output.Txid,
+					Vout:      int64(output.VOut),
+					Amount:    int64(output.Amount),
+					SweepTxid: output.SweepTxid,
+				},
+			); err != nil {
+				return fmt.Errorf("failed to insert sweep output: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, r.db, txBody)
+}
+
+func (r *sweepRepository) GetSweep(
+	ctx context.Context, id string,
+) (*domain.Sweep, error) {
+	rows, err := r.querier.SelectSweep(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sos := make([]sweepOutputRow, 0, len(rows))
+	for _, row := range rows {
+		sos = append(sos, sweepOutputRow{
+			sweep:  row.Sweep,
+			output: row.SweepOutputVw,
+		})
+	}
+
+	sweeps := readSweepRows(sos)
+	if len(sweeps) <= 0 {
+		return nil, fmt.Errorf("sweep %s not found", id)
+	}
+	return &sweeps[0], nil
+}
+
+func (r *sweepRepository) GetAllSweeps(
+	ctx context.Context,
+) ([]domain.Sweep, error) {
+	rows, err := r.querier.SelectAllSweeps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sos := make([]sweepOutputRow, 0, len(rows))
+	for _, row := range rows {
+		sos = append(sos, sweepOutputRow{
+			sweep:  row.Sweep,
+			output: row.SweepOutputVw,
+		})
+	}
+
+	return readSweepRows(sos), nil
+}
+
+type sweepOutputRow struct {
+	sweep  queries.Sweep
+	output queries.SweepOutputVw
+}
+
+func readSweepRows(rows []sweepOutputRow) []domain.Sweep {
+	sweeps := make([]domain.Sweep, 0)
+	sweepsById := make(map[string]int)
+
+	for _, row := range rows {
+		i, ok := sweepsById[row.sweep.ID]
+		if !ok {
+			sweeps = append(sweeps, domain.Sweep{
+				Id:          row.sweep.ID,
+				RoundTxid:   row.sweep.RoundTxid,
+				ScheduledAt: row.sweep.ScheduledAt,
+				Status:      domain.SweepStatus(row.sweep.Status),
+				AttemptedAt: row.sweep.AttemptedAt,
+				Outputs:     make([]domain.SweepOutput, 0),
+				Error:       row.sweep.Error,
+			})
+			i = len(sweeps) - 1
+			sweepsById[row.sweep.ID] = i
+		}
+
+		if row.output.ID.Valid {
+			sweeps[i].Outputs = append(sweeps[i].Outputs, domain.SweepOutput{
+				VtxoKey: domain.VtxoKey{
+					Txid: row.output.Txid.String,
+					VOut: uint32(row.output.Vout.Int64),
+				},
+				Amount:    uint64(row.output.Amount.Int64),
+				SweepTxid: row.output.SweepTxid.String,
+			})
+		}
+	}
+
+	return sweeps
+}
@@ -34,6 +34,19 @@ func OpenDb(dbPath string) (*sql.DB, error) {
 	return db, nil
 }
 
+// Backup writes a consistent copy of the given db to the given path, the
+// file must not exist.
+func Backup(ctx context.Context, db *sql.DB, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("failed to backup db: %w", err)
+	}
+	return nil
+}
+
 func extendArray[T any](arr []T, position int) []T {
 	if arr == nil {
 		return make([]T, position+1)
@@ -158,12 +158,14 @@ func (v *vxtoRepository) GetVtxos(ctx context.Context, outpoints []domain.VtxoKe
 			return nil, err
 		}
 
-		result, err := readRows([]vtxoWithUnconditionalForfeitTxs{
-			{
-				vtxo: res.Vtxo,
-				tx:   res.UncondForfeitTxVw,
-			},
-		})
+		rows := make([]vtxoWithUnconditionalForfeitTxs, 0, len(res))
+		for _, row := range res {
+			rows = append(rows, vtxoWithUnconditionalForfeitTxs{
+				vtxo: row.Vtxo,
+				tx:   row.UncondForfeitTxVw,
+			})
+		}
+		result, err := readRows(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -178,6 +180,89 @@ func (v *vxtoRepository) GetVtxos(ctx context.Context, outpoints []domain.VtxoKe
 	return vtxos, nil
 }
 
+func (v *vxtoRepository) ListVtxos(
+	ctx context.Context, filter domain.VtxoFilter, page domain.Page,
+) ([]domain.Vtxo, string, error) {
+	params := queries.SelectVtxosWithFilterParams{Limit: -1}
+	if len(page.Cursor) > 0 {
+		after, err := domain.ParseVtxoCursor(page.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		params.AfterTxid = after.Txid
+		params.AfterVout = int64(after.VOut)
+	}
+	// One more vtxo is fetched to know whether there's a next page.
+	if page.Size > 0 {
+		params.Limit = int64(page.Size) + 1
+	}
+	if len(filter.Pubkey) > 0 {
+		params.Pubkey = filter.Pubkey
+	}
+	if filter.Spent != nil {
+		params.Spent = *filter.Spent
+	}
+	if filter.Swept != nil {
+		params.Swept = *filter.Swept
+	}
+	if filter.Redeemed != nil {
+		params.Redeemed = *filter.Redeemed
+	}
+	if filter.MinAmount > 0 {
+		params.MinAmount = int64(filter.MinAmount)
+	}
+	if filter.MaxAmount > 0 {
+		params.MaxAmount = int64(filter.MaxAmount)
+	}
+	if filter.MinExpireAt > 0 {
+		params.MinExpireAt = filter.MinExpireAt
+	}
+	if filter.MaxExpireAt > 0 {
+		params.MaxExpireAt = filter.MaxExpireAt
+	}
+	if len(filter.RoundTxid) > 0 {
+		params.PoolTx = filter.RoundTxid
+	}
+
+	res, err := v.querier.SelectVtxosWithFilter(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+	rows := make([]vtxoWithUnconditionalForfeitTxs, 0, len(res))
+	for _, row := range res {
+		rows = append(rows, vtxoWithUnconditionalForfeitTxs{
+			vtxo: row.Vtxo,
+			tx:   row.UncondForfeitTxVw,
+		})
+	}
+	vtxos, err := readRows(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if page.Size <= 0 || len(vtxos) <= page.Size {
+		return vtxos, "", nil
+	}
+	vtxos = vtxos[:page.Size]
+	return vtxos, domain.NewVtxoCursor(vtxos[page.Size-1].VtxoKey), nil
+}
+
+func (v *vxtoRepository) GetAllVtxoKeys(ctx context.Context) ([]domain.VtxoKey, error) {
+	rows, err := v.querier.SelectAllVtxoKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]domain.VtxoKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, domain.VtxoKey{
+			Txid: row.Txid,
+			VOut: uint32(row.Vout),
+		})
+	}
+	return keys, nil
+}
+
 func (v *vxtoRepository) GetVtxosForRound(ctx context.Context, txid string) ([]domain.Vtxo, error) {
 	res, err := v.querier.SelectVtxosByPoolTxid(ctx, txid)
 	if err != nil {
@@ -255,6 +340,46 @@ func (v *vxtoRepository) SweepVtxos(ctx context.Context, vtxos []domain.VtxoKey)
 	return execTx(ctx, v.db, txBody)
 }
 
+func (v *vxtoRepository) UnredeemVtxos(ctx context.Context, vtxos []domain.VtxoKey) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		for _, vtxo := range vtxos {
+			if err := querierWithTx.MarkVtxoAsNotRedeemed(
+				ctx,
+				queries.MarkVtxoAsNotRedeemedParams{
+					Txid: vtxo.Txid,
+					Vout: int64(vtxo.VOut),
+				},
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, v.db, txBody)
+}
+
+func (v *vxtoRepository) UnsweepVtxos(ctx context.Context, vtxos []domain.VtxoKey) error {
+	txBody := func(querierWithTx *queries.Queries) error {
+		for _, vtxo := range vtxos {
+			if err := querierWithTx.MarkVtxoAsNotSwept(
+				ctx,
+				queries.MarkVtxoAsNotSweptParams{
+					Txid: vtxo.Txid,
+					Vout: int64(vtxo.VOut),
+				},
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return execTx(ctx, v.db, txBody)
+}
+
 func (v *vxtoRepository) UpdateExpireAt(ctx context.Context, vtxos []domain.VtxoKey, expireAt int64) error {
 	txBody := func(querierWithTx *queries.Queries) error {
 		for _, vtxo := range vtxos {
@@ -332,11 +457,18 @@ func readRows(rows []vtxoWithUnconditionalForfeitTxs) ([]domain.Vtxo, error) {
 		}
 	}
 	vtxos := make([]domain.Vtxo, 0, len(rows))
+	// A vtxo is joined with each of its unconditional forfeit txs, hence it
+	// can show up in more than one row.
+	seen := make(map[domain.VtxoKey]struct{})
 	for _, row := range rows {
 		vtxoKey := domain.VtxoKey{
 			Txid: row.vtxo.Txid,
 			VOut: uint32(row.vtxo.Vout),
 		}
+		if _, ok := seen[vtxoKey]; ok {
+			continue
+		}
+		seen[vtxoKey] = struct{}{}
 		uncondForfeitTxs := uncondForfeitTxsMap[vtxoKey]
 		vtxos = append(vtxos, rowToVtxo(row.vtxo, uncondForfeitTxs))
 	}
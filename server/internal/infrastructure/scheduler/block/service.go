@@ -0,0 +1,170 @@
+package blockscheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+// lockTimeThreshold is the value below which the time of a one-off task is
+// a block height rather than a unix timestamp, like for tx locktimes.
+const lockTimeThreshold = 500_000_000
+
+// service is a scheduler driven by the new blocks notified by the scanner.
+// Tasks are run as soon as the chain tip reaches their scheduled time, that is
+// when a relative timelock expiring at that time becomes spendable in the next
+// block. The time is either a block height or a median time past.
+type service struct {
+	scanner ports.BlockchainScanner
+
+	tasks  []*task
+	tip    ports.BlockInfo
+	lock   *sync.Mutex
+	cancel context.CancelFunc
+}
+
+type task struct {
+	// at is the height or the median time at which the task is run. Recurring
+	// tasks are always scheduled by median time, those scheduled before any
+	// block is notified have it zero, and get it set relative to the next one.
+	at int64
+	// interval is zero for one-off tasks.
+	interval int64
+	run      func()
+}
+
+func NewScheduler(scanner ports.BlockchainScanner) ports.SchedulerService {
+	return &service{
+		scanner: scanner,
+		tasks:   make([]*task, 0),
+		lock:    &sync.Mutex{},
+	}
+}
+
+func (s *service) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	blocks, err := s.scanner.GetBlockNotificationChannel(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to listen for new blocks: %s", err)
+	}
+
+	s.cancel = cancel
+	go func() {
+		for block := range blocks {
+			s.onBlock(block)
+		}
+	}()
+	return nil
+}
+
+func (s *service) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func (s *service) ScheduleTask(interval int64, immediate bool, run func()) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid interval, must be greater than 0")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	t := &task{interval: interval, run: run}
+	if s.tip.MedianTime > 0 {
+		t.at = s.tip.MedianTime + interval
+	}
+	s.tasks = append(s.tasks, t)
+
+	if immediate {
+		go run()
+	}
+	return nil
+}
+
+func (s *service) ScheduleTaskOnce(at int64, run func()) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// the time is already reached by the chain, no need to wait for the
+	// next block
+	t := &task{at: at, run: run}
+	if s.tip.MedianTime > 0 && t.isDue(s.tip) {
+		go run()
+		return nil
+	}
+
+	s.tasks = append(s.tasks, t)
+	return nil
+}
+
+func (s *service) Unit() ports.TimeUnit {
+	return ports.MedianTime
+}
+
+// Now returns the median time past of the chain tip. Until the first block is
+// notified it falls back to the wall clock, which is ahead of the median time
+// past, so that the tasks scheduled relative to it are run late rather than
+// early.
+func (s *service) Now() int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.tip.MedianTime <= 0 {
+		return time.Now().Unix()
+	}
+	return s.tip.MedianTime
+}
+
+// onBlock updates the chain tip and runs the tasks whose time is reached,
+// rescheduling the recurring ones.
+func (s *service) onBlock(block ports.BlockInfo) {
+	s.lock.Lock()
+
+	s.tip = block
+	due := make([]func(), 0)
+	pending := make([]*task, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		if t.interval > 0 && t.at == 0 {
+			t.at = block.MedianTime + t.interval
+			pending = append(pending, t)
+			continue
+		}
+		if !t.isDue(block) {
+			pending = append(pending, t)
+			continue
+		}
+
+		due = append(due, t.run)
+		if t.interval > 0 {
+			t.at = block.MedianTime + t.interval
+			pending = append(pending, t)
+		}
+	}
+	s.tasks = pending
+
+	s.lock.Unlock()
+
+	log.Debugf(
+		"block %d (median time %d), running %d scheduled tasks",
+		block.Height, block.MedianTime, len(due),
+	)
+	for _, run := range due {
+		go run()
+	}
+}
+
+// isDue returns whether the time of the task is reached by the given block.
+func (t *task) isDue(block ports.BlockInfo) bool {
+	if t.at < lockTimeThreshold {
+		return block.Height >= t.at
+	}
+	return block.MedianTime >= t.at
+}
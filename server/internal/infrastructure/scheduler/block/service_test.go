@@ -0,0 +1,198 @@
+package blockscheduler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/ports"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	medianTime = int64(1720000000)
+	height     = int64(850000)
+)
+
+func TestStart(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		scanner := newMockedScanner(nil)
+		svc := NewScheduler(scanner)
+
+		require.NoError(t, svc.Start())
+		svc.Stop()
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		scanner := newMockedScanner(fmt.Errorf("no block notifications"))
+		svc := NewScheduler(scanner)
+
+		err := svc.Start()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no block notifications")
+	})
+}
+
+func TestScheduleTaskOnce(t *testing.T) {
+	fixtures := []struct {
+		name      string
+		at        int64
+		notDue    ports.BlockInfo
+		due       ports.BlockInfo
+		alreadyAt ports.BlockInfo
+	}{
+		{
+			name:      "median time",
+			at:        medianTime + 600,
+			notDue:    ports.BlockInfo{Height: height + 1, MedianTime: medianTime + 599},
+			due:       ports.BlockInfo{Height: height + 2, MedianTime: medianTime + 600},
+			alreadyAt: ports.BlockInfo{Height: height, MedianTime: medianTime + 601},
+		},
+		{
+			name:      "height",
+			at:        height + 2,
+			notDue:    ports.BlockInfo{Height: height + 1, MedianTime: medianTime + 600},
+			due:       ports.BlockInfo{Height: height + 2, MedianTime: medianTime + 1200},
+			alreadyAt: ports.BlockInfo{Height: height + 3, MedianTime: medianTime},
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			scanner := newMockedScanner(nil)
+			svc := NewScheduler(scanner)
+			require.NoError(t, svc.Start())
+			defer svc.Stop()
+
+			scanner.notify(ports.BlockInfo{Height: height, MedianTime: medianTime})
+
+			done := make(chan struct{}, 1)
+			err := svc.ScheduleTaskOnce(f.at, func() { done <- struct{}{} })
+			require.NoError(t, err)
+
+			scanner.notify(f.notDue)
+			requireNotRun(t, done)
+
+			scanner.notify(f.due)
+			requireRun(t, done)
+
+			// the task is run only once
+			scanner.notify(f.alreadyAt)
+			requireNotRun(t, done)
+		})
+
+		t.Run(f.name+" already reached", func(t *testing.T) {
+			scanner := newMockedScanner(nil)
+			svc := NewScheduler(scanner)
+			require.NoError(t, svc.Start())
+			defer svc.Stop()
+
+			scanner.notify(f.alreadyAt)
+
+			done := make(chan struct{}, 1)
+			err := svc.ScheduleTaskOnce(f.at, func() { done <- struct{}{} })
+			require.NoError(t, err)
+			requireRun(t, done)
+		})
+	}
+}
+
+func TestScheduleTask(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		scanner := newMockedScanner(nil)
+		svc := NewScheduler(scanner)
+		require.NoError(t, svc.Start())
+		defer svc.Stop()
+
+		done := make(chan struct{}, 1)
+		err := svc.ScheduleTask(600, false, func() { done <- struct{}{} })
+		require.NoError(t, err)
+
+		// the first block sets the time of the task
+		scanner.notify(ports.BlockInfo{Height: height, MedianTime: medianTime})
+		requireNotRun(t, done)
+
+		scanner.notify(ports.BlockInfo{Height: height + 1, MedianTime: medianTime + 599})
+		requireNotRun(t, done)
+
+		scanner.notify(ports.BlockInfo{Height: height + 2, MedianTime: medianTime + 600})
+		requireRun(t, done)
+
+		// the task is rescheduled relative to the block that triggered it
+		scanner.notify(ports.BlockInfo{Height: height + 3, MedianTime: medianTime + 1199})
+		requireNotRun(t, done)
+
+		scanner.notify(ports.BlockInfo{Height: height + 4, MedianTime: medianTime + 1200})
+		requireRun(t, done)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		svc := NewScheduler(newMockedScanner(nil))
+
+		err := svc.ScheduleTask(0, false, func() {})
+		require.Error(t, err)
+	})
+}
+
+func TestNow(t *testing.T) {
+	scanner := newMockedScanner(nil)
+	svc := NewScheduler(scanner)
+	require.NoError(t, svc.Start())
+	defer svc.Stop()
+
+	require.Equal(t, ports.MedianTime, svc.Unit())
+
+	// before any block the wall clock is used
+	require.InDelta(t, time.Now().Unix(), svc.Now(), 1)
+
+	scanner.notify(ports.BlockInfo{Height: height, MedianTime: medianTime})
+	require.Equal(t, medianTime, svc.Now())
+}
+
+func requireRun(t *testing.T, done chan struct{}) {
+	t.Helper()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected task to run")
+	}
+}
+
+func requireNotRun(t *testing.T, done chan struct{}) {
+	t.Helper()
+	select {
+	case <-done:
+		t.Fatal("expected task not to run")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// mockedScanner notifies the blocks passed to notify, or fails to return the
+// channel with the given error.
+type mockedScanner struct {
+	ports.BlockchainScanner
+	err    error
+	blocks chan ports.BlockInfo
+}
+
+func newMockedScanner(err error) *mockedScanner {
+	return &mockedScanner{err: err, blocks: make(chan ports.BlockInfo)}
+}
+
+func (m *mockedScanner) GetBlockNotificationChannel(
+	_ context.Context,
+) (<-chan ports.BlockInfo, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.blocks, nil
+}
+
+// notify returns once the scheduler has handled the given block. The block is
+// sent twice since the second send completes only after the first one is
+// handled, while being a no-op for the scheduler.
+func (m *mockedScanner) notify(block ports.BlockInfo) {
+	m.blocks <- block
+	m.blocks <- block
+}
@@ -17,8 +17,9 @@ func NewScheduler() ports.SchedulerService {
 	return &service{svc}
 }
 
-func (s *service) Start() {
+func (s *service) Start() error {
 	s.scheduler.StartAsync()
+	return nil
 }
 
 func (s *service) Stop() {
@@ -43,3 +44,11 @@ func (s *service) ScheduleTaskOnce(at int64, task func()) error {
 	_, err := s.scheduler.Every(int(delay)).Seconds().WaitForSchedule().LimitRunsTo(1).Do(task)
 	return err
 }
+
+func (s *service) Unit() ports.TimeUnit {
+	return ports.UnixTime
+}
+
+func (s *service) Now() int64 {
+	return time.Now().Unix()
+}
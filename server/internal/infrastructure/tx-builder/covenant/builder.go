@@ -48,11 +48,14 @@ func (b *txBuilder) GetVtxoScript(userPubkey, aspPubkey *secp256k1.PublicKey) ([
 	return outputScript, nil
 }
 
-func (b *txBuilder) BuildSweepTx(inputs []ports.SweepInput) (signedSweepTx string, err error) {
+func (b *txBuilder) BuildSweepTx(
+	inputs []ports.SweepInput, feeRate uint64,
+) (signedSweepTx string, err error) {
 	sweepPset, err := sweepTransaction(
 		b.wallet,
 		inputs,
 		b.onchainNetwork().AssetID,
+		feeRate,
 	)
 	if err != nil {
 		return "", err
@@ -237,18 +240,18 @@ func (b *txBuilder) VerifyForfeitTx(tx string) (bool, string, error) {
 
 			sig, err := schnorr.ParseSignature(tapScriptSig.Signature)
 			if err != nil {
-				return false, txid, err
+				return false, txid, fmt.Errorf("%w: %s", ports.ErrInvalidForfeitSignature, err)
 			}
 
 			pubkey, err := schnorr.ParsePubKey(tapScriptSig.PubKey)
 			if err != nil {
-				return false, txid, err
+				return false, txid, fmt.Errorf("%w: %s", ports.ErrInvalidForfeitSignature, err)
 			}
 
 			if sig.Verify(preimage, pubkey) {
 				return true, txid, nil
 			} else {
-				return false, txid, fmt.Errorf("invalid signature")
+				return false, txid, ports.ErrInvalidForfeitSignature
 			}
 		}
 	}
@@ -52,6 +52,18 @@ func (m *mockedWallet) BroadcastTransaction(ctx context.Context, txHex string) (
 	return res, args.Error(1)
 }
 
+func (m *mockedWallet) BumpFeeWithChild(
+	ctx context.Context, txHex string, feeRate uint64,
+) (string, error) {
+	args := m.Called(ctx, txHex, feeRate)
+
+	var res string
+	if a := args.Get(0); a != nil {
+		res = a.(string)
+	}
+	return res, args.Error(1)
+}
+
 func (m *mockedWallet) Close() {
 	m.Called()
 }
@@ -130,6 +142,52 @@ func (m *mockedWallet) EstimateFees(ctx context.Context, pset string) (uint64, e
 	return res, args.Error(1)
 }
 
+func (m *mockedWallet) EstimateFeeRate(ctx context.Context) (uint64, error) {
+	args := m.Called(ctx)
+
+	var res uint64
+	if a := args.Get(0); a != nil {
+		res = a.(uint64)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockedWallet) GetBlockNotificationChannel(
+	ctx context.Context,
+) (<-chan ports.BlockInfo, error) {
+	args := m.Called(ctx)
+
+	var res <-chan ports.BlockInfo
+	if a := args.Get(0); a != nil {
+		res = a.(<-chan ports.BlockInfo)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockedWallet) GetReorgNotificationChannel(
+	ctx context.Context,
+) (<-chan ports.Reorg, error) {
+	args := m.Called(ctx)
+
+	var res <-chan ports.Reorg
+	if a := args.Get(0); a != nil {
+		res = a.(<-chan ports.Reorg)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockedWallet) GetTransactionBlock(
+	ctx context.Context, txid string,
+) (*ports.BlockInfo, error) {
+	args := m.Called(ctx, txid)
+
+	var res *ports.BlockInfo
+	if a := args.Get(0); a != nil {
+		res = a.(*ports.BlockInfo)
+	}
+	return res, args.Error(1)
+}
+
 func (m *mockedWallet) IsTransactionConfirmed(ctx context.Context, txid string) (bool, int64, error) {
 	args := m.Called(ctx, txid)
 
@@ -146,6 +204,17 @@ func (m *mockedWallet) IsTransactionConfirmed(ctx context.Context, txid string)
 	return res, blocktime, args.Error(2)
 }
 
+func (m *mockedWallet) IsTransactionPublished(ctx context.Context, txid string) (bool, error) {
+	args := m.Called(ctx, txid)
+
+	var res bool
+	if a := args.Get(0); a != nil {
+		res = a.(bool)
+	}
+
+	return res, args.Error(1)
+}
+
 func (m *mockedWallet) SignTransactionTapscript(ctx context.Context, pset string, inputIndexes []int) (string, error) {
 	args := m.Called(ctx, pset, inputIndexes)
 
@@ -196,6 +265,47 @@ func (m *mockedWallet) LockConnectorUtxos(ctx context.Context, utxos []ports.TxO
 	return args.Error(0)
 }
 
+func (m *mockedWallet) Withdraw(
+	ctx context.Context, address string, amount, feeRate uint64,
+) (string, error) {
+	args := m.Called(ctx, address, amount, feeRate)
+
+	var res string
+	if a := args.Get(0); a != nil {
+		res = a.(string)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockedWallet) Consolidate(
+	ctx context.Context, maxInputs uint32, feeRate uint64,
+) (string, error) {
+	args := m.Called(ctx, maxInputs, feeRate)
+
+	var res string
+	if a := args.Get(0); a != nil {
+		res = a.(string)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockedWallet) Backup(ctx context.Context, dir string) error {
+	args := m.Called(ctx, dir)
+	return args.Error(0)
+}
+
+func (m *mockedWallet) ReleaseConnectorUtxos(
+	ctx context.Context, utxos []ports.TxInput, feeRate uint64,
+) (string, error) {
+	args := m.Called(ctx, utxos, feeRate)
+
+	var res string
+	if a := args.Get(0); a != nil {
+		res = a.(string)
+	}
+	return res, args.Error(1)
+}
+
 func (m *mockedWallet) WaitForSync(ctx context.Context, txid string) error {
 	args := m.Called(ctx, txid)
 	return args.Error(0)
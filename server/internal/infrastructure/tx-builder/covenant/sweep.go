@@ -18,6 +18,7 @@ func sweepTransaction(
 	wallet ports.WalletService,
 	sweepInputs []ports.SweepInput,
 	lbtc string,
+	feeRate uint64,
 ) (*psetv2.Pset, error) {
 	sweepPset, err := psetv2.New(nil, nil, nil)
 	if err != nil {
@@ -131,6 +132,17 @@ func sweepTransaction(
 		return nil, err
 	}
 
+	// scale the estimated fees up to the requested fee rate, if higher
+	if feeRate > 0 {
+		estimatedFeeRate, err := wallet.EstimateFeeRate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if estimatedFeeRate > 0 && feeRate > estimatedFeeRate {
+			fees = fees * feeRate / estimatedFeeRate
+		}
+	}
+
 	if amount < fees {
 		return nil, fmt.Errorf("insufficient funds (%d) to cover fees (%d) for sweep transaction", amount, fees)
 	}
@@ -57,18 +57,18 @@ func (b *txBuilder) VerifyForfeitTx(tx string) (bool, string, error) {
 
 			sig, err := schnorr.ParseSignature(tapScriptSig.Signature)
 			if err != nil {
-				return false, txid, err
+				return false, txid, fmt.Errorf("%w: %s", ports.ErrInvalidForfeitSignature, err)
 			}
 
 			pubkey, err := schnorr.ParsePubKey(tapScriptSig.XOnlyPubKey)
 			if err != nil {
-				return false, txid, err
+				return false, txid, fmt.Errorf("%w: %s", ports.ErrInvalidForfeitSignature, err)
 			}
 
 			if sig.Verify(preimage, pubkey) {
 				return true, txid, nil
 			} else {
-				return false, txid, fmt.Errorf("invalid signature for tx %s", txid)
+				return false, txid, fmt.Errorf("%w for tx %s", ports.ErrInvalidForfeitSignature, txid)
 			}
 		}
 	}
@@ -107,10 +107,13 @@ func (b *txBuilder) GetVtxoScript(userPubkey, aspPubkey *secp256k1.PublicKey) ([
 	return outputScript, nil
 }
 
-func (b *txBuilder) BuildSweepTx(inputs []ports.SweepInput) (signedSweepTx string, err error) {
+func (b *txBuilder) BuildSweepTx(
+	inputs []ports.SweepInput, feeRate uint64,
+) (signedSweepTx string, err error) {
 	sweepPsbt, err := sweepTransaction(
 		b.wallet,
 		inputs,
+		feeRate,
 	)
 	if err != nil {
 		return "", err
@@ -210,6 +213,15 @@ func (b *txBuilder) BuildPoolTx(
 		return
 	}
 
+	if !isOnchainOnly(payments) {
+		// lets a remote signer verify the shared output is sweepable by the ASP
+		if err = bitcointree.AddSharedOutputTapTree(
+			0, ptx, cosigners, aspPubkey, b.roundLifetime,
+		); err != nil {
+			return
+		}
+	}
+
 	poolTx, err = ptx.B64Encode()
 	if err != nil {
 		return
@@ -17,6 +17,7 @@ import (
 func sweepTransaction(
 	wallet ports.WalletService,
 	sweepInputs []ports.SweepInput,
+	feeRate uint64,
 ) (*psbt.Packet, error) {
 	ins := make([]*wire.OutPoint, 0)
 	sequences := make([]uint32, 0)
@@ -137,6 +138,17 @@ func sweepTransaction(
 		return nil, err
 	}
 
+	// scale the estimated fees up to the requested fee rate, if higher
+	if feeRate > 0 {
+		estimatedFeeRate, err := wallet.EstimateFeeRate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if estimatedFeeRate > 0 && feeRate > estimatedFeeRate {
+			fees = fees * feeRate / estimatedFeeRate
+		}
+	}
+
 	if amount < int64(fees) {
 		return nil, fmt.Errorf("insufficient funds (%d) to cover fees (%d) for sweep transaction", amount, fees)
 	}
@@ -0,0 +1,439 @@
+package btcwallet
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcwallet/chain"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	electrumProtocolVersion = "1.4"
+	electrumClientName      = "arkd"
+	electrumRequestTimeout  = 30 * time.Second
+	electrumPingInterval    = time.Minute
+)
+
+// electrumReconnectInterval is how long to wait before reconnecting to the
+// electrum server once the connection is lost.
+var electrumReconnectInterval = 5 * time.Second
+
+// electrumReconnected is queued along with the server notifications once the
+// connection is reestablished, since all the subscriptions are lost.
+type electrumReconnected struct{}
+
+type electrumNotification struct {
+	method string
+	params json.RawMessage
+}
+
+type electrumMessage struct {
+	ID     *uint64         `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+type electrumResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+type electrumHistoryItem struct {
+	Height int32  `json:"height"`
+	TxHash string `json:"tx_hash"`
+}
+
+type electrumHeaders struct {
+	Count int    `json:"count"`
+	Hex   string `json:"hex"`
+}
+
+type electrumTip struct {
+	Height int32  `json:"height"`
+	Hex    string `json:"hex"`
+}
+
+// electrumClient is a client of the Electrum JSON-RPC protocol. Requests and
+// notifications are newline-delimited json messages sent over a tcp (or tls)
+// connection, that is reestablished if lost.
+type electrumClient struct {
+	addr   string
+	useTLS bool
+
+	connLock sync.Mutex
+	conn     net.Conn
+
+	nextID      uint64
+	pendingLock sync.Mutex
+	pending     map[uint64]chan electrumResponse
+
+	notifications *chain.ConcurrentQueue
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newElectrumClient returns a client for the server at the given address,
+// in the form host:port. The ssl:// or tls:// prefix makes the client
+// connect over tls, while tcp:// is the default.
+func newElectrumClient(addr string) (*electrumClient, error) {
+	useTLS := false
+	switch {
+	case strings.HasPrefix(addr, "ssl://"), strings.HasPrefix(addr, "tls://"):
+		useTLS = true
+		addr = addr[len("ssl://"):]
+	case strings.HasPrefix(addr, "tcp://"):
+		addr = addr[len("tcp://"):]
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return nil, fmt.Errorf("invalid electrum server address: %s", err)
+	}
+
+	return &electrumClient{
+		addr:          addr,
+		useTLS:        useTLS,
+		pending:       make(map[uint64]chan electrumResponse),
+		notifications: chain.NewConcurrentQueue(20),
+		quit:          make(chan struct{}),
+	}, nil
+}
+
+func (c *electrumClient) start() error {
+	if err := c.connect(); err != nil {
+		return err
+	}
+
+	c.notifications.Start()
+
+	c.wg.Add(2)
+	go c.readLoop()
+	go c.pingLoop()
+
+	return c.handshake()
+}
+
+func (c *electrumClient) stop() {
+	close(c.quit)
+
+	c.connLock.Lock()
+	if c.conn != nil {
+		// nolint
+		c.conn.Close()
+	}
+	c.connLock.Unlock()
+
+	c.wg.Wait()
+	c.notifications.Stop()
+}
+
+// notificationsChan returns the channel of the server notifications, read by
+// a single consumer, since requests can't be made from the read loop.
+func (c *electrumClient) notificationsChan() <-chan interface{} {
+	return c.notifications.ChanOut()
+}
+
+func (c *electrumClient) call(method string, result interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	id := atomic.AddUint64(&c.nextID, 1)
+	req, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	ch := make(chan electrumResponse, 1)
+	c.pendingLock.Lock()
+	c.pending[id] = ch
+	c.pendingLock.Unlock()
+
+	defer func() {
+		c.pendingLock.Lock()
+		delete(c.pending, id)
+		c.pendingLock.Unlock()
+	}()
+
+	c.connLock.Lock()
+	if c.conn == nil {
+		c.connLock.Unlock()
+		return fmt.Errorf("electrum server not connected")
+	}
+	_, err = c.conn.Write(append(req, '\n'))
+	c.connLock.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to send %s request: %s", method, err)
+	}
+
+	select {
+	case res := <-ch:
+		if res.err != nil {
+			return res.err
+		}
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(res.result, result)
+	case <-time.After(electrumRequestTimeout):
+		return fmt.Errorf("%s request timed out", method)
+	case <-c.quit:
+		return fmt.Errorf("electrum client stopped")
+	}
+}
+
+func (c *electrumClient) connect() error {
+	var conn net.Conn
+	var err error
+	dialer := &net.Dialer{Timeout: electrumRequestTimeout}
+	if c.useTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", c.addr, &tls.Config{})
+	} else {
+		conn, err = dialer.Dial("tcp", c.addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to electrum server: %s", err)
+	}
+
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
+	// the client may have been stopped while dialing
+	select {
+	case <-c.quit:
+		// nolint
+		conn.Close()
+		return fmt.Errorf("electrum client stopped")
+	default:
+	}
+
+	c.conn = conn
+	return nil
+}
+
+func (c *electrumClient) handshake() error {
+	var version []string
+	return c.call(
+		"server.version", &version, electrumClientName, electrumProtocolVersion,
+	)
+}
+
+func (c *electrumClient) readLoop() {
+	defer c.wg.Done()
+
+	for {
+		c.connLock.Lock()
+		conn := c.conn
+		c.connLock.Unlock()
+
+		err := c.read(conn)
+
+		c.failPending(fmt.Errorf("connection to electrum server lost: %v", err))
+		select {
+		case <-c.quit:
+			return
+		default:
+		}
+
+		log.WithError(err).Warnf(
+			"connection to electrum server %s lost, reconnecting", c.addr,
+		)
+		if !c.reconnect() {
+			return
+		}
+	}
+}
+
+func (c *electrumClient) read(conn net.Conn) error {
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return err
+		}
+
+		var msg electrumMessage
+		if err := json.Unmarshal(line, &msg); err != nil {
+			log.WithError(err).Warn("failed to parse electrum message")
+			continue
+		}
+
+		if msg.ID == nil {
+			if len(msg.Method) > 0 {
+				select {
+				case c.notifications.ChanIn() <- electrumNotification{
+					msg.Method, msg.Params,
+				}:
+				case <-c.quit:
+					return nil
+				}
+			}
+			continue
+		}
+
+		c.pendingLock.Lock()
+		ch, ok := c.pending[*msg.ID]
+		delete(c.pending, *msg.ID)
+		c.pendingLock.Unlock()
+		if !ok {
+			continue
+		}
+
+		if len(msg.Error) > 0 && string(msg.Error) != "null" {
+			ch <- electrumResponse{err: parseElectrumError(msg.Error)}
+			continue
+		}
+		ch <- electrumResponse{result: msg.Result}
+	}
+}
+
+func (c *electrumClient) reconnect() bool {
+	for {
+		select {
+		case <-c.quit:
+			return false
+		case <-time.After(electrumReconnectInterval):
+		}
+
+		if err := c.connect(); err != nil {
+			log.WithError(err).Warn("failed to reconnect to electrum server")
+			continue
+		}
+
+		// the handshake can't be done from the read loop, the consumer of the
+		// notifications is told to resubscribe only once it's done
+		go func() {
+			if err := c.handshake(); err != nil {
+				log.WithError(err).Warn("failed handshake with electrum server")
+			}
+			select {
+			case c.notifications.ChanIn() <- electrumReconnected{}:
+			case <-c.quit:
+			}
+		}()
+		return true
+	}
+}
+
+func (c *electrumClient) pingLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(electrumPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-ticker.C:
+			if err := c.call("server.ping", nil); err != nil {
+				log.WithError(err).Debug("failed to ping electrum server")
+			}
+		}
+	}
+}
+
+func (c *electrumClient) failPending(err error) {
+	c.pendingLock.Lock()
+	defer c.pendingLock.Unlock()
+
+	for id, ch := range c.pending {
+		ch <- electrumResponse{err: err}
+		delete(c.pending, id)
+	}
+}
+
+func (c *electrumClient) subscribeHeaders() (*electrumTip, error) {
+	var tip electrumTip
+	if err := c.call("blockchain.headers.subscribe", &tip); err != nil {
+		return nil, err
+	}
+	return &tip, nil
+}
+
+// getHeaders returns the raw headers of at most count blocks starting from the
+// given height.
+func (c *electrumClient) getHeaders(height int32, count int) ([]byte, error) {
+	var res electrumHeaders
+	if err := c.call("blockchain.block.headers", &res, height, count); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(res.Hex)
+}
+
+func (c *electrumClient) subscribeScriptHash(scriptHash string) error {
+	return c.call("blockchain.scripthash.subscribe", nil, scriptHash)
+}
+
+func (c *electrumClient) getHistory(scriptHash string) ([]electrumHistoryItem, error) {
+	var history []electrumHistoryItem
+	if err := c.call("blockchain.scripthash.get_history", &history, scriptHash); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (c *electrumClient) getTransaction(txid string) ([]byte, error) {
+	var txHex string
+	if err := c.call("blockchain.transaction.get", &txHex, txid); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(txHex)
+}
+
+func (c *electrumClient) broadcast(txHex string) (string, error) {
+	var txid string
+	if err := c.call("blockchain.transaction.broadcast", &txid, txHex); err != nil {
+		return "", err
+	}
+	return txid, nil
+}
+
+// electrumScriptHash returns the hash of the output script of the given
+// address, which is how the electrum protocol indexes the history of scripts.
+func electrumScriptHash(addr btcutil.Address) (string, error) {
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(script)
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func parseElectrumError(raw json.RawMessage) error {
+	var rpcErr struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &rpcErr); err == nil && len(rpcErr.Message) > 0 {
+		return fmt.Errorf("%s", rpcErr.Message)
+	}
+
+	var msg string
+	if err := json.Unmarshal(raw, &msg); err == nil {
+		return fmt.Errorf("%s", msg)
+	}
+	return fmt.Errorf("%s", string(raw))
+}
@@ -0,0 +1,927 @@
+package btcwallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	electrumBackend = "electrum"
+	// electrumHeadersBatch is the number of headers fetched and cached at once.
+	electrumHeadersBatch = 100
+	// electrumMaxReorgDepth is the max number of blocks walked back to find the
+	// fork point of a reorg, and the number of blocks whose txs are kept.
+	electrumMaxReorgDepth = 100
+	// electrumCurrentDelta is the max age of the chain tip for the server to
+	// be considered synced.
+	electrumCurrentDelta = 2 * time.Hour
+)
+
+type electrumHeader struct {
+	height int32
+	header wire.BlockHeader
+}
+
+// electrumBlocks keeps the watched txs confirmed in the recent blocks. The
+// electrum protocol doesn't serve blocks, so these are the only txs returned
+// by GetBlock. It's shared by the chain source and the scanner, so that both
+// the wallet and the vtxo txs of a block can be retrieved.
+type electrumBlocks struct {
+	lock    sync.RWMutex
+	txs     map[chainhash.Hash]map[chainhash.Hash]*wire.MsgTx
+	heights map[chainhash.Hash]int32
+}
+
+func newElectrumBlocks() *electrumBlocks {
+	return &electrumBlocks{
+		txs:     make(map[chainhash.Hash]map[chainhash.Hash]*wire.MsgTx),
+		heights: make(map[chainhash.Hash]int32),
+	}
+}
+
+func (b *electrumBlocks) add(block wtxmgr.BlockMeta, tx *wire.MsgTx) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, ok := b.txs[block.Hash]; !ok {
+		b.txs[block.Hash] = make(map[chainhash.Hash]*wire.MsgTx)
+		b.heights[block.Hash] = block.Height
+	}
+	b.txs[block.Hash][tx.TxHash()] = tx
+}
+
+func (b *electrumBlocks) get(hash chainhash.Hash) []*wire.MsgTx {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	txs := make([]*wire.MsgTx, 0, len(b.txs[hash]))
+	for _, tx := range b.txs[hash] {
+		txs = append(txs, tx)
+	}
+	return sortByDependency(txs)
+}
+
+func (b *electrumBlocks) prune(minHeight int32) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for hash, height := range b.heights {
+		if height < minHeight {
+			delete(b.txs, hash)
+			delete(b.heights, hash)
+		}
+	}
+}
+
+// electrumChainClient implements chain.Interface on top of an electrum server,
+// subscribing to the headers and to the script hashes of the watched
+// addresses.
+type electrumChainClient struct {
+	client    *electrumClient
+	netParams *chaincfg.Params
+	blocks    *electrumBlocks
+
+	// tipLock serializes the updates of the best chain.
+	tipLock     sync.Mutex
+	headersLock sync.RWMutex
+	headers     map[chainhash.Hash]electrumHeader
+	hashes      map[int32]chainhash.Hash
+	tip         electrumHeader
+
+	watchedLock sync.RWMutex
+	watched     map[string]btcutil.Address
+	// txHeights are the heights of the notified txs, 0 if unconfirmed.
+	txHeights map[chainhash.Hash]int32
+
+	notifyBlocks      atomic.Bool
+	notificationQueue *chain.ConcurrentQueue
+
+	stopOnce sync.Once
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+var _ chain.Interface = (*electrumChainClient)(nil)
+
+func newElectrumChainClient(
+	addr string, netParams *chaincfg.Params, blocks *electrumBlocks,
+) (*electrumChainClient, error) {
+	client, err := newElectrumClient(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &electrumChainClient{
+		client:            client,
+		netParams:         netParams,
+		blocks:            blocks,
+		headers:           make(map[chainhash.Hash]electrumHeader),
+		hashes:            make(map[int32]chainhash.Hash),
+		watched:           make(map[string]btcutil.Address),
+		txHeights:         make(map[chainhash.Hash]int32),
+		notificationQueue: chain.NewConcurrentQueue(20),
+		quit:              make(chan struct{}),
+	}, nil
+}
+
+func (c *electrumChainClient) Start() error {
+	if err := c.client.start(); err != nil {
+		return err
+	}
+	c.notificationQueue.Start()
+
+	tip, err := c.client.subscribeHeaders()
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to headers: %s", err)
+	}
+	header, err := parseElectrumHeader(tip.Hex)
+	if err != nil {
+		return err
+	}
+
+	c.headersLock.Lock()
+	c.tip = electrumHeader{tip.Height, *header}
+	c.cacheHeader(tip.Height, *header)
+	c.headersLock.Unlock()
+
+	// the recent headers are needed to compute the median time past and to
+	// find the fork point of reorgs
+	startHeight := tip.Height - electrumHeadersBatch + 1
+	if startHeight < 0 {
+		startHeight = 0
+	}
+	if err := c.fetchHeaders(startHeight); err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+	go c.handleNotifications()
+
+	c.notify(chain.ClientConnected{})
+	return nil
+}
+
+func (c *electrumChainClient) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.quit)
+		c.client.stop()
+		c.notificationQueue.Stop()
+	})
+}
+
+func (c *electrumChainClient) WaitForShutdown() {
+	c.wg.Wait()
+}
+
+func (c *electrumChainClient) GetBestBlock() (*chainhash.Hash, int32, error) {
+	c.headersLock.RLock()
+	defer c.headersLock.RUnlock()
+
+	hash := c.tip.header.BlockHash()
+	return &hash, c.tip.height, nil
+}
+
+// GetBlock returns the header of the given block along with the watched txs
+// confirmed in it, since electrum servers don't serve full blocks.
+func (c *electrumChainClient) GetBlock(hash *chainhash.Hash) (*wire.MsgBlock, error) {
+	c.headersLock.RLock()
+	header, ok := c.headers[*hash]
+	c.headersLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", hash)
+	}
+
+	return &wire.MsgBlock{
+		Header:       header.header,
+		Transactions: c.blocks.get(*hash),
+	}, nil
+}
+
+func (c *electrumChainClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	_, hash, err := c.getHeaderByHeight(int32(height))
+	return hash, err
+}
+
+func (c *electrumChainClient) GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader, error) {
+	c.headersLock.RLock()
+	defer c.headersLock.RUnlock()
+
+	header, ok := c.headers[*hash]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", hash)
+	}
+	return &header.header, nil
+}
+
+func (c *electrumChainClient) IsCurrent() bool {
+	c.headersLock.RLock()
+	defer c.headersLock.RUnlock()
+
+	return c.tip.header.Timestamp.After(time.Now().Add(-electrumCurrentDelta))
+}
+
+// FilterBlocks returns the relevant txs of the first of the given blocks that
+// has any, found in the history of the given addresses.
+func (c *electrumChainClient) FilterBlocks(
+	req *chain.FilterBlocksRequest,
+) (*chain.FilterBlocksResponse, error) {
+	if len(req.Blocks) <= 0 {
+		return nil, nil
+	}
+
+	type scriptInfo struct {
+		addr     btcutil.Address
+		index    waddrmgr.ScopedIndex
+		internal bool
+	}
+	scripts := make(map[string]scriptInfo)
+	addrs := make([]btcutil.Address, 0)
+	for index, addr := range req.ExternalAddrs {
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		scripts[string(script)] = scriptInfo{addr, index, false}
+		addrs = append(addrs, addr)
+	}
+	for index, addr := range req.InternalAddrs {
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		scripts[string(script)] = scriptInfo{addr, index, true}
+		addrs = append(addrs, addr)
+	}
+	// the txs spending the watched outpoints are in the history of the
+	// addresses they pay to
+	for _, addr := range req.WatchedOutPoints {
+		addrs = append(addrs, addr)
+	}
+
+	minHeight := req.Blocks[0].Height
+	maxHeight := req.Blocks[len(req.Blocks)-1].Height
+	txidsByHeight := make(map[int32]map[chainhash.Hash]struct{})
+	for _, addr := range addrs {
+		scriptHash, err := electrumScriptHash(addr)
+		if err != nil {
+			return nil, err
+		}
+		history, err := c.client.getHistory(scriptHash)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range history {
+			if item.Height < minHeight || item.Height > maxHeight {
+				continue
+			}
+			txid, err := chainhash.NewHashFromStr(item.TxHash)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := txidsByHeight[item.Height]; !ok {
+				txidsByHeight[item.Height] = make(map[chainhash.Hash]struct{})
+			}
+			txidsByHeight[item.Height][*txid] = struct{}{}
+		}
+	}
+
+	for i, block := range req.Blocks {
+		txids := txidsByHeight[block.Height]
+		if len(txids) <= 0 {
+			continue
+		}
+
+		res := &chain.FilterBlocksResponse{
+			BatchIndex:         uint32(i),
+			BlockMeta:          block,
+			FoundExternalAddrs: make(map[waddrmgr.KeyScope]map[uint32]struct{}),
+			FoundInternalAddrs: make(map[waddrmgr.KeyScope]map[uint32]struct{}),
+			FoundOutPoints:     make(map[wire.OutPoint]btcutil.Address),
+		}
+
+		txs := make([]*wire.MsgTx, 0, len(txids))
+		for txid := range txids {
+			tx, err := c.getTransaction(txid.String())
+			if err != nil {
+				return nil, err
+			}
+			txs = append(txs, tx)
+		}
+
+		for _, tx := range sortByDependency(txs) {
+			relevant := false
+			for _, in := range tx.TxIn {
+				if _, ok := req.WatchedOutPoints[in.PreviousOutPoint]; ok {
+					relevant = true
+				}
+			}
+
+			for vout, out := range tx.TxOut {
+				info, ok := scripts[string(out.PkScript)]
+				if !ok {
+					continue
+				}
+				relevant = true
+
+				found := res.FoundExternalAddrs
+				if info.internal {
+					found = res.FoundInternalAddrs
+				}
+				if _, ok := found[info.index.Scope]; !ok {
+					found[info.index.Scope] = make(map[uint32]struct{})
+				}
+				found[info.index.Scope][info.index.Index] = struct{}{}
+
+				outpoint := wire.OutPoint{Hash: tx.TxHash(), Index: uint32(vout)}
+				res.FoundOutPoints[outpoint] = info.addr
+			}
+
+			if relevant {
+				res.RelevantTxns = append(res.RelevantTxns, tx)
+			}
+		}
+
+		if len(res.RelevantTxns) > 0 {
+			return res, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *electrumChainClient) BlockStamp() (*waddrmgr.BlockStamp, error) {
+	c.headersLock.RLock()
+	defer c.headersLock.RUnlock()
+
+	return &waddrmgr.BlockStamp{
+		Height:    c.tip.height,
+		Hash:      c.tip.header.BlockHash(),
+		Timestamp: c.tip.header.Timestamp,
+	}, nil
+}
+
+func (c *electrumChainClient) SendRawTransaction(
+	tx *wire.MsgTx, _ bool,
+) (*chainhash.Hash, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	txid, err := c.client.broadcast(hex.EncodeToString(buf.Bytes()))
+	if err != nil {
+		return nil, c.MapRPCErr(err)
+	}
+	return chainhash.NewHashFromStr(txid)
+}
+
+// Rescan notifies the txs of the given addresses and outpoints confirmed from
+// the given block on, or still unconfirmed, and watches them from now on.
+func (c *electrumChainClient) Rescan(
+	startHash *chainhash.Hash, addrs []btcutil.Address,
+	outpoints map[wire.OutPoint]btcutil.Address,
+) error {
+	startHeight := int32(0)
+	if startHash != nil {
+		c.headersLock.RLock()
+		header, ok := c.headers[*startHash]
+		c.headersLock.RUnlock()
+		if !ok {
+			return fmt.Errorf("rescan start block %s not found", startHash)
+		}
+		startHeight = header.height
+	}
+
+	all := append([]btcutil.Address{}, addrs...)
+	for _, addr := range outpoints {
+		all = append(all, addr)
+	}
+	for _, addr := range all {
+		if err := c.watch(addr, startHeight); err != nil {
+			return err
+		}
+	}
+
+	c.headersLock.RLock()
+	tip := c.tip
+	c.headersLock.RUnlock()
+
+	tipHash := tip.header.BlockHash()
+	c.notify(&chain.RescanFinished{
+		Hash:   &tipHash,
+		Height: tip.height,
+		Time:   tip.header.Timestamp,
+	})
+	return nil
+}
+
+// NotifyReceived watches the given addresses, only the txs not yet confirmed
+// are notified, the confirmed ones are left to Rescan.
+func (c *electrumChainClient) NotifyReceived(addrs []btcutil.Address) error {
+	for _, addr := range addrs {
+		if err := c.watch(addr, math.MaxInt32); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *electrumChainClient) NotifyBlocks() error {
+	c.notifyBlocks.Store(true)
+	return nil
+}
+
+func (c *electrumChainClient) Notifications() <-chan interface{} {
+	return c.notificationQueue.ChanOut()
+}
+
+func (c *electrumChainClient) BackEnd() string {
+	return electrumBackend
+}
+
+// TestMempoolAccept is not part of the electrum protocol, the txs are
+// broadcasted without testing them first.
+func (c *electrumChainClient) TestMempoolAccept(
+	_ []*wire.MsgTx, _ float64,
+) ([]*btcjson.TestMempoolAcceptResult, error) {
+	return nil, rpcclient.ErrBackendVersion
+}
+
+// MapRPCErr maps the errors of the electrum server, which are those of the
+// bitcoind node it's connected to.
+func (c *electrumChainClient) MapRPCErr(err error) error {
+	// the bitcoind errors are enumerated up to a sentinel one, whose message
+	// is the default
+	for rpcErr := chain.RPCErr(0); rpcErr.Error() != "unknown error"; rpcErr++ {
+		if strings.Contains(normalizeRPCErr(err.Error()), normalizeRPCErr(rpcErr.Error())) {
+			return rpcErr
+		}
+	}
+	return fmt.Errorf("%w: %v", chain.ErrUndefined, err)
+}
+
+func (c *electrumChainClient) handleNotifications() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.quit:
+			return
+		case n := <-c.client.notificationsChan():
+			switch n := n.(type) {
+			case electrumReconnected:
+				c.resubscribe()
+			case electrumNotification:
+				c.handleNotification(n)
+			}
+		}
+	}
+}
+
+func (c *electrumChainClient) handleNotification(n electrumNotification) {
+	switch n.method {
+	case "blockchain.headers.subscribe":
+		var params []electrumTip
+		if err := json.Unmarshal(n.params, &params); err != nil || len(params) <= 0 {
+			log.Warnf("invalid electrum headers notification: %s", n.params)
+			return
+		}
+		if err := c.onNewTip(params[0]); err != nil {
+			log.WithError(err).Warn("failed to handle new chain tip")
+		}
+
+	case "blockchain.scripthash.subscribe":
+		var params []json.RawMessage
+		var scriptHash string
+		if err := json.Unmarshal(n.params, &params); err != nil || len(params) <= 0 {
+			log.Warnf("invalid electrum scripthash notification: %s", n.params)
+			return
+		}
+		if err := json.Unmarshal(params[0], &scriptHash); err != nil {
+			log.Warnf("invalid electrum scripthash notification: %s", n.params)
+			return
+		}
+
+		c.watchedLock.RLock()
+		_, ok := c.watched[scriptHash]
+		c.watchedLock.RUnlock()
+		if !ok {
+			return
+		}
+		if err := c.syncHistory(scriptHash, 0); err != nil {
+			log.WithError(err).Warnf("failed to sync history of script hash %s", scriptHash)
+		}
+	}
+}
+
+// resubscribe restores the subscriptions lost with the connection, notifying
+// what's been missed in the meantime.
+func (c *electrumChainClient) resubscribe() {
+	if err := c.refreshTip(); err != nil {
+		log.WithError(err).Warn("failed to resubscribe to headers")
+	}
+
+	c.watchedLock.RLock()
+	scriptHashes := make([]string, 0, len(c.watched))
+	for scriptHash := range c.watched {
+		scriptHashes = append(scriptHashes, scriptHash)
+	}
+	c.watchedLock.RUnlock()
+
+	for _, scriptHash := range scriptHashes {
+		if err := c.client.subscribeScriptHash(scriptHash); err != nil {
+			log.WithError(err).Warnf("failed to resubscribe to script hash %s", scriptHash)
+			continue
+		}
+		if err := c.syncHistory(scriptHash, 0); err != nil {
+			log.WithError(err).Warnf("failed to sync history of script hash %s", scriptHash)
+		}
+	}
+}
+
+// onNewTip updates the best chain and notifies the disconnected blocks, if
+// any, and then the connected ones.
+func (c *electrumChainClient) onNewTip(tip electrumTip) error {
+	c.tipLock.Lock()
+	defer c.tipLock.Unlock()
+
+	header, err := parseElectrumHeader(tip.Hex)
+	if err != nil {
+		return err
+	}
+
+	c.headersLock.RLock()
+	oldTip := c.tip
+	c.headersLock.RUnlock()
+	if header.BlockHash() == oldTip.header.BlockHash() {
+		return nil
+	}
+
+	connected := []electrumHeader{{tip.Height, *header}}
+	disconnected := make([]electrumHeader, 0)
+
+	// the blocks above the new tip are disconnected
+	c.headersLock.RLock()
+	for height := oldTip.height; height > tip.Height; height-- {
+		if hash, ok := c.hashes[height]; ok {
+			disconnected = append(disconnected, c.headers[hash])
+		}
+	}
+	c.headersLock.RUnlock()
+
+	// walk back the new chain until the fork point with the known one
+	for len(connected) <= electrumMaxReorgDepth {
+		first := connected[0]
+		prevHeight := first.height - 1
+		if prevHeight < 0 {
+			break
+		}
+
+		c.headersLock.RLock()
+		knownHash, known := c.hashes[prevHeight]
+		knownHeader := c.headers[knownHash]
+		c.headersLock.RUnlock()
+
+		if known && knownHash == first.header.PrevBlock {
+			break
+		}
+		// nothing is known about the chain below the cached headers
+		if !known && prevHeight <= oldTip.height {
+			break
+		}
+		if known {
+			disconnected = append(disconnected, knownHeader)
+		}
+
+		raw, err := c.client.getHeaders(prevHeight, 1)
+		if err != nil {
+			return err
+		}
+		prevHeader, err := deserializeHeader(raw)
+		if err != nil {
+			return err
+		}
+		connected = append([]electrumHeader{{prevHeight, *prevHeader}}, connected...)
+	}
+
+	c.headersLock.Lock()
+	for _, block := range disconnected {
+		delete(c.hashes, block.height)
+	}
+	for _, block := range connected {
+		c.cacheHeader(block.height, block.header)
+	}
+	c.tip = connected[len(connected)-1]
+	c.headersLock.Unlock()
+
+	c.blocks.prune(tip.Height - electrumMaxReorgDepth)
+
+	if !c.notifyBlocks.Load() {
+		return nil
+	}
+	for _, block := range disconnected {
+		c.notify(chain.BlockDisconnected(block.blockMeta()))
+	}
+	for _, block := range connected {
+		c.notify(chain.BlockConnected(block.blockMeta()))
+	}
+	return nil
+}
+
+// watch subscribes to the script hash of the given address, if not already,
+// and syncs its history.
+func (c *electrumChainClient) watch(addr btcutil.Address, minHeight int32) error {
+	scriptHash, err := electrumScriptHash(addr)
+	if err != nil {
+		return err
+	}
+
+	c.watchedLock.Lock()
+	_, ok := c.watched[scriptHash]
+	c.watched[scriptHash] = addr
+	c.watchedLock.Unlock()
+
+	if !ok {
+		if err := c.client.subscribeScriptHash(scriptHash); err != nil {
+			c.watchedLock.Lock()
+			delete(c.watched, scriptHash)
+			c.watchedLock.Unlock()
+			return fmt.Errorf("failed to subscribe to address %s: %s", addr, err)
+		}
+	}
+
+	return c.syncHistory(scriptHash, minHeight)
+}
+
+// syncHistory notifies the txs of the history of the given script hash not
+// notified yet, or whose height changed. Those confirmed below minHeight are
+// only marked as notified.
+func (c *electrumChainClient) syncHistory(scriptHash string, minHeight int32) error {
+	history, err := c.client.getHistory(scriptHash)
+	if err != nil {
+		return err
+	}
+
+	// the unconfirmed txs have height 0, or -1 if they have unconfirmed
+	// parents, and are notified last
+	for i := range history {
+		if history[i].Height < 0 {
+			history[i].Height = 0
+		}
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		if history[i].Height == 0 || history[j].Height == 0 {
+			return history[j].Height == 0 && history[i].Height != 0
+		}
+		return history[i].Height < history[j].Height
+	})
+
+	for _, item := range history {
+		txid, err := chainhash.NewHashFromStr(item.TxHash)
+		if err != nil {
+			return err
+		}
+
+		c.watchedLock.Lock()
+		height, notified := c.txHeights[*txid]
+		c.txHeights[*txid] = item.Height
+		c.watchedLock.Unlock()
+
+		if notified && height == item.Height {
+			continue
+		}
+		if item.Height > 0 && item.Height < minHeight {
+			continue
+		}
+
+		if err := c.notifyTx(*txid, item.Height); err != nil {
+			// let the tx be notified again at the next sync
+			c.watchedLock.Lock()
+			delete(c.txHeights, *txid)
+			c.watchedLock.Unlock()
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *electrumChainClient) notifyTx(txid chainhash.Hash, height int32) error {
+	tx, err := c.getTransaction(txid.String())
+	if err != nil {
+		return err
+	}
+
+	var block *wtxmgr.BlockMeta
+	received := time.Now()
+	if height > 0 {
+		header, hash, err := c.getHeaderByHeight(height)
+		if err != nil {
+			return err
+		}
+		block = &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{Hash: *hash, Height: height},
+			Time:  header.Timestamp,
+		}
+		received = header.Timestamp
+		c.blocks.add(*block, tx)
+	}
+
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, received)
+	if err != nil {
+		return err
+	}
+	c.notify(chain.RelevantTx{TxRecord: rec, Block: block})
+	return nil
+}
+
+func (c *electrumChainClient) getTransaction(txid string) (*wire.MsgTx, error) {
+	raw, err := c.client.getTransaction(txid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx %s: %s", txid, err)
+	}
+
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("failed to parse tx %s: %s", txid, err)
+	}
+	return &tx, nil
+}
+
+func (c *electrumChainClient) getHeaderByHeight(
+	height int32,
+) (*wire.BlockHeader, *chainhash.Hash, error) {
+	if header, hash, ok := c.cachedHeader(height); ok {
+		return header, hash, nil
+	}
+
+	c.headersLock.RLock()
+	tipHeight := c.tip.height
+	c.headersLock.RUnlock()
+
+	// the txs of a new block may be notified before the block itself
+	if height > tipHeight {
+		if err := c.refreshTip(); err != nil {
+			return nil, nil, err
+		}
+		c.headersLock.RLock()
+		tipHeight = c.tip.height
+		c.headersLock.RUnlock()
+	}
+	if height < 0 || height > tipHeight {
+		return nil, nil, fmt.Errorf("block height %d out of range", height)
+	}
+
+	if err := c.fetchHeaders(height); err != nil {
+		return nil, nil, err
+	}
+	if header, hash, ok := c.cachedHeader(height); ok {
+		return header, hash, nil
+	}
+	return nil, nil, fmt.Errorf("block at height %d not found", height)
+}
+
+// refreshTip updates the best chain with the current tip of the server.
+func (c *electrumChainClient) refreshTip() error {
+	tip, err := c.client.subscribeHeaders()
+	if err != nil {
+		return err
+	}
+	return c.onNewTip(*tip)
+}
+
+func (c *electrumChainClient) cachedHeader(
+	height int32,
+) (*wire.BlockHeader, *chainhash.Hash, bool) {
+	c.headersLock.RLock()
+	defer c.headersLock.RUnlock()
+
+	hash, ok := c.hashes[height]
+	if !ok {
+		return nil, nil, false
+	}
+	header := c.headers[hash].header
+	return &header, &hash, true
+}
+
+// fetchHeaders caches a batch of headers of the best chain starting from the
+// given height.
+func (c *electrumChainClient) fetchHeaders(height int32) error {
+	raw, err := c.client.getHeaders(height, electrumHeadersBatch)
+	if err != nil {
+		return fmt.Errorf("failed to get headers: %s", err)
+	}
+	if len(raw)%wire.MaxBlockHeaderPayload != 0 {
+		return fmt.Errorf("invalid headers length %d", len(raw))
+	}
+
+	c.headersLock.Lock()
+	defer c.headersLock.Unlock()
+
+	for i := 0; i < len(raw)/wire.MaxBlockHeaderPayload; i++ {
+		h := height + int32(i)
+		if h > c.tip.height {
+			break
+		}
+		offset := i * wire.MaxBlockHeaderPayload
+		header, err := deserializeHeader(raw[offset : offset+wire.MaxBlockHeaderPayload])
+		if err != nil {
+			return err
+		}
+		c.cacheHeader(h, *header)
+	}
+	return nil
+}
+
+// cacheHeader must be called with the headers lock held.
+func (c *electrumChainClient) cacheHeader(height int32, header wire.BlockHeader) {
+	hash := header.BlockHash()
+	c.headers[hash] = electrumHeader{height, header}
+	c.hashes[height] = hash
+}
+
+func (c *electrumChainClient) notify(n interface{}) {
+	select {
+	case c.notificationQueue.ChanIn() <- n:
+	case <-c.quit:
+	}
+}
+
+func (h electrumHeader) blockMeta() wtxmgr.BlockMeta {
+	return wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: h.header.BlockHash(), Height: h.height},
+		Time:  h.header.Timestamp,
+	}
+}
+
+func parseElectrumHeader(headerHex string) (*wire.BlockHeader, error) {
+	raw, err := hex.DecodeString(headerHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header: %s", err)
+	}
+	return deserializeHeader(raw)
+}
+
+func deserializeHeader(raw []byte) (*wire.BlockHeader, error) {
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("invalid header: %s", err)
+	}
+	return &header, nil
+}
+
+// sortByDependency sorts the given txs so that the parents come before the
+// children spending them.
+func sortByDependency(txs []*wire.MsgTx) []*wire.MsgTx {
+	pending := make(map[chainhash.Hash]*wire.MsgTx, len(txs))
+	for _, tx := range txs {
+		pending[tx.TxHash()] = tx
+	}
+
+	sorted := make([]*wire.MsgTx, 0, len(txs))
+	var visit func(tx *wire.MsgTx)
+	visit = func(tx *wire.MsgTx) {
+		txid := tx.TxHash()
+		if _, ok := pending[txid]; !ok {
+			return
+		}
+		delete(pending, txid)
+
+		for _, in := range tx.TxIn {
+			if parent, ok := pending[in.PreviousOutPoint.Hash]; ok {
+				visit(parent)
+			}
+		}
+		sorted = append(sorted, tx)
+	}
+
+	for _, tx := range txs {
+		visit(tx)
+	}
+	return sorted
+}
+
+// normalizeRPCErr normalizes the bitcoind error messages, that may use either
+// dashes or spaces.
+func normalizeRPCErr(msg string) string {
+	return strings.ToLower(strings.ReplaceAll(msg, "-", " "))
+}
@@ -0,0 +1,729 @@
+package btcwallet
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/stretchr/testify/require"
+)
+
+const notificationTimeout = 5 * time.Second
+
+func TestElectrumChainClient(t *testing.T) {
+	electrumReconnectInterval = 50 * time.Millisecond
+
+	t.Run("headers", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 250)
+		client := newTestElectrumClient(t, server)
+
+		hash, height, err := client.GetBestBlock()
+		require.NoError(t, err)
+		require.Equal(t, int32(249), height)
+		require.Equal(t, server.hash(249), *hash)
+
+		// below the prefetched headers
+		hash, err = client.GetBlockHash(5)
+		require.NoError(t, err)
+		require.Equal(t, server.hash(5), *hash)
+
+		header, err := client.GetBlockHeader(hash)
+		require.NoError(t, err)
+		require.Equal(t, server.hash(4), header.PrevBlock)
+
+		_, err = client.GetBlockHash(250)
+		require.Error(t, err)
+
+		stamp, err := client.BlockStamp()
+		require.NoError(t, err)
+		require.Equal(t, int32(249), stamp.Height)
+		require.Equal(t, server.hash(249), stamp.Hash)
+
+		require.True(t, client.IsCurrent())
+		require.Equal(t, electrumBackend, client.BackEnd())
+	})
+
+	t.Run("blocks", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 10)
+		client := newTestElectrumClient(t, server)
+		require.NoError(t, client.NotifyBlocks())
+
+		server.mineBlocks(2)
+
+		for height := int32(10); height < 12; height++ {
+			n := nextNotification[chain.BlockConnected](t, client)
+			require.Equal(t, height, n.Height)
+			require.Equal(t, server.hash(height), n.Hash)
+		}
+
+		staleHashes := []chainhash.Hash{server.hash(11), server.hash(10)}
+		server.reorg(2, 3)
+
+		for i, height := range []int32{11, 10} {
+			n := nextNotification[chain.BlockDisconnected](t, client)
+			require.Equal(t, height, n.Height)
+			require.Equal(t, staleHashes[i], n.Hash)
+		}
+		for height := int32(10); height < 13; height++ {
+			n := nextNotification[chain.BlockConnected](t, client)
+			require.Equal(t, height, n.Height)
+			require.Equal(t, server.hash(height), n.Hash)
+		}
+
+		hash, height, err := client.GetBestBlock()
+		require.NoError(t, err)
+		require.Equal(t, int32(12), height)
+		require.Equal(t, server.hash(12), *hash)
+
+		// the stale blocks can still be fetched to handle the reorg
+		_, err = client.GetBlock(&staleHashes[0])
+		require.NoError(t, err)
+	})
+
+	t.Run("relevant txs", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 10)
+		client := newTestElectrumClient(t, server)
+
+		addr := randomAddress(t)
+		tx := randomTx(t, addr)
+
+		// already confirmed txs are left to the rescan
+		oldTx := randomTx(t, addr)
+		server.addTx(oldTx)
+		server.mineBlocks(1, oldTx)
+
+		require.NoError(t, client.NotifyReceived([]btcutil.Address{addr}))
+
+		server.addTx(tx)
+		n := nextNotification[chain.RelevantTx](t, client)
+		require.Equal(t, tx.TxHash(), n.TxRecord.Hash)
+		require.Nil(t, n.Block)
+
+		server.mineBlocks(1, tx)
+		n = nextNotification[chain.RelevantTx](t, client)
+		require.Equal(t, tx.TxHash(), n.TxRecord.Hash)
+		require.NotNil(t, n.Block)
+		require.Equal(t, int32(11), n.Block.Height)
+		require.Equal(t, server.hash(11), n.Block.Hash)
+
+		block, err := client.GetBlock(&n.Block.Hash)
+		require.NoError(t, err)
+		require.Len(t, block.Transactions, 1)
+		require.Equal(t, tx.TxHash(), block.Transactions[0].TxHash())
+	})
+
+	t.Run("rescan", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 10)
+		client := newTestElectrumClient(t, server)
+
+		addr := randomAddress(t)
+		oldTx := randomTx(t, addr)
+		tx := randomTx(t, addr)
+		server.addTx(oldTx)
+		server.mineBlocks(1, oldTx)
+		server.mineBlocks(1)
+		server.addTx(tx)
+		server.mineBlocks(1, tx)
+		waitBestBlock(t, client, 12)
+
+		startHash := server.hash(11)
+		require.NoError(t, client.Rescan(&startHash, []btcutil.Address{addr}, nil))
+
+		n := nextNotification[chain.RelevantTx](t, client)
+		require.Equal(t, tx.TxHash(), n.TxRecord.Hash)
+		require.Equal(t, int32(12), n.Block.Height)
+
+		finished := nextNotification[*chain.RescanFinished](t, client)
+		require.Equal(t, int32(12), finished.Height)
+		require.Equal(t, server.hash(12), *finished.Hash)
+	})
+
+	t.Run("filter blocks", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 10)
+		client := newTestElectrumClient(t, server)
+
+		external := randomAddress(t)
+		internal := randomAddress(t)
+		fundingTx := randomTx(t, external)
+		spendingTx := spendTx(t, fundingTx, internal)
+
+		server.mineBlocks(1)
+		server.addTx(fundingTx)
+		server.addTx(spendingTx)
+		server.mineBlocks(1, fundingTx, spendingTx)
+		waitBestBlock(t, client, 11)
+
+		blocks := make([]wtxmgr.BlockMeta, 0)
+		for height := int32(9); height <= 11; height++ {
+			blocks = append(blocks, blockMeta(server, height))
+		}
+
+		scope := waddrmgr.KeyScopeBIP0084
+		res, err := client.FilterBlocks(&chain.FilterBlocksRequest{
+			Blocks: blocks,
+			ExternalAddrs: map[waddrmgr.ScopedIndex]btcutil.Address{
+				{Scope: scope, Index: 3}: external,
+			},
+			InternalAddrs: map[waddrmgr.ScopedIndex]btcutil.Address{
+				{Scope: scope, Index: 1}: internal,
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, uint32(2), res.BatchIndex)
+		require.Equal(t, int32(11), res.BlockMeta.Height)
+		require.Len(t, res.RelevantTxns, 2)
+		// parents come first
+		require.Equal(t, fundingTx.TxHash(), res.RelevantTxns[0].TxHash())
+		require.Equal(t, spendingTx.TxHash(), res.RelevantTxns[1].TxHash())
+		require.Contains(t, res.FoundExternalAddrs[scope], uint32(3))
+		require.Contains(t, res.FoundInternalAddrs[scope], uint32(1))
+		require.Len(t, res.FoundOutPoints, 2)
+
+		res, err = client.FilterBlocks(&chain.FilterBlocksRequest{
+			Blocks: blocks[:2],
+			ExternalAddrs: map[waddrmgr.ScopedIndex]btcutil.Address{
+				{Scope: scope, Index: 3}: external,
+			},
+		})
+		require.NoError(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("broadcast", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 10)
+		client := newTestElectrumClient(t, server)
+
+		tx := randomTx(t, randomAddress(t))
+		txid, err := client.SendRawTransaction(tx, false)
+		require.NoError(t, err)
+		require.Equal(t, tx.TxHash(), *txid)
+		require.Contains(t, server.broadcasted(), tx.TxHash().String())
+
+		server.setBroadcastError("txn-already-in-mempool")
+		_, err = client.SendRawTransaction(tx, false)
+		require.ErrorIs(t, err, chain.ErrTxAlreadyInMempool)
+
+		server.setBroadcastError("something went wrong")
+		_, err = client.SendRawTransaction(tx, false)
+		require.ErrorIs(t, err, chain.ErrUndefined)
+
+		_, err = client.TestMempoolAccept([]*wire.MsgTx{tx}, 0)
+		require.ErrorIs(t, err, rpcclient.ErrBackendVersion)
+	})
+
+	t.Run("reconnect", func(t *testing.T) {
+		server := newFakeElectrumServer(t, 10)
+		client := newTestElectrumClient(t, server)
+		require.NoError(t, client.NotifyBlocks())
+
+		addr := randomAddress(t)
+		require.NoError(t, client.NotifyReceived([]btcutil.Address{addr}))
+
+		server.dropConnections()
+
+		// missed while disconnected
+		tx := randomTx(t, addr)
+		server.addTx(tx)
+		server.mineBlocks(1, tx)
+
+		n := nextNotification[chain.BlockConnected](t, client)
+		require.Equal(t, int32(10), n.Height)
+		rel := nextNotification[chain.RelevantTx](t, client)
+		require.Equal(t, tx.TxHash(), rel.TxRecord.Hash)
+		require.Equal(t, int32(10), rel.Block.Height)
+
+		// the subscriptions are restored
+		tx = randomTx(t, addr)
+		server.addTx(tx)
+		rel = nextNotification[chain.RelevantTx](t, client)
+		require.Equal(t, tx.TxHash(), rel.TxRecord.Hash)
+		require.Nil(t, rel.Block)
+
+		server.mineBlocks(1)
+		n = nextNotification[chain.BlockConnected](t, client)
+		require.Equal(t, int32(11), n.Height)
+	})
+}
+
+func newTestElectrumClient(t *testing.T, server *fakeElectrumServer) *electrumChainClient {
+	client, err := newElectrumChainClient(
+		server.addr(), &chaincfg.RegressionNetParams, newElectrumBlocks(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, client.Start())
+	t.Cleanup(func() {
+		client.Stop()
+		client.WaitForShutdown()
+	})
+
+	nextNotification[chain.ClientConnected](t, client)
+	return client
+}
+
+// nextNotification returns the next notification of the given type, skipping
+// the others.
+func nextNotification[T any](t *testing.T, client *electrumChainClient) T {
+	t.Helper()
+
+	timeout := time.After(notificationTimeout)
+	for {
+		select {
+		case n := <-client.Notifications():
+			if v, ok := n.(T); ok {
+				return v
+			}
+		case <-timeout:
+			var v T
+			t.Fatalf("timed out waiting for %T notification", v)
+			return v
+		}
+	}
+}
+
+func waitBestBlock(t *testing.T, client *electrumChainClient, height int32) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		_, h, err := client.GetBestBlock()
+		return err == nil && h == height
+	}, notificationTimeout, 10*time.Millisecond)
+}
+
+func blockMeta(server *fakeElectrumServer, height int32) wtxmgr.BlockMeta {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	header := server.headers[height]
+	return wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: header.BlockHash(), Height: height},
+		Time:  header.Timestamp,
+	}
+}
+
+func randomAddress(t *testing.T) btcutil.Address {
+	hash := make([]byte, 20)
+	_, err := rand.Read(hash)
+	require.NoError(t, err)
+
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(hash, &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	return addr
+}
+
+func randomTx(t *testing.T, addr btcutil.Address) *wire.MsgTx {
+	var prevHash chainhash.Hash
+	_, err := rand.Read(prevHash[:])
+	require.NoError(t, err)
+
+	script, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(100000, script))
+	return tx
+}
+
+func spendTx(t *testing.T, parent *wire.MsgTx, addr btcutil.Address) *wire.MsgTx {
+	script, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	parentHash := parent.TxHash()
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&parentHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(90000, script))
+	return tx
+}
+
+// fakeElectrumServer serves a fake chain over the electrum protocol.
+type fakeElectrumServer struct {
+	t        *testing.T
+	listener net.Listener
+
+	lock           sync.Mutex
+	headers        []wire.BlockHeader
+	txs            map[chainhash.Hash]*wire.MsgTx
+	histories      map[string][]electrumHistoryItem
+	broadcastedTxs []string
+	broadcastErr   string
+	conns          []*fakeElectrumConn
+	nonce          uint32
+}
+
+type fakeElectrumConn struct {
+	conn      net.Conn
+	writeLock sync.Mutex
+
+	// subscriptions are guarded by the server lock.
+	subscriptions map[string]struct{}
+}
+
+func newFakeElectrumServer(t *testing.T, height int) *fakeElectrumServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeElectrumServer{
+		t:         t,
+		listener:  listener,
+		txs:       make(map[chainhash.Hash]*wire.MsgTx),
+		histories: make(map[string][]electrumHistoryItem),
+	}
+	for i := 0; i < height; i++ {
+		s.appendHeader()
+	}
+
+	go s.serve()
+	t.Cleanup(func() {
+		// nolint
+		listener.Close()
+		s.dropConnections()
+	})
+	return s
+}
+
+func (s *fakeElectrumServer) addr() string {
+	return "tcp://" + s.listener.Addr().String()
+}
+
+func (s *fakeElectrumServer) hash(height int32) chainhash.Hash {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.headers[height].BlockHash()
+}
+
+func (s *fakeElectrumServer) broadcasted() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.broadcastedTxs...)
+}
+
+func (s *fakeElectrumServer) setBroadcastError(msg string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.broadcastErr = msg
+}
+
+// addTx adds the given tx to the mempool.
+func (s *fakeElectrumServer) addTx(tx *wire.MsgTx) {
+	s.lock.Lock()
+	s.txs[tx.TxHash()] = tx
+	scriptHashes := s.setTxHeight(tx, 0)
+	s.lock.Unlock()
+
+	s.notifyScriptHashes(scriptHashes)
+}
+
+// mineBlocks mines n blocks, the given txs are confirmed in the first one.
+func (s *fakeElectrumServer) mineBlocks(n int, txs ...*wire.MsgTx) {
+	s.lock.Lock()
+	scriptHashes := make([]string, 0)
+	for i := 0; i < n; i++ {
+		s.appendHeader()
+		if i == 0 {
+			for _, tx := range txs {
+				scriptHashes = append(scriptHashes, s.setTxHeight(tx, int32(len(s.headers)-1))...)
+			}
+		}
+	}
+	s.lock.Unlock()
+
+	s.notifyTip()
+	s.notifyScriptHashes(scriptHashes)
+}
+
+// reorg replaces the last depth blocks with n new ones.
+func (s *fakeElectrumServer) reorg(depth, n int) {
+	s.lock.Lock()
+	s.headers = s.headers[:len(s.headers)-depth]
+	for i := 0; i < n; i++ {
+		s.appendHeader()
+	}
+	s.lock.Unlock()
+
+	s.notifyTip()
+}
+
+func (s *fakeElectrumServer) dropConnections() {
+	s.lock.Lock()
+	conns := s.conns
+	s.conns = nil
+	s.lock.Unlock()
+
+	for _, c := range conns {
+		// nolint
+		c.conn.Close()
+	}
+}
+
+// appendHeader must be called with the lock held.
+func (s *fakeElectrumServer) appendHeader() {
+	prevHash := chainhash.Hash{}
+	if len(s.headers) > 0 {
+		prevHash = s.headers[len(s.headers)-1].BlockHash()
+	}
+	s.nonce++
+	s.headers = append(s.headers, wire.BlockHeader{
+		Version:   1,
+		PrevBlock: prevHash,
+		Timestamp: time.Unix(time.Now().Unix(), 0),
+		Bits:      0x207fffff,
+		Nonce:     s.nonce,
+	})
+}
+
+// setTxHeight adds the tx to the history of the scripts it pays to and of
+// those it spends from, and returns their script hashes. It must be called
+// with the lock held.
+func (s *fakeElectrumServer) setTxHeight(tx *wire.MsgTx, height int32) []string {
+	scripts := make([][]byte, 0)
+	for _, out := range tx.TxOut {
+		scripts = append(scripts, out.PkScript)
+	}
+	for _, in := range tx.TxIn {
+		if parent, ok := s.txs[in.PreviousOutPoint.Hash]; ok {
+			scripts = append(scripts, parent.TxOut[in.PreviousOutPoint.Index].PkScript)
+		}
+	}
+
+	txid := tx.TxHash().String()
+	scriptHashes := make([]string, 0, len(scripts))
+	for _, script := range scripts {
+		hash := sha256.Sum256(script)
+		for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+			hash[i], hash[j] = hash[j], hash[i]
+		}
+		scriptHash := hex.EncodeToString(hash[:])
+		scriptHashes = append(scriptHashes, scriptHash)
+
+		history := s.histories[scriptHash]
+		found := false
+		for i := range history {
+			if history[i].TxHash == txid {
+				history[i].Height = height
+				found = true
+			}
+		}
+		if !found {
+			history = append(history, electrumHistoryItem{height, txid})
+		}
+		s.histories[scriptHash] = history
+	}
+	return scriptHashes
+}
+
+func (s *fakeElectrumServer) notifyTip() {
+	s.lock.Lock()
+	tip := s.tip()
+	s.lock.Unlock()
+
+	s.broadcast("", "blockchain.headers.subscribe", []interface{}{tip})
+}
+
+func (s *fakeElectrumServer) notifyScriptHashes(scriptHashes []string) {
+	for _, scriptHash := range scriptHashes {
+		s.broadcast(scriptHash, "blockchain.scripthash.subscribe", []interface{}{scriptHash, "status"})
+	}
+}
+
+// broadcast sends the notification to the connections subscribed to the
+// given script hash, or to the headers if empty.
+func (s *fakeElectrumServer) broadcast(
+	scriptHash, method string, params interface{},
+) {
+	s.lock.Lock()
+	conns := make([]*fakeElectrumConn, 0, len(s.conns))
+	for _, c := range s.conns {
+		if _, ok := c.subscriptions[scriptHash]; ok {
+			conns = append(conns, c)
+		}
+	}
+	s.lock.Unlock()
+
+	for _, c := range conns {
+		c.write(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"method":  method,
+			"params":  params,
+		})
+	}
+}
+
+// tip must be called with the lock held.
+func (s *fakeElectrumServer) tip() electrumTip {
+	height := len(s.headers) - 1
+	return electrumTip{
+		Height: int32(height),
+		Hex:    serializeHeaders(s.headers[height : height+1]),
+	}
+}
+
+func (s *fakeElectrumServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		c := &fakeElectrumConn{conn: conn, subscriptions: make(map[string]struct{})}
+		s.lock.Lock()
+		s.conns = append(s.conns, c)
+		s.lock.Unlock()
+
+		go s.handle(c)
+	}
+}
+
+func (s *fakeElectrumServer) handle(c *fakeElectrumConn) {
+	reader := bufio.NewReader(c.conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.t.Errorf("invalid request: %s", line)
+			return
+		}
+
+		result, rpcErr := s.handleRequest(c, req.Method, req.Params)
+		res := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if len(rpcErr) > 0 {
+			res["error"] = map[string]interface{}{"code": 1, "message": rpcErr}
+		} else {
+			res["result"] = result
+		}
+		c.write(res)
+	}
+}
+
+func (s *fakeElectrumServer) handleRequest(
+	c *fakeElectrumConn, method string, params []json.RawMessage,
+) (interface{}, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch method {
+	case "server.version":
+		return []string{"fake", electrumProtocolVersion}, ""
+	case "server.ping":
+		return nil, ""
+	case "blockchain.headers.subscribe":
+		c.subscriptions[""] = struct{}{}
+		return s.tip(), ""
+	case "blockchain.block.headers":
+		var height, count int
+		if err := json.Unmarshal(params[0], &height); err != nil {
+			return nil, err.Error()
+		}
+		if err := json.Unmarshal(params[1], &count); err != nil {
+			return nil, err.Error()
+		}
+		if height >= len(s.headers) {
+			return electrumHeaders{}, ""
+		}
+		end := height + count
+		if end > len(s.headers) {
+			end = len(s.headers)
+		}
+		return electrumHeaders{
+			Count: end - height,
+			Hex:   serializeHeaders(s.headers[height:end]),
+		}, ""
+	case "blockchain.scripthash.subscribe":
+		var scriptHash string
+		if err := json.Unmarshal(params[0], &scriptHash); err != nil {
+			return nil, err.Error()
+		}
+		c.subscriptions[scriptHash] = struct{}{}
+		return nil, ""
+	case "blockchain.scripthash.get_history":
+		var scriptHash string
+		if err := json.Unmarshal(params[0], &scriptHash); err != nil {
+			return nil, err.Error()
+		}
+		return append([]electrumHistoryItem{}, s.histories[scriptHash]...), ""
+	case "blockchain.transaction.get":
+		var txid string
+		if err := json.Unmarshal(params[0], &txid); err != nil {
+			return nil, err.Error()
+		}
+		hash, err := chainhash.NewHashFromStr(txid)
+		if err != nil {
+			return nil, err.Error()
+		}
+		tx, ok := s.txs[*hash]
+		if !ok {
+			return nil, "tx not found"
+		}
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			return nil, err.Error()
+		}
+		return hex.EncodeToString(buf.Bytes()), ""
+	case "blockchain.transaction.broadcast":
+		if len(s.broadcastErr) > 0 {
+			return nil, s.broadcastErr
+		}
+		var txHex string
+		if err := json.Unmarshal(params[0], &txHex); err != nil {
+			return nil, err.Error()
+		}
+		raw, err := hex.DecodeString(txHex)
+		if err != nil {
+			return nil, err.Error()
+		}
+		var tx wire.MsgTx
+		if err := tx.Deserialize(bytes.NewReader(raw)); err != nil {
+			return nil, err.Error()
+		}
+		txid := tx.TxHash().String()
+		s.broadcastedTxs = append(s.broadcastedTxs, txid)
+		return txid, ""
+	default:
+		return nil, "unknown method " + method
+	}
+}
+
+func (c *fakeElectrumConn) write(msg interface{}) {
+	buf, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	// nolint
+	c.conn.Write(append(buf, '\n'))
+}
+
+func serializeHeaders(headers []wire.BlockHeader) string {
+	var buf bytes.Buffer
+	for _, header := range headers {
+		// nolint
+		header.Serialize(&buf)
+	}
+	return hex.EncodeToString(buf.Bytes())
+}
@@ -19,9 +19,13 @@ type esploraClient struct {
 }
 
 type esploraTx struct {
+	Fee    uint64 `json:"fee"`
+	Weight int64  `json:"weight"`
 	Status struct {
-		Confirmed bool  `json:"confirmed"`
-		BlockTime int64 `json:"block_time"`
+		Confirmed   bool   `json:"confirmed"`
+		BlockHeight int64  `json:"block_height"`
+		BlockHash   string `json:"block_hash"`
+		BlockTime   int64  `json:"block_time"`
 	} `json:"status"`
 }
 
@@ -55,29 +59,88 @@ func (f *esploraClient) broadcast(txhex string) error {
 }
 
 func (f *esploraClient) getTxStatus(txid string) (isConfirmed bool, blocktime int64, err error) {
+	tx, err := f.getTx(txid)
+	if err != nil || tx == nil {
+		return false, 0, err
+	}
+
+	return tx.Status.Confirmed, tx.Status.BlockTime, nil
+}
+
+// getTx returns the given tx, nil if it's unknown.
+func (f *esploraClient) getTx(txid string) (*esploraTx, error) {
 	endpoint, err := url.JoinPath(f.url, "tx", txid)
 	if err != nil {
-		return false, 0, err
+		return nil, err
 	}
 
 	resp, err := http.DefaultClient.Get(endpoint)
 	if err != nil {
-		return false, 0, err
+		return nil, err
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return false, 0, err
+		return nil, nil
 	}
 
 	var response esploraTx
 
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return false, 0, err
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (f *esploraClient) isTxPublished(txid string) (bool, error) {
+	endpoint, err := url.JoinPath(f.url, "tx", txid, "status")
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := http.DefaultClient.Get(endpoint)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to get status of tx %s: %s", txid, resp.Status)
+	}
+}
+
+func (f *esploraClient) getTxFee(txid string) (fee uint64, weight int64, err error) {
+	endpoint, err := url.JoinPath(f.url, "tx", txid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	resp, err := http.DefaultClient.Get(endpoint)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("tx %s not found: %s", txid, resp.Status)
+	}
+
+	var response esploraTx
+
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return 0, 0, err
 	}
 
-	return response.Status.Confirmed, response.Status.BlockTime, nil
+	return response.Fee, response.Weight, nil
 }
 
 func (f *esploraClient) getFeeRate() (btcutil.Amount, error) {
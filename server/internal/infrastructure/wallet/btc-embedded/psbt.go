@@ -1,8 +1,10 @@
 package btcwallet
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/ark-network/ark/common/bitcointree"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/btcutil/psbt"
@@ -111,9 +113,65 @@ func (s *service) signPsbt(packet *psbt.Packet) ([]uint32, error) {
 	// 	return nil, err
 	// }
 
+	if s.signer != nil {
+		// the signer refuses to pay to outputs it can't prove are its own
+		s.addOutputsDerivation(packet)
+		return s.signer.signPsbt(packet)
+	}
 	return s.wallet.SignPsbt(packet)
 }
 
+// addOutputsDerivation sets the derivation path of the wallet key of the
+// outputs paying to the wallet, or to a tree whose sweep leaf is locked by the
+// ASP key, like the shared output of a round.
+func (s *service) addOutputsDerivation(packet *psbt.Packet) {
+	for idx, out := range packet.UnsignedTx.TxOut {
+		if len(packet.Outputs[idx].TaprootTapTree) > 0 {
+			aspXonlyKey := schnorr.SerializePubKey(s.aspTaprootAddr.PubKey())
+			leafHashes := make([][]byte, 0)
+			leaves, err := bitcointree.DecodeTapTree(packet.Outputs[idx].TaprootTapTree)
+			if err != nil {
+				log.Debugf("SignPsbt: Skipping output %d, error "+
+					"decoding taproot tree: %v", idx, err)
+				continue
+			}
+			for _, leaf := range leaves {
+				closure := &bitcointree.CSVSigClosure{}
+				if valid, err := closure.Decode(leaf.Script); err != nil || !valid {
+					continue
+				}
+				if !bytes.Equal(schnorr.SerializePubKey(closure.Pubkey), aspXonlyKey) {
+					continue
+				}
+				leafHash := leaf.TapHash()
+				leafHashes = append(leafHashes, leafHash[:])
+			}
+			if len(leafHashes) <= 0 {
+				continue
+			}
+
+			bip32Infos := derivationPathForAddress(s.aspTaprootAddr)
+			packet.Outputs[idx].TaprootBip32Derivation = []*psbt.TaprootBip32Derivation{
+				{
+					XOnlyPubKey:          aspXonlyKey,
+					MasterKeyFingerprint: bip32Infos.MasterKeyFingerprint,
+					Bip32Path:            bip32Infos.Bip32Path,
+					LeafHashes:           leafHashes,
+				},
+			}
+			continue
+		}
+
+		managedAddress, _, _, err := s.wallet.ScriptForOutput(out)
+		if err != nil {
+			continue
+		}
+		packet.Outputs[idx].Bip32Derivation = []*psbt.Bip32Derivation{
+			derivationPathForAddress(managedAddress),
+		}
+	}
+}
+
 func derivationPathForAddress(addr waddrmgr.ManagedPubKeyAddress) *psbt.Bip32Derivation {
 	keyscope, derivationInfos, _ := addr.DerivationInfo()
 
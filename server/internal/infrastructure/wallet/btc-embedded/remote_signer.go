@@ -0,0 +1,223 @@
+package btcwallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+var (
+	// remoteSignerRetryInterval and remoteSignerTimeout define how long a
+	// request is retried for while the signer is unavailable.
+	remoteSignerRetryInterval = 3 * time.Second
+	remoteSignerTimeout       = 30 * time.Second
+)
+
+// RemoteSignerConfig is the config of the connection to the remote signer.
+type RemoteSignerConfig struct {
+	// Addr is the host:port the signer listens on.
+	Addr string
+	// AuthToken is the token the signer requires to authenticate requests.
+	AuthToken string
+	// TLSCert is the path of the TLS certificate of the signer, the connection
+	// is insecure if not set, which the signer accepts only on localhost.
+	TLSCert string
+}
+
+// remoteSigner is the client of the signer holding the keys of the wallet,
+// which is watch-only on this side.
+type remoteSigner struct {
+	addr   string
+	conn   *grpc.ClientConn
+	client arkv1.SignerServiceClient
+}
+
+func newRemoteSigner(cfg RemoteSignerConfig) (*remoteSigner, error) {
+	if len(cfg.AuthToken) <= 0 {
+		return nil, fmt.Errorf("missing remote signer auth token")
+	}
+
+	creds := insecure.NewCredentials()
+	if len(cfg.TLSCert) > 0 {
+		tlsCreds, err := credentials.NewClientTLSFromFile(cfg.TLSCert, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signer tls cert: %s", err)
+		}
+		creds = tlsCreds
+	}
+
+	conn, err := grpc.NewClient(
+		cfg.Addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(tokenAuth{cfg.AuthToken, len(cfg.TLSCert) > 0}),
+		// reconnects as often as requests are retried, the default backoff
+		// would keep failing them long after the signer is back
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  remoteSignerRetryInterval,
+				Multiplier: 1,
+				MaxDelay:   remoteSignerRetryInterval,
+			},
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSigner{
+		addr:   cfg.Addr,
+		conn:   conn,
+		client: arkv1.NewSignerServiceClient(conn),
+	}, nil
+}
+
+func (r *remoteSigner) close() {
+	// nolint
+	r.conn.Close()
+}
+
+func (r *remoteSigner) getAccounts(ctx context.Context) ([]SignerAccount, error) {
+	var res *arkv1.GetAccountsResponse
+	if err := r.withRetry(ctx, func(ctx context.Context) (err error) {
+		res, err = r.client.GetAccounts(ctx, &arkv1.GetAccountsRequest{})
+		return
+	}); err != nil {
+		return nil, fmt.Errorf("failed to get accounts from signer: %s", err)
+	}
+
+	accounts := make([]SignerAccount, 0, len(res.GetAccounts()))
+	for _, account := range res.GetAccounts() {
+		accounts = append(accounts, SignerAccount{
+			Name:                 account.GetName(),
+			Purpose:              account.GetPurpose(),
+			CoinType:             account.GetCoinType(),
+			Number:               account.GetNumber(),
+			Xpub:                 account.GetXpub(),
+			MasterKeyFingerprint: account.GetMasterKeyFingerprint(),
+		})
+	}
+	sortAccounts(accounts)
+	return accounts, nil
+}
+
+// GetSignerPubkey returns the pubkey held by the remote signer, derived from
+// the xpub of its asp key account without creating the watch-only wallet.
+func GetSignerPubkey(
+	ctx context.Context, cfg RemoteSignerConfig,
+) (*secp256k1.PublicKey, error) {
+	signer, err := newRemoteSigner(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %s", err)
+	}
+	defer signer.close()
+
+	accounts, err := signer.getAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.Name != string(aspKeyAccount) ||
+			account.Purpose != p2trKeyScope.Purpose {
+			continue
+		}
+
+		// the asp key is the first external address of its account.
+		key, err := hdkeychain.NewKeyFromString(account.Xpub)
+		if err != nil {
+			return nil, fmt.Errorf("invalid xpub of account %s: %s", account.Name, err)
+		}
+		for _, i := range []uint32{0, 0} {
+			if key, err = key.Derive(i); err != nil {
+				return nil, err
+			}
+		}
+		return key.ECPubKey()
+	}
+	return nil, fmt.Errorf("account %s not found in remote signer", aspKeyAccount)
+}
+
+// signPsbt sends the given packet to the signer and replaces it with the
+// signed one.
+func (r *remoteSigner) signPsbt(packet *psbt.Packet) ([]uint32, error) {
+	b64, err := packet.B64Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	var res *arkv1.SignPsbtResponse
+	if err := r.withRetry(context.Background(), func(ctx context.Context) (err error) {
+		res, err = r.client.SignPsbt(ctx, &arkv1.SignPsbtRequest{Psbt: b64})
+		return
+	}); err != nil {
+		return nil, fmt.Errorf("failed to sign psbt with signer: %s", err)
+	}
+
+	signed, err := psbt.NewFromRawBytes(strings.NewReader(res.GetPsbt()), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signed psbt: %s", err)
+	}
+	if signed.UnsignedTx.TxHash() != packet.UnsignedTx.TxHash() {
+		return nil, fmt.Errorf("signer returned a different psbt")
+	}
+
+	*packet = *signed
+	return res.GetSignedInputs(), nil
+}
+
+// withRetry retries the given request as long as the signer is unavailable,
+// so that a restart of the signer doesn't make arkd fail.
+func (r *remoteSigner) withRetry(
+	ctx context.Context, request func(ctx context.Context) error,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, remoteSignerTimeout)
+	defer cancel()
+
+	for {
+		err := request(ctx)
+		if err == nil {
+			return nil
+		}
+
+		code := grpcstatus.Code(err)
+		if code != codes.Unavailable && code != codes.DeadlineExceeded {
+			return err
+		}
+
+		log.WithError(err).Warnf(
+			"signer at %s unavailable, retrying in %s", r.addr, remoteSignerRetryInterval,
+		)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(remoteSignerRetryInterval):
+		}
+	}
+}
+
+// tokenAuth adds the auth token of the signer to the metadata of the requests.
+type tokenAuth struct {
+	token  string
+	secure bool
+}
+
+func (t tokenAuth) GetRequestMetadata(
+	_ context.Context, _ ...string,
+) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t tokenAuth) RequireTransportSecurity() bool {
+	return t.secure
+}
@@ -0,0 +1,186 @@
+package btcwallet
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+const testAuthToken = "token"
+
+func TestRemoteSigner(t *testing.T) {
+	remoteSignerRetryInterval = 50 * time.Millisecond
+	remoteSignerTimeout = time.Second
+
+	t.Run("auth token", func(t *testing.T) {
+		server := newFakeSigner(t, "", 0, codes.OK)
+		client := newTestRemoteSigner(t, server.addr)
+
+		_, err := client.getAccounts(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []string{"Bearer " + testAuthToken}, server.authorization())
+	})
+
+	t.Run("missing asp key account", func(t *testing.T) {
+		server := newFakeSigner(t, "", 0, codes.OK)
+
+		_, err := GetSignerPubkey(context.Background(), RemoteSignerConfig{
+			Addr: server.addr, AuthToken: testAuthToken,
+		})
+		require.ErrorContains(t, err, "account aspkey not found")
+	})
+
+	t.Run("missing auth token", func(t *testing.T) {
+		_, err := newRemoteSigner(RemoteSignerConfig{Addr: "127.0.0.1:7071"})
+		require.Error(t, err)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		server := newFakeSigner(t, "", 2, codes.Unavailable)
+		client := newTestRemoteSigner(t, server.addr)
+
+		packet := newTestPacket(t)
+		signedInputs, err := client.signPsbt(packet)
+		require.NoError(t, err)
+		require.Equal(t, []uint32{0}, signedInputs)
+		require.Equal(t, 3, server.calls())
+	})
+
+	t.Run("signer temporarily unavailable", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := lis.Addr().String()
+		require.NoError(t, lis.Close())
+
+		client := newTestRemoteSigner(t, addr)
+
+		// the signer is started while the request is being retried
+		go func() {
+			time.Sleep(3 * remoteSignerRetryInterval)
+			newFakeSigner(t, addr, 0, codes.OK)
+		}()
+
+		_, err = client.getAccounts(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("signer unavailable", func(t *testing.T) {
+		server := newFakeSigner(t, "", -1, codes.Unavailable)
+		client := newTestRemoteSigner(t, server.addr)
+
+		_, err := client.getAccounts(context.Background())
+		require.Error(t, err)
+		require.Greater(t, server.calls(), 1)
+	})
+
+	t.Run("permanent error", func(t *testing.T) {
+		server := newFakeSigner(t, "", -1, codes.PermissionDenied)
+		client := newTestRemoteSigner(t, server.addr)
+
+		_, err := client.signPsbt(newTestPacket(t))
+		require.Error(t, err)
+		require.Equal(t, 1, server.calls())
+	})
+}
+
+func newTestRemoteSigner(t *testing.T, addr string) *remoteSigner {
+	client, err := newRemoteSigner(RemoteSignerConfig{
+		Addr: addr, AuthToken: testAuthToken,
+	})
+	require.NoError(t, err)
+	t.Cleanup(client.close)
+	return client
+}
+
+func newTestPacket(t *testing.T) *psbt.Packet {
+	packet, err := psbt.New(
+		[]*wire.OutPoint{{Index: 0}},
+		[]*wire.TxOut{{Value: 1000, PkScript: []byte{0x51}}},
+		2, 0, []uint32{wire.MaxTxInSequenceNum},
+	)
+	require.NoError(t, err)
+	return packet
+}
+
+// fakeSigner fails the first given number of requests with the given code, or
+// all of them if negative, and returns the request psbt as signed afterwards.
+type fakeSigner struct {
+	arkv1.UnimplementedSignerServiceServer
+
+	addr     string
+	failures int
+	code     codes.Code
+
+	lock sync.Mutex
+	n    int
+	md   metadata.MD
+}
+
+func newFakeSigner(t *testing.T, addr string, failures int, code codes.Code) *fakeSigner {
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	lis, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+
+	s := &fakeSigner{addr: lis.Addr().String(), failures: failures, code: code}
+	server := grpc.NewServer()
+	arkv1.RegisterSignerServiceServer(server, s)
+	// nolint
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return s
+}
+
+func (s *fakeSigner) GetAccounts(
+	ctx context.Context, _ *arkv1.GetAccountsRequest,
+) (*arkv1.GetAccountsResponse, error) {
+	if err := s.call(ctx); err != nil {
+		return nil, err
+	}
+	return &arkv1.GetAccountsResponse{}, nil
+}
+
+func (s *fakeSigner) SignPsbt(
+	ctx context.Context, req *arkv1.SignPsbtRequest,
+) (*arkv1.SignPsbtResponse, error) {
+	if err := s.call(ctx); err != nil {
+		return nil, err
+	}
+	return &arkv1.SignPsbtResponse{Psbt: req.GetPsbt(), SignedInputs: []uint32{0}}, nil
+}
+
+func (s *fakeSigner) call(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.n++
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	if s.failures < 0 || s.n <= s.failures {
+		return grpcstatus.Error(s.code, "fake error")
+	}
+	return nil
+}
+
+func (s *fakeSigner) calls() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.n
+}
+
+func (s *fakeSigner) authorization() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.md.Get("authorization")
+}
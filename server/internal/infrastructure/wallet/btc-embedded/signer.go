@@ -0,0 +1,370 @@
+package btcwallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ark-network/ark/common/bitcointree"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wallet"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/blockcache"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/vulpemventures/go-bip39"
+)
+
+// lndKeyFamilies is the number of accounts of the lnd key scope, that are
+// expected to exist by the lnd wallet wrapping the btcwallet one.
+const lndKeyFamilies = 255
+
+var (
+	waddrmgrNamespaceKey = []byte("waddrmgr")
+	lndKeyScope          = waddrmgr.KeyScope{
+		Purpose: keychain.BIP0043Purpose,
+	}
+)
+
+// ErrOutputNotAllowed is returned when the signer is asked to sign a PSBT that
+// pays to outputs the policy doesn't allow.
+var ErrOutputNotAllowed = errors.New("output not allowed")
+
+// SignerPolicy restricts the PSBTs the signer signs. The outputs paying to the
+// wallet, or to a tree whose sweep leaf is locked by a wallet key like the
+// shared output of a round, are always allowed, the others only up to the max
+// external amount.
+type SignerPolicy struct {
+	// MaxExternalAmount is the max amount a PSBT can pay to outputs not owned
+	// by the wallet, like those of collaborative exits and withdrawals.
+	MaxExternalAmount uint64
+}
+
+// SignerAccount is an account of the signer wallet, whose xpub is imported by
+// the watch-only one.
+type SignerAccount struct {
+	Name                 string
+	Purpose              uint32
+	CoinType             uint32
+	Number               uint32
+	Xpub                 string
+	MasterKeyFingerprint uint32
+}
+
+// Signer holds the keys of the wallet and signs the PSBTs of the watch-only one
+// configured with WithRemoteSigner. It has the same accounts as the embedded
+// wallet but it doesn't require any chain source.
+type Signer struct {
+	wallet *btcwallet.BtcWallet
+	loader *wallet.Loader
+	policy SignerPolicy
+}
+
+// NewSigner opens and unlocks the signer wallet, or creates it from the given
+// mnemonic if it doesn't exist yet.
+func NewSigner(
+	cfg WalletConfig, mnemonic, password string, policy SignerPolicy,
+) (*Signer, error) {
+	if len(password) <= 0 {
+		return nil, fmt.Errorf("missing password")
+	}
+
+	pwd := []byte(password)
+	opt := btcwallet.LoaderWithLocalWalletDB(cfg.Datadir, false, time.Minute)
+	loader, err := btcwallet.NewWalletLoader(cfg.chainParams(), 0, opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup wallet loader: %s", err)
+	}
+
+	exists, err := loader.WalletExists()
+	if err != nil {
+		return nil, err
+	}
+
+	var w *wallet.Wallet
+	if exists {
+		w, err = loader.OpenExistingWallet(pwd, false)
+	} else {
+		if len(mnemonic) <= 0 {
+			return nil, fmt.Errorf("missing hd seed")
+		}
+		seed := bip39.NewSeed(mnemonic, password)
+		w, err = loader.CreateNewWallet(pwd, pwd, seed, time.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// only the goroutines handling the lock state of the wallet are started,
+	// the chain is never synced
+	w.Start()
+	if err := w.Unlock(pwd, nil); err != nil {
+		w.Stop()
+		return nil, fmt.Errorf("failed to unlock wallet: %s", err)
+	}
+
+	if err := initAccounts(w); err != nil {
+		w.Stop()
+		return nil, err
+	}
+	if err := initLndAccounts(w); err != nil {
+		w.Stop()
+		return nil, fmt.Errorf("failed to create lnd accounts: %s", err)
+	}
+
+	btcWallet, err := btcwallet.New(btcwallet.Config{
+		PrivatePass: pwd,
+		PublicPass:  pwd,
+		NetParams:   cfg.chainParams(),
+		Wallet:      w,
+	}, blockcache.NewBlockCache(0))
+	if err != nil {
+		w.Stop()
+		return nil, err
+	}
+
+	return &Signer{btcWallet, loader, policy}, nil
+}
+
+// GetAccounts returns the accounts of all the key scopes of the wallet, sorted
+// by scope and number, which is the order they must be imported in.
+func (s *Signer) GetAccounts() ([]SignerAccount, error) {
+	w := s.wallet.InternalWallet()
+
+	accounts := make([]SignerAccount, 0)
+	for _, mgr := range w.Manager.ActiveScopedKeyManagers() {
+		scope := mgr.Scope()
+		res, err := w.Accounts(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range res.Accounts {
+			if account.AccountNumber == waddrmgr.ImportedAddrAccount ||
+				account.AccountPubKey == nil {
+				continue
+			}
+			accounts = append(accounts, SignerAccount{
+				Name:                 account.AccountName,
+				Purpose:              scope.Purpose,
+				CoinType:             scope.Coin,
+				Number:               account.AccountNumber,
+				Xpub:                 account.AccountPubKey.String(),
+				MasterKeyFingerprint: account.MasterKeyFingerprint,
+			})
+		}
+	}
+
+	sortAccounts(accounts)
+	return accounts, nil
+}
+
+// SignPsbt signs the inputs of the given PSBT with a BIP32 derivation path of
+// the wallet keys, and returns the PSBT with the indexes of the signed inputs.
+// The PSBT is refused with ErrOutputNotAllowed if its outputs don't satisfy the
+// policy of the signer.
+func (s *Signer) SignPsbt(b64 string) (string, []uint32, error) {
+	ptx, err := psbt.NewFromRawBytes(strings.NewReader(b64), true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := s.checkOutputs(ptx); err != nil {
+		return "", nil, err
+	}
+
+	signedInputs, err := s.wallet.SignPsbt(ptx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	signedPtx, err := ptx.B64Encode()
+	if err != nil {
+		return "", nil, err
+	}
+	return signedPtx, signedInputs, nil
+}
+
+func (s *Signer) Close() {
+	s.wallet.InternalWallet().Lock()
+	// nolint
+	s.loader.UnloadWallet()
+}
+
+// checkOutputs makes sure the outputs of the given PSBT not owned by the wallet
+// don't pay more than the max external amount of the policy.
+func (s *Signer) checkOutputs(ptx *psbt.Packet) error {
+	externalAmount := uint64(0)
+	for i, out := range ptx.UnsignedTx.TxOut {
+		if s.isOwnOutput(out, ptx.Outputs[i]) || s.isSweepableOutput(out, ptx.Outputs[i]) {
+			continue
+		}
+		externalAmount += uint64(out.Value)
+	}
+
+	if externalAmount > s.policy.MaxExternalAmount {
+		return fmt.Errorf(
+			"%w: psbt pays %d sats to external outputs, max %d",
+			ErrOutputNotAllowed, externalAmount, s.policy.MaxExternalAmount,
+		)
+	}
+	return nil
+}
+
+// isOwnOutput returns whether the given output pays to the address derived at
+// one of its BIP32 derivation paths.
+func (s *Signer) isOwnOutput(out *wire.TxOut, pout psbt.POutput) bool {
+	for _, derivation := range pout.Bip32Derivation {
+		addr, err := s.deriveAddress(derivation.Bip32Path)
+		if err != nil {
+			continue
+		}
+		script, err := txscript.PayToAddrScript(addr.Address())
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(script, out.PkScript) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSweepableOutput returns whether the given output is a taproot one, whose
+// tree has a CSV sweep leaf locked by the key derived at one of its taproot
+// BIP32 derivation paths.
+func (s *Signer) isSweepableOutput(out *wire.TxOut, pout psbt.POutput) bool {
+	if !txscript.IsPayToTaproot(out.PkScript) ||
+		len(pout.TaprootInternalKey) <= 0 || len(pout.TaprootTapTree) <= 0 {
+		return false
+	}
+
+	internalKey, err := schnorr.ParsePubKey(pout.TaprootInternalKey)
+	if err != nil {
+		return false
+	}
+	leaves, err := bitcointree.DecodeTapTree(pout.TaprootTapTree)
+	if err != nil {
+		return false
+	}
+
+	tapTree := txscript.AssembleTaprootScriptTree(leaves...)
+	root := tapTree.RootNode.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, root[:])
+	if !bytes.Equal(schnorr.SerializePubKey(outputKey), out.PkScript[2:]) {
+		return false
+	}
+
+	for _, leaf := range leaves {
+		closure := &bitcointree.CSVSigClosure{}
+		if valid, err := closure.Decode(leaf.Script); err != nil || !valid {
+			continue
+		}
+		sweepKey := schnorr.SerializePubKey(closure.Pubkey)
+
+		for _, derivation := range pout.TaprootBip32Derivation {
+			if !bytes.Equal(derivation.XOnlyPubKey, sweepKey) {
+				continue
+			}
+			addr, err := s.deriveAddress(derivation.Bip32Path)
+			if err != nil {
+				continue
+			}
+			if bytes.Equal(schnorr.SerializePubKey(addr.PubKey()), sweepKey) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deriveAddress returns the address of the wallet at the given BIP32 path,
+// made of purpose, coin type, account, branch and index.
+func (s *Signer) deriveAddress(path []uint32) (waddrmgr.ManagedPubKeyAddress, error) {
+	if len(path) != 5 {
+		return nil, fmt.Errorf("invalid derivation path length %d", len(path))
+	}
+	for _, i := range path[:3] {
+		if i < hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("invalid derivation path, expected hardened keys")
+		}
+	}
+
+	w := s.wallet.InternalWallet()
+	mgr, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScope{
+		Purpose: path[0] - hdkeychain.HardenedKeyStart,
+		Coin:    path[1] - hdkeychain.HardenedKeyStart,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var addr waddrmgr.ManagedAddress
+	if err := walletdb.View(w.Database(), func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		addr, err = mgr.DeriveFromKeyPath(ns, waddrmgr.DerivationPath{
+			InternalAccount: path[2] - hdkeychain.HardenedKeyStart,
+			Account:         path[2],
+			Branch:          path[3],
+			Index:           path[4],
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	pubkeyAddr, ok := addr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return nil, fmt.Errorf("invalid address type")
+	}
+	return pubkeyAddr, nil
+}
+
+// initLndAccounts creates the accounts of the lnd key scope, like the lnd
+// wallet does when started. The watch-only wallet can't derive them, so they
+// have to be imported as well.
+func initLndAccounts(w *wallet.Wallet) error {
+	return walletdb.Update(w.Database(), func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		mgr, err := w.Manager.FetchScopedKeyManager(lndKeyScope)
+		if err != nil {
+			mgr, err = w.Manager.NewScopedKeyManager(
+				ns, lndKeyScope, waddrmgr.ScopeAddrMap[p2wpkhKeyScope],
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		for keyFamily := uint32(1); keyFamily <= lndKeyFamilies; keyFamily++ {
+			if _, err := mgr.AccountName(ns, keyFamily); err == nil {
+				continue
+			}
+			if err := mgr.NewRawAccount(ns, keyFamily); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func sortAccounts(accounts []SignerAccount) {
+	sort.SliceStable(accounts, func(i, j int) bool {
+		if accounts[i].Purpose != accounts[j].Purpose {
+			return accounts[i].Purpose < accounts[j].Purpose
+		}
+		if accounts[i].CoinType != accounts[j].CoinType {
+			return accounts[i].CoinType < accounts[j].CoinType
+		}
+		return accounts[i].Number < accounts[j].Number
+	})
+}
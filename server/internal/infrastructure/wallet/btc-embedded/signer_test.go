@@ -0,0 +1,178 @@
+package btcwallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ark-network/ark/common"
+	"github.com/ark-network/ark/common/bitcointree"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-bip39"
+)
+
+const (
+	roundLifetime = 512
+	outputAmount  = 100_000
+)
+
+func TestSignerPolicy(t *testing.T) {
+	signer := newTestSigner(t)
+
+	p2wpkhPath := testPath(p2wpkhKeyScope, 0)
+	p2trPath := testPath(p2trKeyScope, 0)
+
+	ownAddr, err := signer.deriveAddress(p2wpkhPath)
+	require.NoError(t, err)
+	ownScript, err := txscript.PayToAddrScript(ownAddr.Address())
+	require.NoError(t, err)
+
+	sweepAddr, err := signer.deriveAddress(p2trPath)
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	externalScript, err := txscript.PayToTaprootScript(otherKey.PubKey())
+	require.NoError(t, err)
+
+	ownOutput := func(path []uint32) func(*psbt.Packet) {
+		return func(ptx *psbt.Packet) {
+			ptx.UnsignedTx.TxOut[0].PkScript = ownScript
+			ptx.Outputs[0].Bip32Derivation = []*psbt.Bip32Derivation{{
+				PubKey:    ownAddr.PubKey().SerializeCompressed(),
+				Bip32Path: path,
+			}}
+		}
+	}
+	sharedOutput := func(
+		sweepKey *btcec.PublicKey, derivationKey *btcec.PublicKey, path []uint32,
+	) func(*psbt.Packet) {
+		return func(ptx *psbt.Packet) {
+			cosigners := []*btcec.PublicKey{otherKey.PubKey()}
+			script, _, err := bitcointree.CraftSharedOutput(
+				cosigners, sweepKey, []bitcointree.Receiver{{
+					Pubkey: hex.EncodeToString(otherKey.PubKey().SerializeCompressed()),
+					Amount: outputAmount,
+				}}, 0, roundLifetime, roundLifetime,
+			)
+			require.NoError(t, err)
+			ptx.UnsignedTx.TxOut[0].PkScript = script
+
+			err = bitcointree.AddSharedOutputTapTree(0, ptx, cosigners, sweepKey, roundLifetime)
+			require.NoError(t, err)
+			if path != nil {
+				ptx.Outputs[0].TaprootBip32Derivation = []*psbt.TaprootBip32Derivation{{
+					XOnlyPubKey: schnorr.SerializePubKey(derivationKey),
+					Bip32Path:   path,
+				}}
+			}
+		}
+	}
+	externalOutput := func(ptx *psbt.Packet) {
+		ptx.UnsignedTx.TxOut[0].PkScript = externalScript
+	}
+
+	fixtures := []struct {
+		name              string
+		maxExternalAmount uint64
+		output            func(*psbt.Packet)
+		allowed           bool
+	}{
+		{
+			name:    "own output",
+			output:  ownOutput(p2wpkhPath),
+			allowed: true,
+		},
+		{
+			name:    "own output with wrong derivation path",
+			output:  ownOutput(testPath(p2wpkhKeyScope, 1)),
+			allowed: false,
+		},
+		{
+			name:    "shared output",
+			output:  sharedOutput(sweepAddr.PubKey(), sweepAddr.PubKey(), p2trPath),
+			allowed: true,
+		},
+		{
+			name:    "shared output without derivation path",
+			output:  sharedOutput(sweepAddr.PubKey(), nil, nil),
+			allowed: false,
+		},
+		{
+			name:    "shared output swept by external key",
+			output:  sharedOutput(otherKey.PubKey(), otherKey.PubKey(), p2trPath),
+			allowed: false,
+		},
+		{
+			name:              "external output within max amount",
+			maxExternalAmount: outputAmount,
+			output:            externalOutput,
+			allowed:           true,
+		},
+		{
+			name:              "external output above max amount",
+			maxExternalAmount: outputAmount - 1,
+			output:            externalOutput,
+			allowed:           false,
+		},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			signer.policy = SignerPolicy{MaxExternalAmount: f.maxExternalAmount}
+
+			ptx, err := psbt.New(
+				[]*wire.OutPoint{{Index: 0}},
+				[]*wire.TxOut{{Value: outputAmount}},
+				2, 0, []uint32{wire.MaxTxInSequenceNum},
+			)
+			require.NoError(t, err)
+			ptx.Inputs[0].WitnessUtxo = &wire.TxOut{
+				Value: outputAmount, PkScript: externalScript,
+			}
+			f.output(ptx)
+
+			b64, err := ptx.B64Encode()
+			require.NoError(t, err)
+
+			_, _, err = signer.SignPsbt(b64)
+			if f.allowed {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrOutputNotAllowed)
+		})
+	}
+}
+
+func newTestSigner(t *testing.T) *Signer {
+	entropy, err := bip39.NewEntropy(128)
+	require.NoError(t, err)
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	require.NoError(t, err)
+
+	signer, err := NewSigner(WalletConfig{
+		Datadir: t.TempDir(),
+		Network: common.BitcoinRegTest,
+	}, mnemonic, "password", SignerPolicy{})
+	require.NoError(t, err)
+	t.Cleanup(signer.Close)
+	return signer
+}
+
+// testPath returns the path of the key at the given index of the external
+// branch of the default account of the given scope.
+func testPath(scope waddrmgr.KeyScope, index uint32) []uint32 {
+	return []uint32{
+		scope.Purpose + hdkeychain.HardenedKeyStart,
+		scope.Coin + hdkeychain.HardenedKeyStart,
+		hdkeychain.HardenedKeyStart,
+		0,
+		index,
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -13,6 +16,7 @@ import (
 	"github.com/ark-network/ark/server/internal/core/domain"
 	"github.com/ark-network/ark/server/internal/core/ports"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -67,6 +71,21 @@ const (
 	aspKeyAccount    accountName = "aspkey"
 )
 
+// Estimated vsize of a child tx spending p2wpkh outputs to a single p2wpkh
+// output, used to bump the fee of its parent.
+const (
+	childTxBaseVsize  = 11 + 31
+	childTxInputVsize = 68
+	dustAmount        = 330
+)
+
+// Estimated base vsize of a tx, without inputs and outputs.
+const txBaseVsize = 11
+
+// medianTimeBlocks is the number of blocks whose timestamps define the median
+// time past of the chain.
+const medianTimeBlocks = 11
+
 var (
 	p2wpkhKeyScope     = waddrmgr.KeyScopeBIP0084
 	p2trKeyScope       = waddrmgr.KeyScopeBIP0086
@@ -86,6 +105,10 @@ type service struct {
 	watchedScripts     map[string]struct{}
 
 	aspTaprootAddr waddrmgr.ManagedPubKeyAddress
+
+	// signer is set if the wallet is watch-only and its keys are held by a
+	// remote signer.
+	signer *remoteSigner
 }
 
 // WithNeutrino creates a start a neutrino node using the provided service datadir
@@ -200,6 +223,57 @@ func WithPollingBitcoind(host, user, pass string) WalletOption {
 	}
 }
 
+// WithElectrum uses the electrum server at the given address as chain source,
+// in the form host:port, with the ssl:// prefix to connect over tls.
+func WithElectrum(host string) WalletOption {
+	return func(s *service) error {
+		netParams := s.cfg.chainParams()
+
+		chain.UseLogger(logger("chain"))
+
+		// the electrum server doesn't serve blocks, the chain source and the
+		// scanner share the txs they're notified of to rebuild them
+		blocks := newElectrumBlocks()
+
+		chainSrc, err := newElectrumChainClient(host, netParams, blocks)
+		if err != nil {
+			return err
+		}
+		scanner, err := newElectrumChainClient(host, netParams, blocks)
+		if err != nil {
+			return err
+		}
+
+		if err := withChainSource(chainSrc)(s); err != nil {
+			return fmt.Errorf("failed to set chain source: %w", err)
+		}
+		if err := withScanner(scanner)(s); err != nil {
+			return fmt.Errorf("failed to set scanner: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithRemoteSigner makes the wallet watch-only, the psbts are signed by the
+// signer listening at the given address, which holds the keys of the wallet.
+func WithRemoteSigner(cfg RemoteSignerConfig) WalletOption {
+	return func(s *service) error {
+		if s.signer != nil {
+			return fmt.Errorf("remote signer already set")
+		}
+		if len(cfg.Addr) <= 0 {
+			return fmt.Errorf("missing remote signer address")
+		}
+
+		signer, err := newRemoteSigner(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to connect to remote signer: %s", err)
+		}
+		s.signer = signer
+		return nil
+	}
+}
+
 // NewService creates the wallet service, an option must be set to configure the chain source.
 func NewService(cfg WalletConfig, options ...WalletOption) (ports.WalletService, error) {
 	wallet.UseLogger(logger("wallet"))
@@ -224,9 +298,96 @@ func (s *service) Close() {
 	if err := s.wallet.Stop(); err != nil {
 		log.WithError(err).Warn("failed to gracefully stop the wallet, forcing shutdown")
 	}
+	if s.signer != nil {
+		s.signer.close()
+	}
+}
+
+func (s *service) Backup(_ context.Context, dir string) error {
+	if s.wallet == nil {
+		return fmt.Errorf("wallet not initialized")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %s", err)
+	}
+	f, err := os.Create(filepath.Join(dir, wallet.WalletDBName))
+	if err != nil {
+		return err
+	}
+	// nolint
+	defer f.Close()
+
+	// The neutrino db is not backed up, the headers are fetched again at
+	// startup.
+	if err := s.wallet.InternalWallet().Database().Copy(f); err != nil {
+		return fmt.Errorf("failed to backup wallet db: %s", err)
+	}
+	return f.Sync()
+}
+
+// ReadPubkey returns the pubkey of the wallet stored in the given dir, like
+// one extracted from a backup, without starting it. The password is the one
+// of the wallet, which also encrypts its public data.
+func ReadPubkey(
+	dir, password string, network common.Network,
+) (*secp256k1.PublicKey, error) {
+	path := filepath.Join(dir, wallet.WalletDBName)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("wallet db not found: %s", err)
+	}
+	db, err := walletdb.Open("bdb", path, true, time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open wallet db: %s", err)
+	}
+	// nolint
+	defer db.Close()
+
+	var pubkey *secp256k1.PublicKey
+	if err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		if ns == nil {
+			return fmt.Errorf("address manager not found")
+		}
+		mgr, err := waddrmgr.Open(
+			ns, []byte(password), WalletConfig{Network: network}.chainParams(),
+		)
+		if err != nil {
+			return err
+		}
+		defer mgr.Close()
+
+		scopedMgr, err := mgr.FetchScopedKeyManager(p2trKeyScope)
+		if err != nil {
+			return err
+		}
+		account, err := scopedMgr.LookupAccount(ns, string(aspKeyAccount))
+		if err != nil {
+			return err
+		}
+		// the asp key is the first external address of its account.
+		addr, err := scopedMgr.DeriveFromKeyPath(ns, waddrmgr.DerivationPath{
+			InternalAccount: account,
+		})
+		if err != nil {
+			return err
+		}
+		pubkeyAddr, ok := addr.(waddrmgr.ManagedPubKeyAddress)
+		if !ok {
+			return fmt.Errorf("failed to cast address to managed pubkey address")
+		}
+		pubkey = pubkeyAddr.PubKey()
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to read wallet pubkey: %s", err)
+	}
+	return pubkey, nil
 }
 
 func (s *service) GenSeed(_ context.Context) (string, error) {
+	if s.signer != nil {
+		return "", fmt.Errorf("the wallet seed is held by the remote signer")
+	}
 	entropy, err := bip39.NewEntropy(256)
 	if err != nil {
 		return "", err
@@ -256,6 +417,7 @@ func (s *service) Unlock(_ context.Context, password string) error {
 			LoaderOptions:         []btcwallet.LoaderOption{opt},
 			CoinSelectionStrategy: wallet.CoinSelectionLargest,
 			ChainSource:           s.chainSource,
+			WatchOnly:             s.signer != nil,
 		}
 		blockCache := blockcache.NewBlockCache(2 * 1024 * 1024 * 1024)
 
@@ -319,10 +481,17 @@ func (s *service) Unlock(_ context.Context, password string) error {
 		s.wallet = wallet
 		return nil
 	}
+	// a watch-only wallet has no private keys to unlock
+	if s.signer != nil {
+		return nil
+	}
 	return s.wallet.InternalWallet().Unlock([]byte(password), nil)
 }
 
 func (s *service) Lock(_ context.Context, _ string) error {
+	if s.signer != nil {
+		return nil
+	}
 	s.wallet.InternalWallet().Lock()
 	return nil
 }
@@ -343,6 +512,370 @@ func (s *service) BroadcastTransaction(ctx context.Context, txHex string) (strin
 	return tx.TxHash().String(), nil
 }
 
+func (s *service) BumpFeeWithChild(
+	ctx context.Context, txHex string, feeRate uint64,
+) (string, error) {
+	var parent wire.MsgTx
+	if err := parent.Deserialize(hex.NewDecoder(strings.NewReader(txHex))); err != nil {
+		return "", err
+	}
+	parentTxid := parent.TxHash()
+
+	parentFee, parentWeight, err := s.esploraClient.getTxFee(parentTxid.String())
+	if err != nil {
+		return "", fmt.Errorf("failed to get fee of parent tx: %s", err)
+	}
+
+	w := s.wallet.InternalWallet()
+	mainAccountNumber, err := w.AccountNumber(p2wpkhKeyScope, string(mainAccount))
+	if err != nil {
+		return "", err
+	}
+
+	ins := make([]*wire.OutPoint, 0)
+	prevouts := make([]*wire.TxOut, 0)
+	inputAmount := int64(0)
+
+	// only the outputs of the main account are spent, the others, like the
+	// connectors, are reserved
+	for i, out := range parent.TxOut {
+		addr, _, _, err := s.wallet.ScriptForOutput(out)
+		if err != nil || addr.InternalAccount() != mainAccountNumber {
+			continue
+		}
+
+		ins = append(ins, wire.NewOutPoint(&parentTxid, uint32(i)))
+		prevouts = append(prevouts, out)
+		inputAmount += out.Value
+	}
+
+	if len(ins) <= 0 {
+		return "", fmt.Errorf(
+			"tx %s has no outputs owned by the main account", parentTxid,
+		)
+	}
+
+	childFee := func() int64 {
+		parentVsize := (parentWeight + 3) / 4
+		childVsize := int64(childTxBaseVsize + childTxInputVsize*len(ins))
+		return int64(feeRate)*(parentVsize+childVsize)/1000 - int64(parentFee)
+	}
+
+	fee := childFee()
+	if fee <= 0 {
+		return "", fmt.Errorf("tx %s already pays the given fee rate", parentTxid)
+	}
+
+	if inputAmount < fee+dustAmount {
+		utxos, _, err := s.SelectUtxos(ctx, "", uint64(fee+dustAmount-inputAmount))
+		if err != nil {
+			return "", err
+		}
+
+		for _, utxo := range utxos {
+			if utxo.GetTxid() == parentTxid.String() {
+				continue
+			}
+
+			hash, err := chainhash.NewHashFromStr(utxo.GetTxid())
+			if err != nil {
+				return "", err
+			}
+			script, err := hex.DecodeString(utxo.GetScript())
+			if err != nil {
+				return "", err
+			}
+
+			ins = append(ins, wire.NewOutPoint(hash, utxo.GetIndex()))
+			prevouts = append(prevouts, wire.NewTxOut(int64(utxo.GetValue()), script))
+			inputAmount += int64(utxo.GetValue())
+		}
+
+		fee = childFee()
+		if inputAmount < fee+dustAmount {
+			return "", fmt.Errorf(
+				"insufficient funds (%d) to cover fees (%d) for child tx",
+				inputAmount, fee,
+			)
+		}
+	}
+
+	addr, err := s.deriveNextAddress(mainAccount)
+	if err != nil {
+		return "", err
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", err
+	}
+
+	// the child signals replaceability so that it can be replaced by the next
+	// bump
+	sequences := make([]uint32, 0, len(ins))
+	for range ins {
+		sequences = append(sequences, wire.MaxTxInSequenceNum-2)
+	}
+
+	ptx, err := psbt.New(
+		ins,
+		[]*wire.TxOut{wire.NewTxOut(inputAmount-fee, script)},
+		2,
+		0,
+		sequences,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	updater, err := psbt.NewUpdater(ptx)
+	if err != nil {
+		return "", err
+	}
+	for i, prevout := range prevouts {
+		if err := updater.AddInWitnessUtxo(prevout, i); err != nil {
+			return "", err
+		}
+	}
+
+	b64, err := ptx.B64Encode()
+	if err != nil {
+		return "", err
+	}
+
+	childTx, err := s.SignTransaction(ctx, b64, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign child tx: %s", err)
+	}
+
+	return s.BroadcastTransaction(ctx, childTx)
+}
+
+func (s *service) Withdraw(
+	ctx context.Context, address string, amount, feeRate uint64,
+) (string, error) {
+	if amount < dustAmount {
+		return "", fmt.Errorf("amount must be at least %d sats", dustAmount)
+	}
+
+	addr, err := btcutil.DecodeAddress(address, s.cfg.chainParams())
+	if err != nil {
+		return "", fmt.Errorf("invalid address: %s", err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", err
+	}
+
+	changeAddr, err := s.deriveNextAddress(mainAccount)
+	if err != nil {
+		return "", err
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if feeRate == 0 {
+		if feeRate, err = s.EstimateFeeRate(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(int64(amount), script),
+		wire.NewTxOut(0, changeScript),
+	}
+
+	// the more utxos are selected, the higher the fee to cover
+	var utxos []ports.TxInput
+	fee := estimateFee(1, outputs, feeRate)
+	for {
+		utxos, _, err = s.selectUtxos(amount + fee)
+		if err != nil {
+			return "", err
+		}
+		inputAmount := uint64(0)
+		for _, utxo := range utxos {
+			inputAmount += utxo.GetValue()
+		}
+		if inputAmount < amount+fee {
+			return "", fmt.Errorf(
+				"insufficient funds (%d) to send %d sats plus fees (%d)",
+				inputAmount, amount, fee,
+			)
+		}
+
+		fee = estimateFee(len(utxos), outputs, feeRate)
+		if inputAmount < amount+fee {
+			continue
+		}
+
+		change := inputAmount - amount - fee
+		if change < dustAmount {
+			outputs = outputs[:1]
+		} else {
+			outputs[1].Value = int64(change)
+		}
+		break
+	}
+
+	// the utxos are leased to not be selected by a concurrent round
+	if err := s.leaseUtxos(utxos); err != nil {
+		return "", err
+	}
+	txid, err := s.sendToOutputs(ctx, utxos, outputs)
+	if err != nil {
+		s.releaseUtxos(utxos)
+		return "", err
+	}
+	return txid, nil
+}
+
+func (s *service) Consolidate(
+	ctx context.Context, maxInputs uint32, feeRate uint64,
+) (string, error) {
+	w := s.wallet.InternalWallet()
+
+	mainAccountNumber, err := w.AccountNumber(p2wpkhKeyScope, string(mainAccount))
+	if err != nil {
+		return "", err
+	}
+
+	// only the confirmed utxos are merged to not depend on pending txs
+	unspents, err := w.UnspentOutputs(wallet.OutputSelectionPolicy{
+		Account:               mainAccountNumber,
+		RequiredConfirmations: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(unspents) < 2 {
+		return "", fmt.Errorf("not enough utxos to consolidate")
+	}
+
+	sort.SliceStable(unspents, func(i, j int) bool {
+		return unspents[i].Output.Value < unspents[j].Output.Value
+	})
+	if len(unspents) > int(maxInputs) {
+		unspents = unspents[:maxInputs]
+	}
+
+	utxos := make([]ports.TxInput, 0, len(unspents))
+	for _, utxo := range unspents {
+		utxos = append(utxos, transactionOutputTxInput{utxo})
+	}
+
+	// the utxos are leased to not be selected by a concurrent round
+	if err := s.leaseUtxos(utxos); err != nil {
+		return "", err
+	}
+	txid, err := s.sendToMainAccount(ctx, utxos, feeRate)
+	if err != nil {
+		s.releaseUtxos(utxos)
+		return "", err
+	}
+	return txid, nil
+}
+
+func (s *service) ReleaseConnectorUtxos(
+	ctx context.Context, utxos []ports.TxInput, feeRate uint64,
+) (string, error) {
+	if len(utxos) <= 0 {
+		return "", fmt.Errorf("missing connector utxos")
+	}
+
+	return s.sendToMainAccount(ctx, utxos, feeRate)
+}
+
+// sendToMainAccount merges the given utxos into a single output of the main
+// account, paying the given fee rate, or the estimated one if zero.
+func (s *service) sendToMainAccount(
+	ctx context.Context, utxos []ports.TxInput, feeRate uint64,
+) (string, error) {
+	inputAmount := uint64(0)
+	for _, utxo := range utxos {
+		inputAmount += utxo.GetValue()
+	}
+
+	addr, err := s.deriveNextAddress(mainAccount)
+	if err != nil {
+		return "", err
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", err
+	}
+
+	if feeRate == 0 {
+		if feeRate, err = s.EstimateFeeRate(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	outputs := []*wire.TxOut{wire.NewTxOut(0, script)}
+	fee := estimateFee(len(utxos), outputs, feeRate)
+	if inputAmount < fee+dustAmount {
+		return "", fmt.Errorf(
+			"amount of utxos (%d) too low to cover fees (%d)", inputAmount, fee,
+		)
+	}
+	outputs[0].Value = int64(inputAmount - fee)
+
+	return s.sendToOutputs(ctx, utxos, outputs)
+}
+
+// sendToOutputs signs and broadcasts a tx spending the given utxos owned by
+// the wallet to the given outputs.
+func (s *service) sendToOutputs(
+	ctx context.Context, utxos []ports.TxInput, outputs []*wire.TxOut,
+) (string, error) {
+	ins := make([]*wire.OutPoint, 0, len(utxos))
+	prevouts := make([]*wire.TxOut, 0, len(utxos))
+	sequences := make([]uint32, 0, len(utxos))
+	for _, utxo := range utxos {
+		hash, err := chainhash.NewHashFromStr(utxo.GetTxid())
+		if err != nil {
+			return "", err
+		}
+		script, err := hex.DecodeString(utxo.GetScript())
+		if err != nil {
+			return "", err
+		}
+
+		ins = append(ins, wire.NewOutPoint(hash, utxo.GetIndex()))
+		prevouts = append(prevouts, wire.NewTxOut(int64(utxo.GetValue()), script))
+		// signal replaceability to allow bumping the fee
+		sequences = append(sequences, wire.MaxTxInSequenceNum-2)
+	}
+
+	ptx, err := psbt.New(ins, outputs, 2, 0, sequences)
+	if err != nil {
+		return "", err
+	}
+
+	updater, err := psbt.NewUpdater(ptx)
+	if err != nil {
+		return "", err
+	}
+	for i, prevout := range prevouts {
+		if err := updater.AddInWitnessUtxo(prevout, i); err != nil {
+			return "", err
+		}
+	}
+
+	b64, err := ptx.B64Encode()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := s.SignTransaction(ctx, b64, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign tx: %s", err)
+	}
+
+	return s.BroadcastTransaction(ctx, tx)
+}
+
 func (s *service) ConnectorsAccountBalance(ctx context.Context) (uint64, uint64, error) {
 	amount, err := s.getBalance(connectorAccount)
 	if err != nil {
@@ -451,7 +984,67 @@ func (s *service) LockConnectorUtxos(ctx context.Context, utxos []ports.TxOutpoi
 	return nil
 }
 
+// SelectUtxos selects utxos of the main account for the given amount and
+// leases them, so that they're not selected again by another tx before the
+// lease expires.
 func (s *service) SelectUtxos(ctx context.Context, _ string, amount uint64) ([]ports.TxInput, uint64, error) {
+	utxos, change, err := s.selectUtxos(amount)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.leaseUtxos(utxos); err != nil {
+		return nil, 0, err
+	}
+	return utxos, change, nil
+}
+
+// leaseUtxos locks the given utxos for outputLockDuration. The leases of a
+// failed call are released.
+func (s *service) leaseUtxos(utxos []ports.TxInput) error {
+	w := s.wallet.InternalWallet()
+
+	for i, utxo := range utxos {
+		id, err := chainhash.NewHashFromStr(utxo.GetTxid())
+		if err != nil {
+			s.releaseUtxos(utxos[:i])
+			return err
+		}
+		if _, err := w.LeaseOutput(
+			wtxmgr.LockID(id[:]),
+			wire.OutPoint{Hash: *id, Index: utxo.GetIndex()},
+			outputLockDuration,
+		); err != nil {
+			s.releaseUtxos(utxos[:i])
+			return fmt.Errorf(
+				"failed to lease utxo %s:%d: %s", utxo.GetTxid(), utxo.GetIndex(), err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// releaseUtxos unlocks the given utxos leased with leaseUtxos.
+func (s *service) releaseUtxos(utxos []ports.TxInput) {
+	w := s.wallet.InternalWallet()
+
+	for _, utxo := range utxos {
+		id, err := chainhash.NewHashFromStr(utxo.GetTxid())
+		if err != nil {
+			continue
+		}
+		if err := w.ReleaseOutput(
+			wtxmgr.LockID(id[:]),
+			wire.OutPoint{Hash: *id, Index: utxo.GetIndex()},
+		); err != nil {
+			log.WithError(err).Warnf(
+				"failed to release utxo %s:%d", utxo.GetTxid(), utxo.GetIndex(),
+			)
+		}
+	}
+}
+
+func (s *service) selectUtxos(amount uint64) ([]ports.TxInput, uint64, error) {
 	w := s.wallet.InternalWallet()
 
 	mainAccountNumber, err := w.AccountNumber(p2wpkhKeyScope, string(mainAccount))
@@ -584,7 +1177,8 @@ func (s *service) Status(ctx context.Context) (ports.WalletStatus, error) {
 	w := s.wallet.InternalWallet()
 	return status{
 		true,
-		!w.Manager.IsLocked(),
+		// a watch-only wallet is always reported as locked by the manager
+		s.signer != nil || !w.Manager.IsLocked(),
 		w.ChainSynced(),
 	}, nil
 }
@@ -636,6 +1230,14 @@ func (s *service) EstimateFees(ctx context.Context, partialTx string) (uint64, e
 	return uint64(fee.ToUnit(btcutil.AmountSatoshi)), nil
 }
 
+func (s *service) EstimateFeeRate(ctx context.Context) (uint64, error) {
+	feeRate, err := s.esploraClient.getFeeRate()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(feeRate.ToUnit(btcutil.AmountSatoshi)), nil
+}
+
 func (s *service) WatchScripts(ctx context.Context, scripts []string) error {
 	addresses := make([]btcutil.Address, 0, len(scripts))
 
@@ -710,6 +1312,186 @@ func (s *service) IsTransactionConfirmed(
 	return s.esploraClient.getTxStatus(txid)
 }
 
+// GetTransactionBlock returns the height of the block that confirmed the given
+// tx and its median time past.
+func (s *service) GetTransactionBlock(
+	ctx context.Context, txid string,
+) (*ports.BlockInfo, error) {
+	tx, err := s.esploraClient.getTx(txid)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || !tx.Status.Confirmed {
+		return nil, nil
+	}
+
+	hash, err := chainhash.NewHashFromStr(tx.Status.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block hash: %s", err)
+	}
+	medianTime, err := s.medianTimePast(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get median time of block %s: %s", hash, err)
+	}
+
+	return &ports.BlockInfo{
+		Height: tx.Status.BlockHeight, MedianTime: medianTime,
+	}, nil
+}
+
+func (s *service) IsTransactionPublished(
+	ctx context.Context, txid string,
+) (bool, error) {
+	return s.esploraClient.isTxPublished(txid)
+}
+
+func (s *service) GetBlockNotificationChannel(
+	ctx context.Context,
+) (<-chan ports.BlockInfo, error) {
+	if s.wallet == nil {
+		return nil, fmt.Errorf("wallet not initialized")
+	}
+
+	w := s.wallet.InternalWallet()
+	tip := w.Manager.SyncedTo()
+	tipMedianTime, err := s.medianTimePast(&tip.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get median time of chain tip: %s", err)
+	}
+
+	client := w.NtfnServer.TransactionNotifications()
+	ch := make(chan ports.BlockInfo)
+
+	go func() {
+		defer close(ch)
+		defer client.Done()
+
+		notify := func(block ports.BlockInfo) bool {
+			select {
+			case ch <- block:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !notify(ports.BlockInfo{
+			Height: int64(tip.Height), MedianTime: tipMedianTime,
+		}) {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n, ok := <-client.C:
+				if !ok {
+					return
+				}
+				for _, block := range n.AttachedBlocks {
+					medianTime, err := s.medianTimePast(block.Hash)
+					if err != nil {
+						log.WithError(err).Warnf(
+							"failed to get median time of block %s", block.Hash,
+						)
+						continue
+					}
+					if !notify(ports.BlockInfo{
+						Height: int64(block.Height), MedianTime: medianTime,
+					}) {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// GetReorgNotificationChannel notifies the reorgs seen by the wallet, along
+// with its txs moved back to unconfirmed by the disconnected blocks. The blocks
+// themselves are not fetched since the chain source can't serve them once out
+// of the best chain.
+func (s *service) GetReorgNotificationChannel(
+	ctx context.Context,
+) (<-chan ports.Reorg, error) {
+	if s.wallet == nil {
+		return nil, fmt.Errorf("wallet not initialized")
+	}
+
+	client := s.wallet.InternalWallet().NtfnServer.TransactionNotifications()
+	ch := make(chan ports.Reorg)
+
+	go func() {
+		defer close(ch)
+		defer client.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n, ok := <-client.C:
+				if !ok {
+					return
+				}
+				// the disconnected blocks are notified along with those of the
+				// new best chain
+				if len(n.DetachedBlocks) <= 0 {
+					continue
+				}
+
+				select {
+				case ch <- getReorg(n):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// getReorg returns the reorg of the given notification. The txs of the wallet
+// included in the disconnected blocks, and not in the new ones, are among the
+// unconfirmed ones, unless in conflict with the new best chain.
+func getReorg(n *wallet.TransactionNotifications) ports.Reorg {
+	txids := make([]string, 0, len(n.UnminedTransactionHashes))
+	for _, hash := range n.UnminedTransactionHashes {
+		txids = append(txids, hash.String())
+	}
+
+	return ports.Reorg{
+		DisconnectedBlocks: len(n.DetachedBlocks),
+		Txids:              txids,
+	}
+}
+
+// medianTimePast returns the median of the timestamps of the given block and
+// of its predecessors, as defined by BIP113.
+func (s *service) medianTimePast(hash *chainhash.Hash) (int64, error) {
+	timestamps := make([]int64, 0, medianTimeBlocks)
+	for len(timestamps) < medianTimeBlocks {
+		header, err := s.chainSource.GetBlockHeader(hash)
+		if err != nil {
+			return 0, err
+		}
+
+		timestamps = append(timestamps, header.Timestamp.Unix())
+		if header.PrevBlock == (chainhash.Hash{}) {
+			break
+		}
+		hash = &header.PrevBlock
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+	return timestamps[len(timestamps)/2], nil
+}
+
 func (s *service) castNotification(tx *wtxmgr.TxRecord) map[string]ports.VtxoWithValue {
 	vtxos := make(map[string]ports.VtxoWithValue)
 
@@ -735,7 +1517,10 @@ func (s *service) castNotification(tx *wtxmgr.TxRecord) map[string]ports.VtxoWit
 }
 
 func (s *service) create(mnemonic, password string, addrGap uint32) error {
-	if len(mnemonic) <= 0 {
+	if s.signer != nil && len(mnemonic) > 0 {
+		return fmt.Errorf("hd seed must not be set, it is held by the remote signer")
+	}
+	if s.signer == nil && len(mnemonic) <= 0 {
 		return fmt.Errorf("missing hd seed")
 	}
 	if len(password) <= 0 {
@@ -743,7 +1528,6 @@ func (s *service) create(mnemonic, password string, addrGap uint32) error {
 	}
 
 	pwd := []byte(password)
-	seed := bip39.NewSeed(mnemonic, password)
 	opt := btcwallet.LoaderWithLocalWalletDB(s.cfg.Datadir, false, time.Minute)
 	config := btcwallet.Config{
 		LogDir:                s.cfg.Datadir,
@@ -751,12 +1535,21 @@ func (s *service) create(mnemonic, password string, addrGap uint32) error {
 		PublicPass:            pwd,
 		Birthday:              time.Now(),
 		RecoveryWindow:        addrGap,
-		HdSeed:                seed,
 		NetParams:             s.cfg.chainParams(),
 		LoaderOptions:         []btcwallet.LoaderOption{opt},
 		CoinSelectionStrategy: wallet.CoinSelectionLargest,
 		ChainSource:           s.chainSource,
 	}
+	if s.signer != nil {
+		w, err := s.createWatchOnlyWallet(pwd, addrGap)
+		if err != nil {
+			return err
+		}
+		config.Wallet = w
+		config.WatchOnly = true
+	} else {
+		config.HdSeed = bip39.NewSeed(mnemonic, password)
+	}
 	blockCache := blockcache.NewBlockCache(2 * 1024 * 1024 * 1024)
 
 	wallet, err := btcwallet.New(config, blockCache)
@@ -788,55 +1581,65 @@ func (s *service) create(mnemonic, password string, addrGap uint32) error {
 		}
 	}
 
-	wallet.InternalWallet().Lock()
+	if s.signer == nil {
+		wallet.InternalWallet().Lock()
+	}
 	s.wallet = wallet
 	return nil
 }
 
-func (s *service) initWallet(wallet *btcwallet.BtcWallet) error {
-	w := wallet.InternalWallet()
+// createWatchOnlyWallet creates a wallet without private keys, importing the
+// accounts of the remote signer.
+func (s *service) createWatchOnlyWallet(
+	pwd []byte, addrGap uint32,
+) (*wallet.Wallet, error) {
+	accounts, err := s.signer.getAccounts(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
-	walletAccounts, err := w.Accounts(p2wpkhKeyScope)
+	opt := btcwallet.LoaderWithLocalWalletDB(s.cfg.Datadir, false, time.Minute)
+	loader, err := btcwallet.NewWalletLoader(s.cfg.chainParams(), addrGap, opt)
 	if err != nil {
-		return fmt.Errorf("failed to list wallet accounts: %s", err)
+		return nil, fmt.Errorf("failed to setup wallet loader: %s", err)
 	}
-	var mainAccountNumber, connectorAccountNumber, aspKeyAccountNumber uint32
-	if walletAccounts != nil {
-		for _, account := range walletAccounts.Accounts {
-			switch account.AccountName {
-			case string(mainAccount):
-				mainAccountNumber = account.AccountNumber
-			case string(connectorAccount):
-				connectorAccountNumber = account.AccountNumber
-			case string(aspKeyAccount):
-				aspKeyAccountNumber = account.AccountNumber
-			default:
-				continue
-			}
-		}
+
+	w, err := loader.CreateNewWatchingOnlyWallet(pwd, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watch-only wallet: %s", err)
 	}
 
-	if mainAccountNumber == 0 && connectorAccountNumber == 0 && aspKeyAccountNumber == 0 {
-		log.Debug("creating default accounts for ark wallet...")
-		mainAccountNumber, err = w.NextAccount(p2wpkhKeyScope, string(mainAccount))
+	// accounts are numbered in the order they are imported, which is the same
+	// of the signer one.
+	for _, account := range accounts {
+		xpub, err := hdkeychain.NewKeyFromString(account.Xpub)
 		if err != nil {
-			return fmt.Errorf("failed to create %s: %s", mainAccount, err)
+			// nolint
+			loader.UnloadWallet()
+			return nil, fmt.Errorf("invalid xpub of account %s: %s", account.Name, err)
 		}
 
-		connectorAccountNumber, err = w.NextAccount(p2wpkhKeyScope, string(connectorAccount))
-		if err != nil {
-			return fmt.Errorf("failed to create %s: %s", connectorAccount, err)
+		scope := waddrmgr.KeyScope{Purpose: account.Purpose, Coin: account.CoinType}
+		addrSchema := waddrmgr.ScopeAddrMap[p2wpkhKeyScope]
+		if schema, ok := waddrmgr.ScopeAddrMap[scope]; ok {
+			addrSchema = schema
 		}
 
-		aspKeyAccountNumber, err = w.NextAccount(p2trKeyScope, string(aspKeyAccount))
-		if err != nil {
-			return fmt.Errorf("failed to create %s: %s", aspKeyAccount, err)
+		if _, err := w.ImportAccountWithScope(
+			account.Name, xpub, account.MasterKeyFingerprint, scope, addrSchema,
+		); err != nil {
+			// nolint
+			loader.UnloadWallet()
+			return nil, fmt.Errorf("failed to import account %s: %s", account.Name, err)
 		}
 	}
+	return w, nil
+}
 
-	log.Debugf("main account number: %d", mainAccountNumber)
-	log.Debugf("connector account number: %d", connectorAccountNumber)
-	log.Debugf("asp key account number: %d", aspKeyAccountNumber)
+func (s *service) initWallet(wallet *btcwallet.BtcWallet) error {
+	if err := initAccounts(wallet.InternalWallet()); err != nil {
+		return err
+	}
 
 	addrs, err := wallet.ListAddresses(string(aspKeyAccount), false)
 	if err != nil {
@@ -899,6 +1702,53 @@ func (s *service) initWallet(wallet *btcwallet.BtcWallet) error {
 	return nil
 }
 
+// initAccounts creates the accounts of the ark wallet if they don't exist.
+func initAccounts(w *wallet.Wallet) error {
+	walletAccounts, err := w.Accounts(p2wpkhKeyScope)
+	if err != nil {
+		return fmt.Errorf("failed to list wallet accounts: %s", err)
+	}
+	var mainAccountNumber, connectorAccountNumber, aspKeyAccountNumber uint32
+	if walletAccounts != nil {
+		for _, account := range walletAccounts.Accounts {
+			switch account.AccountName {
+			case string(mainAccount):
+				mainAccountNumber = account.AccountNumber
+			case string(connectorAccount):
+				connectorAccountNumber = account.AccountNumber
+			case string(aspKeyAccount):
+				aspKeyAccountNumber = account.AccountNumber
+			default:
+				continue
+			}
+		}
+	}
+
+	if mainAccountNumber == 0 && connectorAccountNumber == 0 && aspKeyAccountNumber == 0 {
+		log.Debug("creating default accounts for ark wallet...")
+		mainAccountNumber, err = w.NextAccount(p2wpkhKeyScope, string(mainAccount))
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %s", mainAccount, err)
+		}
+
+		connectorAccountNumber, err = w.NextAccount(p2wpkhKeyScope, string(connectorAccount))
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %s", connectorAccount, err)
+		}
+
+		aspKeyAccountNumber, err = w.NextAccount(p2trKeyScope, string(aspKeyAccount))
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %s", aspKeyAccount, err)
+		}
+	}
+
+	log.Debugf("main account number: %d", mainAccountNumber)
+	log.Debugf("connector account number: %d", connectorAccountNumber)
+	log.Debugf("asp key account number: %d", aspKeyAccountNumber)
+
+	return nil
+}
+
 func (s *service) getBalance(account accountName) (uint64, error) {
 	balance, err := s.wallet.ConfirmedBalance(0, string(account))
 	if err != nil {
@@ -967,6 +1817,16 @@ func (s status) IsSynced() bool {
 	return s.synced
 }
 
+// estimateFee returns the fee, at the given rate in sats per kvbyte, of a tx
+// spending the given number of p2wpkh inputs to the given outputs.
+func estimateFee(numInputs int, outputs []*wire.TxOut, feeRate uint64) uint64 {
+	vsize := txBaseVsize + childTxInputVsize*numInputs
+	for _, out := range outputs {
+		vsize += out.SerializeSize()
+	}
+	return feeRate * uint64(vsize) / 1000
+}
+
 func fromOutputScript(script []byte, netParams *chaincfg.Params) (btcutil.Address, error) {
 	return btcutil.NewAddressTaproot(script[2:], netParams)
 }
@@ -0,0 +1,83 @@
+package btcwallet
+
+import (
+	"testing"
+
+	"github.com/ark-network/ark/common"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-bip39"
+)
+
+func TestReadPubkey(t *testing.T) {
+	entropy, err := bip39.NewEntropy(128)
+	require.NoError(t, err)
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	signer, err := NewSigner(WalletConfig{
+		Datadir: dir,
+		Network: common.BitcoinRegTest,
+	}, mnemonic, "password", SignerPolicy{})
+	require.NoError(t, err)
+
+	// the asp key is the first external address of the asp key account.
+	w := signer.wallet.InternalWallet()
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(p2trKeyScope)
+	require.NoError(t, err)
+	var expectedPubkey []byte
+	err = walletdb.View(w.Database(), func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		account, err := scopedMgr.LookupAccount(ns, string(aspKeyAccount))
+		if err != nil {
+			return err
+		}
+		addr, err := scopedMgr.DeriveFromKeyPath(ns, waddrmgr.DerivationPath{
+			InternalAccount: account,
+		})
+		if err != nil {
+			return err
+		}
+		expectedPubkey = addr.(waddrmgr.ManagedPubKeyAddress).PubKey().SerializeCompressed()
+		return nil
+	})
+	require.NoError(t, err)
+	signer.Close()
+
+	t.Run("valid", func(t *testing.T) {
+		pubkey, err := ReadPubkey(dir, "password", common.BitcoinRegTest)
+		require.NoError(t, err)
+		require.Equal(t, expectedPubkey, pubkey.SerializeCompressed())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fixtures := []struct {
+			name        string
+			dir         string
+			password    string
+			expectedErr string
+		}{
+			{
+				name:        "missing_wallet",
+				dir:         t.TempDir(),
+				password:    "password",
+				expectedErr: "wallet db not found",
+			},
+			{
+				name:        "wrong_password",
+				dir:         dir,
+				password:    "wrong",
+				expectedErr: "failed to read wallet pubkey",
+			},
+		}
+
+		for _, f := range fixtures {
+			t.Run(f.name, func(t *testing.T) {
+				_, err := ReadPubkey(f.dir, f.password, common.BitcoinRegTest)
+				require.ErrorContains(t, err, f.expectedErr)
+			})
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 
 	pb "github.com/ark-network/ark/api-spec/protobuf/gen/ocean/v1"
 	"github.com/ark-network/ark/server/internal/core/ports"
@@ -45,3 +46,19 @@ func calcScriptHash(script string) string {
 	hash, _ := chainhash.NewHash(hashedBuf[:])
 	return hash.String()
 }
+
+// GetBlockNotificationChannel is not supported since ocean doesn't notify
+// about new blocks.
+func (s *service) GetBlockNotificationChannel(
+	ctx context.Context,
+) (<-chan ports.BlockInfo, error) {
+	return nil, fmt.Errorf("block notifications are not supported by ocean")
+}
+
+// GetReorgNotificationChannel is not supported since ocean doesn't notify
+// about chain reorganisations.
+func (s *service) GetReorgNotificationChannel(
+	ctx context.Context,
+) (<-chan ports.Reorg, error) {
+	return nil, fmt.Errorf("reorg notifications are not supported by ocean")
+}
@@ -65,6 +65,12 @@ func (s *service) Close() {
 	s.conn.Close()
 }
 
+// Backup does nothing, the wallet is held by ocean, which takes care of
+// backing it up.
+func (s *service) Backup(_ context.Context, _ string) error {
+	return nil
+}
+
 func (s *service) GenSeed(ctx context.Context) (string, error) {
 	res, err := s.walletClient.GenSeed(ctx, &pb.GenSeedRequest{})
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,12 +14,17 @@ import (
 	"github.com/ark-network/ark/server/internal/core/ports"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/network"
 	"github.com/vulpemventures/go-elements/psetv2"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 const (
 	zero32 = "0000000000000000000000000000000000000000000000000000000000000000"
+	// 0.1 sat/vbyte
+	liquidFeeRate = uint64(100)
 )
 
 func (s *service) SignTransaction(
@@ -99,6 +105,201 @@ func (s *service) BroadcastTransaction(
 	return res.GetTxid(), nil
 }
 
+// BumpFeeWithChild is not supported since Liquid txs pay the fixed min relay
+// fee rate and are expected to confirm in the next block.
+func (s *service) BumpFeeWithChild(
+	ctx context.Context, txHex string, feeRate uint64,
+) (string, error) {
+	return "", fmt.Errorf(
+		"fee bumping is not supported on liquid, txs pay the fixed fee rate of %d sats/kvbyte",
+		liquidFeeRate,
+	)
+}
+
+func (s *service) Withdraw(
+	ctx context.Context, addr string, amount, feeRate uint64,
+) (string, error) {
+	net, err := address.NetworkForAddress(addr)
+	if err != nil {
+		return "", fmt.Errorf("invalid address: %s", err)
+	}
+	if feeRate == 0 {
+		feeRate = liquidFeeRate
+	}
+
+	// the fee rate in sats/kvbyte matches the one in millisats/byte
+	res, err := s.txClient.Transfer(ctx, &pb.TransferRequest{
+		AccountName: arkAccount,
+		Receivers: []*pb.Output{{
+			Asset:   net.AssetID,
+			Amount:  amount,
+			Address: addr,
+		}},
+		MillisatsPerByte: feeRate,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.BroadcastTransaction(ctx, res.GetTxHex())
+}
+
+func (s *service) Consolidate(
+	ctx context.Context, maxInputs uint32, feeRate uint64,
+) (string, error) {
+	res, err := s.accountClient.ListUtxos(ctx, &pb.ListUtxosRequest{
+		AccountName: arkAccount,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// only the unconfidential LBTC utxos can be merged without blinding
+	utxos := make([]*pb.Utxo, 0)
+	for _, utxo := range res.GetSpendableUtxos().GetUtxos() {
+		if !isLbtc(utxo.GetAsset()) ||
+			utxo.GetAssetBlinder() != zero32 || utxo.GetValueBlinder() != zero32 {
+			continue
+		}
+		utxos = append(utxos, utxo)
+	}
+	if len(utxos) < 2 {
+		return "", fmt.Errorf("not enough utxos to consolidate")
+	}
+
+	sort.SliceStable(utxos, func(i, j int) bool {
+		return utxos[i].GetValue() < utxos[j].GetValue()
+	})
+	if len(utxos) > int(maxInputs) {
+		utxos = utxos[:maxInputs]
+	}
+
+	inputs := make([]ports.TxInput, 0, len(utxos))
+	for _, utxo := range utxos {
+		inputs = append(inputs, utxo)
+	}
+
+	return s.sendToArkAccount(ctx, inputs, feeRate)
+}
+
+func (s *service) ReleaseConnectorUtxos(
+	ctx context.Context, utxos []ports.TxInput, feeRate uint64,
+) (string, error) {
+	if len(utxos) <= 0 {
+		return "", fmt.Errorf("missing connector utxos")
+	}
+
+	return s.sendToArkAccount(ctx, utxos, feeRate)
+}
+
+// sendToArkAccount merges the given unconfidential LBTC utxos into a single
+// output of the ark account, paying the given fee rate, or the min relay one
+// if zero.
+func (s *service) sendToArkAccount(
+	ctx context.Context, utxos []ports.TxInput, feeRate uint64,
+) (string, error) {
+	addresses, err := s.deriveAddresses(ctx, 1, arkAccount)
+	if err != nil {
+		return "", err
+	}
+	net, err := address.NetworkForAddress(addresses[0])
+	if err != nil {
+		return "", err
+	}
+	script, err := address.ToOutputScript(addresses[0])
+	if err != nil {
+		return "", err
+	}
+	if feeRate == 0 {
+		feeRate = liquidFeeRate
+	}
+
+	ptx, _ := psetv2.New(nil, nil, nil)
+	updater, _ := psetv2.NewUpdater(ptx)
+
+	inputs := make([]*pb.Input, 0, len(utxos))
+	inputAmount := uint64(0)
+	for i, utxo := range utxos {
+		if err := updater.AddInputs([]psetv2.InputArgs{{
+			Txid:    utxo.GetTxid(),
+			TxIndex: utxo.GetIndex(),
+		}}); err != nil {
+			return "", err
+		}
+
+		assetBytes, err := elementsutil.AssetHashToBytes(utxo.GetAsset())
+		if err != nil {
+			return "", err
+		}
+		valueBytes, err := elementsutil.ValueToBytes(utxo.GetValue())
+		if err != nil {
+			return "", err
+		}
+		prevoutScript, err := hex.DecodeString(utxo.GetScript())
+		if err != nil {
+			return "", err
+		}
+		if err := updater.AddInWitnessUtxo(
+			i, transaction.NewTxOutput(assetBytes, valueBytes, prevoutScript),
+		); err != nil {
+			return "", err
+		}
+
+		inputs = append(inputs, &pb.Input{
+			Txid:   utxo.GetTxid(),
+			Index:  utxo.GetIndex(),
+			Script: utxo.GetScript(),
+		})
+		inputAmount += utxo.GetValue()
+	}
+
+	fees, err := s.txClient.EstimateFees(ctx, &pb.EstimateFeesRequest{
+		Inputs: inputs,
+		Outputs: []*pb.Output{{
+			Asset:  net.AssetID,
+			Amount: inputAmount,
+			Script: hex.EncodeToString(script),
+		}},
+		MillisatsPerByte: feeRate,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to estimate fees: %s", err)
+	}
+	// we add 5 sats in order to avoid min-relay-fee not met errors
+	fee := fees.GetFeeAmount() + 5
+	if inputAmount <= fee {
+		return "", fmt.Errorf(
+			"amount of utxos (%d) too low to cover fees (%d)", inputAmount, fee,
+		)
+	}
+
+	if err := updater.AddOutputs([]psetv2.OutputArgs{
+		{
+			Asset:  net.AssetID,
+			Amount: inputAmount - fee,
+			Script: script,
+		},
+		{
+			Asset:  net.AssetID,
+			Amount: fee,
+		},
+	}); err != nil {
+		return "", err
+	}
+
+	b64, err := ptx.ToBase64()
+	if err != nil {
+		return "", err
+	}
+
+	txHex, err := s.SignTransaction(ctx, b64, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign tx: %s", err)
+	}
+
+	return s.BroadcastTransaction(ctx, txHex)
+}
+
 func (s *service) IsTransactionConfirmed(
 	ctx context.Context, txid string,
 ) (bool, int64, error) {
@@ -112,6 +313,27 @@ func (s *service) IsTransactionConfirmed(
 
 	return isConfirmed, blocktime, nil
 }
+
+// GetTransactionBlock is not supported since ocean doesn't return the median
+// time of blocks.
+func (s *service) GetTransactionBlock(
+	ctx context.Context, txid string,
+) (*ports.BlockInfo, error) {
+	return nil, fmt.Errorf("median time of blocks not supported by ocean")
+}
+
+func (s *service) IsTransactionPublished(
+	ctx context.Context, txid string,
+) (bool, error) {
+	if _, _, _, err := s.getTransaction(ctx, txid); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "missing transaction") {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func (s *service) WaitForSync(ctx context.Context, txid string) error {
 	for {
 		time.Sleep(5 * time.Second)
@@ -281,6 +503,12 @@ func (s *service) EstimateFees(
 	return fee.GetFeeAmount() + 5, nil
 }
 
+// EstimateFeeRate returns the min relay fee rate of Liquid, since ocean
+// doesn't expose any fee rate estimation.
+func (s *service) EstimateFeeRate(ctx context.Context) (uint64, error) {
+	return liquidFeeRate, nil
+}
+
 func (s *service) getTransaction(
 	ctx context.Context, txid string,
 ) (string, bool, int64, error) {
@@ -298,3 +526,9 @@ func (s *service) getTransaction(
 	// if not confirmed, we return now + 1 min to estimate the next blocktime
 	return res.GetTxHex(), false, time.Now().Add(time.Minute).Unix(), nil
 }
+
+func isLbtc(asset string) bool {
+	return asset == network.Liquid.AssetID ||
+		asset == network.Testnet.AssetID ||
+		asset == network.Regtest.AssetID
+}
@@ -0,0 +1,138 @@
+package grpcservice
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ark-network/ark/server/internal/core/application"
+	"github.com/ark-network/ark/server/internal/infrastructure/backup"
+	log "github.com/sirupsen/logrus"
+)
+
+const backupFileExt = ".arkbackup"
+
+// onBackup copies the dbs and the macaroons into a staging dir while the
+// rounds are paused, then archives them into the backups dir. It returns the
+// path of the archive.
+func (s *service) onBackup(
+	ctx context.Context, password string,
+) (string, *backup.Manifest, error) {
+	appSvc, err := s.appConfig.AppService()
+	if err != nil {
+		return "", nil, err
+	}
+	info, err := appSvc.GetInfo(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The archive mirrors the datadir, the db dir must be part of it.
+	dbDir, err := filepath.Rel(s.config.Datadir, s.appConfig.DbDir)
+	if err != nil || !filepath.IsLocal(dbDir) {
+		return "", nil, fmt.Errorf(
+			"db dir %s must be in datadir to be backed up", s.appConfig.DbDir,
+		)
+	}
+
+	backupsDir := s.config.backupsDatadir()
+	if err := os.MkdirAll(backupsDir, 0700); err != nil {
+		return "", nil, fmt.Errorf("failed to create backups dir: %s", err)
+	}
+	stagingDir, err := os.MkdirTemp(backupsDir, ".staging-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create staging dir: %s", err)
+	}
+	// nolint
+	defer os.RemoveAll(stagingDir)
+
+	createdAt := time.Now().Unix()
+	if err := s.snapshot(
+		ctx, appSvc, filepath.Join(stagingDir, dbDir), stagingDir,
+	); err != nil {
+		return "", nil, err
+	}
+
+	manifest := &backup.Manifest{
+		CreatedAt: createdAt,
+		Network:   info.Network,
+		Pubkey:    info.PubKey,
+	}
+	path := filepath.Join(
+		backupsDir, fmt.Sprintf("backup-%d%s", createdAt, backupFileExt),
+	)
+	if err := writeArchive(path, stagingDir, manifest, password); err != nil {
+		return "", nil, fmt.Errorf("failed to write archive: %s", err)
+	}
+
+	log.Infof("backup written at path %s", path)
+	return path, manifest, nil
+}
+
+// snapshot pauses the rounds at a round boundary, along with the sweeps, the
+// scanner notifications and the async payments, to copy the dbs into the given
+// db dir and the macaroon db into the given macaroons dir.
+func (s *service) snapshot(
+	ctx context.Context, appSvc application.Service,
+	dbDir, macaroonsDir string,
+) error {
+	resume, err := appSvc.PauseRounds(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to pause rounds: %s", err)
+	}
+	log.Info("paused rounds for backup")
+	defer func() {
+		resume()
+		log.Info("resumed rounds")
+	}()
+
+	if err := s.appConfig.AdminService().Backup(ctx, dbDir); err != nil {
+		return err
+	}
+
+	if s.macaroonDB == nil {
+		return nil
+	}
+	f, err := os.Create(filepath.Join(macaroonsDir, macaroonsDbFile))
+	if err != nil {
+		return err
+	}
+	// nolint
+	defer f.Close()
+
+	if err := s.macaroonDB.Copy(f); err != nil {
+		return fmt.Errorf("failed to backup macaroon db: %s", err)
+	}
+	return f.Sync()
+}
+
+// writeArchive writes the archive to a temporary file renamed once complete,
+// to not leave partial archives at the given path.
+func writeArchive(
+	path, dir string, manifest *backup.Manifest, password string,
+) error {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := backup.Write(f, dir, manifest, password); err != nil {
+		// nolint
+		f.Close()
+		// nolint
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		// nolint
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
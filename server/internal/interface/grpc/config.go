@@ -81,6 +81,10 @@ func (c Config) macaroonsDatadir() string {
 	return filepath.Join(c.Datadir, macaroonsFolder)
 }
 
+func (c Config) backupsDatadir() string {
+	return filepath.Join(c.Datadir, backupsFolder)
+}
+
 func (c Config) tlsDatadir() string {
 	return filepath.Join(c.Datadir, tlsFolder)
 }
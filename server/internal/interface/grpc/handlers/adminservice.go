@@ -6,6 +6,8 @@ import (
 
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
 	"github.com/ark-network/ark/server/internal/core/application"
+	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/ark-network/ark/server/internal/infrastructure/backup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -13,12 +15,17 @@ import (
 type adminHandler struct {
 	adminService application.AdminService
 	aspService   application.Service
+
+	onBackup func(
+		ctx context.Context, password string,
+	) (string, *backup.Manifest, error)
 }
 
 func NewAdminHandler(
 	adminService application.AdminService, aspService application.Service,
+	onBackup func(ctx context.Context, password string) (string, *backup.Manifest, error),
 ) arkv1.AdminServiceServer {
-	return &adminHandler{adminService, aspService}
+	return &adminHandler{adminService, aspService, onBackup}
 }
 
 func (a *adminHandler) GetRoundDetails(ctx context.Context, req *arkv1.GetRoundDetailsRequest) (*arkv1.GetRoundDetailsResponse, error) {
@@ -62,12 +69,29 @@ func (a *adminHandler) GetRounds(ctx context.Context, req *arkv1.GetRoundsReques
 		return nil, status.Error(codes.InvalidArgument, "invalid range")
 	}
 
-	rounds, err := a.adminService.GetRounds(ctx, startAfter, startBefore)
+	cursor := req.GetCursor()
+	if len(cursor) > 0 {
+		if _, _, err := domain.ParseRoundCursor(cursor); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	filter := domain.RoundFilter{
+		StartedAfter:  startAfter,
+		StartedBefore: startBefore,
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	page := domain.Page{Cursor: cursor, Size: pageSize}
+
+	rounds, nextCursor, err := a.adminService.GetRounds(ctx, filter, page)
 	if err != nil {
 		return nil, err
 	}
 
-	return &arkv1.GetRoundsResponse{Rounds: rounds}, nil
+	return &arkv1.GetRoundsResponse{Rounds: rounds, NextCursor: nextCursor}, nil
 }
 
 func (a *adminHandler) GetScheduledSweep(ctx context.Context, _ *arkv1.GetScheduledSweepRequest) (*arkv1.GetScheduledSweepResponse, error) {
@@ -87,18 +111,195 @@ func (a *adminHandler) GetScheduledSweep(ctx context.Context, _ *arkv1.GetSchedu
 				Vout:        output.Vout,
 				ScheduledAt: output.ScheduledAt,
 				Amount:      convertSatoshis(output.Amount),
+				SweepTxid:   output.SweepTxid,
 			})
 		}
 
 		sweeps = append(sweeps, &arkv1.ScheduledSweep{
-			RoundId: sweep.RoundId,
-			Outputs: outputs,
+			RoundId:     sweep.RoundId,
+			Outputs:     outputs,
+			Status:      sweep.Status,
+			ScheduledAt: sweep.ScheduledAt,
+			AttemptedAt: sweep.AttemptedAt,
+			Amount:      convertSatoshis(sweep.Amount),
+			Error:       sweep.Error,
 		})
 	}
 
 	return &arkv1.GetScheduledSweepResponse{Sweeps: sweeps}, nil
 }
 
+func (a *adminHandler) ListBans(ctx context.Context, _ *arkv1.ListBansRequest) (*arkv1.ListBansResponse, error) {
+	bans, err := a.adminService.ListBans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*arkv1.Ban, 0, len(bans))
+	for _, ban := range bans {
+		strikes := make([]*arkv1.Strike, 0, len(ban.Strikes))
+		for _, strike := range ban.Strikes {
+			strikes = append(strikes, &arkv1.Strike{
+				Reason:    strike.Reason,
+				RoundId:   strike.RoundId,
+				Timestamp: strike.Timestamp,
+			})
+		}
+		list = append(list, &arkv1.Ban{
+			Key:         ban.Key,
+			Strikes:     strikes,
+			BannedUntil: ban.BannedUntil,
+		})
+	}
+
+	return &arkv1.ListBansResponse{Bans: list}, nil
+}
+
+func (a *adminHandler) LiftBan(ctx context.Context, req *arkv1.LiftBanRequest) (*arkv1.LiftBanResponse, error) {
+	key := req.GetKey()
+	if len(key) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing key")
+	}
+
+	if err := a.adminService.LiftBan(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &arkv1.LiftBanResponse{}, nil
+}
+
+func (a *adminHandler) ListPendingTxs(ctx context.Context, _ *arkv1.ListPendingTxsRequest) (*arkv1.ListPendingTxsResponse, error) {
+	pendingTxs, err := a.adminService.ListPendingTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*arkv1.PendingTx, 0, len(pendingTxs))
+	for _, tx := range pendingTxs {
+		txs = append(txs, &arkv1.PendingTx{
+			Txid:          tx.Txid,
+			Type:          tx.Type,
+			BroadcastedAt: tx.BroadcastedAt,
+			BumpedAt:      tx.BumpedAt,
+			FeeRate:       tx.FeeRate,
+			ChildTxid:     tx.ChildTxid,
+		})
+	}
+
+	return &arkv1.ListPendingTxsResponse{Txs: txs}, nil
+}
+
+func (a *adminHandler) BumpTxFee(ctx context.Context, req *arkv1.BumpTxFeeRequest) (*arkv1.BumpTxFeeResponse, error) {
+	txid := req.GetTxid()
+	if len(txid) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing txid")
+	}
+
+	bumpTxid, err := a.adminService.BumpTxFee(ctx, txid, req.GetFeeRate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &arkv1.BumpTxFeeResponse{Txid: bumpTxid}, nil
+}
+
+func (a *adminHandler) GetLiquidityForecast(ctx context.Context, req *arkv1.GetLiquidityForecastRequest) (*arkv1.GetLiquidityForecastResponse, error) {
+	lookback := req.GetLookback()
+	if lookback < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid lookback (must be >= 0)")
+	}
+
+	forecast, err := a.adminService.GetLiquidityForecast(
+		ctx, req.GetAlertThreshold(), lookback,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]*arkv1.LiquidityPoint, 0, len(forecast.Timeline))
+	for _, point := range forecast.Timeline {
+		timeline = append(timeline, &arkv1.LiquidityPoint{
+			Timestamp: point.Timestamp,
+			Locked:    convertSatoshis(point.Locked),
+			Available: convertSatoshis(point.Available),
+			Swept:     convertSatoshis(point.Swept),
+			Alert:     point.Alert,
+		})
+	}
+
+	return &arkv1.GetLiquidityForecastResponse{
+		MainAccount: &arkv1.LiquidityBalance{
+			Locked:    convertSatoshis(forecast.MainAccountBalance.Locked),
+			Available: convertSatoshis(forecast.MainAccountBalance.Available),
+		},
+		ConnectorsAccount: &arkv1.LiquidityBalance{
+			Locked:    convertSatoshis(forecast.ConnectorsAccountBalance.Locked),
+			Available: convertSatoshis(forecast.ConnectorsAccountBalance.Available),
+		},
+		VtxosLiability:     convertSatoshis(forecast.VtxosLiability),
+		RecentRoundsAmount: convertSatoshis(forecast.RecentRoundsAmount),
+		Lookback:           forecast.Lookback,
+		AlertThreshold:     convertSatoshis(forecast.AlertThreshold),
+		Timeline:           timeline,
+	}, nil
+}
+
+func (a *adminHandler) GetConnectorsRelease(ctx context.Context, _ *arkv1.GetConnectorsReleaseRequest) (*arkv1.GetConnectorsReleaseResponse, error) {
+	release, err := a.adminService.GetConnectorsRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	connectors := make([]*arkv1.ReleasableConnector, 0, len(release.Connectors))
+	for _, connector := range release.Connectors {
+		connectors = append(connectors, &arkv1.ReleasableConnector{
+			Txid:      connector.Txid,
+			Vout:      connector.Vout,
+			Amount:    convertSatoshis(connector.Amount),
+			RoundTxid: connector.RoundTxid,
+		})
+	}
+
+	return &arkv1.GetConnectorsReleaseResponse{
+		Connectors: connectors,
+		Amount:     convertSatoshis(release.Amount),
+		FeeRate:    release.FeeRate,
+		SkipReason: release.SkipReason,
+	}, nil
+}
+
+func (a *adminHandler) Backup(ctx context.Context, req *arkv1.BackupRequest) (*arkv1.BackupResponse, error) {
+	password := req.GetPassword()
+	if len(password) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing password")
+	}
+	if a.aspService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "wallet is locked")
+	}
+
+	path, manifest, err := a.onBackup(ctx, password)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*arkv1.BackupEntry, 0, len(manifest.Entries))
+	for _, entry := range manifest.Entries {
+		entries = append(entries, &arkv1.BackupEntry{
+			Path:   entry.Path,
+			Size:   entry.Size,
+			Sha256: entry.Sha256,
+		})
+	}
+
+	return &arkv1.BackupResponse{
+		Path:      path,
+		CreatedAt: manifest.CreatedAt,
+		Network:   manifest.Network,
+		Pubkey:    manifest.Pubkey,
+		Entries:   entries,
+	}, nil
+}
+
 // convert sats to string BTC
 func convertSatoshis(sats uint64) string {
 	btc := float64(sats) * 1e-8
@@ -131,6 +131,7 @@ func (h *handler) Ping(ctx context.Context, req *arkv1.PingRequest) (*arkv1.Ping
 			ForfeitTxs:     forfeits,
 			CongestionTree: castCongestionTree(round.CongestionTree),
 			Connectors:     round.Connectors,
+			Attempt:        int64(round.Attempt()),
 		}
 	}
 	return &arkv1.PingResponse{
@@ -139,17 +140,52 @@ func (h *handler) Ping(ctx context.Context, req *arkv1.PingRequest) (*arkv1.Ping
 	}, nil
 }
 
+func (h *handler) GetPaymentNonce(ctx context.Context, req *arkv1.GetPaymentNonceRequest) (*arkv1.GetPaymentNonceResponse, error) {
+	nonce, expireAt, err := h.svc.GetPaymentNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arkv1.GetPaymentNonceResponse{
+		Nonce:    nonce,
+		ExpireAt: expireAt,
+	}, nil
+}
+
 func (h *handler) RegisterPayment(ctx context.Context, req *arkv1.RegisterPaymentRequest) (*arkv1.RegisterPaymentResponse, error) {
 	vtxosKeys, err := parseInputs(req.GetInputs())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	id, err := h.svc.SpendVtxos(ctx, vtxosKeys)
+	signatures, err := parseSignaturesByPubkey(req.GetSignatures())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetNonce() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing nonce")
+	}
+
+	var ephemeralPubkey *secp256k1.PublicKey
+	if len(req.GetEphemeralPubkey()) > 0 {
+		ephemeralPubkey, err = parsePubkey(req.GetEphemeralPubkey())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	id, err := h.svc.SpendVtxos(ctx, vtxosKeys, signatures, req.GetNonce())
 	if err != nil {
 		return nil, err
 	}
 
+	if ephemeralPubkey != nil {
+		if err := h.svc.RegisterCosignerPubkey(ctx, id, ephemeralPubkey); err != nil {
+			return nil, err
+		}
+	}
+
 	return &arkv1.RegisterPaymentResponse{
 		Id: id,
 	}, nil
@@ -173,13 +209,79 @@ func (h *handler) FinalizePayment(ctx context.Context, req *arkv1.FinalizePaymen
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err := h.svc.SignVtxos(ctx, forfeitTxs); err != nil {
+	if len(req.GetPaymentId()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing payment id")
+	}
+	signatures, err := parseSignaturesByPubkey(req.GetSignatures())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.svc.SignVtxos(
+		ctx, req.GetPaymentId(), forfeitTxs, signatures,
+	); err != nil {
 		return nil, err
 	}
 
 	return &arkv1.FinalizePaymentResponse{}, nil
 }
 
+func (h *handler) SendTreeNonces(ctx context.Context, req *arkv1.SendTreeNoncesRequest) (*arkv1.SendTreeNoncesResponse, error) {
+	if len(req.GetRoundId()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing round id")
+	}
+
+	pubkey, err := parsePubkey(req.GetPublicKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.GetTreeNonces()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing tree nonces")
+	}
+
+	signature, err := parseSignatures([]string{req.GetSignature()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.svc.RegisterCosignerNonces(
+		ctx, req.GetRoundId(), pubkey, req.GetTreeNonces(), signature[0],
+	); err != nil {
+		return nil, err
+	}
+
+	return &arkv1.SendTreeNoncesResponse{}, nil
+}
+
+func (h *handler) SendTreeSignatures(ctx context.Context, req *arkv1.SendTreeSignaturesRequest) (*arkv1.SendTreeSignaturesResponse, error) {
+	if len(req.GetRoundId()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing round id")
+	}
+
+	pubkey, err := parsePubkey(req.GetPublicKey())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.GetTreeSignatures()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing tree signatures")
+	}
+
+	signature, err := parseSignatures([]string{req.GetSignature()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.svc.RegisterCosignerSignatures(
+		ctx, req.GetRoundId(), pubkey, req.GetTreeSignatures(), signature[0],
+	); err != nil {
+		return nil, err
+	}
+
+	return &arkv1.SendTreeSignaturesResponse{}, nil
+}
+
 func (h *handler) GetRound(ctx context.Context, req *arkv1.GetRoundRequest) (*arkv1.GetRoundResponse, error) {
 	if len(req.GetTxid()) <= 0 {
 		round, err := h.svc.GetCurrentRound(ctx)
@@ -258,6 +360,8 @@ func (h *handler) GetEventStream(_ *arkv1.GetEventStreamRequest, stream arkv1.Ar
 
 	h.pushListener(listener)
 
+	// Rounds overlap, therefore the stream is kept open until the client
+	// closes it, rather than when a round ends.
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -267,14 +371,6 @@ func (h *handler) GetEventStream(_ *arkv1.GetEventStreamRequest, stream arkv1.Ar
 			if err := stream.Send(ev); err != nil {
 				return err
 			}
-
-			switch ev.Event.(type) {
-			case *arkv1.GetEventStreamResponse_RoundFinalized, *arkv1.GetEventStreamResponse_RoundFailed:
-				if err := stream.Send(ev); err != nil {
-					return err
-				}
-				return nil
-			}
 		}
 	}
 }
@@ -285,7 +381,14 @@ func (h *handler) ListVtxos(ctx context.Context, req *arkv1.ListVtxosRequest) (*
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	spendableVtxos, spentVtxos, err := h.svc.ListVtxos(ctx, userPubkey)
+	filter, page, err := parseListVtxosRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spendableVtxos, spentVtxos, nextCursor, err := h.svc.ListVtxos(
+		ctx, userPubkey, *filter, *page,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -293,6 +396,7 @@ func (h *handler) ListVtxos(ctx context.Context, req *arkv1.ListVtxosRequest) (*
 	return &arkv1.ListVtxosResponse{
 		SpendableVtxos: vtxoList(spendableVtxos).toProto(hrp, aspPubkey),
 		SpentVtxos:     vtxoList(spentVtxos).toProto(hrp, aspPubkey),
+		NextCursor:     nextCursor,
 	}, nil
 }
 
@@ -309,6 +413,14 @@ func (h *handler) GetInfo(ctx context.Context, req *arkv1.GetInfoRequest) (*arkv
 		RoundInterval:       info.RoundInterval,
 		Network:             info.Network,
 		MinRelayFee:         info.MinRelayFee,
+		RoundTrigger: &arkv1.RoundTrigger{
+			Type:        info.RoundTrigger.Type,
+			MinPayments: info.RoundTrigger.MinPayments,
+			MinAmount:   info.RoundTrigger.MinAmount,
+			MaxWait:     info.RoundTrigger.MaxWait,
+		},
+		Fees: feeSchedule(info.Fees).toProto(),
+		Dust: info.DustAmount,
 	}, nil
 }
 
@@ -347,6 +459,7 @@ func (h *handler) listenToEvents() {
 						CongestionTree: castCongestionTree(e.CongestionTree),
 						ForfeitTxs:     e.UnsignedForfeitTxs,
 						Connectors:     e.Connectors,
+						Attempt:        int64(e.Attempt),
 					},
 				},
 			}
@@ -363,8 +476,60 @@ func (h *handler) listenToEvents() {
 			ev = &arkv1.GetEventStreamResponse{
 				Event: &arkv1.GetEventStreamResponse_RoundFailed{
 					RoundFailed: &arkv1.RoundFailed{
-						Id:     e.Id,
-						Reason: e.Err,
+						Id:      e.Id,
+						Reason:  e.Err,
+						Attempt: int64(e.Attempt),
+					},
+				},
+			}
+		case domain.RoundSigningStarted:
+			ev = &arkv1.GetEventStreamResponse{
+				Event: &arkv1.GetEventStreamResponse_RoundSigning{
+					RoundSigning: &arkv1.RoundSigningEvent{
+						Id:               e.Id,
+						CosignersPubkeys: e.Cosigners,
+						UnsignedTree:     castCongestionTree(e.UnsignedTree),
+						UnsignedRoundTx:  e.UnsignedRoundTx,
+					},
+				},
+			}
+		case domain.RoundSigningNoncesGenerated:
+			ev = &arkv1.GetEventStreamResponse{
+				Event: &arkv1.GetEventStreamResponse_RoundSigningNoncesGenerated{
+					RoundSigningNoncesGenerated: &arkv1.RoundSigningNoncesGeneratedEvent{
+						Id:         e.Id,
+						TreeNonces: e.Nonces,
+					},
+				},
+			}
+		case domain.PaymentsDeferred:
+			ev = &arkv1.GetEventStreamResponse{
+				Event: &arkv1.GetEventStreamResponse_PaymentsDeferred{
+					PaymentsDeferred: &arkv1.PaymentsDeferredEvent{
+						Id:         e.Id,
+						PaymentIds: e.PaymentIds,
+					},
+				},
+			}
+		case domain.PaymentsDropped:
+			ev = &arkv1.GetEventStreamResponse{
+				Event: &arkv1.GetEventStreamResponse_PaymentsDropped{
+					PaymentsDropped: &arkv1.PaymentsDroppedEvent{
+						Id:         e.Id,
+						PaymentIds: e.PaymentIds,
+					},
+				},
+			}
+		case domain.PaymentsRegistered:
+			paymentIds := make([]string, 0, len(e.Payments))
+			for _, payment := range e.Payments {
+				paymentIds = append(paymentIds, payment.Id)
+			}
+			ev = &arkv1.GetEventStreamResponse{
+				Event: &arkv1.GetEventStreamResponse_PaymentsRegistered{
+					PaymentsRegistered: &arkv1.PaymentsRegisteredEvent{
+						Id:         e.Id,
+						PaymentIds: paymentIds,
 					},
 				},
 			}
@@ -418,6 +583,22 @@ func (v vtxoList) toProto(hrp string, aspKey *secp256k1.PublicKey) []*arkv1.Vtxo
 	return list
 }
 
+type feeSchedule application.FeeSchedule
+
+func (f feeSchedule) toProto() *arkv1.FeeSchedule {
+	toServiceFee := func(fee application.ServiceFee) *arkv1.ServiceFee {
+		return &arkv1.ServiceFee{
+			Base: fee.Base,
+			Rate: fee.Rate,
+		}
+	}
+	return &arkv1.FeeSchedule{
+		Offchain: toServiceFee(f.Offchain),
+		Onchain:  toServiceFee(f.Onchain),
+		Async:    toServiceFee(f.Async),
+	}
+}
+
 // castCongestionTree converts a tree.CongestionTree to a repeated arkv1.TreeLevel
 func castCongestionTree(congestionTree tree.CongestionTree) *arkv1.Tree {
 	levels := make([]*arkv1.TreeLevel, 0, len(congestionTree))
@@ -7,9 +7,17 @@ import (
 	arkv1 "github.com/ark-network/ark/api-spec/protobuf/gen/ark/v1"
 	"github.com/ark-network/ark/common"
 	"github.com/ark-network/ark/server/internal/core/domain"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
+const (
+	// defaultPageSize is the size of the pages of the lists when not specified.
+	defaultPageSize = 100
+	// maxPageSize is the max size of the pages of the lists.
+	maxPageSize = 1000
+)
+
 func parseTxs(txs []string) ([]string, error) {
 	if len(txs) <= 0 {
 		return nil, fmt.Errorf("missing list of forfeit txs")
@@ -30,6 +38,65 @@ func parseAddress(addr string) (string, *secp256k1.PublicKey, *secp256k1.PublicK
 	return common.DecodeAddress(addr)
 }
 
+func parsePubkey(pubkey string) (*secp256k1.PublicKey, error) {
+	if len(pubkey) <= 0 {
+		return nil, fmt.Errorf("missing pubkey")
+	}
+
+	buf, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pubkey format")
+	}
+
+	key, err := secp256k1.ParsePubKey(buf)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pubkey: %s", err)
+	}
+	return key, nil
+}
+
+func parseSignatures(sigs []string) ([]*schnorr.Signature, error) {
+	if len(sigs) <= 0 {
+		return nil, fmt.Errorf("missing signatures")
+	}
+
+	signatures := make([]*schnorr.Signature, 0, len(sigs))
+	for _, sig := range sigs {
+		buf, err := hex.DecodeString(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature format")
+		}
+		signature, err := schnorr.ParseSignature(buf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %s", err)
+		}
+		signatures = append(signatures, signature)
+	}
+	return signatures, nil
+}
+
+// parseSignaturesByPubkey parses the given signatures, indexed by the hex
+// encoded compressed pubkey of the signer.
+func parseSignaturesByPubkey(sigs map[string]string) (map[string]*schnorr.Signature, error) {
+	if len(sigs) <= 0 {
+		return nil, fmt.Errorf("missing signatures")
+	}
+
+	signatures := make(map[string]*schnorr.Signature, len(sigs))
+	for key, sig := range sigs {
+		pubkey, err := parsePubkey(key)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := parseSignatures([]string{sig})
+		if err != nil {
+			return nil, err
+		}
+		signatures[hex.EncodeToString(pubkey.SerializeCompressed())] = signature[0]
+	}
+	return signatures, nil
+}
+
 func parseInputs(ins []*arkv1.Input) ([]domain.VtxoKey, error) {
 	if len(ins) <= 0 {
 		return nil, fmt.Errorf("missing inputs")
@@ -71,6 +138,55 @@ func parseReceivers(outs []*arkv1.Output) ([]domain.Receiver, error) {
 	return receivers, nil
 }
 
+func parseListVtxosRequest(
+	req *arkv1.ListVtxosRequest,
+) (*domain.VtxoFilter, *domain.Page, error) {
+	minAmount, maxAmount := req.GetMinAmount(), req.GetMaxAmount()
+	if maxAmount > 0 && minAmount > maxAmount {
+		return nil, nil, fmt.Errorf("invalid amount range")
+	}
+	minExpireAt, maxExpireAt := req.GetMinExpireAt(), req.GetMaxExpireAt()
+	if minExpireAt < 0 || maxExpireAt < 0 {
+		return nil, nil, fmt.Errorf("invalid expiry (must be >= 0)")
+	}
+	if maxExpireAt > 0 && minExpireAt > maxExpireAt {
+		return nil, nil, fmt.Errorf("invalid expiry range")
+	}
+	cursor := req.GetCursor()
+	if len(cursor) > 0 {
+		if _, err := domain.ParseVtxoCursor(cursor); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	filter := &domain.VtxoFilter{
+		Spent:       req.Spent,
+		Swept:       req.Swept,
+		Redeemed:    req.Redeemed,
+		MinAmount:   minAmount,
+		MaxAmount:   maxAmount,
+		MinExpireAt: minExpireAt,
+		MaxExpireAt: maxExpireAt,
+		RoundTxid:   req.GetRoundTxid(),
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, nil, err
+	}
+	page := &domain.Page{Cursor: cursor, Size: pageSize}
+	return filter, page, nil
+}
+
+func parsePageSize(size uint32) (int, error) {
+	if size == 0 {
+		return defaultPageSize, nil
+	}
+	if size > maxPageSize {
+		return -1, fmt.Errorf("invalid page size, must be at most %d", maxPageSize)
+	}
+	return int(size), nil
+}
+
 func toRoundStage(stage domain.Stage) arkv1.RoundStage {
 	if stage.Failed {
 		return arkv1.RoundStage_ROUND_STAGE_FAILED
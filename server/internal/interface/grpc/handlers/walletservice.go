@@ -30,9 +30,8 @@ func (a *walletInitHandler) GenSeed(ctx context.Context, _ *arkv1.GenSeedRequest
 }
 
 func (a *walletInitHandler) Create(ctx context.Context, req *arkv1.CreateRequest) (*arkv1.CreateResponse, error) {
-	if len(req.GetSeed()) <= 0 {
-		return nil, fmt.Errorf("missing wallet seed")
-	}
+	// the seed is validated by the wallet, it's not required if the wallet
+	// keys are held by a remote signer
 	if len(req.GetPassword()) <= 0 {
 		return nil, fmt.Errorf("missing wallet password")
 	}
@@ -49,9 +48,8 @@ func (a *walletInitHandler) Create(ctx context.Context, req *arkv1.CreateRequest
 }
 
 func (a *walletInitHandler) Restore(ctx context.Context, req *arkv1.RestoreRequest) (*arkv1.RestoreResponse, error) {
-	if len(req.GetSeed()) <= 0 {
-		return nil, fmt.Errorf("missing wallet seed")
-	}
+	// the seed is validated by the wallet, it's not required if the wallet
+	// keys are held by a remote signer
 	if len(req.GetPassword()) <= 0 {
 		return nil, fmt.Errorf("missing wallet password")
 	}
@@ -142,3 +140,36 @@ func (a *walletHandler) GetBalance(ctx context.Context, _ *arkv1.GetBalanceReque
 		},
 	}, nil
 }
+
+func (a *walletHandler) Withdraw(ctx context.Context, req *arkv1.WithdrawRequest) (*arkv1.WithdrawResponse, error) {
+	if len(req.GetAddress()) <= 0 {
+		return nil, fmt.Errorf("missing address")
+	}
+	if req.GetAmount() <= 0 {
+		return nil, fmt.Errorf("missing amount")
+	}
+
+	txid, err := a.walletService.Withdraw(
+		ctx, req.GetAddress(), req.GetAmount(), req.GetFeeRate(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arkv1.WithdrawResponse{Txid: txid}, nil
+}
+
+func (a *walletHandler) Consolidate(ctx context.Context, req *arkv1.ConsolidateRequest) (*arkv1.ConsolidateResponse, error) {
+	if req.GetMaxInputs() < 2 {
+		return nil, fmt.Errorf("invalid max inputs, must be at least 2")
+	}
+
+	txid, err := a.walletService.Consolidate(
+		ctx, req.GetMaxInputs(), req.GetFeeRate(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arkv1.ConsolidateResponse{Txid: txid}, nil
+}
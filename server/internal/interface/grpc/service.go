@@ -32,6 +32,8 @@ const (
 	macaroonsDbFile   = "macaroons.db"
 	macaroonsFolder   = "macaroons"
 
+	backupsFolder = "backups"
+
 	tlsKeyFile  = "key.pem"
 	tlsCertFile = "cert.pem"
 	tlsFolder   = "tls"
@@ -43,6 +45,7 @@ type service struct {
 	server      *http.Server
 	grpcServer  *grpc.Server
 	macaroonSvc *macaroons.Service
+	macaroonDB  kvdb.Backend
 }
 
 func NewService(
@@ -56,8 +59,9 @@ func NewService(
 	}
 
 	var macaroonSvc *macaroons.Service
+	var macaroonDB kvdb.Backend
 	if !svcConfig.NoMacaroons {
-		macaroonDB, err := kvdb.Create(
+		db, err := kvdb.Create(
 			kvdb.BoltBackendName,
 			filepath.Join(svcConfig.Datadir, macaroonsDbFile),
 			true,
@@ -67,6 +71,8 @@ func NewService(
 			return nil, err
 		}
 
+		macaroonDB = db
+
 		keyStore, err := macaroons.NewRootKeyStorage(macaroonDB)
 		if err != nil {
 			return nil, err
@@ -89,7 +95,9 @@ func NewService(
 		log.Debugf("generated TLS key pair at path: %s", svcConfig.tlsDatadir())
 	}
 
-	return &service{svcConfig, appConfig, nil, nil, macaroonSvc}, nil
+	return &service{
+		svcConfig, appConfig, nil, nil, macaroonSvc, macaroonDB,
+	}, nil
 }
 
 func (s *service) Start() error {
@@ -170,7 +178,9 @@ func (s *service) newServer(tlsConfig *tls.Config, withAppSvc bool) error {
 		arkv1.RegisterArkServiceServer(grpcServer, appHandler)
 	}
 
-	adminHandler := handlers.NewAdminHandler(s.appConfig.AdminService(), appSvc)
+	adminHandler := handlers.NewAdminHandler(
+		s.appConfig.AdminService(), appSvc, s.onBackup,
+	)
 	arkv1.RegisterAdminServiceServer(grpcServer, adminHandler)
 
 	walletHandler := handlers.NewWalletHandler(s.appConfig.WalletService())
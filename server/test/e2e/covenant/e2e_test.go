@@ -12,6 +12,7 @@ import (
 	"github.com/ark-network/ark/common"
 	utils "github.com/ark-network/ark/server/test/e2e"
 	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/address"
 )
 
 const (
@@ -175,6 +176,73 @@ func TestCollaborativeExit(t *testing.T) {
 	require.Equal(t, balanceOnchainBefore+1000, balance.Onchain.Spendable)
 }
 
+func TestWithdraw(t *testing.T) {
+	var receive utils.ArkReceive
+	receiveStr, err := runArkCommand("receive")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(receiveStr), &receive))
+
+	var balance utils.ArkBalance
+	balanceStr, err := runArkCommand("balance")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(balanceStr), &balance))
+	balanceOnchainBefore := balance.Onchain.Spendable
+
+	var withdraw struct {
+		Txid string `json:"txid"`
+	}
+	err = utils.AdminRequest(
+		"POST", "http://localhost:6060/v1/admin/wallet/withdraw",
+		fmt.Sprintf(`{"address": "%s", "amount": 10000}`, receive.Onchain),
+		&withdraw,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, withdraw.Txid)
+
+	time.Sleep(5 * time.Second)
+
+	balanceStr, err = runArkCommand("balance")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(balanceStr), &balance))
+	require.Equal(t, balanceOnchainBefore+10000, balance.Onchain.Spendable)
+}
+
+func TestConsolidate(t *testing.T) {
+	var addr struct {
+		Address string `json:"address"`
+	}
+	err := utils.AdminRequest(
+		"GET", "http://localhost:6060/v1/admin/wallet/address", "", &addr,
+	)
+	require.NoError(t, err)
+
+	// only the unconfidential utxos can be merged
+	info, err := address.FromConfidential(addr.Address)
+	require.NoError(t, err)
+	_, err = utils.RunCommand("nigiri", "faucet", "--liquid", info.Address)
+	require.NoError(t, err)
+	_, err = utils.RunCommand("nigiri", "faucet", "--liquid", info.Address)
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Second)
+
+	var consolidate struct {
+		Txid string `json:"txid"`
+	}
+	err = utils.AdminRequest(
+		"POST", "http://localhost:6060/v1/admin/wallet/consolidate",
+		`{"max_inputs": 2}`, &consolidate,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, consolidate.Txid)
+
+	err = utils.AdminRequest(
+		"POST", "http://localhost:6060/v1/admin/wallet/consolidate",
+		`{"max_inputs": 1}`, nil,
+	)
+	require.Error(t, err)
+}
+
 func runArkCommand(arg ...string) (string, error) {
 	args := append([]string{"exec", "-t", "arkd", "ark"}, arg...)
 	return utils.RunCommand("docker", args...)
@@ -180,6 +180,79 @@ func TestCollaborativeExit(t *testing.T) {
 	require.Equal(t, balanceOnchainBefore+1000, balance.Onchain.Spendable)
 }
 
+func TestWithdraw(t *testing.T) {
+	var receive utils.ArkReceive
+	receiveStr, err := runClarkCommand("receive")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(receiveStr), &receive))
+
+	var balance utils.ArkBalance
+	balanceStr, err := runClarkCommand("balance")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(balanceStr), &balance))
+	balanceOnchainBefore := balance.Onchain.Spendable
+
+	var withdraw struct {
+		Txid string `json:"txid"`
+	}
+	err = utils.AdminRequest(
+		"POST", "http://localhost:7070/v1/admin/wallet/withdraw",
+		fmt.Sprintf(`{"address": "%s", "amount": 10000}`, receive.Onchain),
+		&withdraw,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, withdraw.Txid)
+
+	// the withdrawal must pay at least the dust amount
+	err = utils.AdminRequest(
+		"POST", "http://localhost:7070/v1/admin/wallet/withdraw",
+		fmt.Sprintf(`{"address": "%s", "amount": 100}`, receive.Onchain),
+		nil,
+	)
+	require.Error(t, err)
+
+	time.Sleep(5 * time.Second)
+
+	balanceStr, err = runClarkCommand("balance")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(balanceStr), &balance))
+	require.Equal(t, balanceOnchainBefore+10000, balance.Onchain.Spendable)
+}
+
+func TestConsolidate(t *testing.T) {
+	var addr struct {
+		Address string `json:"address"`
+	}
+	err := utils.AdminRequest(
+		"GET", "http://localhost:7070/v1/admin/wallet/address", "", &addr,
+	)
+	require.NoError(t, err)
+
+	// only the confirmed utxos are merged, the faucet mines a block each time
+	_, err = utils.RunCommand("nigiri", "faucet", addr.Address)
+	require.NoError(t, err)
+	_, err = utils.RunCommand("nigiri", "faucet", addr.Address)
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Second)
+
+	var consolidate struct {
+		Txid string `json:"txid"`
+	}
+	err = utils.AdminRequest(
+		"POST", "http://localhost:7070/v1/admin/wallet/consolidate",
+		`{"max_inputs": 2}`, &consolidate,
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, consolidate.Txid)
+
+	err = utils.AdminRequest(
+		"POST", "http://localhost:7070/v1/admin/wallet/consolidate",
+		`{"max_inputs": 1}`, nil,
+	)
+	require.Error(t, err)
+}
+
 func runClarkCommand(arg ...string) (string, error) {
 	args := append([]string{"exec", "-t", "clarkd", "ark"}, arg...)
 	return utils.RunCommand("docker", args...)